@@ -49,6 +49,7 @@ func Test_ErrorCode(t *testing.T) {
 	assert.Equal(t, pb.ErrorCode_ErrFailedPreCondition, pb.ErrorCode(perun.ErrFailedPreCondition))
 	assert.Equal(t, pb.ErrorCode_ErrInvalidConfig, pb.ErrorCode(perun.ErrInvalidConfig))
 	assert.Equal(t, pb.ErrorCode_ErrInvalidContracts, pb.ErrorCode(perun.ErrInvalidContracts))
+	assert.Equal(t, pb.ErrorCode_ErrSpendingLimitExceeded, pb.ErrorCode(perun.ErrSpendingLimitExceeded))
 	assert.Equal(t, pb.ErrorCode_ErrTxTimedOut, pb.ErrorCode(perun.ErrTxTimedOut))
 	assert.Equal(t, pb.ErrorCode_ErrChainNotReachable, pb.ErrorCode(perun.ErrChainNotReachable))
 	assert.Equal(t, pb.ErrorCode_ErrUnknownInternal, pb.ErrorCode(perun.ErrUnknownInternal))
@@ -106,20 +106,21 @@ const (
 	// Though "0" is an invalid error code, we still define it, because
 	//proto3 requires that every enum definition should have 0 mapped to
 	//atleast one constant.
-	ErrorCode_DefaultInvalidCode      ErrorCode = 0
-	ErrorCode_ErrPeerRequestTimedOut  ErrorCode = 101
-	ErrorCode_ErrPeerRejected         ErrorCode = 102
-	ErrorCode_ErrPeerNotFunded        ErrorCode = 103
-	ErrorCode_ErrUserResponseTimedOut ErrorCode = 104
-	ErrorCode_ErrResourceNotFound     ErrorCode = 201
-	ErrorCode_ErrResourceExists       ErrorCode = 202
-	ErrorCode_ErrInvalidArgument      ErrorCode = 203
-	ErrorCode_ErrFailedPreCondition   ErrorCode = 204
-	ErrorCode_ErrInvalidConfig        ErrorCode = 205
-	ErrorCode_ErrInvalidContracts     ErrorCode = 206
-	ErrorCode_ErrTxTimedOut           ErrorCode = 301
-	ErrorCode_ErrChainNotReachable    ErrorCode = 302
-	ErrorCode_ErrUnknownInternal      ErrorCode = 401
+	ErrorCode_DefaultInvalidCode       ErrorCode = 0
+	ErrorCode_ErrPeerRequestTimedOut   ErrorCode = 101
+	ErrorCode_ErrPeerRejected          ErrorCode = 102
+	ErrorCode_ErrPeerNotFunded         ErrorCode = 103
+	ErrorCode_ErrUserResponseTimedOut  ErrorCode = 104
+	ErrorCode_ErrResourceNotFound      ErrorCode = 201
+	ErrorCode_ErrResourceExists        ErrorCode = 202
+	ErrorCode_ErrInvalidArgument       ErrorCode = 203
+	ErrorCode_ErrFailedPreCondition    ErrorCode = 204
+	ErrorCode_ErrInvalidConfig         ErrorCode = 205
+	ErrorCode_ErrInvalidContracts      ErrorCode = 206
+	ErrorCode_ErrSpendingLimitExceeded ErrorCode = 207
+	ErrorCode_ErrTxTimedOut            ErrorCode = 301
+	ErrorCode_ErrChainNotReachable     ErrorCode = 302
+	ErrorCode_ErrUnknownInternal       ErrorCode = 401
 )
 
 // Enum value maps for ErrorCode.
@@ -136,25 +137,27 @@ var (
 		204: "ErrFailedPreCondition",
 		205: "ErrInvalidConfig",
 		206: "ErrInvalidContracts",
+		207: "ErrSpendingLimitExceeded",
 		301: "ErrTxTimedOut",
 		302: "ErrChainNotReachable",
 		401: "ErrUnknownInternal",
 	}
 	ErrorCode_value = map[string]int32{
-		"DefaultInvalidCode":      0,
-		"ErrPeerRequestTimedOut":  101,
-		"ErrPeerRejected":         102,
-		"ErrPeerNotFunded":        103,
-		"ErrUserResponseTimedOut": 104,
-		"ErrResourceNotFound":     201,
-		"ErrResourceExists":       202,
-		"ErrInvalidArgument":      203,
-		"ErrFailedPreCondition":   204,
-		"ErrInvalidConfig":        205,
-		"ErrInvalidContracts":     206,
-		"ErrTxTimedOut":           301,
-		"ErrChainNotReachable":    302,
-		"ErrUnknownInternal":      401,
+		"DefaultInvalidCode":       0,
+		"ErrPeerRequestTimedOut":   101,
+		"ErrPeerRejected":          102,
+		"ErrPeerNotFunded":         103,
+		"ErrUserResponseTimedOut":  104,
+		"ErrResourceNotFound":      201,
+		"ErrResourceExists":        202,
+		"ErrInvalidArgument":       203,
+		"ErrFailedPreCondition":    204,
+		"ErrInvalidConfig":         205,
+		"ErrInvalidContracts":      206,
+		"ErrSpendingLimitExceeded": 207,
+		"ErrTxTimedOut":            301,
+		"ErrChainNotReachable":     302,
+		"ErrUnknownInternal":       401,
 	}
 )
 
@@ -231,7 +234,7 @@ func (x SubPayChUpdatesResp_Notify_ChUpdateType) Number() protoreflect.EnumNumbe
 
 // Deprecated: Use SubPayChUpdatesResp_Notify_ChUpdateType.Descriptor instead.
 func (SubPayChUpdatesResp_Notify_ChUpdateType) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{49, 0, 0}
+	return file_api_proto_rawDescGZIP(), []int{108, 0, 0}
 }
 
 // Peer ID represents the data required to identify and communicate
@@ -241,10 +244,12 @@ type PeerID struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Alias           string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
-	OffChainAddress string `protobuf:"bytes,2,opt,name=offChainAddress,proto3" json:"offChainAddress,omitempty"`
-	CommAddress     string `protobuf:"bytes,3,opt,name=commAddress,proto3" json:"commAddress,omitempty"`
-	CommType        string `protobuf:"bytes,4,opt,name=commType,proto3" json:"commType,omitempty"`
+	Alias            string          `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	OffChainAddress  string          `protobuf:"bytes,2,opt,name=offChainAddress,proto3" json:"offChainAddress,omitempty"`
+	CommAddress      string          `protobuf:"bytes,3,opt,name=commAddress,proto3" json:"commAddress,omitempty"`
+	CommType         string          `protobuf:"bytes,4,opt,name=commType,proto3" json:"commType,omitempty"`
+	Signature        string          `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	AltCommEndpoints []*CommEndpoint `protobuf:"bytes,6,rep,name=altCommEndpoints,proto3" json:"altCommEndpoints,omitempty"`
 }
 
 func (x *PeerID) Reset() {
@@ -307,6 +312,76 @@ func (x *PeerID) GetCommType() string {
 	return ""
 }
 
+func (x *PeerID) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *PeerID) GetAltCommEndpoints() []*CommEndpoint {
+	if x != nil {
+		return x.AltCommEndpoints
+	}
+	return nil
+}
+
+// CommEndpoint represents an endpoint at which a peer can be reached in the off-chain network.
+type CommEndpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommAddress string `protobuf:"bytes,1,opt,name=commAddress,proto3" json:"commAddress,omitempty"`
+	CommType    string `protobuf:"bytes,2,opt,name=commType,proto3" json:"commType,omitempty"`
+}
+
+func (x *CommEndpoint) Reset() {
+	*x = CommEndpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommEndpoint) ProtoMessage() {}
+
+func (x *CommEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommEndpoint.ProtoReflect.Descriptor instead.
+func (*CommEndpoint) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CommEndpoint) GetCommAddress() string {
+	if x != nil {
+		return x.CommAddress
+	}
+	return ""
+}
+
+func (x *CommEndpoint) GetCommType() string {
+	if x != nil {
+		return x.CommType
+	}
+	return ""
+}
+
 // BalInfo represents the balance information of the channel: Currency and the channel balance.
 // Balance is represented as two corresponding lists:
 // Parts contains the list of aliases of the channel participants and
@@ -330,7 +405,7 @@ type BalInfo struct {
 func (x *BalInfo) Reset() {
 	*x = BalInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[1]
+		mi := &file_api_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -343,7 +418,7 @@ func (x *BalInfo) String() string {
 func (*BalInfo) ProtoMessage() {}
 
 func (x *BalInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[1]
+	mi := &file_api_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +431,7 @@ func (x *BalInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BalInfo.ProtoReflect.Descriptor instead.
 func (*BalInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{1}
+	return file_api_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *BalInfo) GetCurrencies() []string {
@@ -380,20 +455,96 @@ func (x *BalInfo) GetBals() []*BalInfoBal {
 	return nil
 }
 
+// FiatBalInfo is the value of the balances in a BalInfo in fiat, along with the prices used for computing it.
+// Rates, timestamps and bals correspond to the currencies in the BalInfo. If the price of a currency is not
+// available, its rate and values are empty strings and its timestamp is zero.
+type FiatBalInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fiat       string        `protobuf:"bytes,1,opt,name=fiat,proto3" json:"fiat,omitempty"`
+	Rates      []string      `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
+	Timestamps []int64       `protobuf:"varint,3,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
+	Bals       []*BalInfoBal `protobuf:"bytes,4,rep,name=bals,proto3" json:"bals,omitempty"`
+}
+
+func (x *FiatBalInfo) Reset() {
+	*x = FiatBalInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FiatBalInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FiatBalInfo) ProtoMessage() {}
+
+func (x *FiatBalInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FiatBalInfo.ProtoReflect.Descriptor instead.
+func (*FiatBalInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FiatBalInfo) GetFiat() string {
+	if x != nil {
+		return x.Fiat
+	}
+	return ""
+}
+
+func (x *FiatBalInfo) GetRates() []string {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *FiatBalInfo) GetTimestamps() []int64 {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+func (x *FiatBalInfo) GetBals() []*BalInfoBal {
+	if x != nil {
+		return x.Bals
+	}
+	return nil
+}
+
 type PayChInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChID    string   `protobuf:"bytes,1,opt,name=chID,proto3" json:"chID,omitempty"`
-	BalInfo *BalInfo `protobuf:"bytes,2,opt,name=balInfo,proto3" json:"balInfo,omitempty"`
-	Version string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	ChID        string       `protobuf:"bytes,1,opt,name=chID,proto3" json:"chID,omitempty"`
+	BalInfo     *BalInfo     `protobuf:"bytes,2,opt,name=balInfo,proto3" json:"balInfo,omitempty"`
+	Version     string       `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	TxFee       string       `protobuf:"bytes,4,opt,name=txFee,proto3" json:"txFee,omitempty"`
+	FiatBalInfo *FiatBalInfo `protobuf:"bytes,5,opt,name=fiatBalInfo,proto3" json:"fiatBalInfo,omitempty"` // Not set, if no price source is configured for the session.
 }
 
 func (x *PayChInfo) Reset() {
 	*x = PayChInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[2]
+		mi := &file_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -406,7 +557,7 @@ func (x *PayChInfo) String() string {
 func (*PayChInfo) ProtoMessage() {}
 
 func (x *PayChInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[2]
+	mi := &file_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +570,7 @@ func (x *PayChInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayChInfo.ProtoReflect.Descriptor instead.
 func (*PayChInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PayChInfo) GetChID() string {
@@ -443,6 +594,20 @@ func (x *PayChInfo) GetVersion() string {
 	return ""
 }
 
+func (x *PayChInfo) GetTxFee() string {
+	if x != nil {
+		return x.TxFee
+	}
+	return ""
+}
+
+func (x *PayChInfo) GetFiatBalInfo() *FiatBalInfo {
+	if x != nil {
+		return x.FiatBalInfo
+	}
+	return nil
+}
+
 type Payment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -456,7 +621,7 @@ type Payment struct {
 func (x *Payment) Reset() {
 	*x = Payment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[3]
+		mi := &file_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -469,7 +634,7 @@ func (x *Payment) String() string {
 func (*Payment) ProtoMessage() {}
 
 func (x *Payment) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[3]
+	mi := &file_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +647,7 @@ func (x *Payment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Payment.ProtoReflect.Descriptor instead.
 func (*Payment) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Payment) GetCurrency() string {
@@ -506,47 +671,35 @@ func (x *Payment) GetAmount() string {
 	return ""
 }
 
-type MsgError struct {
+// PayChUpdateMeta is the metadata sent to the peer along with a payment update.
+// Keys in metadata should not have the prefix "perun.", as these are reserved for use by the node.
+type PayChUpdateMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Category ErrorCategory `protobuf:"varint,1,opt,name=category,proto3,enum=pb.ErrorCategory" json:"category,omitempty"`
-	Code     ErrorCode     `protobuf:"varint,2,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
-	Message  string        `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	// Types that are assignable to AddInfo:
-	//	*MsgError_ErrInfoPeerRequestTimedOut
-	//	*MsgError_ErrInfoPeerRejected
-	//	*MsgError_ErrInfoPeerNotFunded
-	//	*MsgError_ErrInfoUserResponseTimedOut
-	//	*MsgError_ErrInfoResourceNotFound
-	//	*MsgError_ErrInfoResourceExists
-	//	*MsgError_ErrInfoInvalidArgument
-	//	*MsgError_ErrInfoFailedPreCondUnclosedChs
-	//	*MsgError_ErrInfoInvalidConfig
-	//	*MsgError_ErrInfoInvalidContracts
-	//	*MsgError_ErrInfoTxTimedOut
-	//	*MsgError_ErrInfoChainNotReachable
-	AddInfo isMsgError_AddInfo `protobuf_oneof:"addInfo"`
+	Memo      string            `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	Reference string            `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Metadata  map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *MsgError) Reset() {
-	*x = MsgError{}
+func (x *PayChUpdateMeta) Reset() {
+	*x = PayChUpdateMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[4]
+		mi := &file_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgError) String() string {
+func (x *PayChUpdateMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgError) ProtoMessage() {}
+func (*PayChUpdateMeta) ProtoMessage() {}
 
-func (x *MsgError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[4]
+func (x *PayChUpdateMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -557,110 +710,365 @@ func (x *MsgError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MsgError.ProtoReflect.Descriptor instead.
-func (*MsgError) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *MsgError) GetCategory() ErrorCategory {
-	if x != nil {
-		return x.Category
-	}
-	return ErrorCategory_ParticipantError
+// Deprecated: Use PayChUpdateMeta.ProtoReflect.Descriptor instead.
+func (*PayChUpdateMeta) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MsgError) GetCode() ErrorCode {
+func (x *PayChUpdateMeta) GetMemo() string {
 	if x != nil {
-		return x.Code
+		return x.Memo
 	}
-	return ErrorCode_DefaultInvalidCode
+	return ""
 }
 
-func (x *MsgError) GetMessage() string {
+func (x *PayChUpdateMeta) GetReference() string {
 	if x != nil {
-		return x.Message
+		return x.Reference
 	}
 	return ""
 }
 
-func (m *MsgError) GetAddInfo() isMsgError_AddInfo {
-	if m != nil {
-		return m.AddInfo
+func (x *PayChUpdateMeta) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *MsgError) GetErrInfoPeerRequestTimedOut() *ErrInfoPeerRequestTimedOut {
-	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoPeerRequestTimedOut); ok {
-		return x.ErrInfoPeerRequestTimedOut
-	}
-	return nil
+// SwapQuote is the quote of a swap proposed in a channel. Give and take are from the perspective of the proposer.
+// Rate is the amount of takeCurrency per unit of giveCurrency. Expiry is the time (in unix timestamp) until which
+// the quote is valid.
+type SwapQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GiveCurrency string `protobuf:"bytes,1,opt,name=giveCurrency,proto3" json:"giveCurrency,omitempty"`
+	GiveAmount   string `protobuf:"bytes,2,opt,name=giveAmount,proto3" json:"giveAmount,omitempty"`
+	TakeCurrency string `protobuf:"bytes,3,opt,name=takeCurrency,proto3" json:"takeCurrency,omitempty"`
+	TakeAmount   string `protobuf:"bytes,4,opt,name=takeAmount,proto3" json:"takeAmount,omitempty"`
+	Rate         string `protobuf:"bytes,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	Expiry       int64  `protobuf:"varint,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
 }
 
-func (x *MsgError) GetErrInfoPeerRejected() *ErrInfoPeerRejected {
-	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoPeerRejected); ok {
-		return x.ErrInfoPeerRejected
+func (x *SwapQuote) Reset() {
+	*x = SwapQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *MsgError) GetErrInfoPeerNotFunded() *ErrInfoPeerNotFunded {
-	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoPeerNotFunded); ok {
-		return x.ErrInfoPeerNotFunded
-	}
-	return nil
+func (x *SwapQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgError) GetErrInfoUserResponseTimedOut() *ErrInfoUserResponseTimedOut {
-	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoUserResponseTimedOut); ok {
-		return x.ErrInfoUserResponseTimedOut
+func (*SwapQuote) ProtoMessage() {}
+
+func (x *SwapQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MsgError) GetErrInfoResourceNotFound() *ErrInfoResourceNotFound {
-	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoResourceNotFound); ok {
-		return x.ErrInfoResourceNotFound
-	}
-	return nil
+// Deprecated: Use SwapQuote.ProtoReflect.Descriptor instead.
+func (*SwapQuote) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MsgError) GetErrInfoResourceExists() *ErrInfoResourceExists {
-	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoResourceExists); ok {
-		return x.ErrInfoResourceExists
+func (x *SwapQuote) GetGiveCurrency() string {
+	if x != nil {
+		return x.GiveCurrency
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgError) GetErrInfoInvalidArgument() *ErrInfoInvalidArgument {
-	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoInvalidArgument); ok {
-		return x.ErrInfoInvalidArgument
+func (x *SwapQuote) GetGiveAmount() string {
+	if x != nil {
+		return x.GiveAmount
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgError) GetErrInfoFailedPreCondUnclosedChs() *ErrInfoFailedPreCondUnclosedChs {
-	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoFailedPreCondUnclosedChs); ok {
-		return x.ErrInfoFailedPreCondUnclosedChs
+func (x *SwapQuote) GetTakeCurrency() string {
+	if x != nil {
+		return x.TakeCurrency
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgError) GetErrInfoInvalidConfig() *ErrInfoInvalidConfig {
-	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoInvalidConfig); ok {
-		return x.ErrInfoInvalidConfig
+func (x *SwapQuote) GetTakeAmount() string {
+	if x != nil {
+		return x.TakeAmount
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgError) GetErrInfoInvalidContracts() *ErrInfoInvalidContracts {
-	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoInvalidContracts); ok {
-		return x.ErrInfoInvalidContracts
+func (x *SwapQuote) GetRate() string {
+	if x != nil {
+		return x.Rate
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgError) GetErrInfoTxTimedOut() *ErrInfoTxTimedOut {
+func (x *SwapQuote) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+type PayChUpdateRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version    string           `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	BalInfo    *BalInfo         `protobuf:"bytes,2,opt,name=balInfo,proto3" json:"balInfo,omitempty"`
+	ProposedBy string           `protobuf:"bytes,3,opt,name=proposedBy,proto3" json:"proposedBy,omitempty"`
+	InvoiceID  string           `protobuf:"bytes,4,opt,name=invoiceID,proto3" json:"invoiceID,omitempty"`
+	Meta       *PayChUpdateMeta `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+	AcceptedAt int64            `protobuf:"varint,6,opt,name=acceptedAt,proto3" json:"acceptedAt,omitempty"`
+}
+
+func (x *PayChUpdateRecord) Reset() {
+	*x = PayChUpdateRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayChUpdateRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayChUpdateRecord) ProtoMessage() {}
+
+func (x *PayChUpdateRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayChUpdateRecord.ProtoReflect.Descriptor instead.
+func (*PayChUpdateRecord) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PayChUpdateRecord) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PayChUpdateRecord) GetBalInfo() *BalInfo {
+	if x != nil {
+		return x.BalInfo
+	}
+	return nil
+}
+
+func (x *PayChUpdateRecord) GetProposedBy() string {
+	if x != nil {
+		return x.ProposedBy
+	}
+	return ""
+}
+
+func (x *PayChUpdateRecord) GetInvoiceID() string {
+	if x != nil {
+		return x.InvoiceID
+	}
+	return ""
+}
+
+func (x *PayChUpdateRecord) GetMeta() *PayChUpdateMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PayChUpdateRecord) GetAcceptedAt() int64 {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return 0
+}
+
+type MsgError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category ErrorCategory `protobuf:"varint,1,opt,name=category,proto3,enum=pb.ErrorCategory" json:"category,omitempty"`
+	Code     ErrorCode     `protobuf:"varint,2,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	Message  string        `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Types that are assignable to AddInfo:
+	//	*MsgError_ErrInfoPeerRequestTimedOut
+	//	*MsgError_ErrInfoPeerRejected
+	//	*MsgError_ErrInfoPeerNotFunded
+	//	*MsgError_ErrInfoUserResponseTimedOut
+	//	*MsgError_ErrInfoResourceNotFound
+	//	*MsgError_ErrInfoResourceExists
+	//	*MsgError_ErrInfoInvalidArgument
+	//	*MsgError_ErrInfoFailedPreCondUnclosedChs
+	//	*MsgError_ErrInfoInvalidConfig
+	//	*MsgError_ErrInfoInvalidContracts
+	//	*MsgError_ErrInfoTxTimedOut
+	//	*MsgError_ErrInfoChainNotReachable
+	//	*MsgError_ErrInfoSpendingLimitExceeded
+	AddInfo isMsgError_AddInfo `protobuf_oneof:"addInfo"`
+}
+
+func (x *MsgError) Reset() {
+	*x = MsgError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgError) ProtoMessage() {}
+
+func (x *MsgError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgError.ProtoReflect.Descriptor instead.
+func (*MsgError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MsgError) GetCategory() ErrorCategory {
+	if x != nil {
+		return x.Category
+	}
+	return ErrorCategory_ParticipantError
+}
+
+func (x *MsgError) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_DefaultInvalidCode
+}
+
+func (x *MsgError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (m *MsgError) GetAddInfo() isMsgError_AddInfo {
+	if m != nil {
+		return m.AddInfo
+	}
+	return nil
+}
+
+func (x *MsgError) GetErrInfoPeerRequestTimedOut() *ErrInfoPeerRequestTimedOut {
+	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoPeerRequestTimedOut); ok {
+		return x.ErrInfoPeerRequestTimedOut
+	}
+	return nil
+}
+
+func (x *MsgError) GetErrInfoPeerRejected() *ErrInfoPeerRejected {
+	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoPeerRejected); ok {
+		return x.ErrInfoPeerRejected
+	}
+	return nil
+}
+
+func (x *MsgError) GetErrInfoPeerNotFunded() *ErrInfoPeerNotFunded {
+	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoPeerNotFunded); ok {
+		return x.ErrInfoPeerNotFunded
+	}
+	return nil
+}
+
+func (x *MsgError) GetErrInfoUserResponseTimedOut() *ErrInfoUserResponseTimedOut {
+	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoUserResponseTimedOut); ok {
+		return x.ErrInfoUserResponseTimedOut
+	}
+	return nil
+}
+
+func (x *MsgError) GetErrInfoResourceNotFound() *ErrInfoResourceNotFound {
+	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoResourceNotFound); ok {
+		return x.ErrInfoResourceNotFound
+	}
+	return nil
+}
+
+func (x *MsgError) GetErrInfoResourceExists() *ErrInfoResourceExists {
+	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoResourceExists); ok {
+		return x.ErrInfoResourceExists
+	}
+	return nil
+}
+
+func (x *MsgError) GetErrInfoInvalidArgument() *ErrInfoInvalidArgument {
+	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoInvalidArgument); ok {
+		return x.ErrInfoInvalidArgument
+	}
+	return nil
+}
+
+func (x *MsgError) GetErrInfoFailedPreCondUnclosedChs() *ErrInfoFailedPreCondUnclosedChs {
+	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoFailedPreCondUnclosedChs); ok {
+		return x.ErrInfoFailedPreCondUnclosedChs
+	}
+	return nil
+}
+
+func (x *MsgError) GetErrInfoInvalidConfig() *ErrInfoInvalidConfig {
+	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoInvalidConfig); ok {
+		return x.ErrInfoInvalidConfig
+	}
+	return nil
+}
+
+func (x *MsgError) GetErrInfoInvalidContracts() *ErrInfoInvalidContracts {
+	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoInvalidContracts); ok {
+		return x.ErrInfoInvalidContracts
+	}
+	return nil
+}
+
+func (x *MsgError) GetErrInfoTxTimedOut() *ErrInfoTxTimedOut {
 	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoTxTimedOut); ok {
 		return x.ErrInfoTxTimedOut
 	}
@@ -674,6 +1082,13 @@ func (x *MsgError) GetErrInfoChainNotReachable() *ErrInfoChainNotReachable {
 	return nil
 }
 
+func (x *MsgError) GetErrInfoSpendingLimitExceeded() *ErrInfoSpendingLimitExceeded {
+	if x, ok := x.GetAddInfo().(*MsgError_ErrInfoSpendingLimitExceeded); ok {
+		return x.ErrInfoSpendingLimitExceeded
+	}
+	return nil
+}
+
 type isMsgError_AddInfo interface {
 	isMsgError_AddInfo()
 }
@@ -726,6 +1141,10 @@ type MsgError_ErrInfoChainNotReachable struct {
 	ErrInfoChainNotReachable *ErrInfoChainNotReachable `protobuf:"bytes,16,opt,name=ErrInfoChainNotReachable,proto3,oneof"`
 }
 
+type MsgError_ErrInfoSpendingLimitExceeded struct {
+	ErrInfoSpendingLimitExceeded *ErrInfoSpendingLimitExceeded `protobuf:"bytes,17,opt,name=ErrInfoSpendingLimitExceeded,proto3,oneof"`
+}
+
 func (*MsgError_ErrInfoPeerRequestTimedOut) isMsgError_AddInfo() {}
 
 func (*MsgError_ErrInfoPeerRejected) isMsgError_AddInfo() {}
@@ -750,6 +1169,8 @@ func (*MsgError_ErrInfoTxTimedOut) isMsgError_AddInfo() {}
 
 func (*MsgError_ErrInfoChainNotReachable) isMsgError_AddInfo() {}
 
+func (*MsgError_ErrInfoSpendingLimitExceeded) isMsgError_AddInfo() {}
+
 type ErrInfoPeerRequestTimedOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -762,7 +1183,7 @@ type ErrInfoPeerRequestTimedOut struct {
 func (x *ErrInfoPeerRequestTimedOut) Reset() {
 	*x = ErrInfoPeerRequestTimedOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[5]
+		mi := &file_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -775,7 +1196,7 @@ func (x *ErrInfoPeerRequestTimedOut) String() string {
 func (*ErrInfoPeerRequestTimedOut) ProtoMessage() {}
 
 func (x *ErrInfoPeerRequestTimedOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[5]
+	mi := &file_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +1209,7 @@ func (x *ErrInfoPeerRequestTimedOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrInfoPeerRequestTimedOut.ProtoReflect.Descriptor instead.
 func (*ErrInfoPeerRequestTimedOut) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ErrInfoPeerRequestTimedOut) GetPeerAlias() string {
@@ -817,7 +1238,7 @@ type ErrInfoPeerRejected struct {
 func (x *ErrInfoPeerRejected) Reset() {
 	*x = ErrInfoPeerRejected{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[6]
+		mi := &file_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -830,7 +1251,7 @@ func (x *ErrInfoPeerRejected) String() string {
 func (*ErrInfoPeerRejected) ProtoMessage() {}
 
 func (x *ErrInfoPeerRejected) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[6]
+	mi := &file_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,7 +1264,7 @@ func (x *ErrInfoPeerRejected) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrInfoPeerRejected.ProtoReflect.Descriptor instead.
 func (*ErrInfoPeerRejected) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ErrInfoPeerRejected) GetPeerAlias() string {
@@ -871,7 +1292,7 @@ type ErrInfoPeerNotFunded struct {
 func (x *ErrInfoPeerNotFunded) Reset() {
 	*x = ErrInfoPeerNotFunded{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[7]
+		mi := &file_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -884,7 +1305,7 @@ func (x *ErrInfoPeerNotFunded) String() string {
 func (*ErrInfoPeerNotFunded) ProtoMessage() {}
 
 func (x *ErrInfoPeerNotFunded) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[7]
+	mi := &file_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +1318,7 @@ func (x *ErrInfoPeerNotFunded) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrInfoPeerNotFunded.ProtoReflect.Descriptor instead.
 func (*ErrInfoPeerNotFunded) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{7}
+	return file_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ErrInfoPeerNotFunded) GetPeerAlias() string {
@@ -919,7 +1340,7 @@ type ErrInfoUserResponseTimedOut struct {
 func (x *ErrInfoUserResponseTimedOut) Reset() {
 	*x = ErrInfoUserResponseTimedOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[8]
+		mi := &file_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -932,7 +1353,7 @@ func (x *ErrInfoUserResponseTimedOut) String() string {
 func (*ErrInfoUserResponseTimedOut) ProtoMessage() {}
 
 func (x *ErrInfoUserResponseTimedOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[8]
+	mi := &file_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -945,7 +1366,7 @@ func (x *ErrInfoUserResponseTimedOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrInfoUserResponseTimedOut.ProtoReflect.Descriptor instead.
 func (*ErrInfoUserResponseTimedOut) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{8}
+	return file_api_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ErrInfoUserResponseTimedOut) GetExpiry() int64 {
@@ -974,7 +1395,7 @@ type ErrInfoResourceNotFound struct {
 func (x *ErrInfoResourceNotFound) Reset() {
 	*x = ErrInfoResourceNotFound{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[9]
+		mi := &file_api_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -987,7 +1408,7 @@ func (x *ErrInfoResourceNotFound) String() string {
 func (*ErrInfoResourceNotFound) ProtoMessage() {}
 
 func (x *ErrInfoResourceNotFound) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[9]
+	mi := &file_api_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,7 +1421,7 @@ func (x *ErrInfoResourceNotFound) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrInfoResourceNotFound.ProtoReflect.Descriptor instead.
 func (*ErrInfoResourceNotFound) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{9}
+	return file_api_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ErrInfoResourceNotFound) GetType() string {
@@ -1029,7 +1450,7 @@ type ErrInfoResourceExists struct {
 func (x *ErrInfoResourceExists) Reset() {
 	*x = ErrInfoResourceExists{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[10]
+		mi := &file_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1042,7 +1463,7 @@ func (x *ErrInfoResourceExists) String() string {
 func (*ErrInfoResourceExists) ProtoMessage() {}
 
 func (x *ErrInfoResourceExists) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[10]
+	mi := &file_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1055,7 +1476,7 @@ func (x *ErrInfoResourceExists) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrInfoResourceExists.ProtoReflect.Descriptor instead.
 func (*ErrInfoResourceExists) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{10}
+	return file_api_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ErrInfoResourceExists) GetType() string {
@@ -1085,7 +1506,7 @@ type ErrInfoInvalidArgument struct {
 func (x *ErrInfoInvalidArgument) Reset() {
 	*x = ErrInfoInvalidArgument{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[11]
+		mi := &file_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1098,7 +1519,7 @@ func (x *ErrInfoInvalidArgument) String() string {
 func (*ErrInfoInvalidArgument) ProtoMessage() {}
 
 func (x *ErrInfoInvalidArgument) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[11]
+	mi := &file_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,7 +1532,7 @@ func (x *ErrInfoInvalidArgument) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrInfoInvalidArgument.ProtoReflect.Descriptor instead.
 func (*ErrInfoInvalidArgument) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{11}
+	return file_api_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ErrInfoInvalidArgument) GetName() string {
@@ -1146,7 +1567,7 @@ type ErrInfoFailedPreCondUnclosedChs struct {
 func (x *ErrInfoFailedPreCondUnclosedChs) Reset() {
 	*x = ErrInfoFailedPreCondUnclosedChs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[12]
+		mi := &file_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1159,7 +1580,7 @@ func (x *ErrInfoFailedPreCondUnclosedChs) String() string {
 func (*ErrInfoFailedPreCondUnclosedChs) ProtoMessage() {}
 
 func (x *ErrInfoFailedPreCondUnclosedChs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[12]
+	mi := &file_api_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,7 +1593,7 @@ func (x *ErrInfoFailedPreCondUnclosedChs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrInfoFailedPreCondUnclosedChs.ProtoReflect.Descriptor instead.
 func (*ErrInfoFailedPreCondUnclosedChs) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{12}
+	return file_api_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ErrInfoFailedPreCondUnclosedChs) GetChs() []*PayChInfo {
@@ -1194,7 +1615,7 @@ type ErrInfoInvalidConfig struct {
 func (x *ErrInfoInvalidConfig) Reset() {
 	*x = ErrInfoInvalidConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[13]
+		mi := &file_api_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1207,7 +1628,7 @@ func (x *ErrInfoInvalidConfig) String() string {
 func (*ErrInfoInvalidConfig) ProtoMessage() {}
 
 func (x *ErrInfoInvalidConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[13]
+	mi := &file_api_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1220,7 +1641,7 @@ func (x *ErrInfoInvalidConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrInfoInvalidConfig.ProtoReflect.Descriptor instead.
 func (*ErrInfoInvalidConfig) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{13}
+	return file_api_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ErrInfoInvalidConfig) GetName() string {
@@ -1250,7 +1671,7 @@ type ContractErrInfo struct {
 func (x *ContractErrInfo) Reset() {
 	*x = ContractErrInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[14]
+		mi := &file_api_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1263,7 +1684,7 @@ func (x *ContractErrInfo) String() string {
 func (*ContractErrInfo) ProtoMessage() {}
 
 func (x *ContractErrInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[14]
+	mi := &file_api_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1276,7 +1697,7 @@ func (x *ContractErrInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContractErrInfo.ProtoReflect.Descriptor instead.
 func (*ContractErrInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{14}
+	return file_api_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ContractErrInfo) GetName() string {
@@ -1311,7 +1732,7 @@ type ErrInfoInvalidContracts struct {
 func (x *ErrInfoInvalidContracts) Reset() {
 	*x = ErrInfoInvalidContracts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[15]
+		mi := &file_api_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1324,7 +1745,7 @@ func (x *ErrInfoInvalidContracts) String() string {
 func (*ErrInfoInvalidContracts) ProtoMessage() {}
 
 func (x *ErrInfoInvalidContracts) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[15]
+	mi := &file_api_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1337,7 +1758,7 @@ func (x *ErrInfoInvalidContracts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrInfoInvalidContracts.ProtoReflect.Descriptor instead.
 func (*ErrInfoInvalidContracts) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{15}
+	return file_api_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ErrInfoInvalidContracts) GetContractErrInfos() []*ContractErrInfo {
@@ -1360,7 +1781,7 @@ type ErrInfoTxTimedOut struct {
 func (x *ErrInfoTxTimedOut) Reset() {
 	*x = ErrInfoTxTimedOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[16]
+		mi := &file_api_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1373,7 +1794,7 @@ func (x *ErrInfoTxTimedOut) String() string {
 func (*ErrInfoTxTimedOut) ProtoMessage() {}
 
 func (x *ErrInfoTxTimedOut) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[16]
+	mi := &file_api_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,7 +1807,7 @@ func (x *ErrInfoTxTimedOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrInfoTxTimedOut.ProtoReflect.Descriptor instead.
 func (*ErrInfoTxTimedOut) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{16}
+	return file_api_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ErrInfoTxTimedOut) GetTxType() string {
@@ -1421,7 +1842,7 @@ type ErrInfoChainNotReachable struct {
 func (x *ErrInfoChainNotReachable) Reset() {
 	*x = ErrInfoChainNotReachable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[17]
+		mi := &file_api_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1434,7 +1855,7 @@ func (x *ErrInfoChainNotReachable) String() string {
 func (*ErrInfoChainNotReachable) ProtoMessage() {}
 
 func (x *ErrInfoChainNotReachable) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[17]
+	mi := &file_api_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,7 +1868,7 @@ func (x *ErrInfoChainNotReachable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrInfoChainNotReachable.ProtoReflect.Descriptor instead.
 func (*ErrInfoChainNotReachable) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{17}
+	return file_api_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ErrInfoChainNotReachable) GetChainURL() string {
@@ -1457,6 +1878,93 @@ func (x *ErrInfoChainNotReachable) GetChainURL() string {
 	return ""
 }
 
+type ErrInfoSpendingLimitExceeded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LimitType string `protobuf:"bytes,1,opt,name=limitType,proto3" json:"limitType,omitempty"`
+	PeerAlias string `protobuf:"bytes,2,opt,name=peerAlias,proto3" json:"peerAlias,omitempty"`
+	Currency  string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Limit     string `protobuf:"bytes,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Usage     string `protobuf:"bytes,5,opt,name=usage,proto3" json:"usage,omitempty"`
+	Amount    string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *ErrInfoSpendingLimitExceeded) Reset() {
+	*x = ErrInfoSpendingLimitExceeded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrInfoSpendingLimitExceeded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrInfoSpendingLimitExceeded) ProtoMessage() {}
+
+func (x *ErrInfoSpendingLimitExceeded) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrInfoSpendingLimitExceeded.ProtoReflect.Descriptor instead.
+func (*ErrInfoSpendingLimitExceeded) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ErrInfoSpendingLimitExceeded) GetLimitType() string {
+	if x != nil {
+		return x.LimitType
+	}
+	return ""
+}
+
+func (x *ErrInfoSpendingLimitExceeded) GetPeerAlias() string {
+	if x != nil {
+		return x.PeerAlias
+	}
+	return ""
+}
+
+func (x *ErrInfoSpendingLimitExceeded) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ErrInfoSpendingLimitExceeded) GetLimit() string {
+	if x != nil {
+		return x.Limit
+	}
+	return ""
+}
+
+func (x *ErrInfoSpendingLimitExceeded) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+func (x *ErrInfoSpendingLimitExceeded) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
 type GetConfigReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1466,7 +1974,7 @@ type GetConfigReq struct {
 func (x *GetConfigReq) Reset() {
 	*x = GetConfigReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[18]
+		mi := &file_api_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1479,7 +1987,7 @@ func (x *GetConfigReq) String() string {
 func (*GetConfigReq) ProtoMessage() {}
 
 func (x *GetConfigReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[18]
+	mi := &file_api_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1492,7 +2000,7 @@ func (x *GetConfigReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigReq.ProtoReflect.Descriptor instead.
 func (*GetConfigReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{18}
+	return file_api_proto_rawDescGZIP(), []int{24}
 }
 
 type GetConfigResp struct {
@@ -1510,7 +2018,7 @@ type GetConfigResp struct {
 func (x *GetConfigResp) Reset() {
 	*x = GetConfigResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[19]
+		mi := &file_api_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1523,7 +2031,7 @@ func (x *GetConfigResp) String() string {
 func (*GetConfigResp) ProtoMessage() {}
 
 func (x *GetConfigResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[19]
+	mi := &file_api_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1536,7 +2044,7 @@ func (x *GetConfigResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigResp.ProtoReflect.Descriptor instead.
 func (*GetConfigResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{19}
+	return file_api_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetConfigResp) GetChainAddress() string {
@@ -1585,7 +2093,7 @@ type OpenSessionReq struct {
 func (x *OpenSessionReq) Reset() {
 	*x = OpenSessionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[20]
+		mi := &file_api_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1598,7 +2106,7 @@ func (x *OpenSessionReq) String() string {
 func (*OpenSessionReq) ProtoMessage() {}
 
 func (x *OpenSessionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[20]
+	mi := &file_api_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1611,7 +2119,7 @@ func (x *OpenSessionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenSessionReq.ProtoReflect.Descriptor instead.
 func (*OpenSessionReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{20}
+	return file_api_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *OpenSessionReq) GetConfigFile() string {
@@ -1635,7 +2143,7 @@ type OpenSessionResp struct {
 func (x *OpenSessionResp) Reset() {
 	*x = OpenSessionResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[21]
+		mi := &file_api_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1648,7 +2156,7 @@ func (x *OpenSessionResp) String() string {
 func (*OpenSessionResp) ProtoMessage() {}
 
 func (x *OpenSessionResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[21]
+	mi := &file_api_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1661,7 +2169,7 @@ func (x *OpenSessionResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenSessionResp.ProtoReflect.Descriptor instead.
 func (*OpenSessionResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{21}
+	return file_api_proto_rawDescGZIP(), []int{27}
 }
 
 func (m *OpenSessionResp) GetResponse() isOpenSessionResp_Response {
@@ -1710,7 +2218,7 @@ type TimeReq struct {
 func (x *TimeReq) Reset() {
 	*x = TimeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[22]
+		mi := &file_api_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1723,7 +2231,7 @@ func (x *TimeReq) String() string {
 func (*TimeReq) ProtoMessage() {}
 
 func (x *TimeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[22]
+	mi := &file_api_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1736,7 +2244,7 @@ func (x *TimeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeReq.ProtoReflect.Descriptor instead.
 func (*TimeReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{22}
+	return file_api_proto_rawDescGZIP(), []int{28}
 }
 
 type TimeResp struct {
@@ -1750,7 +2258,7 @@ type TimeResp struct {
 func (x *TimeResp) Reset() {
 	*x = TimeResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[23]
+		mi := &file_api_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1763,7 +2271,7 @@ func (x *TimeResp) String() string {
 func (*TimeResp) ProtoMessage() {}
 
 func (x *TimeResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[23]
+	mi := &file_api_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1776,7 +2284,7 @@ func (x *TimeResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeResp.ProtoReflect.Descriptor instead.
 func (*TimeResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{23}
+	return file_api_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *TimeResp) GetTime() int64 {
@@ -1798,7 +2306,7 @@ type RegisterCurrencyReq struct {
 func (x *RegisterCurrencyReq) Reset() {
 	*x = RegisterCurrencyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[24]
+		mi := &file_api_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1811,7 +2319,7 @@ func (x *RegisterCurrencyReq) String() string {
 func (*RegisterCurrencyReq) ProtoMessage() {}
 
 func (x *RegisterCurrencyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[24]
+	mi := &file_api_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1824,7 +2332,7 @@ func (x *RegisterCurrencyReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterCurrencyReq.ProtoReflect.Descriptor instead.
 func (*RegisterCurrencyReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{24}
+	return file_api_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *RegisterCurrencyReq) GetTokenAddr() string {
@@ -1855,7 +2363,7 @@ type RegisterCurrencyResp struct {
 func (x *RegisterCurrencyResp) Reset() {
 	*x = RegisterCurrencyResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[25]
+		mi := &file_api_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1868,7 +2376,7 @@ func (x *RegisterCurrencyResp) String() string {
 func (*RegisterCurrencyResp) ProtoMessage() {}
 
 func (x *RegisterCurrencyResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[25]
+	mi := &file_api_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1881,7 +2389,7 @@ func (x *RegisterCurrencyResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterCurrencyResp.ProtoReflect.Descriptor instead.
 func (*RegisterCurrencyResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{25}
+	return file_api_proto_rawDescGZIP(), []int{31}
 }
 
 func (m *RegisterCurrencyResp) GetResponse() isRegisterCurrencyResp_Response {
@@ -1930,7 +2438,7 @@ type HelpReq struct {
 func (x *HelpReq) Reset() {
 	*x = HelpReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[26]
+		mi := &file_api_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1943,7 +2451,7 @@ func (x *HelpReq) String() string {
 func (*HelpReq) ProtoMessage() {}
 
 func (x *HelpReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[26]
+	mi := &file_api_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,7 +2464,7 @@ func (x *HelpReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HelpReq.ProtoReflect.Descriptor instead.
 func (*HelpReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{26}
+	return file_api_proto_rawDescGZIP(), []int{32}
 }
 
 type HelpResp struct {
@@ -1970,7 +2478,7 @@ type HelpResp struct {
 func (x *HelpResp) Reset() {
 	*x = HelpResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[27]
+		mi := &file_api_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1983,7 +2491,7 @@ func (x *HelpResp) String() string {
 func (*HelpResp) ProtoMessage() {}
 
 func (x *HelpResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[27]
+	mi := &file_api_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1996,7 +2504,7 @@ func (x *HelpResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HelpResp.ProtoReflect.Descriptor instead.
 func (*HelpResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{27}
+	return file_api_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *HelpResp) GetApis() []string {
@@ -2018,7 +2526,7 @@ type AddPeerIDReq struct {
 func (x *AddPeerIDReq) Reset() {
 	*x = AddPeerIDReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[28]
+		mi := &file_api_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2031,7 +2539,7 @@ func (x *AddPeerIDReq) String() string {
 func (*AddPeerIDReq) ProtoMessage() {}
 
 func (x *AddPeerIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[28]
+	mi := &file_api_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2044,7 +2552,7 @@ func (x *AddPeerIDReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPeerIDReq.ProtoReflect.Descriptor instead.
 func (*AddPeerIDReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{28}
+	return file_api_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *AddPeerIDReq) GetSessionID() string {
@@ -2075,7 +2583,7 @@ type AddPeerIDResp struct {
 func (x *AddPeerIDResp) Reset() {
 	*x = AddPeerIDResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[29]
+		mi := &file_api_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2088,7 +2596,7 @@ func (x *AddPeerIDResp) String() string {
 func (*AddPeerIDResp) ProtoMessage() {}
 
 func (x *AddPeerIDResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[29]
+	mi := &file_api_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2101,7 +2609,7 @@ func (x *AddPeerIDResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPeerIDResp.ProtoReflect.Descriptor instead.
 func (*AddPeerIDResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{29}
+	return file_api_proto_rawDescGZIP(), []int{35}
 }
 
 func (m *AddPeerIDResp) GetResponse() isAddPeerIDResp_Response {
@@ -2153,7 +2661,7 @@ type GetPeerIDReq struct {
 func (x *GetPeerIDReq) Reset() {
 	*x = GetPeerIDReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[30]
+		mi := &file_api_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2166,7 +2674,7 @@ func (x *GetPeerIDReq) String() string {
 func (*GetPeerIDReq) ProtoMessage() {}
 
 func (x *GetPeerIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[30]
+	mi := &file_api_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2179,7 +2687,7 @@ func (x *GetPeerIDReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPeerIDReq.ProtoReflect.Descriptor instead.
 func (*GetPeerIDReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{30}
+	return file_api_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetPeerIDReq) GetSessionID() string {
@@ -2210,7 +2718,7 @@ type GetPeerIDResp struct {
 func (x *GetPeerIDResp) Reset() {
 	*x = GetPeerIDResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[31]
+		mi := &file_api_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2223,7 +2731,7 @@ func (x *GetPeerIDResp) String() string {
 func (*GetPeerIDResp) ProtoMessage() {}
 
 func (x *GetPeerIDResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[31]
+	mi := &file_api_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2236,7 +2744,7 @@ func (x *GetPeerIDResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPeerIDResp.ProtoReflect.Descriptor instead.
 func (*GetPeerIDResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{31}
+	return file_api_proto_rawDescGZIP(), []int{37}
 }
 
 func (m *GetPeerIDResp) GetResponse() isGetPeerIDResp_Response {
@@ -2276,33 +2784,32 @@ func (*GetPeerIDResp_MsgSuccess_) isGetPeerIDResp_Response() {}
 
 func (*GetPeerIDResp_Error) isGetPeerIDResp_Response() {}
 
-type OpenPayChReq struct {
+type ExportPeerIDsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID        string   `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	OpeningBalInfo   *BalInfo `protobuf:"bytes,2,opt,name=openingBalInfo,proto3" json:"openingBalInfo,omitempty"`
-	ChallengeDurSecs uint64   `protobuf:"varint,3,opt,name=challengeDurSecs,proto3" json:"challengeDurSecs,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Format    string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
 }
 
-func (x *OpenPayChReq) Reset() {
-	*x = OpenPayChReq{}
+func (x *ExportPeerIDsReq) Reset() {
+	*x = ExportPeerIDsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[32]
+		mi := &file_api_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenPayChReq) String() string {
+func (x *ExportPeerIDsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenPayChReq) ProtoMessage() {}
+func (*ExportPeerIDsReq) ProtoMessage() {}
 
-func (x *OpenPayChReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[32]
+func (x *ExportPeerIDsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2313,60 +2820,53 @@ func (x *OpenPayChReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenPayChReq.ProtoReflect.Descriptor instead.
-func (*OpenPayChReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ExportPeerIDsReq.ProtoReflect.Descriptor instead.
+func (*ExportPeerIDsReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *OpenPayChReq) GetSessionID() string {
+func (x *ExportPeerIDsReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *OpenPayChReq) GetOpeningBalInfo() *BalInfo {
-	if x != nil {
-		return x.OpeningBalInfo
-	}
-	return nil
-}
-
-func (x *OpenPayChReq) GetChallengeDurSecs() uint64 {
+func (x *ExportPeerIDsReq) GetFormat() string {
 	if x != nil {
-		return x.ChallengeDurSecs
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-type OpenPayChResp struct {
+type ExportPeerIDsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//	*OpenPayChResp_MsgSuccess_
-	//	*OpenPayChResp_Error
-	Response isOpenPayChResp_Response `protobuf_oneof:"response"`
+	//	*ExportPeerIDsResp_MsgSuccess_
+	//	*ExportPeerIDsResp_Error
+	Response isExportPeerIDsResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *OpenPayChResp) Reset() {
-	*x = OpenPayChResp{}
+func (x *ExportPeerIDsResp) Reset() {
+	*x = ExportPeerIDsResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[33]
+		mi := &file_api_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenPayChResp) String() string {
+func (x *ExportPeerIDsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenPayChResp) ProtoMessage() {}
+func (*ExportPeerIDsResp) ProtoMessage() {}
 
-func (x *OpenPayChResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[33]
+func (x *ExportPeerIDsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2377,73 +2877,77 @@ func (x *OpenPayChResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenPayChResp.ProtoReflect.Descriptor instead.
-func (*OpenPayChResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ExportPeerIDsResp.ProtoReflect.Descriptor instead.
+func (*ExportPeerIDsResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{39}
 }
 
-func (m *OpenPayChResp) GetResponse() isOpenPayChResp_Response {
+func (m *ExportPeerIDsResp) GetResponse() isExportPeerIDsResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *OpenPayChResp) GetMsgSuccess() *OpenPayChResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*OpenPayChResp_MsgSuccess_); ok {
+func (x *ExportPeerIDsResp) GetMsgSuccess() *ExportPeerIDsResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*ExportPeerIDsResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *OpenPayChResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*OpenPayChResp_Error); ok {
+func (x *ExportPeerIDsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*ExportPeerIDsResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isOpenPayChResp_Response interface {
-	isOpenPayChResp_Response()
+type isExportPeerIDsResp_Response interface {
+	isExportPeerIDsResp_Response()
 }
 
-type OpenPayChResp_MsgSuccess_ struct {
-	MsgSuccess *OpenPayChResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type ExportPeerIDsResp_MsgSuccess_ struct {
+	MsgSuccess *ExportPeerIDsResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type OpenPayChResp_Error struct {
+type ExportPeerIDsResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*OpenPayChResp_MsgSuccess_) isOpenPayChResp_Response() {}
+func (*ExportPeerIDsResp_MsgSuccess_) isExportPeerIDsResp_Response() {}
 
-func (*OpenPayChResp_Error) isOpenPayChResp_Response() {}
+func (*ExportPeerIDsResp_Error) isExportPeerIDsResp_Response() {}
 
-type GetPayChsInfoReq struct {
+type ImportPeerIDsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	SessionID     string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Data          string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	MergeStrategy string `protobuf:"bytes,4,opt,name=mergeStrategy,proto3" json:"mergeStrategy,omitempty"`
+	DryRun        bool   `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
 }
 
-func (x *GetPayChsInfoReq) Reset() {
-	*x = GetPayChsInfoReq{}
+func (x *ImportPeerIDsReq) Reset() {
+	*x = ImportPeerIDsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[34]
+		mi := &file_api_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPayChsInfoReq) String() string {
+func (x *ImportPeerIDsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayChsInfoReq) ProtoMessage() {}
+func (*ImportPeerIDsReq) ProtoMessage() {}
 
-func (x *GetPayChsInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[34]
+func (x *ImportPeerIDsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2454,46 +2958,73 @@ func (x *GetPayChsInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayChsInfoReq.ProtoReflect.Descriptor instead.
-func (*GetPayChsInfoReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ImportPeerIDsReq.ProtoReflect.Descriptor instead.
+func (*ImportPeerIDsReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetPayChsInfoReq) GetSessionID() string {
+func (x *ImportPeerIDsReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-type GetPayChsInfoResp struct {
+func (x *ImportPeerIDsReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportPeerIDsReq) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ImportPeerIDsReq) GetMergeStrategy() string {
+	if x != nil {
+		return x.MergeStrategy
+	}
+	return ""
+}
+
+func (x *ImportPeerIDsReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PeerIDImportConflict struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Response:
-	//	*GetPayChsInfoResp_MsgSuccess_
-	//	*GetPayChsInfoResp_Error
-	Response isGetPayChsInfoResp_Response `protobuf_oneof:"response"`
+	Alias         string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	ExistingAlias string `protobuf:"bytes,2,opt,name=existingAlias,proto3" json:"existingAlias,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *GetPayChsInfoResp) Reset() {
-	*x = GetPayChsInfoResp{}
+func (x *PeerIDImportConflict) Reset() {
+	*x = PeerIDImportConflict{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[35]
+		mi := &file_api_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPayChsInfoResp) String() string {
+func (x *PeerIDImportConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayChsInfoResp) ProtoMessage() {}
+func (*PeerIDImportConflict) ProtoMessage() {}
 
-func (x *GetPayChsInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[35]
+func (x *PeerIDImportConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2504,73 +3035,59 @@ func (x *GetPayChsInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayChsInfoResp.ProtoReflect.Descriptor instead.
-func (*GetPayChsInfoResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use PeerIDImportConflict.ProtoReflect.Descriptor instead.
+func (*PeerIDImportConflict) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{41}
 }
 
-func (m *GetPayChsInfoResp) GetResponse() isGetPayChsInfoResp_Response {
-	if m != nil {
-		return m.Response
+func (x *PeerIDImportConflict) GetAlias() string {
+	if x != nil {
+		return x.Alias
 	}
-	return nil
+	return ""
 }
 
-func (x *GetPayChsInfoResp) GetMsgSuccess() *GetPayChsInfoResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*GetPayChsInfoResp_MsgSuccess_); ok {
-		return x.MsgSuccess
+func (x *PeerIDImportConflict) GetExistingAlias() string {
+	if x != nil {
+		return x.ExistingAlias
 	}
-	return nil
+	return ""
 }
 
-func (x *GetPayChsInfoResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*GetPayChsInfoResp_Error); ok {
-		return x.Error
+func (x *PeerIDImportConflict) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
-	return nil
-}
-
-type isGetPayChsInfoResp_Response interface {
-	isGetPayChsInfoResp_Response()
-}
-
-type GetPayChsInfoResp_MsgSuccess_ struct {
-	MsgSuccess *GetPayChsInfoResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
-}
-
-type GetPayChsInfoResp_Error struct {
-	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+	return ""
 }
 
-func (*GetPayChsInfoResp_MsgSuccess_) isGetPayChsInfoResp_Response() {}
-
-func (*GetPayChsInfoResp_Error) isGetPayChsInfoResp_Response() {}
-
-type SubPayChProposalsReq struct {
+type PeerIDImportInvalid struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Index  int64  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Alias  string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *SubPayChProposalsReq) Reset() {
-	*x = SubPayChProposalsReq{}
+func (x *PeerIDImportInvalid) Reset() {
+	*x = PeerIDImportInvalid{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[36]
+		mi := &file_api_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubPayChProposalsReq) String() string {
+func (x *PeerIDImportInvalid) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubPayChProposalsReq) ProtoMessage() {}
+func (*PeerIDImportInvalid) ProtoMessage() {}
 
-func (x *SubPayChProposalsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[36]
+func (x *PeerIDImportInvalid) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2581,46 +3098,60 @@ func (x *SubPayChProposalsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubPayChProposalsReq.ProtoReflect.Descriptor instead.
-func (*SubPayChProposalsReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use PeerIDImportInvalid.ProtoReflect.Descriptor instead.
+func (*PeerIDImportInvalid) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SubPayChProposalsReq) GetSessionID() string {
+func (x *PeerIDImportInvalid) GetIndex() int64 {
 	if x != nil {
-		return x.SessionID
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PeerIDImportInvalid) GetAlias() string {
+	if x != nil {
+		return x.Alias
 	}
 	return ""
 }
 
-type SubPayChProposalsResp struct {
+func (x *PeerIDImportInvalid) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ImportPeerIDsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//	*SubPayChProposalsResp_Notify_
-	//	*SubPayChProposalsResp_Error
-	Response isSubPayChProposalsResp_Response `protobuf_oneof:"response"`
+	//	*ImportPeerIDsResp_MsgSuccess_
+	//	*ImportPeerIDsResp_Error
+	Response isImportPeerIDsResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *SubPayChProposalsResp) Reset() {
-	*x = SubPayChProposalsResp{}
+func (x *ImportPeerIDsResp) Reset() {
+	*x = ImportPeerIDsResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[37]
+		mi := &file_api_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubPayChProposalsResp) String() string {
+func (x *ImportPeerIDsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubPayChProposalsResp) ProtoMessage() {}
+func (*ImportPeerIDsResp) ProtoMessage() {}
 
-func (x *SubPayChProposalsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[37]
+func (x *ImportPeerIDsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2631,73 +3162,81 @@ func (x *SubPayChProposalsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubPayChProposalsResp.ProtoReflect.Descriptor instead.
-func (*SubPayChProposalsResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ImportPeerIDsResp.ProtoReflect.Descriptor instead.
+func (*ImportPeerIDsResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{43}
 }
 
-func (m *SubPayChProposalsResp) GetResponse() isSubPayChProposalsResp_Response {
+func (m *ImportPeerIDsResp) GetResponse() isImportPeerIDsResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *SubPayChProposalsResp) GetNotify() *SubPayChProposalsResp_Notify {
-	if x, ok := x.GetResponse().(*SubPayChProposalsResp_Notify_); ok {
-		return x.Notify
+func (x *ImportPeerIDsResp) GetMsgSuccess() *ImportPeerIDsResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*ImportPeerIDsResp_MsgSuccess_); ok {
+		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *SubPayChProposalsResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*SubPayChProposalsResp_Error); ok {
+func (x *ImportPeerIDsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*ImportPeerIDsResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isSubPayChProposalsResp_Response interface {
-	isSubPayChProposalsResp_Response()
+type isImportPeerIDsResp_Response interface {
+	isImportPeerIDsResp_Response()
 }
 
-type SubPayChProposalsResp_Notify_ struct {
-	Notify *SubPayChProposalsResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
+type ImportPeerIDsResp_MsgSuccess_ struct {
+	MsgSuccess *ImportPeerIDsResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type SubPayChProposalsResp_Error struct {
+type ImportPeerIDsResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*SubPayChProposalsResp_Notify_) isSubPayChProposalsResp_Response() {}
+func (*ImportPeerIDsResp_MsgSuccess_) isImportPeerIDsResp_Response() {}
 
-func (*SubPayChProposalsResp_Error) isSubPayChProposalsResp_Response() {}
+func (*ImportPeerIDsResp_Error) isImportPeerIDsResp_Response() {}
 
-type UnsubPayChProposalsReq struct {
+// PeerStatus represents the connectivity status of a peer. lastSeen is the time (in unix timestamp) at which a
+// message was last received and is 0 if never. rttMicros is the round trip time measured using pings and is 0
+// if not known.
+type PeerStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Alias           string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	OffChainAddress string `protobuf:"bytes,2,opt,name=offChainAddress,proto3" json:"offChainAddress,omitempty"`
+	Connected       bool   `protobuf:"varint,3,opt,name=connected,proto3" json:"connected,omitempty"`
+	LastSeen        int64  `protobuf:"varint,4,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	LastError       string `protobuf:"bytes,5,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	RttMicros       int64  `protobuf:"varint,6,opt,name=rttMicros,proto3" json:"rttMicros,omitempty"`
 }
 
-func (x *UnsubPayChProposalsReq) Reset() {
-	*x = UnsubPayChProposalsReq{}
+func (x *PeerStatus) Reset() {
+	*x = PeerStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[38]
+		mi := &file_api_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnsubPayChProposalsReq) String() string {
+func (x *PeerStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubPayChProposalsReq) ProtoMessage() {}
+func (*PeerStatus) ProtoMessage() {}
 
-func (x *UnsubPayChProposalsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[38]
+func (x *PeerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2708,46 +3247,79 @@ func (x *UnsubPayChProposalsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubPayChProposalsReq.ProtoReflect.Descriptor instead.
-func (*UnsubPayChProposalsReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use PeerStatus.ProtoReflect.Descriptor instead.
+func (*PeerStatus) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UnsubPayChProposalsReq) GetSessionID() string {
+func (x *PeerStatus) GetAlias() string {
 	if x != nil {
-		return x.SessionID
+		return x.Alias
 	}
 	return ""
 }
 
-type UnsubPayChProposalsResp struct {
+func (x *PeerStatus) GetOffChainAddress() string {
+	if x != nil {
+		return x.OffChainAddress
+	}
+	return ""
+}
+
+func (x *PeerStatus) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *PeerStatus) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *PeerStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *PeerStatus) GetRttMicros() int64 {
+	if x != nil {
+		return x.RttMicros
+	}
+	return 0
+}
+
+type GetPeerStatusReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Response:
-	//	*UnsubPayChProposalsResp_MsgSuccess_
-	//	*UnsubPayChProposalsResp_Error
-	Response isUnsubPayChProposalsResp_Response `protobuf_oneof:"response"`
+	SessionID   string   `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	PeerAliases []string `protobuf:"bytes,2,rep,name=peerAliases,proto3" json:"peerAliases,omitempty"`
 }
 
-func (x *UnsubPayChProposalsResp) Reset() {
-	*x = UnsubPayChProposalsResp{}
+func (x *GetPeerStatusReq) Reset() {
+	*x = GetPeerStatusReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[39]
+		mi := &file_api_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnsubPayChProposalsResp) String() string {
+func (x *GetPeerStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubPayChProposalsResp) ProtoMessage() {}
+func (*GetPeerStatusReq) ProtoMessage() {}
 
-func (x *UnsubPayChProposalsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[39]
+func (x *GetPeerStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2758,75 +3330,130 @@ func (x *UnsubPayChProposalsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubPayChProposalsResp.ProtoReflect.Descriptor instead.
-func (*UnsubPayChProposalsResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GetPeerStatusReq.ProtoReflect.Descriptor instead.
+func (*GetPeerStatusReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{45}
 }
 
-func (m *UnsubPayChProposalsResp) GetResponse() isUnsubPayChProposalsResp_Response {
-	if m != nil {
-		return m.Response
+func (x *GetPeerStatusReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
 	}
-	return nil
+	return ""
 }
 
-func (x *UnsubPayChProposalsResp) GetMsgSuccess() *UnsubPayChProposalsResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*UnsubPayChProposalsResp_MsgSuccess_); ok {
-		return x.MsgSuccess
+func (x *GetPeerStatusReq) GetPeerAliases() []string {
+	if x != nil {
+		return x.PeerAliases
 	}
 	return nil
 }
 
-func (x *UnsubPayChProposalsResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*UnsubPayChProposalsResp_Error); ok {
-		return x.Error
-	}
-	return nil
-}
+type GetPeerStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type isUnsubPayChProposalsResp_Response interface {
-	isUnsubPayChProposalsResp_Response()
+	// Types that are assignable to Response:
+	//	*GetPeerStatusResp_MsgSuccess_
+	//	*GetPeerStatusResp_Error
+	Response isGetPeerStatusResp_Response `protobuf_oneof:"response"`
 }
 
-type UnsubPayChProposalsResp_MsgSuccess_ struct {
-	MsgSuccess *UnsubPayChProposalsResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+func (x *GetPeerStatusResp) Reset() {
+	*x = GetPeerStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type UnsubPayChProposalsResp_Error struct {
-	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+func (x *GetPeerStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubPayChProposalsResp_MsgSuccess_) isUnsubPayChProposalsResp_Response() {}
-
-func (*UnsubPayChProposalsResp_Error) isUnsubPayChProposalsResp_Response() {}
+func (*GetPeerStatusResp) ProtoMessage() {}
 
-type RespondPayChProposalReq struct {
-	state         protoimpl.MessageState
+func (x *GetPeerStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerStatusResp.ProtoReflect.Descriptor instead.
+func (*GetPeerStatusResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (m *GetPeerStatusResp) GetResponse() isGetPeerStatusResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetPeerStatusResp) GetMsgSuccess() *GetPeerStatusResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetPeerStatusResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetPeerStatusResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetPeerStatusResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetPeerStatusResp_Response interface {
+	isGetPeerStatusResp_Response()
+}
+
+type GetPeerStatusResp_MsgSuccess_ struct {
+	MsgSuccess *GetPeerStatusResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetPeerStatusResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetPeerStatusResp_MsgSuccess_) isGetPeerStatusResp_Response() {}
+
+func (*GetPeerStatusResp_Error) isGetPeerStatusResp_Response() {}
+
+type SubPeerStatusReq struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID  string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ProposalID string `protobuf:"bytes,2,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
-	Accept     bool   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
 }
 
-func (x *RespondPayChProposalReq) Reset() {
-	*x = RespondPayChProposalReq{}
+func (x *SubPeerStatusReq) Reset() {
+	*x = SubPeerStatusReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[40]
+		mi := &file_api_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RespondPayChProposalReq) String() string {
+func (x *SubPeerStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondPayChProposalReq) ProtoMessage() {}
+func (*SubPeerStatusReq) ProtoMessage() {}
 
-func (x *RespondPayChProposalReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[40]
+func (x *SubPeerStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2837,60 +3464,46 @@ func (x *RespondPayChProposalReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondPayChProposalReq.ProtoReflect.Descriptor instead.
-func (*RespondPayChProposalReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use SubPeerStatusReq.ProtoReflect.Descriptor instead.
+func (*SubPeerStatusReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *RespondPayChProposalReq) GetSessionID() string {
+func (x *SubPeerStatusReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *RespondPayChProposalReq) GetProposalID() string {
-	if x != nil {
-		return x.ProposalID
-	}
-	return ""
-}
-
-func (x *RespondPayChProposalReq) GetAccept() bool {
-	if x != nil {
-		return x.Accept
-	}
-	return false
-}
-
-type RespondPayChProposalResp struct {
+type SubPeerStatusResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//	*RespondPayChProposalResp_MsgSuccess_
-	//	*RespondPayChProposalResp_Error
-	Response isRespondPayChProposalResp_Response `protobuf_oneof:"response"`
+	//	*SubPeerStatusResp_Notify_
+	//	*SubPeerStatusResp_Error
+	Response isSubPeerStatusResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *RespondPayChProposalResp) Reset() {
-	*x = RespondPayChProposalResp{}
+func (x *SubPeerStatusResp) Reset() {
+	*x = SubPeerStatusResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[41]
+		mi := &file_api_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RespondPayChProposalResp) String() string {
+func (x *SubPeerStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondPayChProposalResp) ProtoMessage() {}
+func (*SubPeerStatusResp) ProtoMessage() {}
 
-func (x *RespondPayChProposalResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[41]
+func (x *SubPeerStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2901,74 +3514,73 @@ func (x *RespondPayChProposalResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondPayChProposalResp.ProtoReflect.Descriptor instead.
-func (*RespondPayChProposalResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use SubPeerStatusResp.ProtoReflect.Descriptor instead.
+func (*SubPeerStatusResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{48}
 }
 
-func (m *RespondPayChProposalResp) GetResponse() isRespondPayChProposalResp_Response {
+func (m *SubPeerStatusResp) GetResponse() isSubPeerStatusResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *RespondPayChProposalResp) GetMsgSuccess() *RespondPayChProposalResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*RespondPayChProposalResp_MsgSuccess_); ok {
-		return x.MsgSuccess
+func (x *SubPeerStatusResp) GetNotify() *SubPeerStatusResp_Notify {
+	if x, ok := x.GetResponse().(*SubPeerStatusResp_Notify_); ok {
+		return x.Notify
 	}
 	return nil
 }
 
-func (x *RespondPayChProposalResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*RespondPayChProposalResp_Error); ok {
+func (x *SubPeerStatusResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SubPeerStatusResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isRespondPayChProposalResp_Response interface {
-	isRespondPayChProposalResp_Response()
+type isSubPeerStatusResp_Response interface {
+	isSubPeerStatusResp_Response()
 }
 
-type RespondPayChProposalResp_MsgSuccess_ struct {
-	MsgSuccess *RespondPayChProposalResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type SubPeerStatusResp_Notify_ struct {
+	Notify *SubPeerStatusResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
 }
 
-type RespondPayChProposalResp_Error struct {
+type SubPeerStatusResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*RespondPayChProposalResp_MsgSuccess_) isRespondPayChProposalResp_Response() {}
+func (*SubPeerStatusResp_Notify_) isSubPeerStatusResp_Response() {}
 
-func (*RespondPayChProposalResp_Error) isRespondPayChProposalResp_Response() {}
+func (*SubPeerStatusResp_Error) isSubPeerStatusResp_Response() {}
 
-type CloseSessionReq struct {
+type UnsubPeerStatusReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	Force     bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (x *CloseSessionReq) Reset() {
-	*x = CloseSessionReq{}
+func (x *UnsubPeerStatusReq) Reset() {
+	*x = UnsubPeerStatusReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[42]
+		mi := &file_api_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseSessionReq) String() string {
+func (x *UnsubPeerStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseSessionReq) ProtoMessage() {}
+func (*UnsubPeerStatusReq) ProtoMessage() {}
 
-func (x *CloseSessionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[42]
+func (x *UnsubPeerStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2979,53 +3591,46 @@ func (x *CloseSessionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseSessionReq.ProtoReflect.Descriptor instead.
-func (*CloseSessionReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use UnsubPeerStatusReq.ProtoReflect.Descriptor instead.
+func (*UnsubPeerStatusReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CloseSessionReq) GetSessionID() string {
+func (x *UnsubPeerStatusReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *CloseSessionReq) GetForce() bool {
-	if x != nil {
-		return x.Force
-	}
-	return false
-}
-
-type CloseSessionResp struct {
+type UnsubPeerStatusResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//	*CloseSessionResp_MsgSuccess_
-	//	*CloseSessionResp_Error
-	Response isCloseSessionResp_Response `protobuf_oneof:"response"`
+	//	*UnsubPeerStatusResp_MsgSuccess_
+	//	*UnsubPeerStatusResp_Error
+	Response isUnsubPeerStatusResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *CloseSessionResp) Reset() {
-	*x = CloseSessionResp{}
+func (x *UnsubPeerStatusResp) Reset() {
+	*x = UnsubPeerStatusResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[43]
+		mi := &file_api_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseSessionResp) String() string {
+func (x *UnsubPeerStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseSessionResp) ProtoMessage() {}
+func (*UnsubPeerStatusResp) ProtoMessage() {}
 
-func (x *CloseSessionResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[43]
+func (x *UnsubPeerStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3036,74 +3641,75 @@ func (x *CloseSessionResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseSessionResp.ProtoReflect.Descriptor instead.
-func (*CloseSessionResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use UnsubPeerStatusResp.ProtoReflect.Descriptor instead.
+func (*UnsubPeerStatusResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{50}
 }
 
-func (m *CloseSessionResp) GetResponse() isCloseSessionResp_Response {
+func (m *UnsubPeerStatusResp) GetResponse() isUnsubPeerStatusResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *CloseSessionResp) GetMsgSuccess() *CloseSessionResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*CloseSessionResp_MsgSuccess_); ok {
+func (x *UnsubPeerStatusResp) GetMsgSuccess() *UnsubPeerStatusResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*UnsubPeerStatusResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *CloseSessionResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*CloseSessionResp_Error); ok {
+func (x *UnsubPeerStatusResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*UnsubPeerStatusResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isCloseSessionResp_Response interface {
-	isCloseSessionResp_Response()
+type isUnsubPeerStatusResp_Response interface {
+	isUnsubPeerStatusResp_Response()
 }
 
-type CloseSessionResp_MsgSuccess_ struct {
-	MsgSuccess *CloseSessionResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type UnsubPeerStatusResp_MsgSuccess_ struct {
+	MsgSuccess *UnsubPeerStatusResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type CloseSessionResp_Error struct {
+type UnsubPeerStatusResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*CloseSessionResp_MsgSuccess_) isCloseSessionResp_Response() {}
+func (*UnsubPeerStatusResp_MsgSuccess_) isUnsubPeerStatusResp_Response() {}
 
-func (*CloseSessionResp_Error) isCloseSessionResp_Response() {}
+func (*UnsubPeerStatusResp_Error) isUnsubPeerStatusResp_Response() {}
 
-type DeployAssetERC20Req struct {
+type OpenPayChReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	TokenAddr string `protobuf:"bytes,2,opt,name=tokenAddr,proto3" json:"tokenAddr,omitempty"`
+	SessionID        string   `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	OpeningBalInfo   *BalInfo `protobuf:"bytes,2,opt,name=openingBalInfo,proto3" json:"openingBalInfo,omitempty"`
+	ChallengeDurSecs uint64   `protobuf:"varint,3,opt,name=challengeDurSecs,proto3" json:"challengeDurSecs,omitempty"`
 }
 
-func (x *DeployAssetERC20Req) Reset() {
-	*x = DeployAssetERC20Req{}
+func (x *OpenPayChReq) Reset() {
+	*x = OpenPayChReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[44]
+		mi := &file_api_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeployAssetERC20Req) String() string {
+func (x *OpenPayChReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployAssetERC20Req) ProtoMessage() {}
+func (*OpenPayChReq) ProtoMessage() {}
 
-func (x *DeployAssetERC20Req) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[44]
+func (x *OpenPayChReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3114,53 +3720,60 @@ func (x *DeployAssetERC20Req) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployAssetERC20Req.ProtoReflect.Descriptor instead.
-func (*DeployAssetERC20Req) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use OpenPayChReq.ProtoReflect.Descriptor instead.
+func (*OpenPayChReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *DeployAssetERC20Req) GetSessionID() string {
+func (x *OpenPayChReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *DeployAssetERC20Req) GetTokenAddr() string {
+func (x *OpenPayChReq) GetOpeningBalInfo() *BalInfo {
 	if x != nil {
-		return x.TokenAddr
+		return x.OpeningBalInfo
 	}
-	return ""
+	return nil
 }
 
-type DeployAssetERC20Resp struct {
+func (x *OpenPayChReq) GetChallengeDurSecs() uint64 {
+	if x != nil {
+		return x.ChallengeDurSecs
+	}
+	return 0
+}
+
+type OpenPayChResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//	*DeployAssetERC20Resp_MsgSuccess_
-	//	*DeployAssetERC20Resp_Error
-	Response isDeployAssetERC20Resp_Response `protobuf_oneof:"response"`
+	//	*OpenPayChResp_MsgSuccess_
+	//	*OpenPayChResp_Error
+	Response isOpenPayChResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *DeployAssetERC20Resp) Reset() {
-	*x = DeployAssetERC20Resp{}
+func (x *OpenPayChResp) Reset() {
+	*x = OpenPayChResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[45]
+		mi := &file_api_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeployAssetERC20Resp) String() string {
+func (x *OpenPayChResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployAssetERC20Resp) ProtoMessage() {}
+func (*OpenPayChResp) ProtoMessage() {}
 
-func (x *DeployAssetERC20Resp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[45]
+func (x *OpenPayChResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3171,75 +3784,73 @@ func (x *DeployAssetERC20Resp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployAssetERC20Resp.ProtoReflect.Descriptor instead.
-func (*DeployAssetERC20Resp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use OpenPayChResp.ProtoReflect.Descriptor instead.
+func (*OpenPayChResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{52}
 }
 
-func (m *DeployAssetERC20Resp) GetResponse() isDeployAssetERC20Resp_Response {
+func (m *OpenPayChResp) GetResponse() isOpenPayChResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *DeployAssetERC20Resp) GetMsgSuccess() *DeployAssetERC20Resp_MsgSuccess {
-	if x, ok := x.GetResponse().(*DeployAssetERC20Resp_MsgSuccess_); ok {
+func (x *OpenPayChResp) GetMsgSuccess() *OpenPayChResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*OpenPayChResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *DeployAssetERC20Resp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*DeployAssetERC20Resp_Error); ok {
+func (x *OpenPayChResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*OpenPayChResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isDeployAssetERC20Resp_Response interface {
-	isDeployAssetERC20Resp_Response()
+type isOpenPayChResp_Response interface {
+	isOpenPayChResp_Response()
 }
 
-type DeployAssetERC20Resp_MsgSuccess_ struct {
-	MsgSuccess *DeployAssetERC20Resp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type OpenPayChResp_MsgSuccess_ struct {
+	MsgSuccess *OpenPayChResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type DeployAssetERC20Resp_Error struct {
+type OpenPayChResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*DeployAssetERC20Resp_MsgSuccess_) isDeployAssetERC20Resp_Response() {}
+func (*OpenPayChResp_MsgSuccess_) isOpenPayChResp_Response() {}
 
-func (*DeployAssetERC20Resp_Error) isDeployAssetERC20Resp_Response() {}
+func (*OpenPayChResp_Error) isOpenPayChResp_Response() {}
 
-type SendPayChUpdateReq struct {
+type GetPayChsInfoReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string     `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ChID      string     `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
-	Payments  []*Payment `protobuf:"bytes,3,rep,name=payments,proto3" json:"payments,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
 }
 
-func (x *SendPayChUpdateReq) Reset() {
-	*x = SendPayChUpdateReq{}
+func (x *GetPayChsInfoReq) Reset() {
+	*x = GetPayChsInfoReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[46]
+		mi := &file_api_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendPayChUpdateReq) String() string {
+func (x *GetPayChsInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendPayChUpdateReq) ProtoMessage() {}
+func (*GetPayChsInfoReq) ProtoMessage() {}
 
-func (x *SendPayChUpdateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[46]
+func (x *GetPayChsInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3250,60 +3861,46 @@ func (x *SendPayChUpdateReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendPayChUpdateReq.ProtoReflect.Descriptor instead.
-func (*SendPayChUpdateReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use GetPayChsInfoReq.ProtoReflect.Descriptor instead.
+func (*GetPayChsInfoReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *SendPayChUpdateReq) GetSessionID() string {
+func (x *GetPayChsInfoReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *SendPayChUpdateReq) GetChID() string {
-	if x != nil {
-		return x.ChID
-	}
-	return ""
-}
-
-func (x *SendPayChUpdateReq) GetPayments() []*Payment {
-	if x != nil {
-		return x.Payments
-	}
-	return nil
-}
-
-type SendPayChUpdateResp struct {
+type GetPayChsInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//	*SendPayChUpdateResp_MsgSuccess_
-	//	*SendPayChUpdateResp_Error
-	Response isSendPayChUpdateResp_Response `protobuf_oneof:"response"`
+	//	*GetPayChsInfoResp_MsgSuccess_
+	//	*GetPayChsInfoResp_Error
+	Response isGetPayChsInfoResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *SendPayChUpdateResp) Reset() {
-	*x = SendPayChUpdateResp{}
+func (x *GetPayChsInfoResp) Reset() {
+	*x = GetPayChsInfoResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[47]
+		mi := &file_api_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendPayChUpdateResp) String() string {
+func (x *GetPayChsInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendPayChUpdateResp) ProtoMessage() {}
+func (*GetPayChsInfoResp) ProtoMessage() {}
 
-func (x *SendPayChUpdateResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[47]
+func (x *GetPayChsInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3314,74 +3911,73 @@ func (x *SendPayChUpdateResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendPayChUpdateResp.ProtoReflect.Descriptor instead.
-func (*SendPayChUpdateResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use GetPayChsInfoResp.ProtoReflect.Descriptor instead.
+func (*GetPayChsInfoResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{54}
 }
 
-func (m *SendPayChUpdateResp) GetResponse() isSendPayChUpdateResp_Response {
+func (m *GetPayChsInfoResp) GetResponse() isGetPayChsInfoResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *SendPayChUpdateResp) GetMsgSuccess() *SendPayChUpdateResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*SendPayChUpdateResp_MsgSuccess_); ok {
+func (x *GetPayChsInfoResp) GetMsgSuccess() *GetPayChsInfoResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetPayChsInfoResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *SendPayChUpdateResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*SendPayChUpdateResp_Error); ok {
+func (x *GetPayChsInfoResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetPayChsInfoResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isSendPayChUpdateResp_Response interface {
-	isSendPayChUpdateResp_Response()
+type isGetPayChsInfoResp_Response interface {
+	isGetPayChsInfoResp_Response()
 }
 
-type SendPayChUpdateResp_MsgSuccess_ struct {
-	MsgSuccess *SendPayChUpdateResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type GetPayChsInfoResp_MsgSuccess_ struct {
+	MsgSuccess *GetPayChsInfoResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type SendPayChUpdateResp_Error struct {
+type GetPayChsInfoResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*SendPayChUpdateResp_MsgSuccess_) isSendPayChUpdateResp_Response() {}
+func (*GetPayChsInfoResp_MsgSuccess_) isGetPayChsInfoResp_Response() {}
 
-func (*SendPayChUpdateResp_Error) isSendPayChUpdateResp_Response() {}
+func (*GetPayChsInfoResp_Error) isGetPayChsInfoResp_Response() {}
 
-type SubpayChUpdatesReq struct {
+type SubPayChProposalsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
 }
 
-func (x *SubpayChUpdatesReq) Reset() {
-	*x = SubpayChUpdatesReq{}
+func (x *SubPayChProposalsReq) Reset() {
+	*x = SubPayChProposalsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[48]
+		mi := &file_api_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubpayChUpdatesReq) String() string {
+func (x *SubPayChProposalsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubpayChUpdatesReq) ProtoMessage() {}
+func (*SubPayChProposalsReq) ProtoMessage() {}
 
-func (x *SubpayChUpdatesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[48]
+func (x *SubPayChProposalsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3392,53 +3988,46 @@ func (x *SubpayChUpdatesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubpayChUpdatesReq.ProtoReflect.Descriptor instead.
-func (*SubpayChUpdatesReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use SubPayChProposalsReq.ProtoReflect.Descriptor instead.
+func (*SubPayChProposalsReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *SubpayChUpdatesReq) GetSessionID() string {
+func (x *SubPayChProposalsReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *SubpayChUpdatesReq) GetChID() string {
-	if x != nil {
-		return x.ChID
-	}
-	return ""
-}
-
-type SubPayChUpdatesResp struct {
+type SubPayChProposalsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//	*SubPayChUpdatesResp_Notify_
-	//	*SubPayChUpdatesResp_Error
-	Response isSubPayChUpdatesResp_Response `protobuf_oneof:"response"`
+	//	*SubPayChProposalsResp_Notify_
+	//	*SubPayChProposalsResp_Error
+	Response isSubPayChProposalsResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *SubPayChUpdatesResp) Reset() {
-	*x = SubPayChUpdatesResp{}
+func (x *SubPayChProposalsResp) Reset() {
+	*x = SubPayChProposalsResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[49]
+		mi := &file_api_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubPayChUpdatesResp) String() string {
+func (x *SubPayChProposalsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubPayChUpdatesResp) ProtoMessage() {}
+func (*SubPayChProposalsResp) ProtoMessage() {}
 
-func (x *SubPayChUpdatesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[49]
+func (x *SubPayChProposalsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3449,74 +4038,73 @@ func (x *SubPayChUpdatesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubPayChUpdatesResp.ProtoReflect.Descriptor instead.
-func (*SubPayChUpdatesResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use SubPayChProposalsResp.ProtoReflect.Descriptor instead.
+func (*SubPayChProposalsResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{56}
 }
 
-func (m *SubPayChUpdatesResp) GetResponse() isSubPayChUpdatesResp_Response {
+func (m *SubPayChProposalsResp) GetResponse() isSubPayChProposalsResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *SubPayChUpdatesResp) GetNotify() *SubPayChUpdatesResp_Notify {
-	if x, ok := x.GetResponse().(*SubPayChUpdatesResp_Notify_); ok {
+func (x *SubPayChProposalsResp) GetNotify() *SubPayChProposalsResp_Notify {
+	if x, ok := x.GetResponse().(*SubPayChProposalsResp_Notify_); ok {
 		return x.Notify
 	}
 	return nil
 }
 
-func (x *SubPayChUpdatesResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*SubPayChUpdatesResp_Error); ok {
+func (x *SubPayChProposalsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SubPayChProposalsResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isSubPayChUpdatesResp_Response interface {
-	isSubPayChUpdatesResp_Response()
+type isSubPayChProposalsResp_Response interface {
+	isSubPayChProposalsResp_Response()
 }
 
-type SubPayChUpdatesResp_Notify_ struct {
-	Notify *SubPayChUpdatesResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
+type SubPayChProposalsResp_Notify_ struct {
+	Notify *SubPayChProposalsResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
 }
 
-type SubPayChUpdatesResp_Error struct {
+type SubPayChProposalsResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*SubPayChUpdatesResp_Notify_) isSubPayChUpdatesResp_Response() {}
+func (*SubPayChProposalsResp_Notify_) isSubPayChProposalsResp_Response() {}
 
-func (*SubPayChUpdatesResp_Error) isSubPayChUpdatesResp_Response() {}
+func (*SubPayChProposalsResp_Error) isSubPayChProposalsResp_Response() {}
 
-type UnsubPayChUpdatesReq struct {
+type UnsubPayChProposalsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
 }
 
-func (x *UnsubPayChUpdatesReq) Reset() {
-	*x = UnsubPayChUpdatesReq{}
+func (x *UnsubPayChProposalsReq) Reset() {
+	*x = UnsubPayChProposalsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[50]
+		mi := &file_api_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnsubPayChUpdatesReq) String() string {
+func (x *UnsubPayChProposalsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubPayChUpdatesReq) ProtoMessage() {}
+func (*UnsubPayChProposalsReq) ProtoMessage() {}
 
-func (x *UnsubPayChUpdatesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[50]
+func (x *UnsubPayChProposalsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3527,53 +4115,46 @@ func (x *UnsubPayChUpdatesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubPayChUpdatesReq.ProtoReflect.Descriptor instead.
-func (*UnsubPayChUpdatesReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use UnsubPayChProposalsReq.ProtoReflect.Descriptor instead.
+func (*UnsubPayChProposalsReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *UnsubPayChUpdatesReq) GetSessionID() string {
+func (x *UnsubPayChProposalsReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *UnsubPayChUpdatesReq) GetChID() string {
-	if x != nil {
-		return x.ChID
-	}
-	return ""
-}
-
-type UnsubPayChUpdatesResp struct {
+type UnsubPayChProposalsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//	*UnsubPayChUpdatesResp_MsgSuccess_
-	//	*UnsubPayChUpdatesResp_Error
-	Response isUnsubPayChUpdatesResp_Response `protobuf_oneof:"response"`
+	//	*UnsubPayChProposalsResp_MsgSuccess_
+	//	*UnsubPayChProposalsResp_Error
+	Response isUnsubPayChProposalsResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *UnsubPayChUpdatesResp) Reset() {
-	*x = UnsubPayChUpdatesResp{}
+func (x *UnsubPayChProposalsResp) Reset() {
+	*x = UnsubPayChProposalsResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[51]
+		mi := &file_api_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnsubPayChUpdatesResp) String() string {
+func (x *UnsubPayChProposalsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubPayChUpdatesResp) ProtoMessage() {}
+func (*UnsubPayChProposalsResp) ProtoMessage() {}
 
-func (x *UnsubPayChUpdatesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[51]
+func (x *UnsubPayChProposalsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3584,76 +4165,75 @@ func (x *UnsubPayChUpdatesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubPayChUpdatesResp.ProtoReflect.Descriptor instead.
-func (*UnsubPayChUpdatesResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use UnsubPayChProposalsResp.ProtoReflect.Descriptor instead.
+func (*UnsubPayChProposalsResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{58}
 }
 
-func (m *UnsubPayChUpdatesResp) GetResponse() isUnsubPayChUpdatesResp_Response {
+func (m *UnsubPayChProposalsResp) GetResponse() isUnsubPayChProposalsResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *UnsubPayChUpdatesResp) GetMsgSuccess() *UnsubPayChUpdatesResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*UnsubPayChUpdatesResp_MsgSuccess_); ok {
+func (x *UnsubPayChProposalsResp) GetMsgSuccess() *UnsubPayChProposalsResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*UnsubPayChProposalsResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *UnsubPayChUpdatesResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*UnsubPayChUpdatesResp_Error); ok {
+func (x *UnsubPayChProposalsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*UnsubPayChProposalsResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isUnsubPayChUpdatesResp_Response interface {
-	isUnsubPayChUpdatesResp_Response()
+type isUnsubPayChProposalsResp_Response interface {
+	isUnsubPayChProposalsResp_Response()
 }
 
-type UnsubPayChUpdatesResp_MsgSuccess_ struct {
-	MsgSuccess *UnsubPayChUpdatesResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type UnsubPayChProposalsResp_MsgSuccess_ struct {
+	MsgSuccess *UnsubPayChProposalsResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type UnsubPayChUpdatesResp_Error struct {
+type UnsubPayChProposalsResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*UnsubPayChUpdatesResp_MsgSuccess_) isUnsubPayChUpdatesResp_Response() {}
+func (*UnsubPayChProposalsResp_MsgSuccess_) isUnsubPayChProposalsResp_Response() {}
 
-func (*UnsubPayChUpdatesResp_Error) isUnsubPayChUpdatesResp_Response() {}
+func (*UnsubPayChProposalsResp_Error) isUnsubPayChProposalsResp_Response() {}
 
-type RespondPayChUpdateReq struct {
+type RespondPayChProposalReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
-	UpdateID  string `protobuf:"bytes,3,opt,name=updateID,proto3" json:"updateID,omitempty"`
-	Accept    bool   `protobuf:"varint,4,opt,name=accept,proto3" json:"accept,omitempty"`
+	SessionID  string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ProposalID string `protobuf:"bytes,2,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Accept     bool   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
 }
 
-func (x *RespondPayChUpdateReq) Reset() {
-	*x = RespondPayChUpdateReq{}
+func (x *RespondPayChProposalReq) Reset() {
+	*x = RespondPayChProposalReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[52]
+		mi := &file_api_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RespondPayChUpdateReq) String() string {
+func (x *RespondPayChProposalReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondPayChUpdateReq) ProtoMessage() {}
+func (*RespondPayChProposalReq) ProtoMessage() {}
 
-func (x *RespondPayChUpdateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[52]
+func (x *RespondPayChProposalReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3664,67 +4244,60 @@ func (x *RespondPayChUpdateReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondPayChUpdateReq.ProtoReflect.Descriptor instead.
-func (*RespondPayChUpdateReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use RespondPayChProposalReq.ProtoReflect.Descriptor instead.
+func (*RespondPayChProposalReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *RespondPayChUpdateReq) GetSessionID() string {
+func (x *RespondPayChProposalReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *RespondPayChUpdateReq) GetChID() string {
-	if x != nil {
-		return x.ChID
-	}
-	return ""
-}
-
-func (x *RespondPayChUpdateReq) GetUpdateID() string {
+func (x *RespondPayChProposalReq) GetProposalID() string {
 	if x != nil {
-		return x.UpdateID
+		return x.ProposalID
 	}
 	return ""
 }
 
-func (x *RespondPayChUpdateReq) GetAccept() bool {
+func (x *RespondPayChProposalReq) GetAccept() bool {
 	if x != nil {
 		return x.Accept
 	}
 	return false
 }
 
-type RespondPayChUpdateResp struct {
+type RespondPayChProposalResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//	*RespondPayChUpdateResp_MsgSuccess_
-	//	*RespondPayChUpdateResp_Error
-	Response isRespondPayChUpdateResp_Response `protobuf_oneof:"response"`
+	//	*RespondPayChProposalResp_MsgSuccess_
+	//	*RespondPayChProposalResp_Error
+	Response isRespondPayChProposalResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *RespondPayChUpdateResp) Reset() {
-	*x = RespondPayChUpdateResp{}
+func (x *RespondPayChProposalResp) Reset() {
+	*x = RespondPayChProposalResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[53]
+		mi := &file_api_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RespondPayChUpdateResp) String() string {
+func (x *RespondPayChProposalResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondPayChUpdateResp) ProtoMessage() {}
+func (*RespondPayChProposalResp) ProtoMessage() {}
 
-func (x *RespondPayChUpdateResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[53]
+func (x *RespondPayChProposalResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3735,74 +4308,74 @@ func (x *RespondPayChUpdateResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondPayChUpdateResp.ProtoReflect.Descriptor instead.
-func (*RespondPayChUpdateResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use RespondPayChProposalResp.ProtoReflect.Descriptor instead.
+func (*RespondPayChProposalResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{60}
 }
 
-func (m *RespondPayChUpdateResp) GetResponse() isRespondPayChUpdateResp_Response {
+func (m *RespondPayChProposalResp) GetResponse() isRespondPayChProposalResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *RespondPayChUpdateResp) GetMsgSuccess() *RespondPayChUpdateResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*RespondPayChUpdateResp_MsgSuccess_); ok {
+func (x *RespondPayChProposalResp) GetMsgSuccess() *RespondPayChProposalResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*RespondPayChProposalResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *RespondPayChUpdateResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*RespondPayChUpdateResp_Error); ok {
+func (x *RespondPayChProposalResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*RespondPayChProposalResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isRespondPayChUpdateResp_Response interface {
-	isRespondPayChUpdateResp_Response()
+type isRespondPayChProposalResp_Response interface {
+	isRespondPayChProposalResp_Response()
 }
 
-type RespondPayChUpdateResp_MsgSuccess_ struct {
-	MsgSuccess *RespondPayChUpdateResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type RespondPayChProposalResp_MsgSuccess_ struct {
+	MsgSuccess *RespondPayChProposalResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type RespondPayChUpdateResp_Error struct {
+type RespondPayChProposalResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*RespondPayChUpdateResp_MsgSuccess_) isRespondPayChUpdateResp_Response() {}
+func (*RespondPayChProposalResp_MsgSuccess_) isRespondPayChProposalResp_Response() {}
 
-func (*RespondPayChUpdateResp_Error) isRespondPayChUpdateResp_Response() {}
+func (*RespondPayChProposalResp_Error) isRespondPayChProposalResp_Response() {}
 
-type GetPayChInfoReq struct {
+type CloseSessionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	Force     bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (x *GetPayChInfoReq) Reset() {
-	*x = GetPayChInfoReq{}
+func (x *CloseSessionReq) Reset() {
+	*x = CloseSessionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[54]
+		mi := &file_api_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPayChInfoReq) String() string {
+func (x *CloseSessionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayChInfoReq) ProtoMessage() {}
+func (*CloseSessionReq) ProtoMessage() {}
 
-func (x *GetPayChInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[54]
+func (x *CloseSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3813,53 +4386,53 @@ func (x *GetPayChInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayChInfoReq.ProtoReflect.Descriptor instead.
-func (*GetPayChInfoReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use CloseSessionReq.ProtoReflect.Descriptor instead.
+func (*CloseSessionReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetPayChInfoReq) GetSessionID() string {
+func (x *CloseSessionReq) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *GetPayChInfoReq) GetChID() string {
+func (x *CloseSessionReq) GetForce() bool {
 	if x != nil {
-		return x.ChID
+		return x.Force
 	}
-	return ""
+	return false
 }
 
-type GetPayChInfoResp struct {
+type CloseSessionResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//	*GetPayChInfoResp_MsgSuccess_
-	//	*GetPayChInfoResp_Error
-	Response isGetPayChInfoResp_Response `protobuf_oneof:"response"`
+	//	*CloseSessionResp_MsgSuccess_
+	//	*CloseSessionResp_Error
+	Response isCloseSessionResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *GetPayChInfoResp) Reset() {
-	*x = GetPayChInfoResp{}
+func (x *CloseSessionResp) Reset() {
+	*x = CloseSessionResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[55]
+		mi := &file_api_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPayChInfoResp) String() string {
+func (x *CloseSessionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayChInfoResp) ProtoMessage() {}
+func (*CloseSessionResp) ProtoMessage() {}
 
-func (x *GetPayChInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[55]
+func (x *CloseSessionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3870,74 +4443,74 @@ func (x *GetPayChInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayChInfoResp.ProtoReflect.Descriptor instead.
-func (*GetPayChInfoResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use CloseSessionResp.ProtoReflect.Descriptor instead.
+func (*CloseSessionResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{62}
 }
 
-func (m *GetPayChInfoResp) GetResponse() isGetPayChInfoResp_Response {
+func (m *CloseSessionResp) GetResponse() isCloseSessionResp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *GetPayChInfoResp) GetMsgSuccess() *GetPayChInfoResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*GetPayChInfoResp_MsgSuccess_); ok {
+func (x *CloseSessionResp) GetMsgSuccess() *CloseSessionResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*CloseSessionResp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *GetPayChInfoResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*GetPayChInfoResp_Error); ok {
+func (x *CloseSessionResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*CloseSessionResp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isGetPayChInfoResp_Response interface {
-	isGetPayChInfoResp_Response()
+type isCloseSessionResp_Response interface {
+	isCloseSessionResp_Response()
 }
 
-type GetPayChInfoResp_MsgSuccess_ struct {
-	MsgSuccess *GetPayChInfoResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type CloseSessionResp_MsgSuccess_ struct {
+	MsgSuccess *CloseSessionResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type GetPayChInfoResp_Error struct {
+type CloseSessionResp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*GetPayChInfoResp_MsgSuccess_) isGetPayChInfoResp_Response() {}
+func (*CloseSessionResp_MsgSuccess_) isCloseSessionResp_Response() {}
 
-func (*GetPayChInfoResp_Error) isGetPayChInfoResp_Response() {}
+func (*CloseSessionResp_Error) isCloseSessionResp_Response() {}
 
-type ClosePayChReq struct {
+type DeployAssetERC20Req struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	TokenAddr string `protobuf:"bytes,2,opt,name=tokenAddr,proto3" json:"tokenAddr,omitempty"`
 }
 
-func (x *ClosePayChReq) Reset() {
-	*x = ClosePayChReq{}
+func (x *DeployAssetERC20Req) Reset() {
+	*x = DeployAssetERC20Req{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[56]
+		mi := &file_api_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClosePayChReq) String() string {
+func (x *DeployAssetERC20Req) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClosePayChReq) ProtoMessage() {}
+func (*DeployAssetERC20Req) ProtoMessage() {}
 
-func (x *ClosePayChReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[56]
+func (x *DeployAssetERC20Req) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3948,53 +4521,53 @@ func (x *ClosePayChReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClosePayChReq.ProtoReflect.Descriptor instead.
-func (*ClosePayChReq) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use DeployAssetERC20Req.ProtoReflect.Descriptor instead.
+func (*DeployAssetERC20Req) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ClosePayChReq) GetSessionID() string {
+func (x *DeployAssetERC20Req) GetSessionID() string {
 	if x != nil {
 		return x.SessionID
 	}
 	return ""
 }
 
-func (x *ClosePayChReq) GetChID() string {
+func (x *DeployAssetERC20Req) GetTokenAddr() string {
 	if x != nil {
-		return x.ChID
+		return x.TokenAddr
 	}
 	return ""
 }
 
-type ClosePayChResp struct {
+type DeployAssetERC20Resp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//	*ClosePayChResp_MsgSuccess_
-	//	*ClosePayChResp_Error
-	Response isClosePayChResp_Response `protobuf_oneof:"response"`
+	//	*DeployAssetERC20Resp_MsgSuccess_
+	//	*DeployAssetERC20Resp_Error
+	Response isDeployAssetERC20Resp_Response `protobuf_oneof:"response"`
 }
 
-func (x *ClosePayChResp) Reset() {
-	*x = ClosePayChResp{}
+func (x *DeployAssetERC20Resp) Reset() {
+	*x = DeployAssetERC20Resp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[57]
+		mi := &file_api_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClosePayChResp) String() string {
+func (x *DeployAssetERC20Resp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClosePayChResp) ProtoMessage() {}
+func (*DeployAssetERC20Resp) ProtoMessage() {}
 
-func (x *ClosePayChResp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[57]
+func (x *DeployAssetERC20Resp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4005,73 +4578,74 @@ func (x *ClosePayChResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClosePayChResp.ProtoReflect.Descriptor instead.
-func (*ClosePayChResp) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use DeployAssetERC20Resp.ProtoReflect.Descriptor instead.
+func (*DeployAssetERC20Resp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{64}
 }
 
-func (m *ClosePayChResp) GetResponse() isClosePayChResp_Response {
+func (m *DeployAssetERC20Resp) GetResponse() isDeployAssetERC20Resp_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (x *ClosePayChResp) GetMsgSuccess() *ClosePayChResp_MsgSuccess {
-	if x, ok := x.GetResponse().(*ClosePayChResp_MsgSuccess_); ok {
+func (x *DeployAssetERC20Resp) GetMsgSuccess() *DeployAssetERC20Resp_MsgSuccess {
+	if x, ok := x.GetResponse().(*DeployAssetERC20Resp_MsgSuccess_); ok {
 		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *ClosePayChResp) GetError() *MsgError {
-	if x, ok := x.GetResponse().(*ClosePayChResp_Error); ok {
+func (x *DeployAssetERC20Resp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*DeployAssetERC20Resp_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
-type isClosePayChResp_Response interface {
-	isClosePayChResp_Response()
+type isDeployAssetERC20Resp_Response interface {
+	isDeployAssetERC20Resp_Response()
 }
 
-type ClosePayChResp_MsgSuccess_ struct {
-	MsgSuccess *ClosePayChResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+type DeployAssetERC20Resp_MsgSuccess_ struct {
+	MsgSuccess *DeployAssetERC20Resp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
 }
 
-type ClosePayChResp_Error struct {
+type DeployAssetERC20Resp_Error struct {
 	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*ClosePayChResp_MsgSuccess_) isClosePayChResp_Response() {}
+func (*DeployAssetERC20Resp_MsgSuccess_) isDeployAssetERC20Resp_Response() {}
 
-func (*ClosePayChResp_Error) isClosePayChResp_Response() {}
+func (*DeployAssetERC20Resp_Error) isDeployAssetERC20Resp_Response() {}
 
-type BalInfoBal struct {
+type GetOnChainBalReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bal []string `protobuf:"bytes,1,rep,name=bal,proto3" json:"bal,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Currency  string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
 }
 
-func (x *BalInfoBal) Reset() {
-	*x = BalInfoBal{}
+func (x *GetOnChainBalReq) Reset() {
+	*x = GetOnChainBalReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[58]
+		mi := &file_api_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BalInfoBal) String() string {
+func (x *GetOnChainBalReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BalInfoBal) ProtoMessage() {}
+func (*GetOnChainBalReq) ProtoMessage() {}
 
-func (x *BalInfoBal) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[58]
+func (x *GetOnChainBalReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4082,44 +4656,53 @@ func (x *BalInfoBal) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BalInfoBal.ProtoReflect.Descriptor instead.
-func (*BalInfoBal) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use GetOnChainBalReq.ProtoReflect.Descriptor instead.
+func (*GetOnChainBalReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *BalInfoBal) GetBal() []string {
+func (x *GetOnChainBalReq) GetSessionID() string {
 	if x != nil {
-		return x.Bal
+		return x.SessionID
 	}
-	return nil
+	return ""
 }
 
-type OpenSessionResp_MsgSuccess struct {
+func (x *GetOnChainBalReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetOnChainBalResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID   string       `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
-	RestoredChs []*PayChInfo `protobuf:"bytes,2,rep,name=restoredChs,proto3" json:"restoredChs,omitempty"`
+	// Types that are assignable to Response:
+	//	*GetOnChainBalResp_MsgSuccess_
+	//	*GetOnChainBalResp_Error
+	Response isGetOnChainBalResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *OpenSessionResp_MsgSuccess) Reset() {
-	*x = OpenSessionResp_MsgSuccess{}
+func (x *GetOnChainBalResp) Reset() {
+	*x = GetOnChainBalResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[59]
+		mi := &file_api_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenSessionResp_MsgSuccess) String() string {
+func (x *GetOnChainBalResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenSessionResp_MsgSuccess) ProtoMessage() {}
+func (*GetOnChainBalResp) ProtoMessage() {}
 
-func (x *OpenSessionResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[59]
+func (x *GetOnChainBalResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4130,50 +4713,76 @@ func (x *OpenSessionResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenSessionResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*OpenSessionResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{21, 0}
+// Deprecated: Use GetOnChainBalResp.ProtoReflect.Descriptor instead.
+func (*GetOnChainBalResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *OpenSessionResp_MsgSuccess) GetSessionID() string {
-	if x != nil {
-		return x.SessionID
+func (m *GetOnChainBalResp) GetResponse() isGetOnChainBalResp_Response {
+	if m != nil {
+		return m.Response
 	}
-	return ""
+	return nil
 }
 
-func (x *OpenSessionResp_MsgSuccess) GetRestoredChs() []*PayChInfo {
-	if x != nil {
-		return x.RestoredChs
+func (x *GetOnChainBalResp) GetMsgSuccess() *GetOnChainBalResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetOnChainBalResp_MsgSuccess_); ok {
+		return x.MsgSuccess
 	}
 	return nil
 }
 
-type RegisterCurrencyResp_MsgSuccess struct {
+func (x *GetOnChainBalResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetOnChainBalResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetOnChainBalResp_Response interface {
+	isGetOnChainBalResp_Response()
+}
+
+type GetOnChainBalResp_MsgSuccess_ struct {
+	MsgSuccess *GetOnChainBalResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetOnChainBalResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetOnChainBalResp_MsgSuccess_) isGetOnChainBalResp_Response() {}
+
+func (*GetOnChainBalResp_Error) isGetOnChainBalResp_Response() {}
+
+type TransferOnChainReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Currency  string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	To        string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *RegisterCurrencyResp_MsgSuccess) Reset() {
-	*x = RegisterCurrencyResp_MsgSuccess{}
+func (x *TransferOnChainReq) Reset() {
+	*x = TransferOnChainReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[60]
+		mi := &file_api_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterCurrencyResp_MsgSuccess) String() string {
+func (x *TransferOnChainReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterCurrencyResp_MsgSuccess) ProtoMessage() {}
+func (*TransferOnChainReq) ProtoMessage() {}
 
-func (x *RegisterCurrencyResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[60]
+func (x *TransferOnChainReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4184,43 +4793,67 @@ func (x *RegisterCurrencyResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterCurrencyResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*RegisterCurrencyResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{25, 0}
+// Deprecated: Use TransferOnChainReq.ProtoReflect.Descriptor instead.
+func (*TransferOnChainReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *RegisterCurrencyResp_MsgSuccess) GetSymbol() string {
+func (x *TransferOnChainReq) GetSessionID() string {
 	if x != nil {
-		return x.Symbol
+		return x.SessionID
 	}
 	return ""
 }
 
-type AddPeerIDResp_MsgSuccess struct {
+func (x *TransferOnChainReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TransferOnChainReq) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TransferOnChainReq) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type TransferOnChainResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Types that are assignable to Response:
+	//	*TransferOnChainResp_MsgSuccess_
+	//	*TransferOnChainResp_Error
+	Response isTransferOnChainResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *AddPeerIDResp_MsgSuccess) Reset() {
-	*x = AddPeerIDResp_MsgSuccess{}
+func (x *TransferOnChainResp) Reset() {
+	*x = TransferOnChainResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[61]
+		mi := &file_api_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddPeerIDResp_MsgSuccess) String() string {
+func (x *TransferOnChainResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPeerIDResp_MsgSuccess) ProtoMessage() {}
+func (*TransferOnChainResp) ProtoMessage() {}
 
-func (x *AddPeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[61]
+func (x *TransferOnChainResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4231,43 +4864,74 @@ func (x *AddPeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPeerIDResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*AddPeerIDResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{29, 0}
+// Deprecated: Use TransferOnChainResp.ProtoReflect.Descriptor instead.
+func (*TransferOnChainResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *AddPeerIDResp_MsgSuccess) GetSuccess() bool {
-	if x != nil {
-		return x.Success
+func (m *TransferOnChainResp) GetResponse() isTransferOnChainResp_Response {
+	if m != nil {
+		return m.Response
 	}
-	return false
+	return nil
 }
 
-type GetPeerIDResp_MsgSuccess struct {
+func (x *TransferOnChainResp) GetMsgSuccess() *TransferOnChainResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*TransferOnChainResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *TransferOnChainResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*TransferOnChainResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isTransferOnChainResp_Response interface {
+	isTransferOnChainResp_Response()
+}
+
+type TransferOnChainResp_MsgSuccess_ struct {
+	MsgSuccess *TransferOnChainResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type TransferOnChainResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*TransferOnChainResp_MsgSuccess_) isTransferOnChainResp_Response() {}
+
+func (*TransferOnChainResp_Error) isTransferOnChainResp_Response() {}
+
+type GetAllowanceReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerID *PeerID `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Currency  string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
 }
 
-func (x *GetPeerIDResp_MsgSuccess) Reset() {
-	*x = GetPeerIDResp_MsgSuccess{}
+func (x *GetAllowanceReq) Reset() {
+	*x = GetAllowanceReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[62]
+		mi := &file_api_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPeerIDResp_MsgSuccess) String() string {
+func (x *GetAllowanceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPeerIDResp_MsgSuccess) ProtoMessage() {}
+func (*GetAllowanceReq) ProtoMessage() {}
 
-func (x *GetPeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[62]
+func (x *GetAllowanceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4278,43 +4942,53 @@ func (x *GetPeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPeerIDResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*GetPeerIDResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{31, 0}
+// Deprecated: Use GetAllowanceReq.ProtoReflect.Descriptor instead.
+func (*GetAllowanceReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetPeerIDResp_MsgSuccess) GetPeerID() *PeerID {
+func (x *GetAllowanceReq) GetSessionID() string {
 	if x != nil {
-		return x.PeerID
+		return x.SessionID
 	}
-	return nil
+	return ""
 }
 
-type OpenPayChResp_MsgSuccess struct {
+func (x *GetAllowanceReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetAllowanceResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OpenedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=openedPayChInfo,proto3" json:"openedPayChInfo,omitempty"`
+	// Types that are assignable to Response:
+	//	*GetAllowanceResp_MsgSuccess_
+	//	*GetAllowanceResp_Error
+	Response isGetAllowanceResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *OpenPayChResp_MsgSuccess) Reset() {
-	*x = OpenPayChResp_MsgSuccess{}
+func (x *GetAllowanceResp) Reset() {
+	*x = GetAllowanceResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[63]
+		mi := &file_api_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenPayChResp_MsgSuccess) String() string {
+func (x *GetAllowanceResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenPayChResp_MsgSuccess) ProtoMessage() {}
+func (*GetAllowanceResp) ProtoMessage() {}
 
-func (x *OpenPayChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[63]
+func (x *GetAllowanceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4325,43 +4999,75 @@ func (x *OpenPayChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenPayChResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*OpenPayChResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{33, 0}
+// Deprecated: Use GetAllowanceResp.ProtoReflect.Descriptor instead.
+func (*GetAllowanceResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *OpenPayChResp_MsgSuccess) GetOpenedPayChInfo() *PayChInfo {
-	if x != nil {
-		return x.OpenedPayChInfo
+func (m *GetAllowanceResp) GetResponse() isGetAllowanceResp_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-type GetPayChsInfoResp_MsgSuccess struct {
+func (x *GetAllowanceResp) GetMsgSuccess() *GetAllowanceResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetAllowanceResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetAllowanceResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetAllowanceResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetAllowanceResp_Response interface {
+	isGetAllowanceResp_Response()
+}
+
+type GetAllowanceResp_MsgSuccess_ struct {
+	MsgSuccess *GetAllowanceResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetAllowanceResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetAllowanceResp_MsgSuccess_) isGetAllowanceResp_Response() {}
+
+func (*GetAllowanceResp_Error) isGetAllowanceResp_Response() {}
+
+type SetAllowanceReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OpenPayChsInfo []*PayChInfo `protobuf:"bytes,1,rep,name=openPayChsInfo,proto3" json:"openPayChsInfo,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Currency  string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount    string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *GetPayChsInfoResp_MsgSuccess) Reset() {
-	*x = GetPayChsInfoResp_MsgSuccess{}
+func (x *SetAllowanceReq) Reset() {
+	*x = SetAllowanceReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[64]
+		mi := &file_api_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPayChsInfoResp_MsgSuccess) String() string {
+func (x *SetAllowanceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayChsInfoResp_MsgSuccess) ProtoMessage() {}
+func (*SetAllowanceReq) ProtoMessage() {}
 
-func (x *GetPayChsInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[64]
+func (x *SetAllowanceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4372,46 +5078,60 @@ func (x *GetPayChsInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayChsInfoResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*GetPayChsInfoResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{35, 0}
+// Deprecated: Use SetAllowanceReq.ProtoReflect.Descriptor instead.
+func (*SetAllowanceReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetPayChsInfoResp_MsgSuccess) GetOpenPayChsInfo() []*PayChInfo {
+func (x *SetAllowanceReq) GetSessionID() string {
 	if x != nil {
-		return x.OpenPayChsInfo
+		return x.SessionID
 	}
-	return nil
+	return ""
 }
 
-type SubPayChProposalsResp_Notify struct {
+func (x *SetAllowanceReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SetAllowanceReq) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type SetAllowanceResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProposalID       string   `protobuf:"bytes,2,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
-	OpeningBalInfo   *BalInfo `protobuf:"bytes,4,opt,name=openingBalInfo,proto3" json:"openingBalInfo,omitempty"`
-	ChallengeDurSecs uint64   `protobuf:"varint,5,opt,name=challengeDurSecs,proto3" json:"challengeDurSecs,omitempty"`
-	Expiry           int64    `protobuf:"varint,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Types that are assignable to Response:
+	//	*SetAllowanceResp_MsgSuccess_
+	//	*SetAllowanceResp_Error
+	Response isSetAllowanceResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *SubPayChProposalsResp_Notify) Reset() {
-	*x = SubPayChProposalsResp_Notify{}
+func (x *SetAllowanceResp) Reset() {
+	*x = SetAllowanceResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[65]
+		mi := &file_api_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubPayChProposalsResp_Notify) String() string {
+func (x *SetAllowanceResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubPayChProposalsResp_Notify) ProtoMessage() {}
+func (*SetAllowanceResp) ProtoMessage() {}
 
-func (x *SubPayChProposalsResp_Notify) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[65]
+func (x *SetAllowanceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4422,64 +5142,77 @@ func (x *SubPayChProposalsResp_Notify) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubPayChProposalsResp_Notify.ProtoReflect.Descriptor instead.
-func (*SubPayChProposalsResp_Notify) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{37, 0}
+// Deprecated: Use SetAllowanceResp.ProtoReflect.Descriptor instead.
+func (*SetAllowanceResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *SubPayChProposalsResp_Notify) GetProposalID() string {
-	if x != nil {
-		return x.ProposalID
+func (m *SetAllowanceResp) GetResponse() isSetAllowanceResp_Response {
+	if m != nil {
+		return m.Response
 	}
-	return ""
+	return nil
 }
 
-func (x *SubPayChProposalsResp_Notify) GetOpeningBalInfo() *BalInfo {
-	if x != nil {
-		return x.OpeningBalInfo
+func (x *SetAllowanceResp) GetMsgSuccess() *SetAllowanceResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*SetAllowanceResp_MsgSuccess_); ok {
+		return x.MsgSuccess
 	}
 	return nil
 }
 
-func (x *SubPayChProposalsResp_Notify) GetChallengeDurSecs() uint64 {
-	if x != nil {
-		return x.ChallengeDurSecs
+func (x *SetAllowanceResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SetAllowanceResp_Error); ok {
+		return x.Error
 	}
-	return 0
+	return nil
 }
 
-func (x *SubPayChProposalsResp_Notify) GetExpiry() int64 {
-	if x != nil {
-		return x.Expiry
-	}
-	return 0
+type isSetAllowanceResp_Response interface {
+	isSetAllowanceResp_Response()
 }
 
-type UnsubPayChProposalsResp_MsgSuccess struct {
+type SetAllowanceResp_MsgSuccess_ struct {
+	MsgSuccess *SetAllowanceResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type SetAllowanceResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SetAllowanceResp_MsgSuccess_) isSetAllowanceResp_Response() {}
+
+func (*SetAllowanceResp_Error) isSetAllowanceResp_Response() {}
+
+type TxCostInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ChID    string `protobuf:"bytes,1,opt,name=chID,proto3" json:"chID,omitempty"`
+	TxType  string `protobuf:"bytes,2,opt,name=txType,proto3" json:"txType,omitempty"`
+	TxID    string `protobuf:"bytes,3,opt,name=txID,proto3" json:"txID,omitempty"`
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Fee     string `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (x *UnsubPayChProposalsResp_MsgSuccess) Reset() {
-	*x = UnsubPayChProposalsResp_MsgSuccess{}
+func (x *TxCostInfo) Reset() {
+	*x = TxCostInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[66]
+		mi := &file_api_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnsubPayChProposalsResp_MsgSuccess) String() string {
+func (x *TxCostInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubPayChProposalsResp_MsgSuccess) ProtoMessage() {}
+func (*TxCostInfo) ProtoMessage() {}
 
-func (x *UnsubPayChProposalsResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[66]
+func (x *TxCostInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4490,43 +5223,71 @@ func (x *UnsubPayChProposalsResp_MsgSuccess) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubPayChProposalsResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*UnsubPayChProposalsResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{39, 0}
+// Deprecated: Use TxCostInfo.ProtoReflect.Descriptor instead.
+func (*TxCostInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *UnsubPayChProposalsResp_MsgSuccess) GetSuccess() bool {
+func (x *TxCostInfo) GetChID() string {
 	if x != nil {
-		return x.Success
+		return x.ChID
 	}
-	return false
+	return ""
 }
 
-type RespondPayChProposalResp_MsgSuccess struct {
+func (x *TxCostInfo) GetTxType() string {
+	if x != nil {
+		return x.TxType
+	}
+	return ""
+}
+
+func (x *TxCostInfo) GetTxID() string {
+	if x != nil {
+		return x.TxID
+	}
+	return ""
+}
+
+func (x *TxCostInfo) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *TxCostInfo) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+type GetTxCostReportReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OpenedPayChInfo *PayChInfo `protobuf:"bytes,2,opt,name=openedPayChInfo,proto3" json:"openedPayChInfo,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
 }
 
-func (x *RespondPayChProposalResp_MsgSuccess) Reset() {
-	*x = RespondPayChProposalResp_MsgSuccess{}
+func (x *GetTxCostReportReq) Reset() {
+	*x = GetTxCostReportReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[67]
+		mi := &file_api_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RespondPayChProposalResp_MsgSuccess) String() string {
+func (x *GetTxCostReportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondPayChProposalResp_MsgSuccess) ProtoMessage() {}
+func (*GetTxCostReportReq) ProtoMessage() {}
 
-func (x *RespondPayChProposalResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[67]
+func (x *GetTxCostReportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4537,43 +5298,46 @@ func (x *RespondPayChProposalResp_MsgSuccess) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondPayChProposalResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*RespondPayChProposalResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{41, 0}
+// Deprecated: Use GetTxCostReportReq.ProtoReflect.Descriptor instead.
+func (*GetTxCostReportReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *RespondPayChProposalResp_MsgSuccess) GetOpenedPayChInfo() *PayChInfo {
+func (x *GetTxCostReportReq) GetSessionID() string {
 	if x != nil {
-		return x.OpenedPayChInfo
+		return x.SessionID
 	}
-	return nil
+	return ""
 }
 
-type CloseSessionResp_MsgSuccess struct {
+type GetTxCostReportResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OpenPayChsInfo []*PayChInfo `protobuf:"bytes,1,rep,name=openPayChsInfo,proto3" json:"openPayChsInfo,omitempty"`
+	// Types that are assignable to Response:
+	//	*GetTxCostReportResp_MsgSuccess_
+	//	*GetTxCostReportResp_Error
+	Response isGetTxCostReportResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *CloseSessionResp_MsgSuccess) Reset() {
-	*x = CloseSessionResp_MsgSuccess{}
+func (x *GetTxCostReportResp) Reset() {
+	*x = GetTxCostReportResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[68]
+		mi := &file_api_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseSessionResp_MsgSuccess) String() string {
+func (x *GetTxCostReportResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseSessionResp_MsgSuccess) ProtoMessage() {}
+func (*GetTxCostReportResp) ProtoMessage() {}
 
-func (x *CloseSessionResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[68]
+func (x *GetTxCostReportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4584,43 +5348,77 @@ func (x *CloseSessionResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseSessionResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*CloseSessionResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{43, 0}
+// Deprecated: Use GetTxCostReportResp.ProtoReflect.Descriptor instead.
+func (*GetTxCostReportResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *CloseSessionResp_MsgSuccess) GetOpenPayChsInfo() []*PayChInfo {
-	if x != nil {
-		return x.OpenPayChsInfo
+func (m *GetTxCostReportResp) GetResponse() isGetTxCostReportResp_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-type DeployAssetERC20Resp_MsgSuccess struct {
+func (x *GetTxCostReportResp) GetMsgSuccess() *GetTxCostReportResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetTxCostReportResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetTxCostReportResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetTxCostReportResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetTxCostReportResp_Response interface {
+	isGetTxCostReportResp_Response()
+}
+
+type GetTxCostReportResp_MsgSuccess_ struct {
+	MsgSuccess *GetTxCostReportResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetTxCostReportResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetTxCostReportResp_MsgSuccess_) isGetTxCostReportResp_Response() {}
+
+func (*GetTxCostReportResp_Error) isGetTxCostReportResp_Response() {}
+
+type InvoicePayment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetAddr string `protobuf:"bytes,2,opt,name=AssetAddr,proto3" json:"AssetAddr,omitempty"`
+	ChID       string `protobuf:"bytes,1,opt,name=chID,proto3" json:"chID,omitempty"`
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	PayerAlias string `protobuf:"bytes,3,opt,name=payerAlias,proto3" json:"payerAlias,omitempty"`
+	Amount     string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaidAt     int64  `protobuf:"varint,5,opt,name=paidAt,proto3" json:"paidAt,omitempty"`
 }
 
-func (x *DeployAssetERC20Resp_MsgSuccess) Reset() {
-	*x = DeployAssetERC20Resp_MsgSuccess{}
+func (x *InvoicePayment) Reset() {
+	*x = InvoicePayment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[69]
+		mi := &file_api_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeployAssetERC20Resp_MsgSuccess) String() string {
+func (x *InvoicePayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployAssetERC20Resp_MsgSuccess) ProtoMessage() {}
+func (*InvoicePayment) ProtoMessage() {}
 
-func (x *DeployAssetERC20Resp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[69]
+func (x *InvoicePayment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4631,94 +5429,79 @@ func (x *DeployAssetERC20Resp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployAssetERC20Resp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*DeployAssetERC20Resp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{45, 0}
+// Deprecated: Use InvoicePayment.ProtoReflect.Descriptor instead.
+func (*InvoicePayment) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *DeployAssetERC20Resp_MsgSuccess) GetAssetAddr() string {
+func (x *InvoicePayment) GetChID() string {
 	if x != nil {
-		return x.AssetAddr
+		return x.ChID
 	}
 	return ""
 }
 
-type SendPayChUpdateResp_MsgSuccess struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UpdatedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=updatedPayChInfo,proto3" json:"updatedPayChInfo,omitempty"`
-}
-
-func (x *SendPayChUpdateResp_MsgSuccess) Reset() {
-	*x = SendPayChUpdateResp_MsgSuccess{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[70]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *InvoicePayment) GetVersion() string {
+	if x != nil {
+		return x.Version
 	}
+	return ""
 }
 
-func (x *SendPayChUpdateResp_MsgSuccess) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SendPayChUpdateResp_MsgSuccess) ProtoMessage() {}
-
-func (x *SendPayChUpdateResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[70]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *InvoicePayment) GetPayerAlias() string {
+	if x != nil {
+		return x.PayerAlias
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SendPayChUpdateResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*SendPayChUpdateResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{47, 0}
+func (x *InvoicePayment) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
 }
 
-func (x *SendPayChUpdateResp_MsgSuccess) GetUpdatedPayChInfo() *PayChInfo {
+func (x *InvoicePayment) GetPaidAt() int64 {
 	if x != nil {
-		return x.UpdatedPayChInfo
+		return x.PaidAt
 	}
-	return nil
+	return 0
 }
 
-type SubPayChUpdatesResp_Notify struct {
+type Invoice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UpdateID          string                                  `protobuf:"bytes,1,opt,name=updateID,proto3" json:"updateID,omitempty"`
-	ProposedPayChInfo *PayChInfo                              `protobuf:"bytes,2,opt,name=proposedPayChInfo,proto3" json:"proposedPayChInfo,omitempty"`
-	Type              SubPayChUpdatesResp_Notify_ChUpdateType `protobuf:"varint,3,opt,name=Type,proto3,enum=pb.SubPayChUpdatesResp_Notify_ChUpdateType" json:"Type,omitempty"`
-	Expiry            int64                                   `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	Error             *MsgError                               `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	InvoiceID  string            `protobuf:"bytes,1,opt,name=invoiceID,proto3" json:"invoiceID,omitempty"`
+	Currency   string            `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount     string            `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo       string            `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	CreatedAt  int64             `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Expiry     int64             `protobuf:"varint,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Status     string            `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	AmountPaid string            `protobuf:"bytes,8,opt,name=amountPaid,proto3" json:"amountPaid,omitempty"`
+	Payments   []*InvoicePayment `protobuf:"bytes,9,rep,name=payments,proto3" json:"payments,omitempty"`
 }
 
-func (x *SubPayChUpdatesResp_Notify) Reset() {
-	*x = SubPayChUpdatesResp_Notify{}
+func (x *Invoice) Reset() {
+	*x = Invoice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[71]
+		mi := &file_api_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubPayChUpdatesResp_Notify) String() string {
+func (x *Invoice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubPayChUpdatesResp_Notify) ProtoMessage() {}
+func (*Invoice) ProtoMessage() {}
 
-func (x *SubPayChUpdatesResp_Notify) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[71]
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4729,71 +5512,108 @@ func (x *SubPayChUpdatesResp_Notify) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubPayChUpdatesResp_Notify.ProtoReflect.Descriptor instead.
-func (*SubPayChUpdatesResp_Notify) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{49, 0}
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *SubPayChUpdatesResp_Notify) GetUpdateID() string {
+func (x *Invoice) GetInvoiceID() string {
 	if x != nil {
-		return x.UpdateID
+		return x.InvoiceID
 	}
 	return ""
 }
 
-func (x *SubPayChUpdatesResp_Notify) GetProposedPayChInfo() *PayChInfo {
+func (x *Invoice) GetCurrency() string {
 	if x != nil {
-		return x.ProposedPayChInfo
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-func (x *SubPayChUpdatesResp_Notify) GetType() SubPayChUpdatesResp_Notify_ChUpdateType {
+func (x *Invoice) GetAmount() string {
 	if x != nil {
-		return x.Type
+		return x.Amount
 	}
-	return SubPayChUpdatesResp_Notify_open
+	return ""
 }
 
-func (x *SubPayChUpdatesResp_Notify) GetExpiry() int64 {
+func (x *Invoice) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Invoice) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Invoice) GetExpiry() int64 {
 	if x != nil {
 		return x.Expiry
 	}
 	return 0
 }
 
-func (x *SubPayChUpdatesResp_Notify) GetError() *MsgError {
+func (x *Invoice) GetStatus() string {
 	if x != nil {
-		return x.Error
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invoice) GetAmountPaid() string {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return ""
+}
+
+func (x *Invoice) GetPayments() []*InvoicePayment {
+	if x != nil {
+		return x.Payments
 	}
 	return nil
 }
 
-type UnsubPayChUpdatesResp_MsgSuccess struct {
+// SpendingLimit represents the limits on the amount of the currency that can be paid to or funded
+// in channels with a peer. If the peer alias is empty, it applies to each of the peers without a peer
+// specific limit. Empty values mean no limit.
+type SpendingLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Currency     string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	PeerAlias    string `protobuf:"bytes,2,opt,name=peerAlias,proto3" json:"peerAlias,omitempty"`
+	MaxPayment   string `protobuf:"bytes,3,opt,name=maxPayment,proto3" json:"maxPayment,omitempty"`
+	MaxPerHour   string `protobuf:"bytes,4,opt,name=maxPerHour,proto3" json:"maxPerHour,omitempty"`
+	MaxPerDay    string `protobuf:"bytes,5,opt,name=maxPerDay,proto3" json:"maxPerDay,omitempty"`
+	MaxChFunding string `protobuf:"bytes,6,opt,name=maxChFunding,proto3" json:"maxChFunding,omitempty"`
+	Fiat         string `protobuf:"bytes,7,opt,name=fiat,proto3" json:"fiat,omitempty"` // If set, the amounts are in this fiat currency.
 }
 
-func (x *UnsubPayChUpdatesResp_MsgSuccess) Reset() {
-	*x = UnsubPayChUpdatesResp_MsgSuccess{}
+func (x *SpendingLimit) Reset() {
+	*x = SpendingLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[72]
+		mi := &file_api_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnsubPayChUpdatesResp_MsgSuccess) String() string {
+func (x *SpendingLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubPayChUpdatesResp_MsgSuccess) ProtoMessage() {}
+func (*SpendingLimit) ProtoMessage() {}
 
-func (x *UnsubPayChUpdatesResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[72]
+func (x *SpendingLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4804,43 +5624,86 @@ func (x *UnsubPayChUpdatesResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubPayChUpdatesResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*UnsubPayChUpdatesResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{51, 0}
+// Deprecated: Use SpendingLimit.ProtoReflect.Descriptor instead.
+func (*SpendingLimit) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *UnsubPayChUpdatesResp_MsgSuccess) GetSuccess() bool {
+func (x *SpendingLimit) GetCurrency() string {
 	if x != nil {
-		return x.Success
+		return x.Currency
 	}
-	return false
+	return ""
 }
 
-type RespondPayChUpdateResp_MsgSuccess struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UpdatedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=updatedPayChInfo,proto3" json:"updatedPayChInfo,omitempty"`
+func (x *SpendingLimit) GetPeerAlias() string {
+	if x != nil {
+		return x.PeerAlias
+	}
+	return ""
 }
 
-func (x *RespondPayChUpdateResp_MsgSuccess) Reset() {
-	*x = RespondPayChUpdateResp_MsgSuccess{}
+func (x *SpendingLimit) GetMaxPayment() string {
+	if x != nil {
+		return x.MaxPayment
+	}
+	return ""
+}
+
+func (x *SpendingLimit) GetMaxPerHour() string {
+	if x != nil {
+		return x.MaxPerHour
+	}
+	return ""
+}
+
+func (x *SpendingLimit) GetMaxPerDay() string {
+	if x != nil {
+		return x.MaxPerDay
+	}
+	return ""
+}
+
+func (x *SpendingLimit) GetMaxChFunding() string {
+	if x != nil {
+		return x.MaxChFunding
+	}
+	return ""
+}
+
+func (x *SpendingLimit) GetFiat() string {
+	if x != nil {
+		return x.Fiat
+	}
+	return ""
+}
+
+type SetSpendingLimitsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string           `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Limits    []*SpendingLimit `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits,omitempty"`
+}
+
+func (x *SetSpendingLimitsReq) Reset() {
+	*x = SetSpendingLimitsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[73]
+		mi := &file_api_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RespondPayChUpdateResp_MsgSuccess) String() string {
+func (x *SetSpendingLimitsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondPayChUpdateResp_MsgSuccess) ProtoMessage() {}
+func (*SetSpendingLimitsReq) ProtoMessage() {}
 
-func (x *RespondPayChUpdateResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[73]
+func (x *SetSpendingLimitsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4851,43 +5714,53 @@ func (x *RespondPayChUpdateResp_MsgSuccess) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondPayChUpdateResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*RespondPayChUpdateResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{53, 0}
+// Deprecated: Use SetSpendingLimitsReq.ProtoReflect.Descriptor instead.
+func (*SetSpendingLimitsReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *RespondPayChUpdateResp_MsgSuccess) GetUpdatedPayChInfo() *PayChInfo {
+func (x *SetSpendingLimitsReq) GetSessionID() string {
 	if x != nil {
-		return x.UpdatedPayChInfo
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SetSpendingLimitsReq) GetLimits() []*SpendingLimit {
+	if x != nil {
+		return x.Limits
 	}
 	return nil
 }
 
-type GetPayChInfoResp_MsgSuccess struct {
+type SetSpendingLimitsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=payChInfo,proto3" json:"payChInfo,omitempty"`
+	// Types that are assignable to Response:
+	//	*SetSpendingLimitsResp_MsgSuccess_
+	//	*SetSpendingLimitsResp_Error
+	Response isSetSpendingLimitsResp_Response `protobuf_oneof:"response"`
 }
 
-func (x *GetPayChInfoResp_MsgSuccess) Reset() {
-	*x = GetPayChInfoResp_MsgSuccess{}
+func (x *SetSpendingLimitsResp) Reset() {
+	*x = SetSpendingLimitsResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[74]
+		mi := &file_api_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPayChInfoResp_MsgSuccess) String() string {
+func (x *SetSpendingLimitsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayChInfoResp_MsgSuccess) ProtoMessage() {}
+func (*SetSpendingLimitsResp) ProtoMessage() {}
 
-func (x *GetPayChInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[74]
+func (x *SetSpendingLimitsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4898,43 +5771,73 @@ func (x *GetPayChInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayChInfoResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*GetPayChInfoResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{55, 0}
+// Deprecated: Use SetSpendingLimitsResp.ProtoReflect.Descriptor instead.
+func (*SetSpendingLimitsResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetPayChInfoResp_MsgSuccess) GetPayChInfo() *PayChInfo {
-	if x != nil {
-		return x.PayChInfo
+func (m *SetSpendingLimitsResp) GetResponse() isSetSpendingLimitsResp_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-type ClosePayChResp_MsgSuccess struct {
+func (x *SetSpendingLimitsResp) GetMsgSuccess() *SetSpendingLimitsResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*SetSpendingLimitsResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *SetSpendingLimitsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SetSpendingLimitsResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSetSpendingLimitsResp_Response interface {
+	isSetSpendingLimitsResp_Response()
+}
+
+type SetSpendingLimitsResp_MsgSuccess_ struct {
+	MsgSuccess *SetSpendingLimitsResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type SetSpendingLimitsResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SetSpendingLimitsResp_MsgSuccess_) isSetSpendingLimitsResp_Response() {}
+
+func (*SetSpendingLimitsResp_Error) isSetSpendingLimitsResp_Response() {}
+
+type GetSpendingLimitsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClosedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=closedPayChInfo,proto3" json:"closedPayChInfo,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
 }
 
-func (x *ClosePayChResp_MsgSuccess) Reset() {
-	*x = ClosePayChResp_MsgSuccess{}
+func (x *GetSpendingLimitsReq) Reset() {
+	*x = GetSpendingLimitsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[75]
+		mi := &file_api_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClosePayChResp_MsgSuccess) String() string {
+func (x *GetSpendingLimitsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClosePayChResp_MsgSuccess) ProtoMessage() {}
+func (*GetSpendingLimitsReq) ProtoMessage() {}
 
-func (x *ClosePayChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[75]
+func (x *GetSpendingLimitsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4945,2575 +5848,12693 @@ func (x *ClosePayChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClosePayChResp_MsgSuccess.ProtoReflect.Descriptor instead.
-func (*ClosePayChResp_MsgSuccess) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{57, 0}
+// Deprecated: Use GetSpendingLimitsReq.ProtoReflect.Descriptor instead.
+func (*GetSpendingLimitsReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ClosePayChResp_MsgSuccess) GetClosedPayChInfo() *PayChInfo {
+func (x *GetSpendingLimitsReq) GetSessionID() string {
 	if x != nil {
-		return x.ClosedPayChInfo
+		return x.SessionID
 	}
-	return nil
+	return ""
 }
 
-var File_api_proto protoreflect.FileDescriptor
+type GetSpendingLimitsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_api_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22,
-	0x86, 0x01, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
-	0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
-	0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x66, 0x66, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
-	0x6d, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x22, 0x7d, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x62, 0x61, 0x6c,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x62, 0x61, 0x6c, 0x52, 0x04, 0x62, 0x61, 0x6c, 0x73, 0x1a, 0x17,
-	0x0a, 0x03, 0x62, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x03, 0x62, 0x61, 0x6c, 0x22, 0x60, 0x0a, 0x09, 0x50, 0x61, 0x79, 0x43, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42,
-	0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x07, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x70, 0x61, 0x79, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa4,
-	0x09, 0x0a, 0x08, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x08, 0x63,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
-	0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x60, 0x0a, 0x1a, 0x45, 0x72, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x64, 0x4f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62,
-	0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x45,
-	0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x4b, 0x0a, 0x13, 0x45, 0x72, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x48,
-	0x00, 0x52, 0x13, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
-	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x14, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x48, 0x00,
-	0x52, 0x14, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74,
-	0x46, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x63, 0x0a, 0x1b, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x64, 0x4f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62,
-	0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x48, 0x00, 0x52, 0x1b,
-	0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x45,
-	0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f,
-	0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
-	0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x17, 0x45, 0x72, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x46,
-	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x51, 0x0a, 0x15, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x48, 0x00,
-	0x52, 0x15, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x16, 0x45, 0x72, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x16, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x6f, 0x0a,
-	0x1f, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72,
-	0x65, 0x43, 0x6f, 0x6e, 0x64, 0x55, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x43, 0x68, 0x73,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64,
-	0x55, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x43, 0x68, 0x73, 0x48, 0x00, 0x52, 0x1f, 0x45,
-	0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x65, 0x43,
-	0x6f, 0x6e, 0x64, 0x55, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x43, 0x68, 0x73, 0x12, 0x4e,
-	0x0a, 0x14, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
-	0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x14, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x57,
-	0x0a, 0x17, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x48, 0x00, 0x52, 0x17,
-	0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x45, 0x72, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x54, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x54,
-	0x78, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x48, 0x00, 0x52, 0x11, 0x45, 0x72, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x54, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x5a,
-	0x0a, 0x18, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x6f,
-	0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00,
-	0x52, 0x18, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x6f,
-	0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x54, 0x0a, 0x1a, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x64,
-	0x4f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x45,
-	0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x14, 0x45, 0x72, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x55,
-	0x0a, 0x1b, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3d, 0x0a, 0x17, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x15, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x64, 0x0a, 0x16, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x1f, 0x45, 0x72, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x55,
-	0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x43, 0x68, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x63, 0x68,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x63, 0x68, 0x73, 0x22, 0x40, 0x0a, 0x14, 0x45,
-	0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x55, 0x0a,
-	0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x17, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49,
-	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12,
-	0x3f, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x72, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73,
-	0x22, 0x5d, 0x0a, 0x11, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x78, 0x54, 0x69, 0x6d,
-	0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x78, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49,
-	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22,
-	0x36, 0x0a, 0x18, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4e,
-	0x6f, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x55, 0x52, 0x4c, 0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x22, 0xb9, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a,
-	0x0b, 0x61, 0x64, 0x6a, 0x75, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x54, 0x48, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x45, 0x54, 0x48, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x64, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0f, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46,
-	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x46, 0x69, 0x6c, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x0a, 0x6d, 0x73, 0x67,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52,
-	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x1a, 0x5b, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2f, 0x0a,
-	0x0b, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x43, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x43, 0x68, 0x73, 0x42, 0x0a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x09, 0x0a, 0x07, 0x54, 0x69,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x22, 0x1e, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0xb5, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x45, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x2e,
-	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73,
-	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x24,
-	0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x09, 0x0a, 0x07, 0x48, 0x65, 0x6c, 0x70, 0x52, 0x65, 0x71, 0x22, 0x1e, 0x0a, 0x08, 0x48,
-	0x65, 0x6c, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x70, 0x69, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x70, 0x69, 0x73, 0x22, 0x50, 0x0a, 0x0c, 0x41,
-	0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x70, 0x65, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50,
-	0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22, 0xa9, 0x01,
-	0x0a, 0x0d, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x3e, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0xb3, 0x01,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x3e, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x30, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52,
-	0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x12, 0x33, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e,
-	0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67,
-	0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53,
-	0x65, 0x63, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a, 0x0a, 0x4d,
-	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x6f, 0x70, 0x65,
-	0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e,
-	0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x22, 0xce, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a,
-	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d,
-	0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x1a, 0x43, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x35,
-	0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x34, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xa9, 0x02, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x3a, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x24, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x1a, 0xa1, 0x01, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x1e,
-	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x33,
-	0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63,
-	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x16, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xbd, 0x01, 0x0a, 0x17,
-	0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x62,
-	0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42,
-	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a, 0x17, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0xde, 0x01, 0x0a,
-	0x18, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x6d, 0x73, 0x67,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a, 0x0a, 0x4d, 0x73,
-	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x6e,
-	0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66,
-	0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a,
-	0x0f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x14,
-	0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0a, 0x6d, 0x73, 0x67,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00,
-	0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x1a, 0x43, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x35, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61,
-	0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x45, 0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x22, 0xbb, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x45, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x45, 0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73,
-	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x2a, 0x0a, 0x0a,
-	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x27, 0x0a, 0x08,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61,
-	0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a,
-	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x47, 0x0a, 0x0a, 0x4d, 0x73, 0x67,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e,
-	0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46,
-	0x0a, 0x12, 0x53, 0x75, 0x62, 0x70, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0x93, 0x03, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x50, 0x61,
-	0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38,
-	0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x00,
-	0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x8f,
-	0x02, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x3b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
-	0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x3f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x2e, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x2f, 0x0a, 0x0c, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x08, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x66, 0x69, 0x6e,
-	0x61, 0x6c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x10, 0x02,
-	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x14,
-	0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0xb9, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x73, 0x75, 0x62,
-	0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x46, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e,
-	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73,
-	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26,
-	0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x7d, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x22, 0xdc, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a,
-	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x47, 0x0a, 0x0a, 0x4d,
-	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x43, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0xc2, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0a, 0x6d, 0x73,
-	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48,
-	0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x1a, 0x39, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x09, 0x70, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x0d, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0xca, 0x01,
-	0x0a, 0x0e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x3f, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x5c, 0x0a, 0x0d, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10,
-	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x03, 0x2a, 0xe7, 0x02, 0x0a, 0x09, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x1a,
-	0x0a, 0x16, 0x45, 0x72, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x10, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x72,
-	0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x66, 0x12,
-	0x14, 0x0a, 0x10, 0x45, 0x72, 0x72, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46, 0x75, 0x6e,
-	0x64, 0x65, 0x64, 0x10, 0x67, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x72, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74,
-	0x10, 0x68, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10, 0xc9, 0x01, 0x12, 0x16, 0x0a, 0x11,
-	0x45, 0x72, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x10, 0xca, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0xcb, 0x01, 0x12, 0x1a, 0x0a,
-	0x15, 0x45, 0x72, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0xcc, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x72, 0x72,
-	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xcd, 0x01,
-	0x12, 0x18, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x10, 0xce, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x45, 0x72,
-	0x72, 0x54, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x10, 0xad, 0x02, 0x12, 0x19,
-	0x0a, 0x14, 0x45, 0x72, 0x72, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61,
-	0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x10, 0xae, 0x02, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x72, 0x72,
-	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x10,
-	0x91, 0x03, 0x32, 0x82, 0x0a, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x41,
-	0x50, 0x49, 0x12, 0x32, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
-	0x12, 0x23, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52,
-	0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x23,
-	0x0a, 0x04, 0x48, 0x65, 0x6c, 0x70, 0x12, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x65, 0x6c, 0x70,
-	0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x65, 0x6c, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44,
-	0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49,
-	0x44, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x65,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65,
-	0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x4f,
-	0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x3e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x4c, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79,
-	0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x50, 0x0a,
-	0x13, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x53, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x47, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x45, 0x52, 0x43, 0x32, 0x30, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x71, 0x1a, 0x18,
-	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45,
-	0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x53, 0x65,
-	0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e,
-	0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
-	0x12, 0x46, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x70, 0x61, 0x79, 0x43,
-	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62,
-	0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x11, 0x55, 0x6e, 0x73, 0x75,
-	0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x2e,
-	0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73,
-	0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50,
-	0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
-	0x12, 0x35, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x12, 0x11,
-	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65,
-	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// Types that are assignable to Response:
+	//	*GetSpendingLimitsResp_MsgSuccess_
+	//	*GetSpendingLimitsResp_Error
+	Response isGetSpendingLimitsResp_Response `protobuf_oneof:"response"`
 }
 
-var (
-	file_api_proto_rawDescOnce sync.Once
-	file_api_proto_rawDescData = file_api_proto_rawDesc
-)
+func (x *GetSpendingLimitsResp) Reset() {
+	*x = GetSpendingLimitsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_proto_rawDescGZIP() []byte {
-	file_api_proto_rawDescOnce.Do(func() {
-		file_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_proto_rawDescData)
-	})
-	return file_api_proto_rawDescData
+func (x *GetSpendingLimitsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
-var file_api_proto_goTypes = []interface{}{
-	(ErrorCategory)(0), // 0: pb.ErrorCategory
-	(ErrorCode)(0),     // 1: pb.ErrorCode
-	(SubPayChUpdatesResp_Notify_ChUpdateType)(0), // 2: pb.SubPayChUpdatesResp.Notify.ChUpdateType
-	(*PeerID)(nil),                              // 3: pb.PeerID
-	(*BalInfo)(nil),                             // 4: pb.BalInfo
-	(*PayChInfo)(nil),                           // 5: pb.PayChInfo
-	(*Payment)(nil),                             // 6: pb.Payment
-	(*MsgError)(nil),                            // 7: pb.MsgError
-	(*ErrInfoPeerRequestTimedOut)(nil),          // 8: pb.ErrInfoPeerRequestTimedOut
-	(*ErrInfoPeerRejected)(nil),                 // 9: pb.ErrInfoPeerRejected
-	(*ErrInfoPeerNotFunded)(nil),                // 10: pb.ErrInfoPeerNotFunded
-	(*ErrInfoUserResponseTimedOut)(nil),         // 11: pb.ErrInfoUserResponseTimedOut
-	(*ErrInfoResourceNotFound)(nil),             // 12: pb.ErrInfoResourceNotFound
-	(*ErrInfoResourceExists)(nil),               // 13: pb.ErrInfoResourceExists
-	(*ErrInfoInvalidArgument)(nil),              // 14: pb.ErrInfoInvalidArgument
-	(*ErrInfoFailedPreCondUnclosedChs)(nil),     // 15: pb.ErrInfoFailedPreCondUnclosedChs
-	(*ErrInfoInvalidConfig)(nil),                // 16: pb.ErrInfoInvalidConfig
-	(*ContractErrInfo)(nil),                     // 17: pb.ContractErrInfo
-	(*ErrInfoInvalidContracts)(nil),             // 18: pb.ErrInfoInvalidContracts
-	(*ErrInfoTxTimedOut)(nil),                   // 19: pb.ErrInfoTxTimedOut
-	(*ErrInfoChainNotReachable)(nil),            // 20: pb.ErrInfoChainNotReachable
-	(*GetConfigReq)(nil),                        // 21: pb.GetConfigReq
-	(*GetConfigResp)(nil),                       // 22: pb.GetConfigResp
-	(*OpenSessionReq)(nil),                      // 23: pb.OpenSessionReq
-	(*OpenSessionResp)(nil),                     // 24: pb.OpenSessionResp
-	(*TimeReq)(nil),                             // 25: pb.TimeReq
-	(*TimeResp)(nil),                            // 26: pb.TimeResp
-	(*RegisterCurrencyReq)(nil),                 // 27: pb.RegisterCurrencyReq
-	(*RegisterCurrencyResp)(nil),                // 28: pb.RegisterCurrencyResp
-	(*HelpReq)(nil),                             // 29: pb.HelpReq
-	(*HelpResp)(nil),                            // 30: pb.HelpResp
-	(*AddPeerIDReq)(nil),                        // 31: pb.AddPeerIDReq
-	(*AddPeerIDResp)(nil),                       // 32: pb.AddPeerIDResp
-	(*GetPeerIDReq)(nil),                        // 33: pb.GetPeerIDReq
-	(*GetPeerIDResp)(nil),                       // 34: pb.GetPeerIDResp
-	(*OpenPayChReq)(nil),                        // 35: pb.OpenPayChReq
-	(*OpenPayChResp)(nil),                       // 36: pb.OpenPayChResp
-	(*GetPayChsInfoReq)(nil),                    // 37: pb.GetPayChsInfoReq
-	(*GetPayChsInfoResp)(nil),                   // 38: pb.GetPayChsInfoResp
-	(*SubPayChProposalsReq)(nil),                // 39: pb.SubPayChProposalsReq
-	(*SubPayChProposalsResp)(nil),               // 40: pb.SubPayChProposalsResp
-	(*UnsubPayChProposalsReq)(nil),              // 41: pb.UnsubPayChProposalsReq
-	(*UnsubPayChProposalsResp)(nil),             // 42: pb.UnsubPayChProposalsResp
-	(*RespondPayChProposalReq)(nil),             // 43: pb.RespondPayChProposalReq
-	(*RespondPayChProposalResp)(nil),            // 44: pb.RespondPayChProposalResp
-	(*CloseSessionReq)(nil),                     // 45: pb.CloseSessionReq
-	(*CloseSessionResp)(nil),                    // 46: pb.CloseSessionResp
-	(*DeployAssetERC20Req)(nil),                 // 47: pb.DeployAssetERC20Req
-	(*DeployAssetERC20Resp)(nil),                // 48: pb.DeployAssetERC20Resp
-	(*SendPayChUpdateReq)(nil),                  // 49: pb.SendPayChUpdateReq
-	(*SendPayChUpdateResp)(nil),                 // 50: pb.SendPayChUpdateResp
-	(*SubpayChUpdatesReq)(nil),                  // 51: pb.SubpayChUpdatesReq
-	(*SubPayChUpdatesResp)(nil),                 // 52: pb.SubPayChUpdatesResp
-	(*UnsubPayChUpdatesReq)(nil),                // 53: pb.UnsubPayChUpdatesReq
-	(*UnsubPayChUpdatesResp)(nil),               // 54: pb.UnsubPayChUpdatesResp
-	(*RespondPayChUpdateReq)(nil),               // 55: pb.RespondPayChUpdateReq
-	(*RespondPayChUpdateResp)(nil),              // 56: pb.RespondPayChUpdateResp
-	(*GetPayChInfoReq)(nil),                     // 57: pb.GetPayChInfoReq
-	(*GetPayChInfoResp)(nil),                    // 58: pb.GetPayChInfoResp
-	(*ClosePayChReq)(nil),                       // 59: pb.ClosePayChReq
-	(*ClosePayChResp)(nil),                      // 60: pb.ClosePayChResp
-	(*BalInfoBal)(nil),                          // 61: pb.BalInfo.bal
-	(*OpenSessionResp_MsgSuccess)(nil),          // 62: pb.OpenSessionResp.MsgSuccess
-	(*RegisterCurrencyResp_MsgSuccess)(nil),     // 63: pb.RegisterCurrencyResp.MsgSuccess
-	(*AddPeerIDResp_MsgSuccess)(nil),            // 64: pb.AddPeerIDResp.MsgSuccess
-	(*GetPeerIDResp_MsgSuccess)(nil),            // 65: pb.GetPeerIDResp.MsgSuccess
-	(*OpenPayChResp_MsgSuccess)(nil),            // 66: pb.OpenPayChResp.MsgSuccess
-	(*GetPayChsInfoResp_MsgSuccess)(nil),        // 67: pb.GetPayChsInfoResp.MsgSuccess
-	(*SubPayChProposalsResp_Notify)(nil),        // 68: pb.SubPayChProposalsResp.Notify
-	(*UnsubPayChProposalsResp_MsgSuccess)(nil),  // 69: pb.UnsubPayChProposalsResp.MsgSuccess
-	(*RespondPayChProposalResp_MsgSuccess)(nil), // 70: pb.RespondPayChProposalResp.MsgSuccess
-	(*CloseSessionResp_MsgSuccess)(nil),         // 71: pb.CloseSessionResp.MsgSuccess
-	(*DeployAssetERC20Resp_MsgSuccess)(nil),     // 72: pb.DeployAssetERC20Resp.MsgSuccess
-	(*SendPayChUpdateResp_MsgSuccess)(nil),      // 73: pb.SendPayChUpdateResp.MsgSuccess
-	(*SubPayChUpdatesResp_Notify)(nil),          // 74: pb.SubPayChUpdatesResp.Notify
-	(*UnsubPayChUpdatesResp_MsgSuccess)(nil),    // 75: pb.UnsubPayChUpdatesResp.MsgSuccess
-	(*RespondPayChUpdateResp_MsgSuccess)(nil),   // 76: pb.RespondPayChUpdateResp.MsgSuccess
-	(*GetPayChInfoResp_MsgSuccess)(nil),         // 77: pb.GetPayChInfoResp.MsgSuccess
-	(*ClosePayChResp_MsgSuccess)(nil),           // 78: pb.ClosePayChResp.MsgSuccess
+func (*GetSpendingLimitsResp) ProtoMessage() {}
+
+func (x *GetSpendingLimitsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_proto_depIdxs = []int32{
-	61, // 0: pb.BalInfo.bals:type_name -> pb.BalInfo.bal
-	4,  // 1: pb.PayChInfo.balInfo:type_name -> pb.BalInfo
-	0,  // 2: pb.MsgError.category:type_name -> pb.ErrorCategory
-	1,  // 3: pb.MsgError.code:type_name -> pb.ErrorCode
-	8,  // 4: pb.MsgError.ErrInfoPeerRequestTimedOut:type_name -> pb.ErrInfoPeerRequestTimedOut
-	9,  // 5: pb.MsgError.ErrInfoPeerRejected:type_name -> pb.ErrInfoPeerRejected
-	10, // 6: pb.MsgError.ErrInfoPeerNotFunded:type_name -> pb.ErrInfoPeerNotFunded
-	11, // 7: pb.MsgError.ErrInfoUserResponseTimedOut:type_name -> pb.ErrInfoUserResponseTimedOut
-	12, // 8: pb.MsgError.ErrInfoResourceNotFound:type_name -> pb.ErrInfoResourceNotFound
-	13, // 9: pb.MsgError.ErrInfoResourceExists:type_name -> pb.ErrInfoResourceExists
-	14, // 10: pb.MsgError.ErrInfoInvalidArgument:type_name -> pb.ErrInfoInvalidArgument
-	15, // 11: pb.MsgError.ErrInfoFailedPreCondUnclosedChs:type_name -> pb.ErrInfoFailedPreCondUnclosedChs
-	16, // 12: pb.MsgError.ErrInfoInvalidConfig:type_name -> pb.ErrInfoInvalidConfig
-	18, // 13: pb.MsgError.ErrInfoInvalidContracts:type_name -> pb.ErrInfoInvalidContracts
-	19, // 14: pb.MsgError.ErrInfoTxTimedOut:type_name -> pb.ErrInfoTxTimedOut
-	20, // 15: pb.MsgError.ErrInfoChainNotReachable:type_name -> pb.ErrInfoChainNotReachable
-	5,  // 16: pb.ErrInfoFailedPreCondUnclosedChs.chs:type_name -> pb.PayChInfo
-	17, // 17: pb.ErrInfoInvalidContracts.ContractErrInfos:type_name -> pb.ContractErrInfo
-	62, // 18: pb.OpenSessionResp.msgSuccess:type_name -> pb.OpenSessionResp.MsgSuccess
-	7,  // 19: pb.OpenSessionResp.error:type_name -> pb.MsgError
-	63, // 20: pb.RegisterCurrencyResp.msgSuccess:type_name -> pb.RegisterCurrencyResp.MsgSuccess
-	7,  // 21: pb.RegisterCurrencyResp.error:type_name -> pb.MsgError
-	3,  // 22: pb.AddPeerIDReq.peerID:type_name -> pb.PeerID
-	64, // 23: pb.AddPeerIDResp.msgSuccess:type_name -> pb.AddPeerIDResp.MsgSuccess
-	7,  // 24: pb.AddPeerIDResp.error:type_name -> pb.MsgError
-	65, // 25: pb.GetPeerIDResp.msgSuccess:type_name -> pb.GetPeerIDResp.MsgSuccess
-	7,  // 26: pb.GetPeerIDResp.error:type_name -> pb.MsgError
-	4,  // 27: pb.OpenPayChReq.openingBalInfo:type_name -> pb.BalInfo
-	66, // 28: pb.OpenPayChResp.msgSuccess:type_name -> pb.OpenPayChResp.MsgSuccess
-	7,  // 29: pb.OpenPayChResp.error:type_name -> pb.MsgError
-	67, // 30: pb.GetPayChsInfoResp.msgSuccess:type_name -> pb.GetPayChsInfoResp.MsgSuccess
-	7,  // 31: pb.GetPayChsInfoResp.error:type_name -> pb.MsgError
-	68, // 32: pb.SubPayChProposalsResp.notify:type_name -> pb.SubPayChProposalsResp.Notify
-	7,  // 33: pb.SubPayChProposalsResp.error:type_name -> pb.MsgError
-	69, // 34: pb.UnsubPayChProposalsResp.msgSuccess:type_name -> pb.UnsubPayChProposalsResp.MsgSuccess
-	7,  // 35: pb.UnsubPayChProposalsResp.error:type_name -> pb.MsgError
-	70, // 36: pb.RespondPayChProposalResp.msgSuccess:type_name -> pb.RespondPayChProposalResp.MsgSuccess
-	7,  // 37: pb.RespondPayChProposalResp.error:type_name -> pb.MsgError
-	71, // 38: pb.CloseSessionResp.msgSuccess:type_name -> pb.CloseSessionResp.MsgSuccess
-	7,  // 39: pb.CloseSessionResp.error:type_name -> pb.MsgError
-	72, // 40: pb.DeployAssetERC20Resp.msgSuccess:type_name -> pb.DeployAssetERC20Resp.MsgSuccess
-	7,  // 41: pb.DeployAssetERC20Resp.error:type_name -> pb.MsgError
-	6,  // 42: pb.SendPayChUpdateReq.payments:type_name -> pb.Payment
-	73, // 43: pb.SendPayChUpdateResp.msgSuccess:type_name -> pb.SendPayChUpdateResp.MsgSuccess
-	7,  // 44: pb.SendPayChUpdateResp.error:type_name -> pb.MsgError
-	74, // 45: pb.SubPayChUpdatesResp.notify:type_name -> pb.SubPayChUpdatesResp.Notify
-	7,  // 46: pb.SubPayChUpdatesResp.error:type_name -> pb.MsgError
-	75, // 47: pb.UnsubPayChUpdatesResp.msgSuccess:type_name -> pb.UnsubPayChUpdatesResp.MsgSuccess
-	7,  // 48: pb.UnsubPayChUpdatesResp.error:type_name -> pb.MsgError
-	76, // 49: pb.RespondPayChUpdateResp.msgSuccess:type_name -> pb.RespondPayChUpdateResp.MsgSuccess
-	7,  // 50: pb.RespondPayChUpdateResp.error:type_name -> pb.MsgError
-	77, // 51: pb.GetPayChInfoResp.msgSuccess:type_name -> pb.GetPayChInfoResp.MsgSuccess
-	7,  // 52: pb.GetPayChInfoResp.error:type_name -> pb.MsgError
-	78, // 53: pb.ClosePayChResp.msgSuccess:type_name -> pb.ClosePayChResp.MsgSuccess
-	7,  // 54: pb.ClosePayChResp.error:type_name -> pb.MsgError
-	5,  // 55: pb.OpenSessionResp.MsgSuccess.restoredChs:type_name -> pb.PayChInfo
-	3,  // 56: pb.GetPeerIDResp.MsgSuccess.peerID:type_name -> pb.PeerID
-	5,  // 57: pb.OpenPayChResp.MsgSuccess.openedPayChInfo:type_name -> pb.PayChInfo
-	5,  // 58: pb.GetPayChsInfoResp.MsgSuccess.openPayChsInfo:type_name -> pb.PayChInfo
-	4,  // 59: pb.SubPayChProposalsResp.Notify.openingBalInfo:type_name -> pb.BalInfo
-	5,  // 60: pb.RespondPayChProposalResp.MsgSuccess.openedPayChInfo:type_name -> pb.PayChInfo
-	5,  // 61: pb.CloseSessionResp.MsgSuccess.openPayChsInfo:type_name -> pb.PayChInfo
-	5,  // 62: pb.SendPayChUpdateResp.MsgSuccess.updatedPayChInfo:type_name -> pb.PayChInfo
-	5,  // 63: pb.SubPayChUpdatesResp.Notify.proposedPayChInfo:type_name -> pb.PayChInfo
-	2,  // 64: pb.SubPayChUpdatesResp.Notify.Type:type_name -> pb.SubPayChUpdatesResp.Notify.ChUpdateType
-	7,  // 65: pb.SubPayChUpdatesResp.Notify.error:type_name -> pb.MsgError
-	5,  // 66: pb.RespondPayChUpdateResp.MsgSuccess.updatedPayChInfo:type_name -> pb.PayChInfo
-	5,  // 67: pb.GetPayChInfoResp.MsgSuccess.payChInfo:type_name -> pb.PayChInfo
-	5,  // 68: pb.ClosePayChResp.MsgSuccess.closedPayChInfo:type_name -> pb.PayChInfo
-	21, // 69: pb.Payment_API.GetConfig:input_type -> pb.GetConfigReq
-	23, // 70: pb.Payment_API.OpenSession:input_type -> pb.OpenSessionReq
-	25, // 71: pb.Payment_API.Time:input_type -> pb.TimeReq
-	27, // 72: pb.Payment_API.RegisterCurrency:input_type -> pb.RegisterCurrencyReq
-	29, // 73: pb.Payment_API.Help:input_type -> pb.HelpReq
-	31, // 74: pb.Payment_API.AddPeerID:input_type -> pb.AddPeerIDReq
-	33, // 75: pb.Payment_API.GetPeerID:input_type -> pb.GetPeerIDReq
-	35, // 76: pb.Payment_API.OpenPayCh:input_type -> pb.OpenPayChReq
-	37, // 77: pb.Payment_API.GetPayChsInfo:input_type -> pb.GetPayChsInfoReq
-	39, // 78: pb.Payment_API.SubPayChProposals:input_type -> pb.SubPayChProposalsReq
-	41, // 79: pb.Payment_API.UnsubPayChProposals:input_type -> pb.UnsubPayChProposalsReq
-	43, // 80: pb.Payment_API.RespondPayChProposal:input_type -> pb.RespondPayChProposalReq
-	45, // 81: pb.Payment_API.CloseSession:input_type -> pb.CloseSessionReq
-	47, // 82: pb.Payment_API.DeployAssetERC20:input_type -> pb.DeployAssetERC20Req
-	49, // 83: pb.Payment_API.SendPayChUpdate:input_type -> pb.SendPayChUpdateReq
-	51, // 84: pb.Payment_API.SubPayChUpdates:input_type -> pb.SubpayChUpdatesReq
-	53, // 85: pb.Payment_API.UnsubPayChUpdates:input_type -> pb.UnsubPayChUpdatesReq
-	55, // 86: pb.Payment_API.RespondPayChUpdate:input_type -> pb.RespondPayChUpdateReq
-	57, // 87: pb.Payment_API.GetPayChInfo:input_type -> pb.GetPayChInfoReq
-	59, // 88: pb.Payment_API.ClosePayCh:input_type -> pb.ClosePayChReq
-	22, // 89: pb.Payment_API.GetConfig:output_type -> pb.GetConfigResp
-	24, // 90: pb.Payment_API.OpenSession:output_type -> pb.OpenSessionResp
-	26, // 91: pb.Payment_API.Time:output_type -> pb.TimeResp
-	28, // 92: pb.Payment_API.RegisterCurrency:output_type -> pb.RegisterCurrencyResp
-	30, // 93: pb.Payment_API.Help:output_type -> pb.HelpResp
-	32, // 94: pb.Payment_API.AddPeerID:output_type -> pb.AddPeerIDResp
-	34, // 95: pb.Payment_API.GetPeerID:output_type -> pb.GetPeerIDResp
-	36, // 96: pb.Payment_API.OpenPayCh:output_type -> pb.OpenPayChResp
-	38, // 97: pb.Payment_API.GetPayChsInfo:output_type -> pb.GetPayChsInfoResp
-	40, // 98: pb.Payment_API.SubPayChProposals:output_type -> pb.SubPayChProposalsResp
-	42, // 99: pb.Payment_API.UnsubPayChProposals:output_type -> pb.UnsubPayChProposalsResp
-	44, // 100: pb.Payment_API.RespondPayChProposal:output_type -> pb.RespondPayChProposalResp
-	46, // 101: pb.Payment_API.CloseSession:output_type -> pb.CloseSessionResp
-	48, // 102: pb.Payment_API.DeployAssetERC20:output_type -> pb.DeployAssetERC20Resp
-	50, // 103: pb.Payment_API.SendPayChUpdate:output_type -> pb.SendPayChUpdateResp
-	52, // 104: pb.Payment_API.SubPayChUpdates:output_type -> pb.SubPayChUpdatesResp
-	54, // 105: pb.Payment_API.UnsubPayChUpdates:output_type -> pb.UnsubPayChUpdatesResp
-	56, // 106: pb.Payment_API.RespondPayChUpdate:output_type -> pb.RespondPayChUpdateResp
-	58, // 107: pb.Payment_API.GetPayChInfo:output_type -> pb.GetPayChInfoResp
-	60, // 108: pb.Payment_API.ClosePayCh:output_type -> pb.ClosePayChResp
-	89, // [89:109] is the sub-list for method output_type
-	69, // [69:89] is the sub-list for method input_type
-	69, // [69:69] is the sub-list for extension type_name
-	69, // [69:69] is the sub-list for extension extendee
-	0,  // [0:69] is the sub-list for field type_name
+
+// Deprecated: Use GetSpendingLimitsResp.ProtoReflect.Descriptor instead.
+func (*GetSpendingLimitsResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{82}
 }
 
-func init() { file_api_proto_init() }
-func file_api_proto_init() {
-	if File_api_proto != nil {
-		return
+func (m *GetSpendingLimitsResp) GetResponse() isGetSpendingLimitsResp_Response {
+	if m != nil {
+		return m.Response
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeerID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BalInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PayChInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+func (x *GetSpendingLimitsResp) GetMsgSuccess() *GetSpendingLimitsResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetSpendingLimitsResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetSpendingLimitsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetSpendingLimitsResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetSpendingLimitsResp_Response interface {
+	isGetSpendingLimitsResp_Response()
+}
+
+type GetSpendingLimitsResp_MsgSuccess_ struct {
+	MsgSuccess *GetSpendingLimitsResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetSpendingLimitsResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetSpendingLimitsResp_MsgSuccess_) isGetSpendingLimitsResp_Response() {}
+
+func (*GetSpendingLimitsResp_Error) isGetSpendingLimitsResp_Response() {}
+
+type CreateInvoiceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID  string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Currency   string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount     string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo       string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	ExpirySecs uint64 `protobuf:"varint,5,opt,name=expirySecs,proto3" json:"expirySecs,omitempty"`
+}
+
+func (x *CreateInvoiceReq) Reset() {
+	*x = CreateInvoiceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInvoiceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceReq) ProtoMessage() {}
+
+func (x *CreateInvoiceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Payment); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceReq.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CreateInvoiceReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *CreateInvoiceReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateInvoiceReq) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreateInvoiceReq) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CreateInvoiceReq) GetExpirySecs() uint64 {
+	if x != nil {
+		return x.ExpirySecs
+	}
+	return 0
+}
+
+type CreateInvoiceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*CreateInvoiceResp_MsgSuccess_
+	//	*CreateInvoiceResp_Error
+	Response isCreateInvoiceResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *CreateInvoiceResp) Reset() {
+	*x = CreateInvoiceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInvoiceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceResp) ProtoMessage() {}
+
+func (x *CreateInvoiceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgError); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceResp.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{84}
+}
+
+func (m *CreateInvoiceResp) GetResponse() isCreateInvoiceResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *CreateInvoiceResp) GetMsgSuccess() *CreateInvoiceResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*CreateInvoiceResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *CreateInvoiceResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*CreateInvoiceResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCreateInvoiceResp_Response interface {
+	isCreateInvoiceResp_Response()
+}
+
+type CreateInvoiceResp_MsgSuccess_ struct {
+	MsgSuccess *CreateInvoiceResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type CreateInvoiceResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateInvoiceResp_MsgSuccess_) isCreateInvoiceResp_Response() {}
+
+func (*CreateInvoiceResp_Error) isCreateInvoiceResp_Response() {}
+
+type GetInvoiceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	InvoiceID string `protobuf:"bytes,2,opt,name=invoiceID,proto3" json:"invoiceID,omitempty"`
+}
+
+func (x *GetInvoiceReq) Reset() {
+	*x = GetInvoiceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvoiceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceReq) ProtoMessage() {}
+
+func (x *GetInvoiceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrInfoPeerRequestTimedOut); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceReq.ProtoReflect.Descriptor instead.
+func (*GetInvoiceReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetInvoiceReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *GetInvoiceReq) GetInvoiceID() string {
+	if x != nil {
+		return x.InvoiceID
+	}
+	return ""
+}
+
+type GetInvoiceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*GetInvoiceResp_MsgSuccess_
+	//	*GetInvoiceResp_Error
+	Response isGetInvoiceResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetInvoiceResp) Reset() {
+	*x = GetInvoiceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvoiceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceResp) ProtoMessage() {}
+
+func (x *GetInvoiceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrInfoPeerRejected); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrInfoPeerNotFunded); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceResp.ProtoReflect.Descriptor instead.
+func (*GetInvoiceResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{86}
+}
+
+func (m *GetInvoiceResp) GetResponse() isGetInvoiceResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetInvoiceResp) GetMsgSuccess() *GetInvoiceResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetInvoiceResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetInvoiceResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetInvoiceResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetInvoiceResp_Response interface {
+	isGetInvoiceResp_Response()
+}
+
+type GetInvoiceResp_MsgSuccess_ struct {
+	MsgSuccess *GetInvoiceResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetInvoiceResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetInvoiceResp_MsgSuccess_) isGetInvoiceResp_Response() {}
+
+func (*GetInvoiceResp_Error) isGetInvoiceResp_Response() {}
+
+type GetInvoicesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *GetInvoicesReq) Reset() {
+	*x = GetInvoicesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvoicesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoicesReq) ProtoMessage() {}
+
+func (x *GetInvoicesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrInfoUserResponseTimedOut); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoicesReq.ProtoReflect.Descriptor instead.
+func (*GetInvoicesReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetInvoicesReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type GetInvoicesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*GetInvoicesResp_MsgSuccess_
+	//	*GetInvoicesResp_Error
+	Response isGetInvoicesResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetInvoicesResp) Reset() {
+	*x = GetInvoicesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvoicesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoicesResp) ProtoMessage() {}
+
+func (x *GetInvoicesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrInfoResourceNotFound); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoicesResp.ProtoReflect.Descriptor instead.
+func (*GetInvoicesResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{88}
+}
+
+func (m *GetInvoicesResp) GetResponse() isGetInvoicesResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetInvoicesResp) GetMsgSuccess() *GetInvoicesResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetInvoicesResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetInvoicesResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetInvoicesResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetInvoicesResp_Response interface {
+	isGetInvoicesResp_Response()
+}
+
+type GetInvoicesResp_MsgSuccess_ struct {
+	MsgSuccess *GetInvoicesResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetInvoicesResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetInvoicesResp_MsgSuccess_) isGetInvoicesResp_Response() {}
+
+func (*GetInvoicesResp_Error) isGetInvoicesResp_Response() {}
+
+type ScheduledPaymentParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChID      string `protobuf:"bytes,1,opt,name=chID,proto3" json:"chID,omitempty"`
+	PeerAlias string `protobuf:"bytes,2,opt,name=peerAlias,proto3" json:"peerAlias,omitempty"`
+	Currency  string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Schedule  string `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	EndTime   int64  `protobuf:"varint,6,opt,name=endTime,proto3" json:"endTime,omitempty"`
+}
+
+func (x *ScheduledPaymentParams) Reset() {
+	*x = ScheduledPaymentParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledPaymentParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPaymentParams) ProtoMessage() {}
+
+func (x *ScheduledPaymentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrInfoResourceExists); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPaymentParams.ProtoReflect.Descriptor instead.
+func (*ScheduledPaymentParams) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ScheduledPaymentParams) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *ScheduledPaymentParams) GetPeerAlias() string {
+	if x != nil {
+		return x.PeerAlias
+	}
+	return ""
+}
+
+func (x *ScheduledPaymentParams) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ScheduledPaymentParams) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ScheduledPaymentParams) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *ScheduledPaymentParams) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type ScheduledPaymentRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DueAt   int64  `protobuf:"varint,1,opt,name=dueAt,proto3" json:"dueAt,omitempty"`
+	RanAt   int64  `protobuf:"varint,2,opt,name=ranAt,proto3" json:"ranAt,omitempty"`
+	Status  string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ChID    string `protobuf:"bytes,4,opt,name=chID,proto3" json:"chID,omitempty"`
+	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	Error   string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ScheduledPaymentRun) Reset() {
+	*x = ScheduledPaymentRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledPaymentRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPaymentRun) ProtoMessage() {}
+
+func (x *ScheduledPaymentRun) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrInfoInvalidArgument); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPaymentRun.ProtoReflect.Descriptor instead.
+func (*ScheduledPaymentRun) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ScheduledPaymentRun) GetDueAt() int64 {
+	if x != nil {
+		return x.DueAt
+	}
+	return 0
+}
+
+func (x *ScheduledPaymentRun) GetRanAt() int64 {
+	if x != nil {
+		return x.RanAt
+	}
+	return 0
+}
+
+func (x *ScheduledPaymentRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduledPaymentRun) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *ScheduledPaymentRun) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ScheduledPaymentRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ScheduledPayment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledPaymentID string                  `protobuf:"bytes,1,opt,name=scheduledPaymentID,proto3" json:"scheduledPaymentID,omitempty"`
+	Params             *ScheduledPaymentParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	Status             string                  `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt          int64                   `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	NextRun            int64                   `protobuf:"varint,5,opt,name=nextRun,proto3" json:"nextRun,omitempty"`
+	Runs               []*ScheduledPaymentRun  `protobuf:"bytes,6,rep,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (x *ScheduledPayment) Reset() {
+	*x = ScheduledPayment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPayment) ProtoMessage() {}
+
+func (x *ScheduledPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrInfoFailedPreCondUnclosedChs); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPayment.ProtoReflect.Descriptor instead.
+func (*ScheduledPayment) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ScheduledPayment) GetScheduledPaymentID() string {
+	if x != nil {
+		return x.ScheduledPaymentID
+	}
+	return ""
+}
+
+func (x *ScheduledPayment) GetParams() *ScheduledPaymentParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *ScheduledPayment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduledPayment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ScheduledPayment) GetNextRun() int64 {
+	if x != nil {
+		return x.NextRun
+	}
+	return 0
+}
+
+func (x *ScheduledPayment) GetRuns() []*ScheduledPaymentRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type CreateScheduledPaymentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string                  `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Params    *ScheduledPaymentParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *CreateScheduledPaymentReq) Reset() {
+	*x = CreateScheduledPaymentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateScheduledPaymentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduledPaymentReq) ProtoMessage() {}
+
+func (x *CreateScheduledPaymentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrInfoInvalidConfig); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduledPaymentReq.ProtoReflect.Descriptor instead.
+func (*CreateScheduledPaymentReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreateScheduledPaymentReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *CreateScheduledPaymentReq) GetParams() *ScheduledPaymentParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type CreateScheduledPaymentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*CreateScheduledPaymentResp_MsgSuccess_
+	//	*CreateScheduledPaymentResp_Error
+	Response isCreateScheduledPaymentResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *CreateScheduledPaymentResp) Reset() {
+	*x = CreateScheduledPaymentResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateScheduledPaymentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduledPaymentResp) ProtoMessage() {}
+
+func (x *CreateScheduledPaymentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContractErrInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduledPaymentResp.ProtoReflect.Descriptor instead.
+func (*CreateScheduledPaymentResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{93}
+}
+
+func (m *CreateScheduledPaymentResp) GetResponse() isCreateScheduledPaymentResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *CreateScheduledPaymentResp) GetMsgSuccess() *CreateScheduledPaymentResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*CreateScheduledPaymentResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *CreateScheduledPaymentResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*CreateScheduledPaymentResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCreateScheduledPaymentResp_Response interface {
+	isCreateScheduledPaymentResp_Response()
+}
+
+type CreateScheduledPaymentResp_MsgSuccess_ struct {
+	MsgSuccess *CreateScheduledPaymentResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type CreateScheduledPaymentResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateScheduledPaymentResp_MsgSuccess_) isCreateScheduledPaymentResp_Response() {}
+
+func (*CreateScheduledPaymentResp_Error) isCreateScheduledPaymentResp_Response() {}
+
+type GetScheduledPaymentsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *GetScheduledPaymentsReq) Reset() {
+	*x = GetScheduledPaymentsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledPaymentsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledPaymentsReq) ProtoMessage() {}
+
+func (x *GetScheduledPaymentsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrInfoInvalidContracts); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrInfoTxTimedOut); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrInfoChainNotReachable); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledPaymentsReq.ProtoReflect.Descriptor instead.
+func (*GetScheduledPaymentsReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetScheduledPaymentsReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type GetScheduledPaymentsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*GetScheduledPaymentsResp_MsgSuccess_
+	//	*GetScheduledPaymentsResp_Error
+	Response isGetScheduledPaymentsResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetScheduledPaymentsResp) Reset() {
+	*x = GetScheduledPaymentsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledPaymentsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledPaymentsResp) ProtoMessage() {}
+
+func (x *GetScheduledPaymentsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledPaymentsResp.ProtoReflect.Descriptor instead.
+func (*GetScheduledPaymentsResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{95}
+}
+
+func (m *GetScheduledPaymentsResp) GetResponse() isGetScheduledPaymentsResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetScheduledPaymentsResp) GetMsgSuccess() *GetScheduledPaymentsResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetScheduledPaymentsResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetScheduledPaymentsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetScheduledPaymentsResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetScheduledPaymentsResp_Response interface {
+	isGetScheduledPaymentsResp_Response()
+}
+
+type GetScheduledPaymentsResp_MsgSuccess_ struct {
+	MsgSuccess *GetScheduledPaymentsResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetScheduledPaymentsResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetScheduledPaymentsResp_MsgSuccess_) isGetScheduledPaymentsResp_Response() {}
+
+func (*GetScheduledPaymentsResp_Error) isGetScheduledPaymentsResp_Response() {}
+
+type CancelScheduledPaymentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID          string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ScheduledPaymentID string `protobuf:"bytes,2,opt,name=scheduledPaymentID,proto3" json:"scheduledPaymentID,omitempty"`
+}
+
+func (x *CancelScheduledPaymentReq) Reset() {
+	*x = CancelScheduledPaymentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledPaymentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPaymentReq) ProtoMessage() {}
+
+func (x *CancelScheduledPaymentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenSessionReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPaymentReq.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPaymentReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CancelScheduledPaymentReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *CancelScheduledPaymentReq) GetScheduledPaymentID() string {
+	if x != nil {
+		return x.ScheduledPaymentID
+	}
+	return ""
+}
+
+type CancelScheduledPaymentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*CancelScheduledPaymentResp_MsgSuccess_
+	//	*CancelScheduledPaymentResp_Error
+	Response isCancelScheduledPaymentResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *CancelScheduledPaymentResp) Reset() {
+	*x = CancelScheduledPaymentResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledPaymentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPaymentResp) ProtoMessage() {}
+
+func (x *CancelScheduledPaymentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenSessionResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPaymentResp.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPaymentResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{97}
+}
+
+func (m *CancelScheduledPaymentResp) GetResponse() isCancelScheduledPaymentResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *CancelScheduledPaymentResp) GetMsgSuccess() *CancelScheduledPaymentResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*CancelScheduledPaymentResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *CancelScheduledPaymentResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*CancelScheduledPaymentResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCancelScheduledPaymentResp_Response interface {
+	isCancelScheduledPaymentResp_Response()
+}
+
+type CancelScheduledPaymentResp_MsgSuccess_ struct {
+	MsgSuccess *CancelScheduledPaymentResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type CancelScheduledPaymentResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CancelScheduledPaymentResp_MsgSuccess_) isCancelScheduledPaymentResp_Response() {}
+
+func (*CancelScheduledPaymentResp_Error) isCancelScheduledPaymentResp_Response() {}
+
+// PayPeerReq is the request for paying a peer on any of the open channels with it, in the currency.
+// chSelectionPolicy is either "fewestUpdates" (default, if empty) or "largestBalance". If allowSplit is true
+// and no single channel has enough balance, the payment is split across several channels.
+type PayPeerReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID         string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	PeerAlias         string `protobuf:"bytes,2,opt,name=peerAlias,proto3" json:"peerAlias,omitempty"`
+	Currency          string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount            string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	ChSelectionPolicy string `protobuf:"bytes,5,opt,name=chSelectionPolicy,proto3" json:"chSelectionPolicy,omitempty"`
+	AllowSplit        bool   `protobuf:"varint,6,opt,name=allowSplit,proto3" json:"allowSplit,omitempty"`
+}
+
+func (x *PayPeerReq) Reset() {
+	*x = PayPeerReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayPeerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayPeerReq) ProtoMessage() {}
+
+func (x *PayPeerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimeReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimeResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterCurrencyReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterCurrencyResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HelpReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HelpResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayPeerReq.ProtoReflect.Descriptor instead.
+func (*PayPeerReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *PayPeerReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *PayPeerReq) GetPeerAlias() string {
+	if x != nil {
+		return x.PeerAlias
+	}
+	return ""
+}
+
+func (x *PayPeerReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PayPeerReq) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PayPeerReq) GetChSelectionPolicy() string {
+	if x != nil {
+		return x.ChSelectionPolicy
+	}
+	return ""
+}
+
+func (x *PayPeerReq) GetAllowSplit() bool {
+	if x != nil {
+		return x.AllowSplit
+	}
+	return false
+}
+
+// PayPeerPart is the part of the payment made on a single channel, along with the updated channel info.
+type PayPeerPart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount           string     `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	UpdatedPayChInfo *PayChInfo `protobuf:"bytes,2,opt,name=updatedPayChInfo,proto3" json:"updatedPayChInfo,omitempty"`
+}
+
+func (x *PayPeerPart) Reset() {
+	*x = PayPeerPart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayPeerPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayPeerPart) ProtoMessage() {}
+
+func (x *PayPeerPart) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPeerIDReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayPeerPart.ProtoReflect.Descriptor instead.
+func (*PayPeerPart) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *PayPeerPart) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PayPeerPart) GetUpdatedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.UpdatedPayChInfo
+	}
+	return nil
+}
+
+// PayPeerResp is the response for PayPeer. When a split payment fails in between, completedParts contains the
+// parts made until then, along with the error. These parts are not reverted.
+type PayPeerResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*PayPeerResp_MsgSuccess_
+	//	*PayPeerResp_Error
+	Response       isPayPeerResp_Response `protobuf_oneof:"response"`
+	CompletedParts []*PayPeerPart         `protobuf:"bytes,3,rep,name=completedParts,proto3" json:"completedParts,omitempty"`
+}
+
+func (x *PayPeerResp) Reset() {
+	*x = PayPeerResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayPeerResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayPeerResp) ProtoMessage() {}
+
+func (x *PayPeerResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPeerIDResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayPeerResp.ProtoReflect.Descriptor instead.
+func (*PayPeerResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{100}
+}
+
+func (m *PayPeerResp) GetResponse() isPayPeerResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *PayPeerResp) GetMsgSuccess() *PayPeerResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*PayPeerResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *PayPeerResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*PayPeerResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *PayPeerResp) GetCompletedParts() []*PayPeerPart {
+	if x != nil {
+		return x.CompletedParts
+	}
+	return nil
+}
+
+type isPayPeerResp_Response interface {
+	isPayPeerResp_Response()
+}
+
+type PayPeerResp_MsgSuccess_ struct {
+	MsgSuccess *PayPeerResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type PayPeerResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*PayPeerResp_MsgSuccess_) isPayPeerResp_Response() {}
+
+func (*PayPeerResp_Error) isPayPeerResp_Response() {}
+
+type SendPayChUpdateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string           `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string           `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	Payments  []*Payment       `protobuf:"bytes,3,rep,name=payments,proto3" json:"payments,omitempty"`
+	Meta      *PayChUpdateMeta `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *SendPayChUpdateReq) Reset() {
+	*x = SendPayChUpdateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendPayChUpdateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPayChUpdateReq) ProtoMessage() {}
+
+func (x *SendPayChUpdateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeerIDReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeerIDResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenPayChReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPayChUpdateReq.ProtoReflect.Descriptor instead.
+func (*SendPayChUpdateReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SendPayChUpdateReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SendPayChUpdateReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *SendPayChUpdateReq) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *SendPayChUpdateReq) GetMeta() *PayChUpdateMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type SendPayChUpdateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*SendPayChUpdateResp_MsgSuccess_
+	//	*SendPayChUpdateResp_Error
+	Response isSendPayChUpdateResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *SendPayChUpdateResp) Reset() {
+	*x = SendPayChUpdateResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendPayChUpdateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPayChUpdateResp) ProtoMessage() {}
+
+func (x *SendPayChUpdateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenPayChResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPayChUpdateResp.ProtoReflect.Descriptor instead.
+func (*SendPayChUpdateResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{102}
+}
+
+func (m *SendPayChUpdateResp) GetResponse() isSendPayChUpdateResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *SendPayChUpdateResp) GetMsgSuccess() *SendPayChUpdateResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*SendPayChUpdateResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *SendPayChUpdateResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SendPayChUpdateResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSendPayChUpdateResp_Response interface {
+	isSendPayChUpdateResp_Response()
+}
+
+type SendPayChUpdateResp_MsgSuccess_ struct {
+	MsgSuccess *SendPayChUpdateResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type SendPayChUpdateResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SendPayChUpdateResp_MsgSuccess_) isSendPayChUpdateResp_Response() {}
+
+func (*SendPayChUpdateResp_Error) isSendPayChUpdateResp_Response() {}
+
+type PayInvoiceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	InvoiceID string `protobuf:"bytes,3,opt,name=invoiceID,proto3" json:"invoiceID,omitempty"`
+	Currency  string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount    string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PayInvoiceReq) Reset() {
+	*x = PayInvoiceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayInvoiceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayInvoiceReq) ProtoMessage() {}
+
+func (x *PayInvoiceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPayChsInfoReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayInvoiceReq.ProtoReflect.Descriptor instead.
+func (*PayInvoiceReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *PayInvoiceReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *PayInvoiceReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *PayInvoiceReq) GetInvoiceID() string {
+	if x != nil {
+		return x.InvoiceID
+	}
+	return ""
+}
+
+func (x *PayInvoiceReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PayInvoiceReq) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type PayInvoiceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*PayInvoiceResp_MsgSuccess_
+	//	*PayInvoiceResp_Error
+	Response isPayInvoiceResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *PayInvoiceResp) Reset() {
+	*x = PayInvoiceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayInvoiceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayInvoiceResp) ProtoMessage() {}
+
+func (x *PayInvoiceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPayChsInfoResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubPayChProposalsReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubPayChProposalsResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubPayChProposalsReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubPayChProposalsResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayInvoiceResp.ProtoReflect.Descriptor instead.
+func (*PayInvoiceResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{104}
+}
+
+func (m *PayInvoiceResp) GetResponse() isPayInvoiceResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *PayInvoiceResp) GetMsgSuccess() *PayInvoiceResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*PayInvoiceResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *PayInvoiceResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*PayInvoiceResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isPayInvoiceResp_Response interface {
+	isPayInvoiceResp_Response()
+}
+
+type PayInvoiceResp_MsgSuccess_ struct {
+	MsgSuccess *PayInvoiceResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type PayInvoiceResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*PayInvoiceResp_MsgSuccess_) isPayInvoiceResp_Response() {}
+
+func (*PayInvoiceResp_Error) isPayInvoiceResp_Response() {}
+
+type ProposeSwapReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID    string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID         string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	GiveCurrency string `protobuf:"bytes,3,opt,name=giveCurrency,proto3" json:"giveCurrency,omitempty"`
+	GiveAmount   string `protobuf:"bytes,4,opt,name=giveAmount,proto3" json:"giveAmount,omitempty"`
+	TakeCurrency string `protobuf:"bytes,5,opt,name=takeCurrency,proto3" json:"takeCurrency,omitempty"`
+	TakeAmount   string `protobuf:"bytes,6,opt,name=takeAmount,proto3" json:"takeAmount,omitempty"`
+	ExpirySecs   uint64 `protobuf:"varint,7,opt,name=expirySecs,proto3" json:"expirySecs,omitempty"`
+}
+
+func (x *ProposeSwapReq) Reset() {
+	*x = ProposeSwapReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposeSwapReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeSwapReq) ProtoMessage() {}
+
+func (x *ProposeSwapReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondPayChProposalReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeSwapReq.ProtoReflect.Descriptor instead.
+func (*ProposeSwapReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ProposeSwapReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *ProposeSwapReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *ProposeSwapReq) GetGiveCurrency() string {
+	if x != nil {
+		return x.GiveCurrency
+	}
+	return ""
+}
+
+func (x *ProposeSwapReq) GetGiveAmount() string {
+	if x != nil {
+		return x.GiveAmount
+	}
+	return ""
+}
+
+func (x *ProposeSwapReq) GetTakeCurrency() string {
+	if x != nil {
+		return x.TakeCurrency
+	}
+	return ""
+}
+
+func (x *ProposeSwapReq) GetTakeAmount() string {
+	if x != nil {
+		return x.TakeAmount
+	}
+	return ""
+}
+
+func (x *ProposeSwapReq) GetExpirySecs() uint64 {
+	if x != nil {
+		return x.ExpirySecs
+	}
+	return 0
+}
+
+type ProposeSwapResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*ProposeSwapResp_MsgSuccess_
+	//	*ProposeSwapResp_Error
+	Response isProposeSwapResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *ProposeSwapResp) Reset() {
+	*x = ProposeSwapResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposeSwapResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeSwapResp) ProtoMessage() {}
+
+func (x *ProposeSwapResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondPayChProposalResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeSwapResp.ProtoReflect.Descriptor instead.
+func (*ProposeSwapResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{106}
+}
+
+func (m *ProposeSwapResp) GetResponse() isProposeSwapResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ProposeSwapResp) GetMsgSuccess() *ProposeSwapResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*ProposeSwapResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *ProposeSwapResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*ProposeSwapResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isProposeSwapResp_Response interface {
+	isProposeSwapResp_Response()
+}
+
+type ProposeSwapResp_MsgSuccess_ struct {
+	MsgSuccess *ProposeSwapResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type ProposeSwapResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ProposeSwapResp_MsgSuccess_) isProposeSwapResp_Response() {}
+
+func (*ProposeSwapResp_Error) isProposeSwapResp_Response() {}
+
+type SubpayChUpdatesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+}
+
+func (x *SubpayChUpdatesReq) Reset() {
+	*x = SubpayChUpdatesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubpayChUpdatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubpayChUpdatesReq) ProtoMessage() {}
+
+func (x *SubpayChUpdatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseSessionReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseSessionResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployAssetERC20Req); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployAssetERC20Resp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendPayChUpdateReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubpayChUpdatesReq.ProtoReflect.Descriptor instead.
+func (*SubpayChUpdatesReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *SubpayChUpdatesReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SubpayChUpdatesReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+type SubPayChUpdatesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*SubPayChUpdatesResp_Notify_
+	//	*SubPayChUpdatesResp_Error
+	Response isSubPayChUpdatesResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *SubPayChUpdatesResp) Reset() {
+	*x = SubPayChUpdatesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubPayChUpdatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubPayChUpdatesResp) ProtoMessage() {}
+
+func (x *SubPayChUpdatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendPayChUpdateResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubPayChUpdatesResp.ProtoReflect.Descriptor instead.
+func (*SubPayChUpdatesResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{108}
+}
+
+func (m *SubPayChUpdatesResp) GetResponse() isSubPayChUpdatesResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *SubPayChUpdatesResp) GetNotify() *SubPayChUpdatesResp_Notify {
+	if x, ok := x.GetResponse().(*SubPayChUpdatesResp_Notify_); ok {
+		return x.Notify
+	}
+	return nil
+}
+
+func (x *SubPayChUpdatesResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SubPayChUpdatesResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSubPayChUpdatesResp_Response interface {
+	isSubPayChUpdatesResp_Response()
+}
+
+type SubPayChUpdatesResp_Notify_ struct {
+	Notify *SubPayChUpdatesResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
+}
+
+type SubPayChUpdatesResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SubPayChUpdatesResp_Notify_) isSubPayChUpdatesResp_Response() {}
+
+func (*SubPayChUpdatesResp_Error) isSubPayChUpdatesResp_Response() {}
+
+type UnsubPayChUpdatesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+}
+
+func (x *UnsubPayChUpdatesReq) Reset() {
+	*x = UnsubPayChUpdatesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPayChUpdatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPayChUpdatesReq) ProtoMessage() {}
+
+func (x *UnsubPayChUpdatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubpayChUpdatesReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPayChUpdatesReq.ProtoReflect.Descriptor instead.
+func (*UnsubPayChUpdatesReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *UnsubPayChUpdatesReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *UnsubPayChUpdatesReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+type UnsubPayChUpdatesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*UnsubPayChUpdatesResp_MsgSuccess_
+	//	*UnsubPayChUpdatesResp_Error
+	Response isUnsubPayChUpdatesResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *UnsubPayChUpdatesResp) Reset() {
+	*x = UnsubPayChUpdatesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPayChUpdatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPayChUpdatesResp) ProtoMessage() {}
+
+func (x *UnsubPayChUpdatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubPayChUpdatesResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPayChUpdatesResp.ProtoReflect.Descriptor instead.
+func (*UnsubPayChUpdatesResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{110}
+}
+
+func (m *UnsubPayChUpdatesResp) GetResponse() isUnsubPayChUpdatesResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *UnsubPayChUpdatesResp) GetMsgSuccess() *UnsubPayChUpdatesResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*UnsubPayChUpdatesResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *UnsubPayChUpdatesResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*UnsubPayChUpdatesResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUnsubPayChUpdatesResp_Response interface {
+	isUnsubPayChUpdatesResp_Response()
+}
+
+type UnsubPayChUpdatesResp_MsgSuccess_ struct {
+	MsgSuccess *UnsubPayChUpdatesResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type UnsubPayChUpdatesResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UnsubPayChUpdatesResp_MsgSuccess_) isUnsubPayChUpdatesResp_Response() {}
+
+func (*UnsubPayChUpdatesResp_Error) isUnsubPayChUpdatesResp_Response() {}
+
+type RespondPayChUpdateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	UpdateID  string `protobuf:"bytes,3,opt,name=updateID,proto3" json:"updateID,omitempty"`
+	Accept    bool   `protobuf:"varint,4,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *RespondPayChUpdateReq) Reset() {
+	*x = RespondPayChUpdateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondPayChUpdateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondPayChUpdateReq) ProtoMessage() {}
+
+func (x *RespondPayChUpdateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubPayChUpdatesReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondPayChUpdateReq.ProtoReflect.Descriptor instead.
+func (*RespondPayChUpdateReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *RespondPayChUpdateReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *RespondPayChUpdateReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *RespondPayChUpdateReq) GetUpdateID() string {
+	if x != nil {
+		return x.UpdateID
+	}
+	return ""
+}
+
+func (x *RespondPayChUpdateReq) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondPayChUpdateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*RespondPayChUpdateResp_MsgSuccess_
+	//	*RespondPayChUpdateResp_Error
+	Response isRespondPayChUpdateResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *RespondPayChUpdateResp) Reset() {
+	*x = RespondPayChUpdateResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondPayChUpdateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondPayChUpdateResp) ProtoMessage() {}
+
+func (x *RespondPayChUpdateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubPayChUpdatesResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondPayChUpdateResp.ProtoReflect.Descriptor instead.
+func (*RespondPayChUpdateResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{112}
+}
+
+func (m *RespondPayChUpdateResp) GetResponse() isRespondPayChUpdateResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RespondPayChUpdateResp) GetMsgSuccess() *RespondPayChUpdateResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*RespondPayChUpdateResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *RespondPayChUpdateResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*RespondPayChUpdateResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isRespondPayChUpdateResp_Response interface {
+	isRespondPayChUpdateResp_Response()
+}
+
+type RespondPayChUpdateResp_MsgSuccess_ struct {
+	MsgSuccess *RespondPayChUpdateResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type RespondPayChUpdateResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*RespondPayChUpdateResp_MsgSuccess_) isRespondPayChUpdateResp_Response() {}
+
+func (*RespondPayChUpdateResp_Error) isRespondPayChUpdateResp_Response() {}
+
+type GetPayChInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+}
+
+func (x *GetPayChInfoReq) Reset() {
+	*x = GetPayChInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayChInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayChInfoReq) ProtoMessage() {}
+
+func (x *GetPayChInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondPayChUpdateReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayChInfoReq.ProtoReflect.Descriptor instead.
+func (*GetPayChInfoReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetPayChInfoReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *GetPayChInfoReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+type GetPayChInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*GetPayChInfoResp_MsgSuccess_
+	//	*GetPayChInfoResp_Error
+	Response isGetPayChInfoResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetPayChInfoResp) Reset() {
+	*x = GetPayChInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayChInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayChInfoResp) ProtoMessage() {}
+
+func (x *GetPayChInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondPayChUpdateResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayChInfoResp.ProtoReflect.Descriptor instead.
+func (*GetPayChInfoResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{114}
+}
+
+func (m *GetPayChInfoResp) GetResponse() isGetPayChInfoResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetPayChInfoResp) GetMsgSuccess() *GetPayChInfoResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetPayChInfoResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetPayChInfoResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetPayChInfoResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetPayChInfoResp_Response interface {
+	isGetPayChInfoResp_Response()
+}
+
+type GetPayChInfoResp_MsgSuccess_ struct {
+	MsgSuccess *GetPayChInfoResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetPayChInfoResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetPayChInfoResp_MsgSuccess_) isGetPayChInfoResp_Response() {}
+
+func (*GetPayChInfoResp_Error) isGetPayChInfoResp_Response() {}
+
+type GetPayChHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+}
+
+func (x *GetPayChHistoryReq) Reset() {
+	*x = GetPayChHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayChHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayChHistoryReq) ProtoMessage() {}
+
+func (x *GetPayChHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPayChInfoReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayChHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetPayChHistoryReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetPayChHistoryReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *GetPayChHistoryReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+type GetPayChHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*GetPayChHistoryResp_MsgSuccess_
+	//	*GetPayChHistoryResp_Error
+	Response isGetPayChHistoryResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetPayChHistoryResp) Reset() {
+	*x = GetPayChHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayChHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayChHistoryResp) ProtoMessage() {}
+
+func (x *GetPayChHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPayChInfoResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayChHistoryResp.ProtoReflect.Descriptor instead.
+func (*GetPayChHistoryResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{116}
+}
+
+func (m *GetPayChHistoryResp) GetResponse() isGetPayChHistoryResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetPayChHistoryResp) GetMsgSuccess() *GetPayChHistoryResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetPayChHistoryResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetPayChHistoryResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetPayChHistoryResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetPayChHistoryResp_Response interface {
+	isGetPayChHistoryResp_Response()
+}
+
+type GetPayChHistoryResp_MsgSuccess_ struct {
+	MsgSuccess *GetPayChHistoryResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetPayChHistoryResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetPayChHistoryResp_MsgSuccess_) isGetPayChHistoryResp_Response() {}
+
+func (*GetPayChHistoryResp_Error) isGetPayChHistoryResp_Response() {}
+
+// ChReceipt is a portable proof that the participants agreed on the state of the channel at the version. It
+// can be verified offline. Params and state are the channel parameters and the state, in the binary encoding of
+// go-perun, as hex strings. Sigs are the signatures on the state, as hex strings, in the order of participants,
+// which are the addresses used for signing the states. BalInfo is only for information, it is not signed.
+type ChReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChID         string   `protobuf:"bytes,1,opt,name=chID,proto3" json:"chID,omitempty"`
+	Version      string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	BalInfo      *BalInfo `protobuf:"bytes,3,opt,name=balInfo,proto3" json:"balInfo,omitempty"`
+	Participants []string `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
+	Params       string   `protobuf:"bytes,5,opt,name=params,proto3" json:"params,omitempty"`
+	State        string   `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Sigs         []string `protobuf:"bytes,7,rep,name=sigs,proto3" json:"sigs,omitempty"`
+}
+
+func (x *ChReceipt) Reset() {
+	*x = ChReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChReceipt) ProtoMessage() {}
+
+func (x *ChReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClosePayChReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClosePayChResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BalInfoBal); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenSessionResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterCurrencyResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPeerIDResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChReceipt.ProtoReflect.Descriptor instead.
+func (*ChReceipt) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ChReceipt) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *ChReceipt) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ChReceipt) GetBalInfo() *BalInfo {
+	if x != nil {
+		return x.BalInfo
+	}
+	return nil
+}
+
+func (x *ChReceipt) GetParticipants() []string {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *ChReceipt) GetParams() string {
+	if x != nil {
+		return x.Params
+	}
+	return ""
+}
+
+func (x *ChReceipt) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ChReceipt) GetSigs() []string {
+	if x != nil {
+		return x.Sigs
+	}
+	return nil
+}
+
+type ExportPayChReceiptReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	Version   string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *ExportPayChReceiptReq) Reset() {
+	*x = ExportPayChReceiptReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPayChReceiptReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPayChReceiptReq) ProtoMessage() {}
+
+func (x *ExportPayChReceiptReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeerIDResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPayChReceiptReq.ProtoReflect.Descriptor instead.
+func (*ExportPayChReceiptReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ExportPayChReceiptReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *ExportPayChReceiptReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *ExportPayChReceiptReq) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ExportPayChReceiptResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*ExportPayChReceiptResp_MsgSuccess_
+	//	*ExportPayChReceiptResp_Error
+	Response isExportPayChReceiptResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *ExportPayChReceiptResp) Reset() {
+	*x = ExportPayChReceiptResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPayChReceiptResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPayChReceiptResp) ProtoMessage() {}
+
+func (x *ExportPayChReceiptResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenPayChResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPayChReceiptResp.ProtoReflect.Descriptor instead.
+func (*ExportPayChReceiptResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{119}
+}
+
+func (m *ExportPayChReceiptResp) GetResponse() isExportPayChReceiptResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ExportPayChReceiptResp) GetMsgSuccess() *ExportPayChReceiptResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*ExportPayChReceiptResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *ExportPayChReceiptResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*ExportPayChReceiptResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isExportPayChReceiptResp_Response interface {
+	isExportPayChReceiptResp_Response()
+}
+
+type ExportPayChReceiptResp_MsgSuccess_ struct {
+	MsgSuccess *ExportPayChReceiptResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type ExportPayChReceiptResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ExportPayChReceiptResp_MsgSuccess_) isExportPayChReceiptResp_Response() {}
+
+func (*ExportPayChReceiptResp_Error) isExportPayChReceiptResp_Response() {}
+
+// PayStreamStatus represents the progress of a payment stream.
+// State is one of "running", "paused", "stopped" or "completed". Error is set, if the stream was paused or
+// stopped due to an error.
+type PayStreamStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID       string    `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	ChID           string    `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	Currency       string    `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount         string    `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	IntervalMillis uint64    `protobuf:"varint,5,opt,name=intervalMillis,proto3" json:"intervalMillis,omitempty"`
+	Cap            string    `protobuf:"bytes,6,opt,name=cap,proto3" json:"cap,omitempty"`
+	EndTime        int64     `protobuf:"varint,7,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	State          string    `protobuf:"bytes,8,opt,name=state,proto3" json:"state,omitempty"`
+	AmountSent     string    `protobuf:"bytes,9,opt,name=amountSent,proto3" json:"amountSent,omitempty"`
+	PaymentsSent   uint64    `protobuf:"varint,10,opt,name=paymentsSent,proto3" json:"paymentsSent,omitempty"`
+	StartedAt      int64     `protobuf:"varint,11,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	UpdatedAt      int64     `protobuf:"varint,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Error          *MsgError `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PayStreamStatus) Reset() {
+	*x = PayStreamStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayStreamStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayStreamStatus) ProtoMessage() {}
+
+func (x *PayStreamStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPayChsInfoResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubPayChProposalsResp_Notify); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayStreamStatus.ProtoReflect.Descriptor instead.
+func (*PayStreamStatus) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *PayStreamStatus) GetStreamID() string {
+	if x != nil {
+		return x.StreamID
+	}
+	return ""
+}
+
+func (x *PayStreamStatus) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *PayStreamStatus) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PayStreamStatus) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PayStreamStatus) GetIntervalMillis() uint64 {
+	if x != nil {
+		return x.IntervalMillis
+	}
+	return 0
+}
+
+func (x *PayStreamStatus) GetCap() string {
+	if x != nil {
+		return x.Cap
+	}
+	return ""
+}
+
+func (x *PayStreamStatus) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *PayStreamStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PayStreamStatus) GetAmountSent() string {
+	if x != nil {
+		return x.AmountSent
+	}
+	return ""
+}
+
+func (x *PayStreamStatus) GetPaymentsSent() uint64 {
+	if x != nil {
+		return x.PaymentsSent
+	}
+	return 0
+}
+
+func (x *PayStreamStatus) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *PayStreamStatus) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *PayStreamStatus) GetError() *MsgError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type StartPayStreamReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID      string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID           string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+	Currency       string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount         string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	IntervalMillis uint64 `protobuf:"varint,5,opt,name=intervalMillis,proto3" json:"intervalMillis,omitempty"`
+	Cap            string `protobuf:"bytes,6,opt,name=cap,proto3" json:"cap,omitempty"`
+	EndTime        int64  `protobuf:"varint,7,opt,name=endTime,proto3" json:"endTime,omitempty"`
+}
+
+func (x *StartPayStreamReq) Reset() {
+	*x = StartPayStreamReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPayStreamReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPayStreamReq) ProtoMessage() {}
+
+func (x *StartPayStreamReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubPayChProposalsResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPayStreamReq.ProtoReflect.Descriptor instead.
+func (*StartPayStreamReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *StartPayStreamReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *StartPayStreamReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+func (x *StartPayStreamReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *StartPayStreamReq) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *StartPayStreamReq) GetIntervalMillis() uint64 {
+	if x != nil {
+		return x.IntervalMillis
+	}
+	return 0
+}
+
+func (x *StartPayStreamReq) GetCap() string {
+	if x != nil {
+		return x.Cap
+	}
+	return ""
+}
+
+func (x *StartPayStreamReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type StartPayStreamResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*StartPayStreamResp_MsgSuccess_
+	//	*StartPayStreamResp_Error
+	Response isStartPayStreamResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *StartPayStreamResp) Reset() {
+	*x = StartPayStreamResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPayStreamResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPayStreamResp) ProtoMessage() {}
+
+func (x *StartPayStreamResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondPayChProposalResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPayStreamResp.ProtoReflect.Descriptor instead.
+func (*StartPayStreamResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{122}
+}
+
+func (m *StartPayStreamResp) GetResponse() isStartPayStreamResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *StartPayStreamResp) GetMsgSuccess() *StartPayStreamResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*StartPayStreamResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *StartPayStreamResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*StartPayStreamResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isStartPayStreamResp_Response interface {
+	isStartPayStreamResp_Response()
+}
+
+type StartPayStreamResp_MsgSuccess_ struct {
+	MsgSuccess *StartPayStreamResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type StartPayStreamResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*StartPayStreamResp_MsgSuccess_) isStartPayStreamResp_Response() {}
+
+func (*StartPayStreamResp_Error) isStartPayStreamResp_Response() {}
+
+type PausePayStreamReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	StreamID  string `protobuf:"bytes,2,opt,name=streamID,proto3" json:"streamID,omitempty"`
+}
+
+func (x *PausePayStreamReq) Reset() {
+	*x = PausePayStreamReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PausePayStreamReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausePayStreamReq) ProtoMessage() {}
+
+func (x *PausePayStreamReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseSessionResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployAssetERC20Resp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendPayChUpdateResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PausePayStreamReq.ProtoReflect.Descriptor instead.
+func (*PausePayStreamReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *PausePayStreamReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *PausePayStreamReq) GetStreamID() string {
+	if x != nil {
+		return x.StreamID
+	}
+	return ""
+}
+
+type PausePayStreamResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*PausePayStreamResp_MsgSuccess_
+	//	*PausePayStreamResp_Error
+	Response isPausePayStreamResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *PausePayStreamResp) Reset() {
+	*x = PausePayStreamResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PausePayStreamResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausePayStreamResp) ProtoMessage() {}
+
+func (x *PausePayStreamResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubPayChUpdatesResp_Notify); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PausePayStreamResp.ProtoReflect.Descriptor instead.
+func (*PausePayStreamResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{124}
+}
+
+func (m *PausePayStreamResp) GetResponse() isPausePayStreamResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *PausePayStreamResp) GetMsgSuccess() *PausePayStreamResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*PausePayStreamResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *PausePayStreamResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*PausePayStreamResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isPausePayStreamResp_Response interface {
+	isPausePayStreamResp_Response()
+}
+
+type PausePayStreamResp_MsgSuccess_ struct {
+	MsgSuccess *PausePayStreamResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type PausePayStreamResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*PausePayStreamResp_MsgSuccess_) isPausePayStreamResp_Response() {}
+
+func (*PausePayStreamResp_Error) isPausePayStreamResp_Response() {}
+
+type ResumePayStreamReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	StreamID  string `protobuf:"bytes,2,opt,name=streamID,proto3" json:"streamID,omitempty"`
+}
+
+func (x *ResumePayStreamReq) Reset() {
+	*x = ResumePayStreamReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumePayStreamReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumePayStreamReq) ProtoMessage() {}
+
+func (x *ResumePayStreamReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubPayChUpdatesResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumePayStreamReq.ProtoReflect.Descriptor instead.
+func (*ResumePayStreamReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ResumePayStreamReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *ResumePayStreamReq) GetStreamID() string {
+	if x != nil {
+		return x.StreamID
+	}
+	return ""
+}
+
+type ResumePayStreamResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*ResumePayStreamResp_MsgSuccess_
+	//	*ResumePayStreamResp_Error
+	Response isResumePayStreamResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *ResumePayStreamResp) Reset() {
+	*x = ResumePayStreamResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumePayStreamResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumePayStreamResp) ProtoMessage() {}
+
+func (x *ResumePayStreamResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RespondPayChUpdateResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumePayStreamResp.ProtoReflect.Descriptor instead.
+func (*ResumePayStreamResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{126}
+}
+
+func (m *ResumePayStreamResp) GetResponse() isResumePayStreamResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ResumePayStreamResp) GetMsgSuccess() *ResumePayStreamResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*ResumePayStreamResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *ResumePayStreamResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*ResumePayStreamResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isResumePayStreamResp_Response interface {
+	isResumePayStreamResp_Response()
+}
+
+type ResumePayStreamResp_MsgSuccess_ struct {
+	MsgSuccess *ResumePayStreamResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type ResumePayStreamResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ResumePayStreamResp_MsgSuccess_) isResumePayStreamResp_Response() {}
+
+func (*ResumePayStreamResp_Error) isResumePayStreamResp_Response() {}
+
+type StopPayStreamReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	StreamID  string `protobuf:"bytes,2,opt,name=streamID,proto3" json:"streamID,omitempty"`
+}
+
+func (x *StopPayStreamReq) Reset() {
+	*x = StopPayStreamReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPayStreamReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPayStreamReq) ProtoMessage() {}
+
+func (x *StopPayStreamReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPayChInfoResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPayStreamReq.ProtoReflect.Descriptor instead.
+func (*StopPayStreamReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *StopPayStreamReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *StopPayStreamReq) GetStreamID() string {
+	if x != nil {
+		return x.StreamID
+	}
+	return ""
+}
+
+type StopPayStreamResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*StopPayStreamResp_MsgSuccess_
+	//	*StopPayStreamResp_Error
+	Response isStopPayStreamResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *StopPayStreamResp) Reset() {
+	*x = StopPayStreamResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPayStreamResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPayStreamResp) ProtoMessage() {}
+
+func (x *StopPayStreamResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClosePayChResp_MsgSuccess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPayStreamResp.ProtoReflect.Descriptor instead.
+func (*StopPayStreamResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{128}
+}
+
+func (m *StopPayStreamResp) GetResponse() isStopPayStreamResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *StopPayStreamResp) GetMsgSuccess() *StopPayStreamResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*StopPayStreamResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *StopPayStreamResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*StopPayStreamResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isStopPayStreamResp_Response interface {
+	isStopPayStreamResp_Response()
+}
+
+type StopPayStreamResp_MsgSuccess_ struct {
+	MsgSuccess *StopPayStreamResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type StopPayStreamResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*StopPayStreamResp_MsgSuccess_) isStopPayStreamResp_Response() {}
+
+func (*StopPayStreamResp_Error) isStopPayStreamResp_Response() {}
+
+type GetPayStreamsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *GetPayStreamsReq) Reset() {
+	*x = GetPayStreamsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayStreamsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayStreamsReq) ProtoMessage() {}
+
+func (x *GetPayStreamsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayStreamsReq.ProtoReflect.Descriptor instead.
+func (*GetPayStreamsReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetPayStreamsReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type GetPayStreamsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*GetPayStreamsResp_MsgSuccess_
+	//	*GetPayStreamsResp_Error
+	Response isGetPayStreamsResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetPayStreamsResp) Reset() {
+	*x = GetPayStreamsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayStreamsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayStreamsResp) ProtoMessage() {}
+
+func (x *GetPayStreamsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayStreamsResp.ProtoReflect.Descriptor instead.
+func (*GetPayStreamsResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{130}
+}
+
+func (m *GetPayStreamsResp) GetResponse() isGetPayStreamsResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetPayStreamsResp) GetMsgSuccess() *GetPayStreamsResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*GetPayStreamsResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *GetPayStreamsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*GetPayStreamsResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetPayStreamsResp_Response interface {
+	isGetPayStreamsResp_Response()
+}
+
+type GetPayStreamsResp_MsgSuccess_ struct {
+	MsgSuccess *GetPayStreamsResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type GetPayStreamsResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetPayStreamsResp_MsgSuccess_) isGetPayStreamsResp_Response() {}
+
+func (*GetPayStreamsResp_Error) isGetPayStreamsResp_Response() {}
+
+type SubPayStreamsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *SubPayStreamsReq) Reset() {
+	*x = SubPayStreamsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubPayStreamsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubPayStreamsReq) ProtoMessage() {}
+
+func (x *SubPayStreamsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubPayStreamsReq.ProtoReflect.Descriptor instead.
+func (*SubPayStreamsReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *SubPayStreamsReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type SubPayStreamsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*SubPayStreamsResp_Notify_
+	//	*SubPayStreamsResp_Error
+	Response isSubPayStreamsResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *SubPayStreamsResp) Reset() {
+	*x = SubPayStreamsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubPayStreamsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubPayStreamsResp) ProtoMessage() {}
+
+func (x *SubPayStreamsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubPayStreamsResp.ProtoReflect.Descriptor instead.
+func (*SubPayStreamsResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{132}
+}
+
+func (m *SubPayStreamsResp) GetResponse() isSubPayStreamsResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *SubPayStreamsResp) GetNotify() *SubPayStreamsResp_Notify {
+	if x, ok := x.GetResponse().(*SubPayStreamsResp_Notify_); ok {
+		return x.Notify
+	}
+	return nil
+}
+
+func (x *SubPayStreamsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*SubPayStreamsResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSubPayStreamsResp_Response interface {
+	isSubPayStreamsResp_Response()
+}
+
+type SubPayStreamsResp_Notify_ struct {
+	Notify *SubPayStreamsResp_Notify `protobuf:"bytes,1,opt,name=notify,proto3,oneof"`
+}
+
+type SubPayStreamsResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SubPayStreamsResp_Notify_) isSubPayStreamsResp_Response() {}
+
+func (*SubPayStreamsResp_Error) isSubPayStreamsResp_Response() {}
+
+type UnsubPayStreamsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *UnsubPayStreamsReq) Reset() {
+	*x = UnsubPayStreamsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPayStreamsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPayStreamsReq) ProtoMessage() {}
+
+func (x *UnsubPayStreamsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPayStreamsReq.ProtoReflect.Descriptor instead.
+func (*UnsubPayStreamsReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *UnsubPayStreamsReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type UnsubPayStreamsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*UnsubPayStreamsResp_MsgSuccess_
+	//	*UnsubPayStreamsResp_Error
+	Response isUnsubPayStreamsResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *UnsubPayStreamsResp) Reset() {
+	*x = UnsubPayStreamsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPayStreamsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPayStreamsResp) ProtoMessage() {}
+
+func (x *UnsubPayStreamsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPayStreamsResp.ProtoReflect.Descriptor instead.
+func (*UnsubPayStreamsResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{134}
+}
+
+func (m *UnsubPayStreamsResp) GetResponse() isUnsubPayStreamsResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *UnsubPayStreamsResp) GetMsgSuccess() *UnsubPayStreamsResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*UnsubPayStreamsResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *UnsubPayStreamsResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*UnsubPayStreamsResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUnsubPayStreamsResp_Response interface {
+	isUnsubPayStreamsResp_Response()
+}
+
+type UnsubPayStreamsResp_MsgSuccess_ struct {
+	MsgSuccess *UnsubPayStreamsResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type UnsubPayStreamsResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UnsubPayStreamsResp_MsgSuccess_) isUnsubPayStreamsResp_Response() {}
+
+func (*UnsubPayStreamsResp_Error) isUnsubPayStreamsResp_Response() {}
+
+type ClosePayChReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ChID      string `protobuf:"bytes,2,opt,name=chID,proto3" json:"chID,omitempty"`
+}
+
+func (x *ClosePayChReq) Reset() {
+	*x = ClosePayChReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePayChReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePayChReq) ProtoMessage() {}
+
+func (x *ClosePayChReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePayChReq.ProtoReflect.Descriptor instead.
+func (*ClosePayChReq) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ClosePayChReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *ClosePayChReq) GetChID() string {
+	if x != nil {
+		return x.ChID
+	}
+	return ""
+}
+
+type ClosePayChResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*ClosePayChResp_MsgSuccess_
+	//	*ClosePayChResp_Error
+	Response isClosePayChResp_Response `protobuf_oneof:"response"`
+}
+
+func (x *ClosePayChResp) Reset() {
+	*x = ClosePayChResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePayChResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePayChResp) ProtoMessage() {}
+
+func (x *ClosePayChResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePayChResp.ProtoReflect.Descriptor instead.
+func (*ClosePayChResp) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{136}
+}
+
+func (m *ClosePayChResp) GetResponse() isClosePayChResp_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ClosePayChResp) GetMsgSuccess() *ClosePayChResp_MsgSuccess {
+	if x, ok := x.GetResponse().(*ClosePayChResp_MsgSuccess_); ok {
+		return x.MsgSuccess
+	}
+	return nil
+}
+
+func (x *ClosePayChResp) GetError() *MsgError {
+	if x, ok := x.GetResponse().(*ClosePayChResp_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isClosePayChResp_Response interface {
+	isClosePayChResp_Response()
+}
+
+type ClosePayChResp_MsgSuccess_ struct {
+	MsgSuccess *ClosePayChResp_MsgSuccess `protobuf:"bytes,1,opt,name=msgSuccess,proto3,oneof"`
+}
+
+type ClosePayChResp_Error struct {
+	Error *MsgError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ClosePayChResp_MsgSuccess_) isClosePayChResp_Response() {}
+
+func (*ClosePayChResp_Error) isClosePayChResp_Response() {}
+
+type BalInfoBal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bal []string `protobuf:"bytes,1,rep,name=bal,proto3" json:"bal,omitempty"`
+}
+
+func (x *BalInfoBal) Reset() {
+	*x = BalInfoBal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalInfoBal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalInfoBal) ProtoMessage() {}
+
+func (x *BalInfoBal) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalInfoBal.ProtoReflect.Descriptor instead.
+func (*BalInfoBal) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *BalInfoBal) GetBal() []string {
+	if x != nil {
+		return x.Bal
+	}
+	return nil
+}
+
+type OpenSessionResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID   string       `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	RestoredChs []*PayChInfo `protobuf:"bytes,2,rep,name=restoredChs,proto3" json:"restoredChs,omitempty"`
+}
+
+func (x *OpenSessionResp_MsgSuccess) Reset() {
+	*x = OpenSessionResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenSessionResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenSessionResp_MsgSuccess) ProtoMessage() {}
+
+func (x *OpenSessionResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenSessionResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*OpenSessionResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *OpenSessionResp_MsgSuccess) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *OpenSessionResp_MsgSuccess) GetRestoredChs() []*PayChInfo {
+	if x != nil {
+		return x.RestoredChs
+	}
+	return nil
+}
+
+type RegisterCurrencyResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (x *RegisterCurrencyResp_MsgSuccess) Reset() {
+	*x = RegisterCurrencyResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterCurrencyResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterCurrencyResp_MsgSuccess) ProtoMessage() {}
+
+func (x *RegisterCurrencyResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterCurrencyResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*RegisterCurrencyResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *RegisterCurrencyResp_MsgSuccess) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type AddPeerIDResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *AddPeerIDResp_MsgSuccess) Reset() {
+	*x = AddPeerIDResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPeerIDResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPeerIDResp_MsgSuccess) ProtoMessage() {}
+
+func (x *AddPeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPeerIDResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*AddPeerIDResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *AddPeerIDResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetPeerIDResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerID *PeerID `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+}
+
+func (x *GetPeerIDResp_MsgSuccess) Reset() {
+	*x = GetPeerIDResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerIDResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerIDResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetPeerIDResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerIDResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetPeerIDResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *GetPeerIDResp_MsgSuccess) GetPeerID() *PeerID {
+	if x != nil {
+		return x.PeerID
+	}
+	return nil
+}
+
+type ExportPeerIDsResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportPeerIDsResp_MsgSuccess) Reset() {
+	*x = ExportPeerIDsResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPeerIDsResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPeerIDsResp_MsgSuccess) ProtoMessage() {}
+
+func (x *ExportPeerIDsResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPeerIDsResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*ExportPeerIDsResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{39, 0}
+}
+
+func (x *ExportPeerIDsResp_MsgSuccess) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type ImportPeerIDsResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Added       []string                `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	Overwritten []string                `protobuf:"bytes,2,rep,name=overwritten,proto3" json:"overwritten,omitempty"`
+	Unchanged   []string                `protobuf:"bytes,3,rep,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Conflicts   []*PeerIDImportConflict `protobuf:"bytes,4,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Invalid     []*PeerIDImportInvalid  `protobuf:"bytes,5,rep,name=invalid,proto3" json:"invalid,omitempty"`
+	Applied     bool                    `protobuf:"varint,6,opt,name=applied,proto3" json:"applied,omitempty"`
+}
+
+func (x *ImportPeerIDsResp_MsgSuccess) Reset() {
+	*x = ImportPeerIDsResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportPeerIDsResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPeerIDsResp_MsgSuccess) ProtoMessage() {}
+
+func (x *ImportPeerIDsResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPeerIDsResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*ImportPeerIDsResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *ImportPeerIDsResp_MsgSuccess) GetAdded() []string {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *ImportPeerIDsResp_MsgSuccess) GetOverwritten() []string {
+	if x != nil {
+		return x.Overwritten
+	}
+	return nil
+}
+
+func (x *ImportPeerIDsResp_MsgSuccess) GetUnchanged() []string {
+	if x != nil {
+		return x.Unchanged
+	}
+	return nil
+}
+
+func (x *ImportPeerIDsResp_MsgSuccess) GetConflicts() []*PeerIDImportConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *ImportPeerIDsResp_MsgSuccess) GetInvalid() []*PeerIDImportInvalid {
+	if x != nil {
+		return x.Invalid
+	}
+	return nil
+}
+
+func (x *ImportPeerIDsResp_MsgSuccess) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type GetPeerStatusResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerStatuses []*PeerStatus `protobuf:"bytes,1,rep,name=peerStatuses,proto3" json:"peerStatuses,omitempty"`
+}
+
+func (x *GetPeerStatusResp_MsgSuccess) Reset() {
+	*x = GetPeerStatusResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerStatusResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerStatusResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetPeerStatusResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerStatusResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetPeerStatusResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{46, 0}
+}
+
+func (x *GetPeerStatusResp_MsgSuccess) GetPeerStatuses() []*PeerStatus {
+	if x != nil {
+		return x.PeerStatuses
+	}
+	return nil
+}
+
+type SubPeerStatusResp_Notify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerStatus *PeerStatus `protobuf:"bytes,1,opt,name=peerStatus,proto3" json:"peerStatus,omitempty"`
+}
+
+func (x *SubPeerStatusResp_Notify) Reset() {
+	*x = SubPeerStatusResp_Notify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubPeerStatusResp_Notify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubPeerStatusResp_Notify) ProtoMessage() {}
+
+func (x *SubPeerStatusResp_Notify) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubPeerStatusResp_Notify.ProtoReflect.Descriptor instead.
+func (*SubPeerStatusResp_Notify) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{48, 0}
+}
+
+func (x *SubPeerStatusResp_Notify) GetPeerStatus() *PeerStatus {
+	if x != nil {
+		return x.PeerStatus
+	}
+	return nil
+}
+
+type UnsubPeerStatusResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UnsubPeerStatusResp_MsgSuccess) Reset() {
+	*x = UnsubPeerStatusResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPeerStatusResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPeerStatusResp_MsgSuccess) ProtoMessage() {}
+
+func (x *UnsubPeerStatusResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPeerStatusResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*UnsubPeerStatusResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{50, 0}
+}
+
+func (x *UnsubPeerStatusResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type OpenPayChResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=openedPayChInfo,proto3" json:"openedPayChInfo,omitempty"`
+}
+
+func (x *OpenPayChResp_MsgSuccess) Reset() {
+	*x = OpenPayChResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenPayChResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenPayChResp_MsgSuccess) ProtoMessage() {}
+
+func (x *OpenPayChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenPayChResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*OpenPayChResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{52, 0}
+}
+
+func (x *OpenPayChResp_MsgSuccess) GetOpenedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.OpenedPayChInfo
+	}
+	return nil
+}
+
+type GetPayChsInfoResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenPayChsInfo []*PayChInfo `protobuf:"bytes,1,rep,name=openPayChsInfo,proto3" json:"openPayChsInfo,omitempty"`
+}
+
+func (x *GetPayChsInfoResp_MsgSuccess) Reset() {
+	*x = GetPayChsInfoResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayChsInfoResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayChsInfoResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetPayChsInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayChsInfoResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetPayChsInfoResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{54, 0}
+}
+
+func (x *GetPayChsInfoResp_MsgSuccess) GetOpenPayChsInfo() []*PayChInfo {
+	if x != nil {
+		return x.OpenPayChsInfo
+	}
+	return nil
+}
+
+type SubPayChProposalsResp_Notify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalID       string   `protobuf:"bytes,2,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	OpeningBalInfo   *BalInfo `protobuf:"bytes,4,opt,name=openingBalInfo,proto3" json:"openingBalInfo,omitempty"`
+	ChallengeDurSecs uint64   `protobuf:"varint,5,opt,name=challengeDurSecs,proto3" json:"challengeDurSecs,omitempty"`
+	Expiry           int64    `protobuf:"varint,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+}
+
+func (x *SubPayChProposalsResp_Notify) Reset() {
+	*x = SubPayChProposalsResp_Notify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubPayChProposalsResp_Notify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubPayChProposalsResp_Notify) ProtoMessage() {}
+
+func (x *SubPayChProposalsResp_Notify) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubPayChProposalsResp_Notify.ProtoReflect.Descriptor instead.
+func (*SubPayChProposalsResp_Notify) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{56, 0}
+}
+
+func (x *SubPayChProposalsResp_Notify) GetProposalID() string {
+	if x != nil {
+		return x.ProposalID
+	}
+	return ""
+}
+
+func (x *SubPayChProposalsResp_Notify) GetOpeningBalInfo() *BalInfo {
+	if x != nil {
+		return x.OpeningBalInfo
+	}
+	return nil
+}
+
+func (x *SubPayChProposalsResp_Notify) GetChallengeDurSecs() uint64 {
+	if x != nil {
+		return x.ChallengeDurSecs
+	}
+	return 0
+}
+
+func (x *SubPayChProposalsResp_Notify) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+type UnsubPayChProposalsResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UnsubPayChProposalsResp_MsgSuccess) Reset() {
+	*x = UnsubPayChProposalsResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPayChProposalsResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPayChProposalsResp_MsgSuccess) ProtoMessage() {}
+
+func (x *UnsubPayChProposalsResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPayChProposalsResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*UnsubPayChProposalsResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{58, 0}
+}
+
+func (x *UnsubPayChProposalsResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RespondPayChProposalResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenedPayChInfo *PayChInfo `protobuf:"bytes,2,opt,name=openedPayChInfo,proto3" json:"openedPayChInfo,omitempty"`
+}
+
+func (x *RespondPayChProposalResp_MsgSuccess) Reset() {
+	*x = RespondPayChProposalResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondPayChProposalResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondPayChProposalResp_MsgSuccess) ProtoMessage() {}
+
+func (x *RespondPayChProposalResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondPayChProposalResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*RespondPayChProposalResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{60, 0}
+}
+
+func (x *RespondPayChProposalResp_MsgSuccess) GetOpenedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.OpenedPayChInfo
+	}
+	return nil
+}
+
+type CloseSessionResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenPayChsInfo []*PayChInfo `protobuf:"bytes,1,rep,name=openPayChsInfo,proto3" json:"openPayChsInfo,omitempty"`
+}
+
+func (x *CloseSessionResp_MsgSuccess) Reset() {
+	*x = CloseSessionResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseSessionResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseSessionResp_MsgSuccess) ProtoMessage() {}
+
+func (x *CloseSessionResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseSessionResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*CloseSessionResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{62, 0}
+}
+
+func (x *CloseSessionResp_MsgSuccess) GetOpenPayChsInfo() []*PayChInfo {
+	if x != nil {
+		return x.OpenPayChsInfo
+	}
+	return nil
+}
+
+type DeployAssetERC20Resp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetAddr string `protobuf:"bytes,2,opt,name=AssetAddr,proto3" json:"AssetAddr,omitempty"`
+}
+
+func (x *DeployAssetERC20Resp_MsgSuccess) Reset() {
+	*x = DeployAssetERC20Resp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeployAssetERC20Resp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployAssetERC20Resp_MsgSuccess) ProtoMessage() {}
+
+func (x *DeployAssetERC20Resp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployAssetERC20Resp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*DeployAssetERC20Resp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{64, 0}
+}
+
+func (x *DeployAssetERC20Resp_MsgSuccess) GetAssetAddr() string {
+	if x != nil {
+		return x.AssetAddr
+	}
+	return ""
+}
+
+type GetOnChainBalResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bal string `protobuf:"bytes,1,opt,name=bal,proto3" json:"bal,omitempty"`
+}
+
+func (x *GetOnChainBalResp_MsgSuccess) Reset() {
+	*x = GetOnChainBalResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOnChainBalResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnChainBalResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetOnChainBalResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnChainBalResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetOnChainBalResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{66, 0}
+}
+
+func (x *GetOnChainBalResp_MsgSuccess) GetBal() string {
+	if x != nil {
+		return x.Bal
+	}
+	return ""
+}
+
+type TransferOnChainResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxID string `protobuf:"bytes,1,opt,name=txID,proto3" json:"txID,omitempty"`
+}
+
+func (x *TransferOnChainResp_MsgSuccess) Reset() {
+	*x = TransferOnChainResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferOnChainResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOnChainResp_MsgSuccess) ProtoMessage() {}
+
+func (x *TransferOnChainResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOnChainResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*TransferOnChainResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{68, 0}
+}
+
+func (x *TransferOnChainResp_MsgSuccess) GetTxID() string {
+	if x != nil {
+		return x.TxID
+	}
+	return ""
+}
+
+type GetAllowanceResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allowance string `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+}
+
+func (x *GetAllowanceResp_MsgSuccess) Reset() {
+	*x = GetAllowanceResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllowanceResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowanceResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetAllowanceResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowanceResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetAllowanceResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{70, 0}
+}
+
+func (x *GetAllowanceResp_MsgSuccess) GetAllowance() string {
+	if x != nil {
+		return x.Allowance
+	}
+	return ""
+}
+
+type SetAllowanceResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxID string `protobuf:"bytes,1,opt,name=txID,proto3" json:"txID,omitempty"`
+}
+
+func (x *SetAllowanceResp_MsgSuccess) Reset() {
+	*x = SetAllowanceResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAllowanceResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAllowanceResp_MsgSuccess) ProtoMessage() {}
+
+func (x *SetAllowanceResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAllowanceResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*SetAllowanceResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{72, 0}
+}
+
+func (x *SetAllowanceResp_MsgSuccess) GetTxID() string {
+	if x != nil {
+		return x.TxID
+	}
+	return ""
+}
+
+type GetTxCostReportResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxCosts  []*TxCostInfo `protobuf:"bytes,1,rep,name=txCosts,proto3" json:"txCosts,omitempty"`
+	TotalFee string        `protobuf:"bytes,2,opt,name=totalFee,proto3" json:"totalFee,omitempty"`
+}
+
+func (x *GetTxCostReportResp_MsgSuccess) Reset() {
+	*x = GetTxCostReportResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxCostReportResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxCostReportResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetTxCostReportResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxCostReportResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetTxCostReportResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{75, 0}
+}
+
+func (x *GetTxCostReportResp_MsgSuccess) GetTxCosts() []*TxCostInfo {
+	if x != nil {
+		return x.TxCosts
+	}
+	return nil
+}
+
+func (x *GetTxCostReportResp_MsgSuccess) GetTotalFee() string {
+	if x != nil {
+		return x.TotalFee
+	}
+	return ""
+}
+
+type SetSpendingLimitsResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *SetSpendingLimitsResp_MsgSuccess) Reset() {
+	*x = SetSpendingLimitsResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSpendingLimitsResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpendingLimitsResp_MsgSuccess) ProtoMessage() {}
+
+func (x *SetSpendingLimitsResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpendingLimitsResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*SetSpendingLimitsResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{80, 0}
+}
+
+func (x *SetSpendingLimitsResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetSpendingLimitsResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limits []*SpendingLimit `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+}
+
+func (x *GetSpendingLimitsResp_MsgSuccess) Reset() {
+	*x = GetSpendingLimitsResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpendingLimitsResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingLimitsResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetSpendingLimitsResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingLimitsResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetSpendingLimitsResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{82, 0}
+}
+
+func (x *GetSpendingLimitsResp_MsgSuccess) GetLimits() []*SpendingLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type CreateInvoiceResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *CreateInvoiceResp_MsgSuccess) Reset() {
+	*x = CreateInvoiceResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInvoiceResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceResp_MsgSuccess) ProtoMessage() {}
+
+func (x *CreateInvoiceResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{84, 0}
+}
+
+func (x *CreateInvoiceResp_MsgSuccess) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+type GetInvoiceResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *GetInvoiceResp_MsgSuccess) Reset() {
+	*x = GetInvoiceResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvoiceResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetInvoiceResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetInvoiceResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{86, 0}
+}
+
+func (x *GetInvoiceResp_MsgSuccess) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+type GetInvoicesResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+}
+
+func (x *GetInvoicesResp_MsgSuccess) Reset() {
+	*x = GetInvoicesResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvoicesResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoicesResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetInvoicesResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoicesResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetInvoicesResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{88, 0}
+}
+
+func (x *GetInvoicesResp_MsgSuccess) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+type CreateScheduledPaymentResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledPayment *ScheduledPayment `protobuf:"bytes,1,opt,name=scheduledPayment,proto3" json:"scheduledPayment,omitempty"`
+}
+
+func (x *CreateScheduledPaymentResp_MsgSuccess) Reset() {
+	*x = CreateScheduledPaymentResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateScheduledPaymentResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduledPaymentResp_MsgSuccess) ProtoMessage() {}
+
+func (x *CreateScheduledPaymentResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduledPaymentResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*CreateScheduledPaymentResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{93, 0}
+}
+
+func (x *CreateScheduledPaymentResp_MsgSuccess) GetScheduledPayment() *ScheduledPayment {
+	if x != nil {
+		return x.ScheduledPayment
+	}
+	return nil
+}
+
+type GetScheduledPaymentsResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledPayments []*ScheduledPayment `protobuf:"bytes,1,rep,name=scheduledPayments,proto3" json:"scheduledPayments,omitempty"`
+}
+
+func (x *GetScheduledPaymentsResp_MsgSuccess) Reset() {
+	*x = GetScheduledPaymentsResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledPaymentsResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledPaymentsResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetScheduledPaymentsResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledPaymentsResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetScheduledPaymentsResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{95, 0}
+}
+
+func (x *GetScheduledPaymentsResp_MsgSuccess) GetScheduledPayments() []*ScheduledPayment {
+	if x != nil {
+		return x.ScheduledPayments
+	}
+	return nil
+}
+
+type CancelScheduledPaymentResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledPayment *ScheduledPayment `protobuf:"bytes,1,opt,name=scheduledPayment,proto3" json:"scheduledPayment,omitempty"`
+}
+
+func (x *CancelScheduledPaymentResp_MsgSuccess) Reset() {
+	*x = CancelScheduledPaymentResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledPaymentResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPaymentResp_MsgSuccess) ProtoMessage() {}
+
+func (x *CancelScheduledPaymentResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPaymentResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPaymentResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{97, 0}
+}
+
+func (x *CancelScheduledPaymentResp_MsgSuccess) GetScheduledPayment() *ScheduledPayment {
+	if x != nil {
+		return x.ScheduledPayment
+	}
+	return nil
+}
+
+type PayPeerResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parts []*PayPeerPart `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+}
+
+func (x *PayPeerResp_MsgSuccess) Reset() {
+	*x = PayPeerResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayPeerResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayPeerResp_MsgSuccess) ProtoMessage() {}
+
+func (x *PayPeerResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayPeerResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*PayPeerResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{100, 0}
+}
+
+func (x *PayPeerResp_MsgSuccess) GetParts() []*PayPeerPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+type SendPayChUpdateResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=updatedPayChInfo,proto3" json:"updatedPayChInfo,omitempty"`
+}
+
+func (x *SendPayChUpdateResp_MsgSuccess) Reset() {
+	*x = SendPayChUpdateResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendPayChUpdateResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPayChUpdateResp_MsgSuccess) ProtoMessage() {}
+
+func (x *SendPayChUpdateResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPayChUpdateResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*SendPayChUpdateResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{102, 0}
+}
+
+func (x *SendPayChUpdateResp_MsgSuccess) GetUpdatedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.UpdatedPayChInfo
+	}
+	return nil
+}
+
+type PayInvoiceResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=updatedPayChInfo,proto3" json:"updatedPayChInfo,omitempty"`
+}
+
+func (x *PayInvoiceResp_MsgSuccess) Reset() {
+	*x = PayInvoiceResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayInvoiceResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayInvoiceResp_MsgSuccess) ProtoMessage() {}
+
+func (x *PayInvoiceResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayInvoiceResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*PayInvoiceResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{104, 0}
+}
+
+func (x *PayInvoiceResp_MsgSuccess) GetUpdatedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.UpdatedPayChInfo
+	}
+	return nil
+}
+
+type ProposeSwapResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=updatedPayChInfo,proto3" json:"updatedPayChInfo,omitempty"`
+	Quote            *SwapQuote `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (x *ProposeSwapResp_MsgSuccess) Reset() {
+	*x = ProposeSwapResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposeSwapResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeSwapResp_MsgSuccess) ProtoMessage() {}
+
+func (x *ProposeSwapResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeSwapResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*ProposeSwapResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{106, 0}
+}
+
+func (x *ProposeSwapResp_MsgSuccess) GetUpdatedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.UpdatedPayChInfo
+	}
+	return nil
+}
+
+func (x *ProposeSwapResp_MsgSuccess) GetQuote() *SwapQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type SubPayChUpdatesResp_Notify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdateID          string                                  `protobuf:"bytes,1,opt,name=updateID,proto3" json:"updateID,omitempty"`
+	ProposedPayChInfo *PayChInfo                              `protobuf:"bytes,2,opt,name=proposedPayChInfo,proto3" json:"proposedPayChInfo,omitempty"`
+	Type              SubPayChUpdatesResp_Notify_ChUpdateType `protobuf:"varint,3,opt,name=Type,proto3,enum=pb.SubPayChUpdatesResp_Notify_ChUpdateType" json:"Type,omitempty"`
+	Expiry            int64                                   `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Error             *MsgError                               `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Info              string                                  `protobuf:"bytes,6,opt,name=info,proto3" json:"info,omitempty"`
+	InvoiceID         string                                  `protobuf:"bytes,7,opt,name=invoiceID,proto3" json:"invoiceID,omitempty"`
+	Meta              *PayChUpdateMeta                        `protobuf:"bytes,8,opt,name=meta,proto3" json:"meta,omitempty"`
+	Swap              *SwapQuote                              `protobuf:"bytes,9,opt,name=swap,proto3" json:"swap,omitempty"`
+}
+
+func (x *SubPayChUpdatesResp_Notify) Reset() {
+	*x = SubPayChUpdatesResp_Notify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubPayChUpdatesResp_Notify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubPayChUpdatesResp_Notify) ProtoMessage() {}
+
+func (x *SubPayChUpdatesResp_Notify) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubPayChUpdatesResp_Notify.ProtoReflect.Descriptor instead.
+func (*SubPayChUpdatesResp_Notify) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{108, 0}
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetUpdateID() string {
+	if x != nil {
+		return x.UpdateID
+	}
+	return ""
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetProposedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.ProposedPayChInfo
+	}
+	return nil
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetType() SubPayChUpdatesResp_Notify_ChUpdateType {
+	if x != nil {
+		return x.Type
+	}
+	return SubPayChUpdatesResp_Notify_open
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetError() *MsgError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetInvoiceID() string {
+	if x != nil {
+		return x.InvoiceID
+	}
+	return ""
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetMeta() *PayChUpdateMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SubPayChUpdatesResp_Notify) GetSwap() *SwapQuote {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+type UnsubPayChUpdatesResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UnsubPayChUpdatesResp_MsgSuccess) Reset() {
+	*x = UnsubPayChUpdatesResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPayChUpdatesResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPayChUpdatesResp_MsgSuccess) ProtoMessage() {}
+
+func (x *UnsubPayChUpdatesResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPayChUpdatesResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*UnsubPayChUpdatesResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{110, 0}
+}
+
+func (x *UnsubPayChUpdatesResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RespondPayChUpdateResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=updatedPayChInfo,proto3" json:"updatedPayChInfo,omitempty"`
+}
+
+func (x *RespondPayChUpdateResp_MsgSuccess) Reset() {
+	*x = RespondPayChUpdateResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondPayChUpdateResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondPayChUpdateResp_MsgSuccess) ProtoMessage() {}
+
+func (x *RespondPayChUpdateResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondPayChUpdateResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*RespondPayChUpdateResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{112, 0}
+}
+
+func (x *RespondPayChUpdateResp_MsgSuccess) GetUpdatedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.UpdatedPayChInfo
+	}
+	return nil
+}
+
+type GetPayChInfoResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=payChInfo,proto3" json:"payChInfo,omitempty"`
+}
+
+func (x *GetPayChInfoResp_MsgSuccess) Reset() {
+	*x = GetPayChInfoResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayChInfoResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayChInfoResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetPayChInfoResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayChInfoResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetPayChInfoResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{114, 0}
+}
+
+func (x *GetPayChInfoResp_MsgSuccess) GetPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.PayChInfo
+	}
+	return nil
+}
+
+type GetPayChHistoryResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*PayChUpdateRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *GetPayChHistoryResp_MsgSuccess) Reset() {
+	*x = GetPayChHistoryResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayChHistoryResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayChHistoryResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetPayChHistoryResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayChHistoryResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetPayChHistoryResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{116, 0}
+}
+
+func (x *GetPayChHistoryResp_MsgSuccess) GetRecords() []*PayChUpdateRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type ExportPayChReceiptResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipt *ChReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (x *ExportPayChReceiptResp_MsgSuccess) Reset() {
+	*x = ExportPayChReceiptResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPayChReceiptResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPayChReceiptResp_MsgSuccess) ProtoMessage() {}
+
+func (x *ExportPayChReceiptResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPayChReceiptResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*ExportPayChReceiptResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{119, 0}
+}
+
+func (x *ExportPayChReceiptResp_MsgSuccess) GetReceipt() *ChReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type StartPayStreamResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *PayStreamStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *StartPayStreamResp_MsgSuccess) Reset() {
+	*x = StartPayStreamResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPayStreamResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPayStreamResp_MsgSuccess) ProtoMessage() {}
+
+func (x *StartPayStreamResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPayStreamResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*StartPayStreamResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{122, 0}
+}
+
+func (x *StartPayStreamResp_MsgSuccess) GetStatus() *PayStreamStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PausePayStreamResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *PayStreamStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *PausePayStreamResp_MsgSuccess) Reset() {
+	*x = PausePayStreamResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PausePayStreamResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausePayStreamResp_MsgSuccess) ProtoMessage() {}
+
+func (x *PausePayStreamResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PausePayStreamResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*PausePayStreamResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{124, 0}
+}
+
+func (x *PausePayStreamResp_MsgSuccess) GetStatus() *PayStreamStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ResumePayStreamResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *PayStreamStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ResumePayStreamResp_MsgSuccess) Reset() {
+	*x = ResumePayStreamResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumePayStreamResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumePayStreamResp_MsgSuccess) ProtoMessage() {}
+
+func (x *ResumePayStreamResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumePayStreamResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*ResumePayStreamResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{126, 0}
+}
+
+func (x *ResumePayStreamResp_MsgSuccess) GetStatus() *PayStreamStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type StopPayStreamResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *PayStreamStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *StopPayStreamResp_MsgSuccess) Reset() {
+	*x = StopPayStreamResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPayStreamResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPayStreamResp_MsgSuccess) ProtoMessage() {}
+
+func (x *StopPayStreamResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPayStreamResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*StopPayStreamResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{128, 0}
+}
+
+func (x *StopPayStreamResp_MsgSuccess) GetStatus() *PayStreamStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetPayStreamsResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statuses []*PayStreamStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (x *GetPayStreamsResp_MsgSuccess) Reset() {
+	*x = GetPayStreamsResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayStreamsResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayStreamsResp_MsgSuccess) ProtoMessage() {}
+
+func (x *GetPayStreamsResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayStreamsResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*GetPayStreamsResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{130, 0}
+}
+
+func (x *GetPayStreamsResp_MsgSuccess) GetStatuses() []*PayStreamStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type SubPayStreamsResp_Notify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *PayStreamStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SubPayStreamsResp_Notify) Reset() {
+	*x = SubPayStreamsResp_Notify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubPayStreamsResp_Notify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubPayStreamsResp_Notify) ProtoMessage() {}
+
+func (x *SubPayStreamsResp_Notify) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubPayStreamsResp_Notify.ProtoReflect.Descriptor instead.
+func (*SubPayStreamsResp_Notify) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{132, 0}
+}
+
+func (x *SubPayStreamsResp_Notify) GetStatus() *PayStreamStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type UnsubPayStreamsResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UnsubPayStreamsResp_MsgSuccess) Reset() {
+	*x = UnsubPayStreamsResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubPayStreamsResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubPayStreamsResp_MsgSuccess) ProtoMessage() {}
+
+func (x *UnsubPayStreamsResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubPayStreamsResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*UnsubPayStreamsResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{134, 0}
+}
+
+func (x *UnsubPayStreamsResp_MsgSuccess) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ClosePayChResp_MsgSuccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClosedPayChInfo *PayChInfo `protobuf:"bytes,1,opt,name=closedPayChInfo,proto3" json:"closedPayChInfo,omitempty"`
+}
+
+func (x *ClosePayChResp_MsgSuccess) Reset() {
+	*x = ClosePayChResp_MsgSuccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePayChResp_MsgSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePayChResp_MsgSuccess) ProtoMessage() {}
+
+func (x *ClosePayChResp_MsgSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePayChResp_MsgSuccess.ProtoReflect.Descriptor instead.
+func (*ClosePayChResp_MsgSuccess) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{136, 0}
+}
+
+func (x *ClosePayChResp_MsgSuccess) GetClosedPayChInfo() *PayChInfo {
+	if x != nil {
+		return x.ClosedPayChInfo
+	}
+	return nil
+}
+
+var File_api_proto protoreflect.FileDescriptor
+
+var file_api_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22,
+	0xe2, 0x01, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x66, 0x66, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3c, 0x0a, 0x10, 0x61, 0x6c, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x10, 0x61, 0x6c, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x22, 0x4c, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x7d, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61,
+	0x72, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x62, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x62,
+	0x61, 0x6c, 0x52, 0x04, 0x62, 0x61, 0x6c, 0x73, 0x1a, 0x17, 0x0a, 0x03, 0x62, 0x61, 0x6c, 0x12,
+	0x10, 0x0a, 0x03, 0x62, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x62, 0x61,
+	0x6c, 0x22, 0x7c, 0x0a, 0x0b, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x69, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x62, 0x61,
+	0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x62, 0x61, 0x6c, 0x52, 0x04, 0x62, 0x61, 0x6c, 0x73, 0x22,
+	0xa9, 0x01, 0x0a, 0x09, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49,
+	0x44, 0x12, 0x25, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x46, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x78, 0x46, 0x65, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x66, 0x69, 0x61, 0x74,
+	0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x61, 0x74, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b,
+	0x66, 0x69, 0x61, 0x74, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x53, 0x0a, 0x07, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x61, 0x79, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xbf, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61,
+	0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xbf, 0x01, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x67, 0x69, 0x76, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x69, 0x76, 0x65, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x69, 0x76, 0x65, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61, 0x6b, 0x65, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x6b, 0x65,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x6b, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61,
+	0x6b, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x22, 0xdb, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x27, 0x0a, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79,
+	0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65,
+	0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x8c, 0x0a, 0x0a, 0x08, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x2d, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x21,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x60, 0x0a, 0x1a, 0x45,
+	0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x48,
+	0x00, 0x52, 0x1a, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x4b, 0x0a,
+	0x13, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x13, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x14, 0x45, 0x72,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46, 0x75, 0x6e, 0x64,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46, 0x75, 0x6e, 0x64,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x14, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65,
+	0x72, 0x4e, 0x6f, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x63, 0x0a, 0x1b, 0x45, 0x72,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74,
+	0x48, 0x00, 0x52, 0x1b, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12,
+	0x57, 0x0a, 0x17, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x00, 0x52,
+	0x17, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x51, 0x0a, 0x15, 0x45, 0x72, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x48, 0x00, 0x52, 0x15, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x16, 0x45,
+	0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41,
+	0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x16, 0x45, 0x72, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x6f, 0x0a, 0x1f, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x55, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x43, 0x68, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x65,
+	0x43, 0x6f, 0x6e, 0x64, 0x55, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x43, 0x68, 0x73, 0x48,
+	0x00, 0x52, 0x1f, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x55, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x43,
+	0x68, 0x73, 0x12, 0x4e, 0x0a, 0x14, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x14, 0x45, 0x72,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x57, 0x0a, 0x17, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73,
+	0x48, 0x00, 0x52, 0x17, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x45,
+	0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x54, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x48, 0x00, 0x52,
+	0x11, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f,
+	0x75, 0x74, 0x12, 0x5a, 0x0a, 0x18, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
+	0x6c, 0x65, 0x48, 0x00, 0x52, 0x18, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x66,
+	0x0a, 0x1c, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x1c, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x54, 0x0a, 0x1a, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x14, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50,
+	0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x55, 0x0a, 0x1b, 0x45, 0x72,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x3d, 0x0a, 0x17, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x3b, 0x0a, 0x15, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x64, 0x0a,
+	0x16, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41,
+	0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x1f, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x55, 0x6e, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x43, 0x68, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x63, 0x68, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x03, 0x63, 0x68, 0x73, 0x22, 0x40, 0x0a, 0x14, 0x45, 0x72, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x55, 0x0a, 0x0f, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x5a, 0x0a, 0x17, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x5d, 0x0a, 0x11,
+	0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x36, 0x0a, 0x18, 0x45,
+	0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x52, 0x65,
+	0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x55, 0x52, 0x4c, 0x22, 0xba, 0x01, 0x0a, 0x1c, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x63, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x22, 0xb9, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x6a,
+	0x75, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x45, 0x54, 0x48, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x45, 0x54, 0x48, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x64, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x0e,
+	0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x22, 0xe2,
+	0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x40, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x5b, 0x0a, 0x0a, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x43, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x43, 0x68, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x09, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x22, 0x1e,
+	0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x51,
+	0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x22, 0xb5, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x6d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x24, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x42, 0x0a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x09, 0x0a, 0x07, 0x48, 0x65, 0x6c,
+	0x70, 0x52, 0x65, 0x71, 0x22, 0x1e, 0x0a, 0x08, 0x48, 0x65, 0x6c, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x70, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x70, 0x69, 0x73, 0x22, 0x50, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06,
+	0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22, 0xa9, 0x01, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x50, 0x65,
+	0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26,
+	0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x42, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x30,
+	0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x06,
+	0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x10,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x0a,
+	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x20, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x22, 0x6a, 0x0a, 0x14, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x59, 0x0a,
+	0x13, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf3, 0x02, 0x0a, 0x11, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42,
+	0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0xe7, 0x01, 0x0a, 0x0a, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x36, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72,
+	0x49, 0x44, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52,
+	0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc2,
+	0x01, 0x0a, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x66,
+	0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x74, 0x74, 0x4d, 0x69, 0x63, 0x72,
+	0x6f, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x74, 0x74, 0x4d, 0x69, 0x63,
+	0x72, 0x6f, 0x73, 0x22, 0x52, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x65, 0x72,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a,
+	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x40, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x70, 0x65, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x50,
+	0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a,
+	0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x38, 0x0a, 0x06, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x32, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xb5, 0x01, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50,
+	0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a,
+	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01,
+	0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x75,
+	0x72, 0x53, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x22, 0xc8, 0x01,
+	0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x3e, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79,
+	0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x79,
+	0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x6f, 0x70, 0x65,
+	0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xce, 0x01, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x42, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79,
+	0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x43, 0x0a, 0x0a, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x14, 0x53,
+	0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x22, 0xa9, 0x02, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x06, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52,
+	0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0xa1, 0x01,
+	0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a,
+	0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x44, 0x75, 0x72, 0x53, 0x65, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a,
+	0x16, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xbd, 0x01, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x48, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52,
+	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x1a, 0x45, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e,
+	0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0xcc,
+	0x01, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x43, 0x0a, 0x0a,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x0e, 0x6f, 0x70,
+	0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a,
+	0x13, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x52, 0x43, 0x32,
+	0x30, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72,
+	0x22, 0xbb, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x45, 0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x6d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x52,
+	0x43, 0x32, 0x30, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x2a, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xa9, 0x01, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x42, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f,
+	0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x1e, 0x0a, 0x0a,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x61, 0x6c, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xaf, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x6e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x1a, 0x20, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x78, 0x49, 0x44, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x4b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22,
+	0xb3, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x2a, 0x0a,
+	0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x10, 0x53,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x41, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x20, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x44, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x0a, 0x54, 0x78, 0x43, 0x6f, 0x73, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x78, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65,
+	0x22, 0x32, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x78, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x22, 0xe1, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x78, 0x43, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0a,
+	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x43, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x52, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x43,
+	0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x73, 0x74, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x79,
+	0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x61, 0x79, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x22, 0x8d, 0x02, 0x0a, 0x07, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62,
+	0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x0d, 0x53, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x48,
+	0x6f, 0x75, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x65,
+	0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x44,
+	0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72,
+	0x44, 0x61, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x46, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x43, 0x68,
+	0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x61, 0x74, 0x22, 0x5f, 0x0a, 0x14, 0x53,
+	0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x29, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xb9, 0x01, 0x0a,
+	0x15, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xca,
+	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x37, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x10,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x53, 0x65, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x53, 0x65, 0x63, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x0a,
+	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x33, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x49, 0x44, 0x22, 0xb8, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x33,
+	0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x07,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22,
+	0xbc, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x35, 0x0a, 0x0a, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x08, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62,
+	0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb4,
+	0x01, 0x0a, 0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x75, 0x65, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x75,
+	0x65, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xf3, 0x01, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x2b,
+	0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x22, 0x6d, 0x0a, 0x19, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x1a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0a, 0x6d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x4e, 0x0a, 0x0a,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x10, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x22, 0xe9, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49,
+	0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a,
+	0x50, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a,
+	0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x11,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a,
+	0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0xeb, 0x01, 0x0a, 0x1a, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x4e, 0x0a, 0x0a, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x10, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x79, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x68, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x63, 0x68, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x22, 0x60, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x50, 0x65, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x10, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xeb, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x61, 0x79, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x50, 0x65, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x74, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x61, 0x72,
+	0x74, 0x73, 0x1a, 0x33, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x25, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x50, 0x65, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74,
+	0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x27, 0x0a, 0x08,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0xd6,
+	0x01, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00,
+	0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62,
+	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x1a, 0x47, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x39, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcc, 0x01,
+	0x0a, 0x0e, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x3f, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x47, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xea, 0x01, 0x0a,
+	0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x67, 0x69, 0x76, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x69, 0x76, 0x65, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x69, 0x76, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61, 0x6b, 0x65, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x6b,
+	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x6b,
+	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x61, 0x6b, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x53, 0x65, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x53, 0x65, 0x63, 0x73, 0x22, 0xf3, 0x01, 0x0a, 0x0f, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a,
+	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x77,
+	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x6c, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61,
+	0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23,
+	0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x46, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x70, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0x91, 0x04, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x38, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48,
+	0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a,
+	0x8d, 0x03, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x3b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x2e, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62,
+	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49,
+	0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x49, 0x44, 0x12, 0x27, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x04, 0x73,
+	0x77, 0x61, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x22, 0x2f,
+	0x0a, 0x0c, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08,
+	0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x10, 0x02, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x14, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0xb9, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x46, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61,
+	0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x26, 0x0a,
+	0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x7d, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x22, 0xdc, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x6d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x47, 0x0a, 0x0a, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x43, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x68, 0x49, 0x44, 0x22, 0xc2, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0a, 0x6d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00,
+	0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62,
+	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x1a, 0x39, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x2b, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x09, 0x70, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49,
+	0x44, 0x22, 0xcc, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0a, 0x6d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x3d, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xc6, 0x01, 0x0a, 0x09, 0x43, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x07,
+	0x62, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x73, 0x22, 0x63, 0x0a, 0x15, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x68, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xca,
+	0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x6d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x35, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83, 0x03, 0x0a, 0x0f,
+	0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x61, 0x70, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xcd, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x79, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x61, 0x70, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x61, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xc6, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x79, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x43, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48,
+	0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x1a, 0x39, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x11, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0xc6, 0x01, 0x0a, 0x12, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x43, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x50,
+	0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x39, 0x0a, 0x0a, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x4e, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x61, 0x79, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x22, 0xc8, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x61, 0x79,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0a, 0x6d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x39, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a,
+	0x10, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0xc4, 0x01, 0x0a, 0x11,
+	0x53, 0x74, 0x6f, 0x70, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x42, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50,
+	0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x39, 0x0a, 0x0a, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x30, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x22, 0xc8, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x0a, 0x6d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x1a, 0x3d, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x30, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x22, 0xb4, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62,
+	0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12,
+	0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x35, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12,
+	0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xb5, 0x01, 0x0a,
+	0x13, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0a,
+	0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d,
+	0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x1a, 0x26, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79,
+	0x43, 0x68, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x68, 0x49, 0x44, 0x22, 0xca, 0x01, 0x0a, 0x0e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0a, 0x6d, 0x73,
+	0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52,
+	0x0a, 0x6d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x1a, 0x45, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x37, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61,
+	0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x5c, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x02, 0x12,
+	0x11, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x10, 0x03, 0x2a, 0x86, 0x03, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x16, 0x0a, 0x12, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x72, 0x72, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f,
+	0x75, 0x74, 0x10, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x72, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x66, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x72, 0x72,
+	0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x10, 0x67, 0x12,
+	0x1b, 0x0a, 0x17, 0x45, 0x72, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x10, 0x68, 0x12, 0x18, 0x0a, 0x13,
+	0x45, 0x72, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f,
+	0x75, 0x6e, 0x64, 0x10, 0xc9, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x72, 0x72, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0xca, 0x01, 0x12, 0x17,
+	0x0a, 0x12, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x10, 0xcb, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x72, 0x72, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x10, 0xcc, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x72, 0x72, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0xcd, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x72,
+	0x72, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x73, 0x10, 0xce, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x45, 0x72, 0x72, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x10, 0xcf, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x54, 0x78, 0x54, 0x69, 0x6d, 0x65,
+	0x64, 0x4f, 0x75, 0x74, 0x10, 0xad, 0x02, 0x12, 0x19, 0x0a, 0x14, 0x45, 0x72, 0x72, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x10,
+	0xae, 0x02, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x72, 0x72, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x10, 0x91, 0x03, 0x32, 0xec, 0x19, 0x0a, 0x0b,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x41, 0x50, 0x49, 0x12, 0x32, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x38, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x23, 0x0a, 0x04, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x47,
+	0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x23, 0x0a, 0x04, 0x48, 0x65, 0x6c, 0x70, 0x12,
+	0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x65, 0x6c, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x70,
+	0x62, 0x2e, 0x48, 0x65, 0x6c, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09,
+	0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x32, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x10, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a,
+	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x65,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0f, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50,
+	0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x65, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09,
+	0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x3e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61,
+	0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x19, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x50,
+	0x0a, 0x13, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x53, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x45, 0x52, 0x43, 0x32, 0x30, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x71, 0x1a,
+	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x45, 0x52, 0x43, 0x32, 0x30, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x12, 0x14, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x42, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x6e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x3b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3b,
+	0x0a, 0x0c, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x13,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x54, 0x78, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x78, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x4a, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4a, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x38, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x16, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x16, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x50, 0x65, 0x65, 0x72,
+	0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0a, 0x50, 0x61, 0x79,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x38, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x53, 0x75,
+	0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x70, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61,
+	0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x4a, 0x0a, 0x11, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73,
+	0x75, 0x62, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4d,
+	0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x50, 0x61, 0x79, 0x43, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61,
+	0x79, 0x43, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x4d, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79,
+	0x43, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x41, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x79, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x0e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x50, 0x61, 0x79, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x50,
+	0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50,
+	0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x61, 0x79, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x53,
+	0x74, 0x6f, 0x70, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x14, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x61, 0x79, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x14, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x53,
+	0x75, 0x62, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x14, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x61, 0x79, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44, 0x0a,
+	0x0f, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
+	0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x50, 0x61, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x61, 0x79, 0x43,
+	0x68, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50,
+	0x61, 0x79, 0x43, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_proto_rawDescOnce sync.Once
+	file_api_proto_rawDescData = file_api_proto_rawDesc
+)
+
+func file_api_proto_rawDescGZIP() []byte {
+	file_api_proto_rawDescOnce.Do(func() {
+		file_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_proto_rawDescData)
+	})
+	return file_api_proto_rawDescData
+}
+
+var file_api_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 186)
+var file_api_proto_goTypes = []interface{}{
+	(ErrorCategory)(0), // 0: pb.ErrorCategory
+	(ErrorCode)(0),     // 1: pb.ErrorCode
+	(SubPayChUpdatesResp_Notify_ChUpdateType)(0), // 2: pb.SubPayChUpdatesResp.Notify.ChUpdateType
+	(*PeerID)(nil),                                // 3: pb.PeerID
+	(*CommEndpoint)(nil),                          // 4: pb.CommEndpoint
+	(*BalInfo)(nil),                               // 5: pb.BalInfo
+	(*FiatBalInfo)(nil),                           // 6: pb.FiatBalInfo
+	(*PayChInfo)(nil),                             // 7: pb.PayChInfo
+	(*Payment)(nil),                               // 8: pb.Payment
+	(*PayChUpdateMeta)(nil),                       // 9: pb.PayChUpdateMeta
+	(*SwapQuote)(nil),                             // 10: pb.SwapQuote
+	(*PayChUpdateRecord)(nil),                     // 11: pb.PayChUpdateRecord
+	(*MsgError)(nil),                              // 12: pb.MsgError
+	(*ErrInfoPeerRequestTimedOut)(nil),            // 13: pb.ErrInfoPeerRequestTimedOut
+	(*ErrInfoPeerRejected)(nil),                   // 14: pb.ErrInfoPeerRejected
+	(*ErrInfoPeerNotFunded)(nil),                  // 15: pb.ErrInfoPeerNotFunded
+	(*ErrInfoUserResponseTimedOut)(nil),           // 16: pb.ErrInfoUserResponseTimedOut
+	(*ErrInfoResourceNotFound)(nil),               // 17: pb.ErrInfoResourceNotFound
+	(*ErrInfoResourceExists)(nil),                 // 18: pb.ErrInfoResourceExists
+	(*ErrInfoInvalidArgument)(nil),                // 19: pb.ErrInfoInvalidArgument
+	(*ErrInfoFailedPreCondUnclosedChs)(nil),       // 20: pb.ErrInfoFailedPreCondUnclosedChs
+	(*ErrInfoInvalidConfig)(nil),                  // 21: pb.ErrInfoInvalidConfig
+	(*ContractErrInfo)(nil),                       // 22: pb.ContractErrInfo
+	(*ErrInfoInvalidContracts)(nil),               // 23: pb.ErrInfoInvalidContracts
+	(*ErrInfoTxTimedOut)(nil),                     // 24: pb.ErrInfoTxTimedOut
+	(*ErrInfoChainNotReachable)(nil),              // 25: pb.ErrInfoChainNotReachable
+	(*ErrInfoSpendingLimitExceeded)(nil),          // 26: pb.ErrInfoSpendingLimitExceeded
+	(*GetConfigReq)(nil),                          // 27: pb.GetConfigReq
+	(*GetConfigResp)(nil),                         // 28: pb.GetConfigResp
+	(*OpenSessionReq)(nil),                        // 29: pb.OpenSessionReq
+	(*OpenSessionResp)(nil),                       // 30: pb.OpenSessionResp
+	(*TimeReq)(nil),                               // 31: pb.TimeReq
+	(*TimeResp)(nil),                              // 32: pb.TimeResp
+	(*RegisterCurrencyReq)(nil),                   // 33: pb.RegisterCurrencyReq
+	(*RegisterCurrencyResp)(nil),                  // 34: pb.RegisterCurrencyResp
+	(*HelpReq)(nil),                               // 35: pb.HelpReq
+	(*HelpResp)(nil),                              // 36: pb.HelpResp
+	(*AddPeerIDReq)(nil),                          // 37: pb.AddPeerIDReq
+	(*AddPeerIDResp)(nil),                         // 38: pb.AddPeerIDResp
+	(*GetPeerIDReq)(nil),                          // 39: pb.GetPeerIDReq
+	(*GetPeerIDResp)(nil),                         // 40: pb.GetPeerIDResp
+	(*ExportPeerIDsReq)(nil),                      // 41: pb.ExportPeerIDsReq
+	(*ExportPeerIDsResp)(nil),                     // 42: pb.ExportPeerIDsResp
+	(*ImportPeerIDsReq)(nil),                      // 43: pb.ImportPeerIDsReq
+	(*PeerIDImportConflict)(nil),                  // 44: pb.PeerIDImportConflict
+	(*PeerIDImportInvalid)(nil),                   // 45: pb.PeerIDImportInvalid
+	(*ImportPeerIDsResp)(nil),                     // 46: pb.ImportPeerIDsResp
+	(*PeerStatus)(nil),                            // 47: pb.PeerStatus
+	(*GetPeerStatusReq)(nil),                      // 48: pb.GetPeerStatusReq
+	(*GetPeerStatusResp)(nil),                     // 49: pb.GetPeerStatusResp
+	(*SubPeerStatusReq)(nil),                      // 50: pb.SubPeerStatusReq
+	(*SubPeerStatusResp)(nil),                     // 51: pb.SubPeerStatusResp
+	(*UnsubPeerStatusReq)(nil),                    // 52: pb.UnsubPeerStatusReq
+	(*UnsubPeerStatusResp)(nil),                   // 53: pb.UnsubPeerStatusResp
+	(*OpenPayChReq)(nil),                          // 54: pb.OpenPayChReq
+	(*OpenPayChResp)(nil),                         // 55: pb.OpenPayChResp
+	(*GetPayChsInfoReq)(nil),                      // 56: pb.GetPayChsInfoReq
+	(*GetPayChsInfoResp)(nil),                     // 57: pb.GetPayChsInfoResp
+	(*SubPayChProposalsReq)(nil),                  // 58: pb.SubPayChProposalsReq
+	(*SubPayChProposalsResp)(nil),                 // 59: pb.SubPayChProposalsResp
+	(*UnsubPayChProposalsReq)(nil),                // 60: pb.UnsubPayChProposalsReq
+	(*UnsubPayChProposalsResp)(nil),               // 61: pb.UnsubPayChProposalsResp
+	(*RespondPayChProposalReq)(nil),               // 62: pb.RespondPayChProposalReq
+	(*RespondPayChProposalResp)(nil),              // 63: pb.RespondPayChProposalResp
+	(*CloseSessionReq)(nil),                       // 64: pb.CloseSessionReq
+	(*CloseSessionResp)(nil),                      // 65: pb.CloseSessionResp
+	(*DeployAssetERC20Req)(nil),                   // 66: pb.DeployAssetERC20Req
+	(*DeployAssetERC20Resp)(nil),                  // 67: pb.DeployAssetERC20Resp
+	(*GetOnChainBalReq)(nil),                      // 68: pb.GetOnChainBalReq
+	(*GetOnChainBalResp)(nil),                     // 69: pb.GetOnChainBalResp
+	(*TransferOnChainReq)(nil),                    // 70: pb.TransferOnChainReq
+	(*TransferOnChainResp)(nil),                   // 71: pb.TransferOnChainResp
+	(*GetAllowanceReq)(nil),                       // 72: pb.GetAllowanceReq
+	(*GetAllowanceResp)(nil),                      // 73: pb.GetAllowanceResp
+	(*SetAllowanceReq)(nil),                       // 74: pb.SetAllowanceReq
+	(*SetAllowanceResp)(nil),                      // 75: pb.SetAllowanceResp
+	(*TxCostInfo)(nil),                            // 76: pb.TxCostInfo
+	(*GetTxCostReportReq)(nil),                    // 77: pb.GetTxCostReportReq
+	(*GetTxCostReportResp)(nil),                   // 78: pb.GetTxCostReportResp
+	(*InvoicePayment)(nil),                        // 79: pb.InvoicePayment
+	(*Invoice)(nil),                               // 80: pb.Invoice
+	(*SpendingLimit)(nil),                         // 81: pb.SpendingLimit
+	(*SetSpendingLimitsReq)(nil),                  // 82: pb.SetSpendingLimitsReq
+	(*SetSpendingLimitsResp)(nil),                 // 83: pb.SetSpendingLimitsResp
+	(*GetSpendingLimitsReq)(nil),                  // 84: pb.GetSpendingLimitsReq
+	(*GetSpendingLimitsResp)(nil),                 // 85: pb.GetSpendingLimitsResp
+	(*CreateInvoiceReq)(nil),                      // 86: pb.CreateInvoiceReq
+	(*CreateInvoiceResp)(nil),                     // 87: pb.CreateInvoiceResp
+	(*GetInvoiceReq)(nil),                         // 88: pb.GetInvoiceReq
+	(*GetInvoiceResp)(nil),                        // 89: pb.GetInvoiceResp
+	(*GetInvoicesReq)(nil),                        // 90: pb.GetInvoicesReq
+	(*GetInvoicesResp)(nil),                       // 91: pb.GetInvoicesResp
+	(*ScheduledPaymentParams)(nil),                // 92: pb.ScheduledPaymentParams
+	(*ScheduledPaymentRun)(nil),                   // 93: pb.ScheduledPaymentRun
+	(*ScheduledPayment)(nil),                      // 94: pb.ScheduledPayment
+	(*CreateScheduledPaymentReq)(nil),             // 95: pb.CreateScheduledPaymentReq
+	(*CreateScheduledPaymentResp)(nil),            // 96: pb.CreateScheduledPaymentResp
+	(*GetScheduledPaymentsReq)(nil),               // 97: pb.GetScheduledPaymentsReq
+	(*GetScheduledPaymentsResp)(nil),              // 98: pb.GetScheduledPaymentsResp
+	(*CancelScheduledPaymentReq)(nil),             // 99: pb.CancelScheduledPaymentReq
+	(*CancelScheduledPaymentResp)(nil),            // 100: pb.CancelScheduledPaymentResp
+	(*PayPeerReq)(nil),                            // 101: pb.PayPeerReq
+	(*PayPeerPart)(nil),                           // 102: pb.PayPeerPart
+	(*PayPeerResp)(nil),                           // 103: pb.PayPeerResp
+	(*SendPayChUpdateReq)(nil),                    // 104: pb.SendPayChUpdateReq
+	(*SendPayChUpdateResp)(nil),                   // 105: pb.SendPayChUpdateResp
+	(*PayInvoiceReq)(nil),                         // 106: pb.PayInvoiceReq
+	(*PayInvoiceResp)(nil),                        // 107: pb.PayInvoiceResp
+	(*ProposeSwapReq)(nil),                        // 108: pb.ProposeSwapReq
+	(*ProposeSwapResp)(nil),                       // 109: pb.ProposeSwapResp
+	(*SubpayChUpdatesReq)(nil),                    // 110: pb.SubpayChUpdatesReq
+	(*SubPayChUpdatesResp)(nil),                   // 111: pb.SubPayChUpdatesResp
+	(*UnsubPayChUpdatesReq)(nil),                  // 112: pb.UnsubPayChUpdatesReq
+	(*UnsubPayChUpdatesResp)(nil),                 // 113: pb.UnsubPayChUpdatesResp
+	(*RespondPayChUpdateReq)(nil),                 // 114: pb.RespondPayChUpdateReq
+	(*RespondPayChUpdateResp)(nil),                // 115: pb.RespondPayChUpdateResp
+	(*GetPayChInfoReq)(nil),                       // 116: pb.GetPayChInfoReq
+	(*GetPayChInfoResp)(nil),                      // 117: pb.GetPayChInfoResp
+	(*GetPayChHistoryReq)(nil),                    // 118: pb.GetPayChHistoryReq
+	(*GetPayChHistoryResp)(nil),                   // 119: pb.GetPayChHistoryResp
+	(*ChReceipt)(nil),                             // 120: pb.ChReceipt
+	(*ExportPayChReceiptReq)(nil),                 // 121: pb.ExportPayChReceiptReq
+	(*ExportPayChReceiptResp)(nil),                // 122: pb.ExportPayChReceiptResp
+	(*PayStreamStatus)(nil),                       // 123: pb.PayStreamStatus
+	(*StartPayStreamReq)(nil),                     // 124: pb.StartPayStreamReq
+	(*StartPayStreamResp)(nil),                    // 125: pb.StartPayStreamResp
+	(*PausePayStreamReq)(nil),                     // 126: pb.PausePayStreamReq
+	(*PausePayStreamResp)(nil),                    // 127: pb.PausePayStreamResp
+	(*ResumePayStreamReq)(nil),                    // 128: pb.ResumePayStreamReq
+	(*ResumePayStreamResp)(nil),                   // 129: pb.ResumePayStreamResp
+	(*StopPayStreamReq)(nil),                      // 130: pb.StopPayStreamReq
+	(*StopPayStreamResp)(nil),                     // 131: pb.StopPayStreamResp
+	(*GetPayStreamsReq)(nil),                      // 132: pb.GetPayStreamsReq
+	(*GetPayStreamsResp)(nil),                     // 133: pb.GetPayStreamsResp
+	(*SubPayStreamsReq)(nil),                      // 134: pb.SubPayStreamsReq
+	(*SubPayStreamsResp)(nil),                     // 135: pb.SubPayStreamsResp
+	(*UnsubPayStreamsReq)(nil),                    // 136: pb.UnsubPayStreamsReq
+	(*UnsubPayStreamsResp)(nil),                   // 137: pb.UnsubPayStreamsResp
+	(*ClosePayChReq)(nil),                         // 138: pb.ClosePayChReq
+	(*ClosePayChResp)(nil),                        // 139: pb.ClosePayChResp
+	(*BalInfoBal)(nil),                            // 140: pb.BalInfo.bal
+	nil,                                           // 141: pb.PayChUpdateMeta.MetadataEntry
+	(*OpenSessionResp_MsgSuccess)(nil),            // 142: pb.OpenSessionResp.MsgSuccess
+	(*RegisterCurrencyResp_MsgSuccess)(nil),       // 143: pb.RegisterCurrencyResp.MsgSuccess
+	(*AddPeerIDResp_MsgSuccess)(nil),              // 144: pb.AddPeerIDResp.MsgSuccess
+	(*GetPeerIDResp_MsgSuccess)(nil),              // 145: pb.GetPeerIDResp.MsgSuccess
+	(*ExportPeerIDsResp_MsgSuccess)(nil),          // 146: pb.ExportPeerIDsResp.MsgSuccess
+	(*ImportPeerIDsResp_MsgSuccess)(nil),          // 147: pb.ImportPeerIDsResp.MsgSuccess
+	(*GetPeerStatusResp_MsgSuccess)(nil),          // 148: pb.GetPeerStatusResp.MsgSuccess
+	(*SubPeerStatusResp_Notify)(nil),              // 149: pb.SubPeerStatusResp.Notify
+	(*UnsubPeerStatusResp_MsgSuccess)(nil),        // 150: pb.UnsubPeerStatusResp.MsgSuccess
+	(*OpenPayChResp_MsgSuccess)(nil),              // 151: pb.OpenPayChResp.MsgSuccess
+	(*GetPayChsInfoResp_MsgSuccess)(nil),          // 152: pb.GetPayChsInfoResp.MsgSuccess
+	(*SubPayChProposalsResp_Notify)(nil),          // 153: pb.SubPayChProposalsResp.Notify
+	(*UnsubPayChProposalsResp_MsgSuccess)(nil),    // 154: pb.UnsubPayChProposalsResp.MsgSuccess
+	(*RespondPayChProposalResp_MsgSuccess)(nil),   // 155: pb.RespondPayChProposalResp.MsgSuccess
+	(*CloseSessionResp_MsgSuccess)(nil),           // 156: pb.CloseSessionResp.MsgSuccess
+	(*DeployAssetERC20Resp_MsgSuccess)(nil),       // 157: pb.DeployAssetERC20Resp.MsgSuccess
+	(*GetOnChainBalResp_MsgSuccess)(nil),          // 158: pb.GetOnChainBalResp.MsgSuccess
+	(*TransferOnChainResp_MsgSuccess)(nil),        // 159: pb.TransferOnChainResp.MsgSuccess
+	(*GetAllowanceResp_MsgSuccess)(nil),           // 160: pb.GetAllowanceResp.MsgSuccess
+	(*SetAllowanceResp_MsgSuccess)(nil),           // 161: pb.SetAllowanceResp.MsgSuccess
+	(*GetTxCostReportResp_MsgSuccess)(nil),        // 162: pb.GetTxCostReportResp.MsgSuccess
+	(*SetSpendingLimitsResp_MsgSuccess)(nil),      // 163: pb.SetSpendingLimitsResp.MsgSuccess
+	(*GetSpendingLimitsResp_MsgSuccess)(nil),      // 164: pb.GetSpendingLimitsResp.MsgSuccess
+	(*CreateInvoiceResp_MsgSuccess)(nil),          // 165: pb.CreateInvoiceResp.MsgSuccess
+	(*GetInvoiceResp_MsgSuccess)(nil),             // 166: pb.GetInvoiceResp.MsgSuccess
+	(*GetInvoicesResp_MsgSuccess)(nil),            // 167: pb.GetInvoicesResp.MsgSuccess
+	(*CreateScheduledPaymentResp_MsgSuccess)(nil), // 168: pb.CreateScheduledPaymentResp.MsgSuccess
+	(*GetScheduledPaymentsResp_MsgSuccess)(nil),   // 169: pb.GetScheduledPaymentsResp.MsgSuccess
+	(*CancelScheduledPaymentResp_MsgSuccess)(nil), // 170: pb.CancelScheduledPaymentResp.MsgSuccess
+	(*PayPeerResp_MsgSuccess)(nil),                // 171: pb.PayPeerResp.MsgSuccess
+	(*SendPayChUpdateResp_MsgSuccess)(nil),        // 172: pb.SendPayChUpdateResp.MsgSuccess
+	(*PayInvoiceResp_MsgSuccess)(nil),             // 173: pb.PayInvoiceResp.MsgSuccess
+	(*ProposeSwapResp_MsgSuccess)(nil),            // 174: pb.ProposeSwapResp.MsgSuccess
+	(*SubPayChUpdatesResp_Notify)(nil),            // 175: pb.SubPayChUpdatesResp.Notify
+	(*UnsubPayChUpdatesResp_MsgSuccess)(nil),      // 176: pb.UnsubPayChUpdatesResp.MsgSuccess
+	(*RespondPayChUpdateResp_MsgSuccess)(nil),     // 177: pb.RespondPayChUpdateResp.MsgSuccess
+	(*GetPayChInfoResp_MsgSuccess)(nil),           // 178: pb.GetPayChInfoResp.MsgSuccess
+	(*GetPayChHistoryResp_MsgSuccess)(nil),        // 179: pb.GetPayChHistoryResp.MsgSuccess
+	(*ExportPayChReceiptResp_MsgSuccess)(nil),     // 180: pb.ExportPayChReceiptResp.MsgSuccess
+	(*StartPayStreamResp_MsgSuccess)(nil),         // 181: pb.StartPayStreamResp.MsgSuccess
+	(*PausePayStreamResp_MsgSuccess)(nil),         // 182: pb.PausePayStreamResp.MsgSuccess
+	(*ResumePayStreamResp_MsgSuccess)(nil),        // 183: pb.ResumePayStreamResp.MsgSuccess
+	(*StopPayStreamResp_MsgSuccess)(nil),          // 184: pb.StopPayStreamResp.MsgSuccess
+	(*GetPayStreamsResp_MsgSuccess)(nil),          // 185: pb.GetPayStreamsResp.MsgSuccess
+	(*SubPayStreamsResp_Notify)(nil),              // 186: pb.SubPayStreamsResp.Notify
+	(*UnsubPayStreamsResp_MsgSuccess)(nil),        // 187: pb.UnsubPayStreamsResp.MsgSuccess
+	(*ClosePayChResp_MsgSuccess)(nil),             // 188: pb.ClosePayChResp.MsgSuccess
+}
+var file_api_proto_depIdxs = []int32{
+	4,   // 0: pb.PeerID.altCommEndpoints:type_name -> pb.CommEndpoint
+	140, // 1: pb.BalInfo.bals:type_name -> pb.BalInfo.bal
+	140, // 2: pb.FiatBalInfo.bals:type_name -> pb.BalInfo.bal
+	5,   // 3: pb.PayChInfo.balInfo:type_name -> pb.BalInfo
+	6,   // 4: pb.PayChInfo.fiatBalInfo:type_name -> pb.FiatBalInfo
+	141, // 5: pb.PayChUpdateMeta.metadata:type_name -> pb.PayChUpdateMeta.MetadataEntry
+	5,   // 6: pb.PayChUpdateRecord.balInfo:type_name -> pb.BalInfo
+	9,   // 7: pb.PayChUpdateRecord.meta:type_name -> pb.PayChUpdateMeta
+	0,   // 8: pb.MsgError.category:type_name -> pb.ErrorCategory
+	1,   // 9: pb.MsgError.code:type_name -> pb.ErrorCode
+	13,  // 10: pb.MsgError.ErrInfoPeerRequestTimedOut:type_name -> pb.ErrInfoPeerRequestTimedOut
+	14,  // 11: pb.MsgError.ErrInfoPeerRejected:type_name -> pb.ErrInfoPeerRejected
+	15,  // 12: pb.MsgError.ErrInfoPeerNotFunded:type_name -> pb.ErrInfoPeerNotFunded
+	16,  // 13: pb.MsgError.ErrInfoUserResponseTimedOut:type_name -> pb.ErrInfoUserResponseTimedOut
+	17,  // 14: pb.MsgError.ErrInfoResourceNotFound:type_name -> pb.ErrInfoResourceNotFound
+	18,  // 15: pb.MsgError.ErrInfoResourceExists:type_name -> pb.ErrInfoResourceExists
+	19,  // 16: pb.MsgError.ErrInfoInvalidArgument:type_name -> pb.ErrInfoInvalidArgument
+	20,  // 17: pb.MsgError.ErrInfoFailedPreCondUnclosedChs:type_name -> pb.ErrInfoFailedPreCondUnclosedChs
+	21,  // 18: pb.MsgError.ErrInfoInvalidConfig:type_name -> pb.ErrInfoInvalidConfig
+	23,  // 19: pb.MsgError.ErrInfoInvalidContracts:type_name -> pb.ErrInfoInvalidContracts
+	24,  // 20: pb.MsgError.ErrInfoTxTimedOut:type_name -> pb.ErrInfoTxTimedOut
+	25,  // 21: pb.MsgError.ErrInfoChainNotReachable:type_name -> pb.ErrInfoChainNotReachable
+	26,  // 22: pb.MsgError.ErrInfoSpendingLimitExceeded:type_name -> pb.ErrInfoSpendingLimitExceeded
+	7,   // 23: pb.ErrInfoFailedPreCondUnclosedChs.chs:type_name -> pb.PayChInfo
+	22,  // 24: pb.ErrInfoInvalidContracts.ContractErrInfos:type_name -> pb.ContractErrInfo
+	142, // 25: pb.OpenSessionResp.msgSuccess:type_name -> pb.OpenSessionResp.MsgSuccess
+	12,  // 26: pb.OpenSessionResp.error:type_name -> pb.MsgError
+	143, // 27: pb.RegisterCurrencyResp.msgSuccess:type_name -> pb.RegisterCurrencyResp.MsgSuccess
+	12,  // 28: pb.RegisterCurrencyResp.error:type_name -> pb.MsgError
+	3,   // 29: pb.AddPeerIDReq.peerID:type_name -> pb.PeerID
+	144, // 30: pb.AddPeerIDResp.msgSuccess:type_name -> pb.AddPeerIDResp.MsgSuccess
+	12,  // 31: pb.AddPeerIDResp.error:type_name -> pb.MsgError
+	145, // 32: pb.GetPeerIDResp.msgSuccess:type_name -> pb.GetPeerIDResp.MsgSuccess
+	12,  // 33: pb.GetPeerIDResp.error:type_name -> pb.MsgError
+	146, // 34: pb.ExportPeerIDsResp.msgSuccess:type_name -> pb.ExportPeerIDsResp.MsgSuccess
+	12,  // 35: pb.ExportPeerIDsResp.error:type_name -> pb.MsgError
+	147, // 36: pb.ImportPeerIDsResp.msgSuccess:type_name -> pb.ImportPeerIDsResp.MsgSuccess
+	12,  // 37: pb.ImportPeerIDsResp.error:type_name -> pb.MsgError
+	148, // 38: pb.GetPeerStatusResp.msgSuccess:type_name -> pb.GetPeerStatusResp.MsgSuccess
+	12,  // 39: pb.GetPeerStatusResp.error:type_name -> pb.MsgError
+	149, // 40: pb.SubPeerStatusResp.notify:type_name -> pb.SubPeerStatusResp.Notify
+	12,  // 41: pb.SubPeerStatusResp.error:type_name -> pb.MsgError
+	150, // 42: pb.UnsubPeerStatusResp.msgSuccess:type_name -> pb.UnsubPeerStatusResp.MsgSuccess
+	12,  // 43: pb.UnsubPeerStatusResp.error:type_name -> pb.MsgError
+	5,   // 44: pb.OpenPayChReq.openingBalInfo:type_name -> pb.BalInfo
+	151, // 45: pb.OpenPayChResp.msgSuccess:type_name -> pb.OpenPayChResp.MsgSuccess
+	12,  // 46: pb.OpenPayChResp.error:type_name -> pb.MsgError
+	152, // 47: pb.GetPayChsInfoResp.msgSuccess:type_name -> pb.GetPayChsInfoResp.MsgSuccess
+	12,  // 48: pb.GetPayChsInfoResp.error:type_name -> pb.MsgError
+	153, // 49: pb.SubPayChProposalsResp.notify:type_name -> pb.SubPayChProposalsResp.Notify
+	12,  // 50: pb.SubPayChProposalsResp.error:type_name -> pb.MsgError
+	154, // 51: pb.UnsubPayChProposalsResp.msgSuccess:type_name -> pb.UnsubPayChProposalsResp.MsgSuccess
+	12,  // 52: pb.UnsubPayChProposalsResp.error:type_name -> pb.MsgError
+	155, // 53: pb.RespondPayChProposalResp.msgSuccess:type_name -> pb.RespondPayChProposalResp.MsgSuccess
+	12,  // 54: pb.RespondPayChProposalResp.error:type_name -> pb.MsgError
+	156, // 55: pb.CloseSessionResp.msgSuccess:type_name -> pb.CloseSessionResp.MsgSuccess
+	12,  // 56: pb.CloseSessionResp.error:type_name -> pb.MsgError
+	157, // 57: pb.DeployAssetERC20Resp.msgSuccess:type_name -> pb.DeployAssetERC20Resp.MsgSuccess
+	12,  // 58: pb.DeployAssetERC20Resp.error:type_name -> pb.MsgError
+	158, // 59: pb.GetOnChainBalResp.msgSuccess:type_name -> pb.GetOnChainBalResp.MsgSuccess
+	12,  // 60: pb.GetOnChainBalResp.error:type_name -> pb.MsgError
+	159, // 61: pb.TransferOnChainResp.msgSuccess:type_name -> pb.TransferOnChainResp.MsgSuccess
+	12,  // 62: pb.TransferOnChainResp.error:type_name -> pb.MsgError
+	160, // 63: pb.GetAllowanceResp.msgSuccess:type_name -> pb.GetAllowanceResp.MsgSuccess
+	12,  // 64: pb.GetAllowanceResp.error:type_name -> pb.MsgError
+	161, // 65: pb.SetAllowanceResp.msgSuccess:type_name -> pb.SetAllowanceResp.MsgSuccess
+	12,  // 66: pb.SetAllowanceResp.error:type_name -> pb.MsgError
+	162, // 67: pb.GetTxCostReportResp.msgSuccess:type_name -> pb.GetTxCostReportResp.MsgSuccess
+	12,  // 68: pb.GetTxCostReportResp.error:type_name -> pb.MsgError
+	79,  // 69: pb.Invoice.payments:type_name -> pb.InvoicePayment
+	81,  // 70: pb.SetSpendingLimitsReq.limits:type_name -> pb.SpendingLimit
+	163, // 71: pb.SetSpendingLimitsResp.msgSuccess:type_name -> pb.SetSpendingLimitsResp.MsgSuccess
+	12,  // 72: pb.SetSpendingLimitsResp.error:type_name -> pb.MsgError
+	164, // 73: pb.GetSpendingLimitsResp.msgSuccess:type_name -> pb.GetSpendingLimitsResp.MsgSuccess
+	12,  // 74: pb.GetSpendingLimitsResp.error:type_name -> pb.MsgError
+	165, // 75: pb.CreateInvoiceResp.msgSuccess:type_name -> pb.CreateInvoiceResp.MsgSuccess
+	12,  // 76: pb.CreateInvoiceResp.error:type_name -> pb.MsgError
+	166, // 77: pb.GetInvoiceResp.msgSuccess:type_name -> pb.GetInvoiceResp.MsgSuccess
+	12,  // 78: pb.GetInvoiceResp.error:type_name -> pb.MsgError
+	167, // 79: pb.GetInvoicesResp.msgSuccess:type_name -> pb.GetInvoicesResp.MsgSuccess
+	12,  // 80: pb.GetInvoicesResp.error:type_name -> pb.MsgError
+	92,  // 81: pb.ScheduledPayment.params:type_name -> pb.ScheduledPaymentParams
+	93,  // 82: pb.ScheduledPayment.runs:type_name -> pb.ScheduledPaymentRun
+	92,  // 83: pb.CreateScheduledPaymentReq.params:type_name -> pb.ScheduledPaymentParams
+	168, // 84: pb.CreateScheduledPaymentResp.msgSuccess:type_name -> pb.CreateScheduledPaymentResp.MsgSuccess
+	12,  // 85: pb.CreateScheduledPaymentResp.error:type_name -> pb.MsgError
+	169, // 86: pb.GetScheduledPaymentsResp.msgSuccess:type_name -> pb.GetScheduledPaymentsResp.MsgSuccess
+	12,  // 87: pb.GetScheduledPaymentsResp.error:type_name -> pb.MsgError
+	170, // 88: pb.CancelScheduledPaymentResp.msgSuccess:type_name -> pb.CancelScheduledPaymentResp.MsgSuccess
+	12,  // 89: pb.CancelScheduledPaymentResp.error:type_name -> pb.MsgError
+	7,   // 90: pb.PayPeerPart.updatedPayChInfo:type_name -> pb.PayChInfo
+	171, // 91: pb.PayPeerResp.msgSuccess:type_name -> pb.PayPeerResp.MsgSuccess
+	12,  // 92: pb.PayPeerResp.error:type_name -> pb.MsgError
+	102, // 93: pb.PayPeerResp.completedParts:type_name -> pb.PayPeerPart
+	8,   // 94: pb.SendPayChUpdateReq.payments:type_name -> pb.Payment
+	9,   // 95: pb.SendPayChUpdateReq.meta:type_name -> pb.PayChUpdateMeta
+	172, // 96: pb.SendPayChUpdateResp.msgSuccess:type_name -> pb.SendPayChUpdateResp.MsgSuccess
+	12,  // 97: pb.SendPayChUpdateResp.error:type_name -> pb.MsgError
+	173, // 98: pb.PayInvoiceResp.msgSuccess:type_name -> pb.PayInvoiceResp.MsgSuccess
+	12,  // 99: pb.PayInvoiceResp.error:type_name -> pb.MsgError
+	174, // 100: pb.ProposeSwapResp.msgSuccess:type_name -> pb.ProposeSwapResp.MsgSuccess
+	12,  // 101: pb.ProposeSwapResp.error:type_name -> pb.MsgError
+	175, // 102: pb.SubPayChUpdatesResp.notify:type_name -> pb.SubPayChUpdatesResp.Notify
+	12,  // 103: pb.SubPayChUpdatesResp.error:type_name -> pb.MsgError
+	176, // 104: pb.UnsubPayChUpdatesResp.msgSuccess:type_name -> pb.UnsubPayChUpdatesResp.MsgSuccess
+	12,  // 105: pb.UnsubPayChUpdatesResp.error:type_name -> pb.MsgError
+	177, // 106: pb.RespondPayChUpdateResp.msgSuccess:type_name -> pb.RespondPayChUpdateResp.MsgSuccess
+	12,  // 107: pb.RespondPayChUpdateResp.error:type_name -> pb.MsgError
+	178, // 108: pb.GetPayChInfoResp.msgSuccess:type_name -> pb.GetPayChInfoResp.MsgSuccess
+	12,  // 109: pb.GetPayChInfoResp.error:type_name -> pb.MsgError
+	179, // 110: pb.GetPayChHistoryResp.msgSuccess:type_name -> pb.GetPayChHistoryResp.MsgSuccess
+	12,  // 111: pb.GetPayChHistoryResp.error:type_name -> pb.MsgError
+	5,   // 112: pb.ChReceipt.balInfo:type_name -> pb.BalInfo
+	180, // 113: pb.ExportPayChReceiptResp.msgSuccess:type_name -> pb.ExportPayChReceiptResp.MsgSuccess
+	12,  // 114: pb.ExportPayChReceiptResp.error:type_name -> pb.MsgError
+	12,  // 115: pb.PayStreamStatus.error:type_name -> pb.MsgError
+	181, // 116: pb.StartPayStreamResp.msgSuccess:type_name -> pb.StartPayStreamResp.MsgSuccess
+	12,  // 117: pb.StartPayStreamResp.error:type_name -> pb.MsgError
+	182, // 118: pb.PausePayStreamResp.msgSuccess:type_name -> pb.PausePayStreamResp.MsgSuccess
+	12,  // 119: pb.PausePayStreamResp.error:type_name -> pb.MsgError
+	183, // 120: pb.ResumePayStreamResp.msgSuccess:type_name -> pb.ResumePayStreamResp.MsgSuccess
+	12,  // 121: pb.ResumePayStreamResp.error:type_name -> pb.MsgError
+	184, // 122: pb.StopPayStreamResp.msgSuccess:type_name -> pb.StopPayStreamResp.MsgSuccess
+	12,  // 123: pb.StopPayStreamResp.error:type_name -> pb.MsgError
+	185, // 124: pb.GetPayStreamsResp.msgSuccess:type_name -> pb.GetPayStreamsResp.MsgSuccess
+	12,  // 125: pb.GetPayStreamsResp.error:type_name -> pb.MsgError
+	186, // 126: pb.SubPayStreamsResp.notify:type_name -> pb.SubPayStreamsResp.Notify
+	12,  // 127: pb.SubPayStreamsResp.error:type_name -> pb.MsgError
+	187, // 128: pb.UnsubPayStreamsResp.msgSuccess:type_name -> pb.UnsubPayStreamsResp.MsgSuccess
+	12,  // 129: pb.UnsubPayStreamsResp.error:type_name -> pb.MsgError
+	188, // 130: pb.ClosePayChResp.msgSuccess:type_name -> pb.ClosePayChResp.MsgSuccess
+	12,  // 131: pb.ClosePayChResp.error:type_name -> pb.MsgError
+	7,   // 132: pb.OpenSessionResp.MsgSuccess.restoredChs:type_name -> pb.PayChInfo
+	3,   // 133: pb.GetPeerIDResp.MsgSuccess.peerID:type_name -> pb.PeerID
+	44,  // 134: pb.ImportPeerIDsResp.MsgSuccess.conflicts:type_name -> pb.PeerIDImportConflict
+	45,  // 135: pb.ImportPeerIDsResp.MsgSuccess.invalid:type_name -> pb.PeerIDImportInvalid
+	47,  // 136: pb.GetPeerStatusResp.MsgSuccess.peerStatuses:type_name -> pb.PeerStatus
+	47,  // 137: pb.SubPeerStatusResp.Notify.peerStatus:type_name -> pb.PeerStatus
+	7,   // 138: pb.OpenPayChResp.MsgSuccess.openedPayChInfo:type_name -> pb.PayChInfo
+	7,   // 139: pb.GetPayChsInfoResp.MsgSuccess.openPayChsInfo:type_name -> pb.PayChInfo
+	5,   // 140: pb.SubPayChProposalsResp.Notify.openingBalInfo:type_name -> pb.BalInfo
+	7,   // 141: pb.RespondPayChProposalResp.MsgSuccess.openedPayChInfo:type_name -> pb.PayChInfo
+	7,   // 142: pb.CloseSessionResp.MsgSuccess.openPayChsInfo:type_name -> pb.PayChInfo
+	76,  // 143: pb.GetTxCostReportResp.MsgSuccess.txCosts:type_name -> pb.TxCostInfo
+	81,  // 144: pb.GetSpendingLimitsResp.MsgSuccess.limits:type_name -> pb.SpendingLimit
+	80,  // 145: pb.CreateInvoiceResp.MsgSuccess.invoice:type_name -> pb.Invoice
+	80,  // 146: pb.GetInvoiceResp.MsgSuccess.invoice:type_name -> pb.Invoice
+	80,  // 147: pb.GetInvoicesResp.MsgSuccess.invoices:type_name -> pb.Invoice
+	94,  // 148: pb.CreateScheduledPaymentResp.MsgSuccess.scheduledPayment:type_name -> pb.ScheduledPayment
+	94,  // 149: pb.GetScheduledPaymentsResp.MsgSuccess.scheduledPayments:type_name -> pb.ScheduledPayment
+	94,  // 150: pb.CancelScheduledPaymentResp.MsgSuccess.scheduledPayment:type_name -> pb.ScheduledPayment
+	102, // 151: pb.PayPeerResp.MsgSuccess.parts:type_name -> pb.PayPeerPart
+	7,   // 152: pb.SendPayChUpdateResp.MsgSuccess.updatedPayChInfo:type_name -> pb.PayChInfo
+	7,   // 153: pb.PayInvoiceResp.MsgSuccess.updatedPayChInfo:type_name -> pb.PayChInfo
+	7,   // 154: pb.ProposeSwapResp.MsgSuccess.updatedPayChInfo:type_name -> pb.PayChInfo
+	10,  // 155: pb.ProposeSwapResp.MsgSuccess.quote:type_name -> pb.SwapQuote
+	7,   // 156: pb.SubPayChUpdatesResp.Notify.proposedPayChInfo:type_name -> pb.PayChInfo
+	2,   // 157: pb.SubPayChUpdatesResp.Notify.Type:type_name -> pb.SubPayChUpdatesResp.Notify.ChUpdateType
+	12,  // 158: pb.SubPayChUpdatesResp.Notify.error:type_name -> pb.MsgError
+	9,   // 159: pb.SubPayChUpdatesResp.Notify.meta:type_name -> pb.PayChUpdateMeta
+	10,  // 160: pb.SubPayChUpdatesResp.Notify.swap:type_name -> pb.SwapQuote
+	7,   // 161: pb.RespondPayChUpdateResp.MsgSuccess.updatedPayChInfo:type_name -> pb.PayChInfo
+	7,   // 162: pb.GetPayChInfoResp.MsgSuccess.payChInfo:type_name -> pb.PayChInfo
+	11,  // 163: pb.GetPayChHistoryResp.MsgSuccess.records:type_name -> pb.PayChUpdateRecord
+	120, // 164: pb.ExportPayChReceiptResp.MsgSuccess.receipt:type_name -> pb.ChReceipt
+	123, // 165: pb.StartPayStreamResp.MsgSuccess.status:type_name -> pb.PayStreamStatus
+	123, // 166: pb.PausePayStreamResp.MsgSuccess.status:type_name -> pb.PayStreamStatus
+	123, // 167: pb.ResumePayStreamResp.MsgSuccess.status:type_name -> pb.PayStreamStatus
+	123, // 168: pb.StopPayStreamResp.MsgSuccess.status:type_name -> pb.PayStreamStatus
+	123, // 169: pb.GetPayStreamsResp.MsgSuccess.statuses:type_name -> pb.PayStreamStatus
+	123, // 170: pb.SubPayStreamsResp.Notify.status:type_name -> pb.PayStreamStatus
+	7,   // 171: pb.ClosePayChResp.MsgSuccess.closedPayChInfo:type_name -> pb.PayChInfo
+	27,  // 172: pb.Payment_API.GetConfig:input_type -> pb.GetConfigReq
+	29,  // 173: pb.Payment_API.OpenSession:input_type -> pb.OpenSessionReq
+	31,  // 174: pb.Payment_API.Time:input_type -> pb.TimeReq
+	33,  // 175: pb.Payment_API.RegisterCurrency:input_type -> pb.RegisterCurrencyReq
+	35,  // 176: pb.Payment_API.Help:input_type -> pb.HelpReq
+	37,  // 177: pb.Payment_API.AddPeerID:input_type -> pb.AddPeerIDReq
+	39,  // 178: pb.Payment_API.GetPeerID:input_type -> pb.GetPeerIDReq
+	41,  // 179: pb.Payment_API.ExportPeerIDs:input_type -> pb.ExportPeerIDsReq
+	43,  // 180: pb.Payment_API.ImportPeerIDs:input_type -> pb.ImportPeerIDsReq
+	48,  // 181: pb.Payment_API.GetPeerStatus:input_type -> pb.GetPeerStatusReq
+	50,  // 182: pb.Payment_API.SubPeerStatus:input_type -> pb.SubPeerStatusReq
+	52,  // 183: pb.Payment_API.UnsubPeerStatus:input_type -> pb.UnsubPeerStatusReq
+	54,  // 184: pb.Payment_API.OpenPayCh:input_type -> pb.OpenPayChReq
+	56,  // 185: pb.Payment_API.GetPayChsInfo:input_type -> pb.GetPayChsInfoReq
+	58,  // 186: pb.Payment_API.SubPayChProposals:input_type -> pb.SubPayChProposalsReq
+	60,  // 187: pb.Payment_API.UnsubPayChProposals:input_type -> pb.UnsubPayChProposalsReq
+	62,  // 188: pb.Payment_API.RespondPayChProposal:input_type -> pb.RespondPayChProposalReq
+	64,  // 189: pb.Payment_API.CloseSession:input_type -> pb.CloseSessionReq
+	66,  // 190: pb.Payment_API.DeployAssetERC20:input_type -> pb.DeployAssetERC20Req
+	68,  // 191: pb.Payment_API.GetOnChainBal:input_type -> pb.GetOnChainBalReq
+	70,  // 192: pb.Payment_API.TransferOnChain:input_type -> pb.TransferOnChainReq
+	72,  // 193: pb.Payment_API.GetAllowance:input_type -> pb.GetAllowanceReq
+	74,  // 194: pb.Payment_API.SetAllowance:input_type -> pb.SetAllowanceReq
+	77,  // 195: pb.Payment_API.GetTxCostReport:input_type -> pb.GetTxCostReportReq
+	82,  // 196: pb.Payment_API.SetSpendingLimits:input_type -> pb.SetSpendingLimitsReq
+	84,  // 197: pb.Payment_API.GetSpendingLimits:input_type -> pb.GetSpendingLimitsReq
+	86,  // 198: pb.Payment_API.CreateInvoice:input_type -> pb.CreateInvoiceReq
+	88,  // 199: pb.Payment_API.GetInvoice:input_type -> pb.GetInvoiceReq
+	90,  // 200: pb.Payment_API.GetInvoices:input_type -> pb.GetInvoicesReq
+	95,  // 201: pb.Payment_API.CreateScheduledPayment:input_type -> pb.CreateScheduledPaymentReq
+	97,  // 202: pb.Payment_API.GetScheduledPayments:input_type -> pb.GetScheduledPaymentsReq
+	99,  // 203: pb.Payment_API.CancelScheduledPayment:input_type -> pb.CancelScheduledPaymentReq
+	101, // 204: pb.Payment_API.PayPeer:input_type -> pb.PayPeerReq
+	104, // 205: pb.Payment_API.SendPayChUpdate:input_type -> pb.SendPayChUpdateReq
+	106, // 206: pb.Payment_API.PayInvoice:input_type -> pb.PayInvoiceReq
+	108, // 207: pb.Payment_API.ProposeSwap:input_type -> pb.ProposeSwapReq
+	110, // 208: pb.Payment_API.SubPayChUpdates:input_type -> pb.SubpayChUpdatesReq
+	112, // 209: pb.Payment_API.UnsubPayChUpdates:input_type -> pb.UnsubPayChUpdatesReq
+	114, // 210: pb.Payment_API.RespondPayChUpdate:input_type -> pb.RespondPayChUpdateReq
+	116, // 211: pb.Payment_API.GetPayChInfo:input_type -> pb.GetPayChInfoReq
+	118, // 212: pb.Payment_API.GetPayChHistory:input_type -> pb.GetPayChHistoryReq
+	121, // 213: pb.Payment_API.ExportPayChReceipt:input_type -> pb.ExportPayChReceiptReq
+	124, // 214: pb.Payment_API.StartPayStream:input_type -> pb.StartPayStreamReq
+	126, // 215: pb.Payment_API.PausePayStream:input_type -> pb.PausePayStreamReq
+	128, // 216: pb.Payment_API.ResumePayStream:input_type -> pb.ResumePayStreamReq
+	130, // 217: pb.Payment_API.StopPayStream:input_type -> pb.StopPayStreamReq
+	132, // 218: pb.Payment_API.GetPayStreams:input_type -> pb.GetPayStreamsReq
+	134, // 219: pb.Payment_API.SubPayStreams:input_type -> pb.SubPayStreamsReq
+	136, // 220: pb.Payment_API.UnsubPayStreams:input_type -> pb.UnsubPayStreamsReq
+	138, // 221: pb.Payment_API.ClosePayCh:input_type -> pb.ClosePayChReq
+	28,  // 222: pb.Payment_API.GetConfig:output_type -> pb.GetConfigResp
+	30,  // 223: pb.Payment_API.OpenSession:output_type -> pb.OpenSessionResp
+	32,  // 224: pb.Payment_API.Time:output_type -> pb.TimeResp
+	34,  // 225: pb.Payment_API.RegisterCurrency:output_type -> pb.RegisterCurrencyResp
+	36,  // 226: pb.Payment_API.Help:output_type -> pb.HelpResp
+	38,  // 227: pb.Payment_API.AddPeerID:output_type -> pb.AddPeerIDResp
+	40,  // 228: pb.Payment_API.GetPeerID:output_type -> pb.GetPeerIDResp
+	42,  // 229: pb.Payment_API.ExportPeerIDs:output_type -> pb.ExportPeerIDsResp
+	46,  // 230: pb.Payment_API.ImportPeerIDs:output_type -> pb.ImportPeerIDsResp
+	49,  // 231: pb.Payment_API.GetPeerStatus:output_type -> pb.GetPeerStatusResp
+	51,  // 232: pb.Payment_API.SubPeerStatus:output_type -> pb.SubPeerStatusResp
+	53,  // 233: pb.Payment_API.UnsubPeerStatus:output_type -> pb.UnsubPeerStatusResp
+	55,  // 234: pb.Payment_API.OpenPayCh:output_type -> pb.OpenPayChResp
+	57,  // 235: pb.Payment_API.GetPayChsInfo:output_type -> pb.GetPayChsInfoResp
+	59,  // 236: pb.Payment_API.SubPayChProposals:output_type -> pb.SubPayChProposalsResp
+	61,  // 237: pb.Payment_API.UnsubPayChProposals:output_type -> pb.UnsubPayChProposalsResp
+	63,  // 238: pb.Payment_API.RespondPayChProposal:output_type -> pb.RespondPayChProposalResp
+	65,  // 239: pb.Payment_API.CloseSession:output_type -> pb.CloseSessionResp
+	67,  // 240: pb.Payment_API.DeployAssetERC20:output_type -> pb.DeployAssetERC20Resp
+	69,  // 241: pb.Payment_API.GetOnChainBal:output_type -> pb.GetOnChainBalResp
+	71,  // 242: pb.Payment_API.TransferOnChain:output_type -> pb.TransferOnChainResp
+	73,  // 243: pb.Payment_API.GetAllowance:output_type -> pb.GetAllowanceResp
+	75,  // 244: pb.Payment_API.SetAllowance:output_type -> pb.SetAllowanceResp
+	78,  // 245: pb.Payment_API.GetTxCostReport:output_type -> pb.GetTxCostReportResp
+	83,  // 246: pb.Payment_API.SetSpendingLimits:output_type -> pb.SetSpendingLimitsResp
+	85,  // 247: pb.Payment_API.GetSpendingLimits:output_type -> pb.GetSpendingLimitsResp
+	87,  // 248: pb.Payment_API.CreateInvoice:output_type -> pb.CreateInvoiceResp
+	89,  // 249: pb.Payment_API.GetInvoice:output_type -> pb.GetInvoiceResp
+	91,  // 250: pb.Payment_API.GetInvoices:output_type -> pb.GetInvoicesResp
+	96,  // 251: pb.Payment_API.CreateScheduledPayment:output_type -> pb.CreateScheduledPaymentResp
+	98,  // 252: pb.Payment_API.GetScheduledPayments:output_type -> pb.GetScheduledPaymentsResp
+	100, // 253: pb.Payment_API.CancelScheduledPayment:output_type -> pb.CancelScheduledPaymentResp
+	103, // 254: pb.Payment_API.PayPeer:output_type -> pb.PayPeerResp
+	105, // 255: pb.Payment_API.SendPayChUpdate:output_type -> pb.SendPayChUpdateResp
+	107, // 256: pb.Payment_API.PayInvoice:output_type -> pb.PayInvoiceResp
+	109, // 257: pb.Payment_API.ProposeSwap:output_type -> pb.ProposeSwapResp
+	111, // 258: pb.Payment_API.SubPayChUpdates:output_type -> pb.SubPayChUpdatesResp
+	113, // 259: pb.Payment_API.UnsubPayChUpdates:output_type -> pb.UnsubPayChUpdatesResp
+	115, // 260: pb.Payment_API.RespondPayChUpdate:output_type -> pb.RespondPayChUpdateResp
+	117, // 261: pb.Payment_API.GetPayChInfo:output_type -> pb.GetPayChInfoResp
+	119, // 262: pb.Payment_API.GetPayChHistory:output_type -> pb.GetPayChHistoryResp
+	122, // 263: pb.Payment_API.ExportPayChReceipt:output_type -> pb.ExportPayChReceiptResp
+	125, // 264: pb.Payment_API.StartPayStream:output_type -> pb.StartPayStreamResp
+	127, // 265: pb.Payment_API.PausePayStream:output_type -> pb.PausePayStreamResp
+	129, // 266: pb.Payment_API.ResumePayStream:output_type -> pb.ResumePayStreamResp
+	131, // 267: pb.Payment_API.StopPayStream:output_type -> pb.StopPayStreamResp
+	133, // 268: pb.Payment_API.GetPayStreams:output_type -> pb.GetPayStreamsResp
+	135, // 269: pb.Payment_API.SubPayStreams:output_type -> pb.SubPayStreamsResp
+	137, // 270: pb.Payment_API.UnsubPayStreams:output_type -> pb.UnsubPayStreamsResp
+	139, // 271: pb.Payment_API.ClosePayCh:output_type -> pb.ClosePayChResp
+	222, // [222:272] is the sub-list for method output_type
+	172, // [172:222] is the sub-list for method input_type
+	172, // [172:172] is the sub-list for extension type_name
+	172, // [172:172] is the sub-list for extension extendee
+	0,   // [0:172] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_init() }
+func file_api_proto_init() {
+	if File_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommEndpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FiatBalInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayChInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Payment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayChUpdateMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayChUpdateRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoPeerRequestTimedOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoPeerRejected); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoPeerNotFunded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoUserResponseTimedOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoResourceNotFound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoResourceExists); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoInvalidArgument); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoFailedPreCondUnclosedChs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoInvalidConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractErrInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoInvalidContracts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoTxTimedOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoChainNotReachable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrInfoSpendingLimitExceeded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenSessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenSessionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterCurrencyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterCurrencyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelpReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelpResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPeerIDReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPeerIDResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerIDReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerIDResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPeerIDsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPeerIDsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportPeerIDsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerIDImportConflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerIDImportInvalid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportPeerIDsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPeerStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPeerStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPeerStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPeerStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenPayChReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenPayChResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChsInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChsInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPayChProposalsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPayChProposalsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayChProposalsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayChProposalsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondPayChProposalReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondPayChProposalResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseSessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseSessionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployAssetERC20Req); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployAssetERC20Resp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOnChainBalReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOnChainBalResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferOnChainReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferOnChainResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllowanceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllowanceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAllowanceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAllowanceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxCostInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxCostReportReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxCostReportResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoicePayment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpendingLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSpendingLimitsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSpendingLimitsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpendingLimitsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpendingLimitsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInvoiceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInvoiceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvoiceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvoiceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvoicesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvoicesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledPaymentParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledPaymentRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledPayment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateScheduledPaymentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateScheduledPaymentResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledPaymentsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledPaymentsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledPaymentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledPaymentResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayPeerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayPeerPart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayPeerResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendPayChUpdateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendPayChUpdateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayInvoiceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayInvoiceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposeSwapReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposeSwapResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubpayChUpdatesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPayChUpdatesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayChUpdatesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayChUpdatesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondPayChUpdateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondPayChUpdateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChHistoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPayChReceiptReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPayChReceiptResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayStreamStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPayStreamReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPayStreamResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PausePayStreamReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PausePayStreamResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumePayStreamReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumePayStreamResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPayStreamReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPayStreamResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayStreamsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayStreamsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPayStreamsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPayStreamsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayStreamsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayStreamsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePayChReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePayChResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalInfoBal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenSessionResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterCurrencyResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPeerIDResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerIDResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPeerIDsResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportPeerIDsResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerStatusResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPeerStatusResp_Notify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPeerStatusResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenPayChResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChsInfoResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPayChProposalsResp_Notify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayChProposalsResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondPayChProposalResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseSessionResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployAssetERC20Resp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOnChainBalResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferOnChainResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllowanceResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAllowanceResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxCostReportResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSpendingLimitsResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpendingLimitsResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInvoiceResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvoiceResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvoicesResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateScheduledPaymentResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledPaymentsResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledPaymentResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayPeerResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendPayChUpdateResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayInvoiceResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposeSwapResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPayChUpdatesResp_Notify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayChUpdatesResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondPayChUpdateResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChInfoResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayChHistoryResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPayChReceiptResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPayStreamResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PausePayStreamResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumePayStreamResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPayStreamResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayStreamsResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubPayStreamsResp_Notify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubPayStreamsResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePayChResp_MsgSuccess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_api_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*MsgError_ErrInfoPeerRequestTimedOut)(nil),
+		(*MsgError_ErrInfoPeerRejected)(nil),
+		(*MsgError_ErrInfoPeerNotFunded)(nil),
+		(*MsgError_ErrInfoUserResponseTimedOut)(nil),
+		(*MsgError_ErrInfoResourceNotFound)(nil),
+		(*MsgError_ErrInfoResourceExists)(nil),
+		(*MsgError_ErrInfoInvalidArgument)(nil),
+		(*MsgError_ErrInfoFailedPreCondUnclosedChs)(nil),
+		(*MsgError_ErrInfoInvalidConfig)(nil),
+		(*MsgError_ErrInfoInvalidContracts)(nil),
+		(*MsgError_ErrInfoTxTimedOut)(nil),
+		(*MsgError_ErrInfoChainNotReachable)(nil),
+		(*MsgError_ErrInfoSpendingLimitExceeded)(nil),
+	}
+	file_api_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*OpenSessionResp_MsgSuccess_)(nil),
+		(*OpenSessionResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[31].OneofWrappers = []interface{}{
+		(*RegisterCurrencyResp_MsgSuccess_)(nil),
+		(*RegisterCurrencyResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[35].OneofWrappers = []interface{}{
+		(*AddPeerIDResp_MsgSuccess_)(nil),
+		(*AddPeerIDResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[37].OneofWrappers = []interface{}{
+		(*GetPeerIDResp_MsgSuccess_)(nil),
+		(*GetPeerIDResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[39].OneofWrappers = []interface{}{
+		(*ExportPeerIDsResp_MsgSuccess_)(nil),
+		(*ExportPeerIDsResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[43].OneofWrappers = []interface{}{
+		(*ImportPeerIDsResp_MsgSuccess_)(nil),
+		(*ImportPeerIDsResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[46].OneofWrappers = []interface{}{
+		(*GetPeerStatusResp_MsgSuccess_)(nil),
+		(*GetPeerStatusResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[48].OneofWrappers = []interface{}{
+		(*SubPeerStatusResp_Notify_)(nil),
+		(*SubPeerStatusResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[50].OneofWrappers = []interface{}{
+		(*UnsubPeerStatusResp_MsgSuccess_)(nil),
+		(*UnsubPeerStatusResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[52].OneofWrappers = []interface{}{
+		(*OpenPayChResp_MsgSuccess_)(nil),
+		(*OpenPayChResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[54].OneofWrappers = []interface{}{
+		(*GetPayChsInfoResp_MsgSuccess_)(nil),
+		(*GetPayChsInfoResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[56].OneofWrappers = []interface{}{
+		(*SubPayChProposalsResp_Notify_)(nil),
+		(*SubPayChProposalsResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[58].OneofWrappers = []interface{}{
+		(*UnsubPayChProposalsResp_MsgSuccess_)(nil),
+		(*UnsubPayChProposalsResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[60].OneofWrappers = []interface{}{
+		(*RespondPayChProposalResp_MsgSuccess_)(nil),
+		(*RespondPayChProposalResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[62].OneofWrappers = []interface{}{
+		(*CloseSessionResp_MsgSuccess_)(nil),
+		(*CloseSessionResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[64].OneofWrappers = []interface{}{
+		(*DeployAssetERC20Resp_MsgSuccess_)(nil),
+		(*DeployAssetERC20Resp_Error)(nil),
+	}
+	file_api_proto_msgTypes[66].OneofWrappers = []interface{}{
+		(*GetOnChainBalResp_MsgSuccess_)(nil),
+		(*GetOnChainBalResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[68].OneofWrappers = []interface{}{
+		(*TransferOnChainResp_MsgSuccess_)(nil),
+		(*TransferOnChainResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[70].OneofWrappers = []interface{}{
+		(*GetAllowanceResp_MsgSuccess_)(nil),
+		(*GetAllowanceResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[72].OneofWrappers = []interface{}{
+		(*SetAllowanceResp_MsgSuccess_)(nil),
+		(*SetAllowanceResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[75].OneofWrappers = []interface{}{
+		(*GetTxCostReportResp_MsgSuccess_)(nil),
+		(*GetTxCostReportResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[80].OneofWrappers = []interface{}{
+		(*SetSpendingLimitsResp_MsgSuccess_)(nil),
+		(*SetSpendingLimitsResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[82].OneofWrappers = []interface{}{
+		(*GetSpendingLimitsResp_MsgSuccess_)(nil),
+		(*GetSpendingLimitsResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[84].OneofWrappers = []interface{}{
+		(*CreateInvoiceResp_MsgSuccess_)(nil),
+		(*CreateInvoiceResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[86].OneofWrappers = []interface{}{
+		(*GetInvoiceResp_MsgSuccess_)(nil),
+		(*GetInvoiceResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[88].OneofWrappers = []interface{}{
+		(*GetInvoicesResp_MsgSuccess_)(nil),
+		(*GetInvoicesResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[93].OneofWrappers = []interface{}{
+		(*CreateScheduledPaymentResp_MsgSuccess_)(nil),
+		(*CreateScheduledPaymentResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[95].OneofWrappers = []interface{}{
+		(*GetScheduledPaymentsResp_MsgSuccess_)(nil),
+		(*GetScheduledPaymentsResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[97].OneofWrappers = []interface{}{
+		(*CancelScheduledPaymentResp_MsgSuccess_)(nil),
+		(*CancelScheduledPaymentResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[100].OneofWrappers = []interface{}{
+		(*PayPeerResp_MsgSuccess_)(nil),
+		(*PayPeerResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[102].OneofWrappers = []interface{}{
+		(*SendPayChUpdateResp_MsgSuccess_)(nil),
+		(*SendPayChUpdateResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[104].OneofWrappers = []interface{}{
+		(*PayInvoiceResp_MsgSuccess_)(nil),
+		(*PayInvoiceResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[106].OneofWrappers = []interface{}{
+		(*ProposeSwapResp_MsgSuccess_)(nil),
+		(*ProposeSwapResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[108].OneofWrappers = []interface{}{
+		(*SubPayChUpdatesResp_Notify_)(nil),
+		(*SubPayChUpdatesResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[110].OneofWrappers = []interface{}{
+		(*UnsubPayChUpdatesResp_MsgSuccess_)(nil),
+		(*UnsubPayChUpdatesResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[112].OneofWrappers = []interface{}{
+		(*RespondPayChUpdateResp_MsgSuccess_)(nil),
+		(*RespondPayChUpdateResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[114].OneofWrappers = []interface{}{
+		(*GetPayChInfoResp_MsgSuccess_)(nil),
+		(*GetPayChInfoResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[116].OneofWrappers = []interface{}{
+		(*GetPayChHistoryResp_MsgSuccess_)(nil),
+		(*GetPayChHistoryResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[119].OneofWrappers = []interface{}{
+		(*ExportPayChReceiptResp_MsgSuccess_)(nil),
+		(*ExportPayChReceiptResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[122].OneofWrappers = []interface{}{
+		(*StartPayStreamResp_MsgSuccess_)(nil),
+		(*StartPayStreamResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[124].OneofWrappers = []interface{}{
+		(*PausePayStreamResp_MsgSuccess_)(nil),
+		(*PausePayStreamResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[126].OneofWrappers = []interface{}{
+		(*ResumePayStreamResp_MsgSuccess_)(nil),
+		(*ResumePayStreamResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[128].OneofWrappers = []interface{}{
+		(*StopPayStreamResp_MsgSuccess_)(nil),
+		(*StopPayStreamResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[130].OneofWrappers = []interface{}{
+		(*GetPayStreamsResp_MsgSuccess_)(nil),
+		(*GetPayStreamsResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[132].OneofWrappers = []interface{}{
+		(*SubPayStreamsResp_Notify_)(nil),
+		(*SubPayStreamsResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[134].OneofWrappers = []interface{}{
+		(*UnsubPayStreamsResp_MsgSuccess_)(nil),
+		(*UnsubPayStreamsResp_Error)(nil),
+	}
+	file_api_proto_msgTypes[136].OneofWrappers = []interface{}{
+		(*ClosePayChResp_MsgSuccess_)(nil),
+		(*ClosePayChResp_Error)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   186,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_goTypes,
+		DependencyIndexes: file_api_proto_depIdxs,
+		EnumInfos:         file_api_proto_enumTypes,
+		MessageInfos:      file_api_proto_msgTypes,
+	}.Build()
+	File_api_proto = out.File
+	file_api_proto_rawDesc = nil
+	file_api_proto_goTypes = nil
+	file_api_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// Payment_APIClient is the client API for Payment_API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type Payment_APIClient interface {
+	GetConfig(ctx context.Context, in *GetConfigReq, opts ...grpc.CallOption) (*GetConfigResp, error)
+	OpenSession(ctx context.Context, in *OpenSessionReq, opts ...grpc.CallOption) (*OpenSessionResp, error)
+	Time(ctx context.Context, in *TimeReq, opts ...grpc.CallOption) (*TimeResp, error)
+	RegisterCurrency(ctx context.Context, in *RegisterCurrencyReq, opts ...grpc.CallOption) (*RegisterCurrencyResp, error)
+	Help(ctx context.Context, in *HelpReq, opts ...grpc.CallOption) (*HelpResp, error)
+	AddPeerID(ctx context.Context, in *AddPeerIDReq, opts ...grpc.CallOption) (*AddPeerIDResp, error)
+	GetPeerID(ctx context.Context, in *GetPeerIDReq, opts ...grpc.CallOption) (*GetPeerIDResp, error)
+	ExportPeerIDs(ctx context.Context, in *ExportPeerIDsReq, opts ...grpc.CallOption) (*ExportPeerIDsResp, error)
+	ImportPeerIDs(ctx context.Context, in *ImportPeerIDsReq, opts ...grpc.CallOption) (*ImportPeerIDsResp, error)
+	GetPeerStatus(ctx context.Context, in *GetPeerStatusReq, opts ...grpc.CallOption) (*GetPeerStatusResp, error)
+	SubPeerStatus(ctx context.Context, in *SubPeerStatusReq, opts ...grpc.CallOption) (Payment_API_SubPeerStatusClient, error)
+	UnsubPeerStatus(ctx context.Context, in *UnsubPeerStatusReq, opts ...grpc.CallOption) (*UnsubPeerStatusResp, error)
+	OpenPayCh(ctx context.Context, in *OpenPayChReq, opts ...grpc.CallOption) (*OpenPayChResp, error)
+	GetPayChsInfo(ctx context.Context, in *GetPayChsInfoReq, opts ...grpc.CallOption) (*GetPayChsInfoResp, error)
+	SubPayChProposals(ctx context.Context, in *SubPayChProposalsReq, opts ...grpc.CallOption) (Payment_API_SubPayChProposalsClient, error)
+	UnsubPayChProposals(ctx context.Context, in *UnsubPayChProposalsReq, opts ...grpc.CallOption) (*UnsubPayChProposalsResp, error)
+	RespondPayChProposal(ctx context.Context, in *RespondPayChProposalReq, opts ...grpc.CallOption) (*RespondPayChProposalResp, error)
+	CloseSession(ctx context.Context, in *CloseSessionReq, opts ...grpc.CallOption) (*CloseSessionResp, error)
+	DeployAssetERC20(ctx context.Context, in *DeployAssetERC20Req, opts ...grpc.CallOption) (*DeployAssetERC20Resp, error)
+	GetOnChainBal(ctx context.Context, in *GetOnChainBalReq, opts ...grpc.CallOption) (*GetOnChainBalResp, error)
+	TransferOnChain(ctx context.Context, in *TransferOnChainReq, opts ...grpc.CallOption) (*TransferOnChainResp, error)
+	GetAllowance(ctx context.Context, in *GetAllowanceReq, opts ...grpc.CallOption) (*GetAllowanceResp, error)
+	SetAllowance(ctx context.Context, in *SetAllowanceReq, opts ...grpc.CallOption) (*SetAllowanceResp, error)
+	GetTxCostReport(ctx context.Context, in *GetTxCostReportReq, opts ...grpc.CallOption) (*GetTxCostReportResp, error)
+	SetSpendingLimits(ctx context.Context, in *SetSpendingLimitsReq, opts ...grpc.CallOption) (*SetSpendingLimitsResp, error)
+	GetSpendingLimits(ctx context.Context, in *GetSpendingLimitsReq, opts ...grpc.CallOption) (*GetSpendingLimitsResp, error)
+	CreateInvoice(ctx context.Context, in *CreateInvoiceReq, opts ...grpc.CallOption) (*CreateInvoiceResp, error)
+	GetInvoice(ctx context.Context, in *GetInvoiceReq, opts ...grpc.CallOption) (*GetInvoiceResp, error)
+	GetInvoices(ctx context.Context, in *GetInvoicesReq, opts ...grpc.CallOption) (*GetInvoicesResp, error)
+	CreateScheduledPayment(ctx context.Context, in *CreateScheduledPaymentReq, opts ...grpc.CallOption) (*CreateScheduledPaymentResp, error)
+	GetScheduledPayments(ctx context.Context, in *GetScheduledPaymentsReq, opts ...grpc.CallOption) (*GetScheduledPaymentsResp, error)
+	CancelScheduledPayment(ctx context.Context, in *CancelScheduledPaymentReq, opts ...grpc.CallOption) (*CancelScheduledPaymentResp, error)
+	PayPeer(ctx context.Context, in *PayPeerReq, opts ...grpc.CallOption) (*PayPeerResp, error)
+	SendPayChUpdate(ctx context.Context, in *SendPayChUpdateReq, opts ...grpc.CallOption) (*SendPayChUpdateResp, error)
+	PayInvoice(ctx context.Context, in *PayInvoiceReq, opts ...grpc.CallOption) (*PayInvoiceResp, error)
+	ProposeSwap(ctx context.Context, in *ProposeSwapReq, opts ...grpc.CallOption) (*ProposeSwapResp, error)
+	SubPayChUpdates(ctx context.Context, in *SubpayChUpdatesReq, opts ...grpc.CallOption) (Payment_API_SubPayChUpdatesClient, error)
+	UnsubPayChUpdates(ctx context.Context, in *UnsubPayChUpdatesReq, opts ...grpc.CallOption) (*UnsubPayChUpdatesResp, error)
+	RespondPayChUpdate(ctx context.Context, in *RespondPayChUpdateReq, opts ...grpc.CallOption) (*RespondPayChUpdateResp, error)
+	GetPayChInfo(ctx context.Context, in *GetPayChInfoReq, opts ...grpc.CallOption) (*GetPayChInfoResp, error)
+	GetPayChHistory(ctx context.Context, in *GetPayChHistoryReq, opts ...grpc.CallOption) (*GetPayChHistoryResp, error)
+	ExportPayChReceipt(ctx context.Context, in *ExportPayChReceiptReq, opts ...grpc.CallOption) (*ExportPayChReceiptResp, error)
+	StartPayStream(ctx context.Context, in *StartPayStreamReq, opts ...grpc.CallOption) (*StartPayStreamResp, error)
+	PausePayStream(ctx context.Context, in *PausePayStreamReq, opts ...grpc.CallOption) (*PausePayStreamResp, error)
+	ResumePayStream(ctx context.Context, in *ResumePayStreamReq, opts ...grpc.CallOption) (*ResumePayStreamResp, error)
+	StopPayStream(ctx context.Context, in *StopPayStreamReq, opts ...grpc.CallOption) (*StopPayStreamResp, error)
+	GetPayStreams(ctx context.Context, in *GetPayStreamsReq, opts ...grpc.CallOption) (*GetPayStreamsResp, error)
+	SubPayStreams(ctx context.Context, in *SubPayStreamsReq, opts ...grpc.CallOption) (Payment_API_SubPayStreamsClient, error)
+	UnsubPayStreams(ctx context.Context, in *UnsubPayStreamsReq, opts ...grpc.CallOption) (*UnsubPayStreamsResp, error)
+	ClosePayCh(ctx context.Context, in *ClosePayChReq, opts ...grpc.CallOption) (*ClosePayChResp, error)
+}
+
+type payment_APIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPayment_APIClient(cc grpc.ClientConnInterface) Payment_APIClient {
+	return &payment_APIClient{cc}
+}
+
+func (c *payment_APIClient) GetConfig(ctx context.Context, in *GetConfigReq, opts ...grpc.CallOption) (*GetConfigResp, error) {
+	out := new(GetConfigResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) OpenSession(ctx context.Context, in *OpenSessionReq, opts ...grpc.CallOption) (*OpenSessionResp, error) {
+	out := new(OpenSessionResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/OpenSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) Time(ctx context.Context, in *TimeReq, opts ...grpc.CallOption) (*TimeResp, error) {
+	out := new(TimeResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/Time", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) RegisterCurrency(ctx context.Context, in *RegisterCurrencyReq, opts ...grpc.CallOption) (*RegisterCurrencyResp, error) {
+	out := new(RegisterCurrencyResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/RegisterCurrency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) Help(ctx context.Context, in *HelpReq, opts ...grpc.CallOption) (*HelpResp, error) {
+	out := new(HelpResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/Help", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) AddPeerID(ctx context.Context, in *AddPeerIDReq, opts ...grpc.CallOption) (*AddPeerIDResp, error) {
+	out := new(AddPeerIDResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/AddPeerID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) GetPeerID(ctx context.Context, in *GetPeerIDReq, opts ...grpc.CallOption) (*GetPeerIDResp, error) {
+	out := new(GetPeerIDResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetPeerID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) ExportPeerIDs(ctx context.Context, in *ExportPeerIDsReq, opts ...grpc.CallOption) (*ExportPeerIDsResp, error) {
+	out := new(ExportPeerIDsResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/ExportPeerIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) ImportPeerIDs(ctx context.Context, in *ImportPeerIDsReq, opts ...grpc.CallOption) (*ImportPeerIDsResp, error) {
+	out := new(ImportPeerIDsResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/ImportPeerIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) GetPeerStatus(ctx context.Context, in *GetPeerStatusReq, opts ...grpc.CallOption) (*GetPeerStatusResp, error) {
+	out := new(GetPeerStatusResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetPeerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) SubPeerStatus(ctx context.Context, in *SubPeerStatusReq, opts ...grpc.CallOption) (Payment_API_SubPeerStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Payment_API_serviceDesc.Streams[0], "/pb.Payment_API/SubPeerStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &payment_APISubPeerStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Payment_API_SubPeerStatusClient interface {
+	Recv() (*SubPeerStatusResp, error)
+	grpc.ClientStream
+}
+
+type payment_APISubPeerStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *payment_APISubPeerStatusClient) Recv() (*SubPeerStatusResp, error) {
+	m := new(SubPeerStatusResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *payment_APIClient) UnsubPeerStatus(ctx context.Context, in *UnsubPeerStatusReq, opts ...grpc.CallOption) (*UnsubPeerStatusResp, error) {
+	out := new(UnsubPeerStatusResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/UnsubPeerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) OpenPayCh(ctx context.Context, in *OpenPayChReq, opts ...grpc.CallOption) (*OpenPayChResp, error) {
+	out := new(OpenPayChResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/OpenPayCh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) GetPayChsInfo(ctx context.Context, in *GetPayChsInfoReq, opts ...grpc.CallOption) (*GetPayChsInfoResp, error) {
+	out := new(GetPayChsInfoResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetPayChsInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) SubPayChProposals(ctx context.Context, in *SubPayChProposalsReq, opts ...grpc.CallOption) (Payment_API_SubPayChProposalsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Payment_API_serviceDesc.Streams[1], "/pb.Payment_API/SubPayChProposals", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &payment_APISubPayChProposalsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Payment_API_SubPayChProposalsClient interface {
+	Recv() (*SubPayChProposalsResp, error)
+	grpc.ClientStream
+}
+
+type payment_APISubPayChProposalsClient struct {
+	grpc.ClientStream
+}
+
+func (x *payment_APISubPayChProposalsClient) Recv() (*SubPayChProposalsResp, error) {
+	m := new(SubPayChProposalsResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[4].OneofWrappers = []interface{}{
-		(*MsgError_ErrInfoPeerRequestTimedOut)(nil),
-		(*MsgError_ErrInfoPeerRejected)(nil),
-		(*MsgError_ErrInfoPeerNotFunded)(nil),
-		(*MsgError_ErrInfoUserResponseTimedOut)(nil),
-		(*MsgError_ErrInfoResourceNotFound)(nil),
-		(*MsgError_ErrInfoResourceExists)(nil),
-		(*MsgError_ErrInfoInvalidArgument)(nil),
-		(*MsgError_ErrInfoFailedPreCondUnclosedChs)(nil),
-		(*MsgError_ErrInfoInvalidConfig)(nil),
-		(*MsgError_ErrInfoInvalidContracts)(nil),
-		(*MsgError_ErrInfoTxTimedOut)(nil),
-		(*MsgError_ErrInfoChainNotReachable)(nil),
+	return m, nil
+}
+
+func (c *payment_APIClient) UnsubPayChProposals(ctx context.Context, in *UnsubPayChProposalsReq, opts ...grpc.CallOption) (*UnsubPayChProposalsResp, error) {
+	out := new(UnsubPayChProposalsResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/UnsubPayChProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[21].OneofWrappers = []interface{}{
-		(*OpenSessionResp_MsgSuccess_)(nil),
-		(*OpenSessionResp_Error)(nil),
+	return out, nil
+}
+
+func (c *payment_APIClient) RespondPayChProposal(ctx context.Context, in *RespondPayChProposalReq, opts ...grpc.CallOption) (*RespondPayChProposalResp, error) {
+	out := new(RespondPayChProposalResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/RespondPayChProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[25].OneofWrappers = []interface{}{
-		(*RegisterCurrencyResp_MsgSuccess_)(nil),
-		(*RegisterCurrencyResp_Error)(nil),
+	return out, nil
+}
+
+func (c *payment_APIClient) CloseSession(ctx context.Context, in *CloseSessionReq, opts ...grpc.CallOption) (*CloseSessionResp, error) {
+	out := new(CloseSessionResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/CloseSession", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[29].OneofWrappers = []interface{}{
-		(*AddPeerIDResp_MsgSuccess_)(nil),
-		(*AddPeerIDResp_Error)(nil),
+	return out, nil
+}
+
+func (c *payment_APIClient) DeployAssetERC20(ctx context.Context, in *DeployAssetERC20Req, opts ...grpc.CallOption) (*DeployAssetERC20Resp, error) {
+	out := new(DeployAssetERC20Resp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/DeployAssetERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[31].OneofWrappers = []interface{}{
-		(*GetPeerIDResp_MsgSuccess_)(nil),
-		(*GetPeerIDResp_Error)(nil),
+	return out, nil
+}
+
+func (c *payment_APIClient) GetOnChainBal(ctx context.Context, in *GetOnChainBalReq, opts ...grpc.CallOption) (*GetOnChainBalResp, error) {
+	out := new(GetOnChainBalResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetOnChainBal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[33].OneofWrappers = []interface{}{
-		(*OpenPayChResp_MsgSuccess_)(nil),
-		(*OpenPayChResp_Error)(nil),
+	return out, nil
+}
+
+func (c *payment_APIClient) TransferOnChain(ctx context.Context, in *TransferOnChainReq, opts ...grpc.CallOption) (*TransferOnChainResp, error) {
+	out := new(TransferOnChainResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/TransferOnChain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[35].OneofWrappers = []interface{}{
-		(*GetPayChsInfoResp_MsgSuccess_)(nil),
-		(*GetPayChsInfoResp_Error)(nil),
+	return out, nil
+}
+
+func (c *payment_APIClient) GetAllowance(ctx context.Context, in *GetAllowanceReq, opts ...grpc.CallOption) (*GetAllowanceResp, error) {
+	out := new(GetAllowanceResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) SetAllowance(ctx context.Context, in *SetAllowanceReq, opts ...grpc.CallOption) (*SetAllowanceResp, error) {
+	out := new(SetAllowanceResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/SetAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) GetTxCostReport(ctx context.Context, in *GetTxCostReportReq, opts ...grpc.CallOption) (*GetTxCostReportResp, error) {
+	out := new(GetTxCostReportResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetTxCostReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) SetSpendingLimits(ctx context.Context, in *SetSpendingLimitsReq, opts ...grpc.CallOption) (*SetSpendingLimitsResp, error) {
+	out := new(SetSpendingLimitsResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/SetSpendingLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) GetSpendingLimits(ctx context.Context, in *GetSpendingLimitsReq, opts ...grpc.CallOption) (*GetSpendingLimitsResp, error) {
+	out := new(GetSpendingLimitsResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetSpendingLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) CreateInvoice(ctx context.Context, in *CreateInvoiceReq, opts ...grpc.CallOption) (*CreateInvoiceResp, error) {
+	out := new(CreateInvoiceResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/CreateInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) GetInvoice(ctx context.Context, in *GetInvoiceReq, opts ...grpc.CallOption) (*GetInvoiceResp, error) {
+	out := new(GetInvoiceResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) GetInvoices(ctx context.Context, in *GetInvoicesReq, opts ...grpc.CallOption) (*GetInvoicesResp, error) {
+	out := new(GetInvoicesResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetInvoices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) CreateScheduledPayment(ctx context.Context, in *CreateScheduledPaymentReq, opts ...grpc.CallOption) (*CreateScheduledPaymentResp, error) {
+	out := new(CreateScheduledPaymentResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/CreateScheduledPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) GetScheduledPayments(ctx context.Context, in *GetScheduledPaymentsReq, opts ...grpc.CallOption) (*GetScheduledPaymentsResp, error) {
+	out := new(GetScheduledPaymentsResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetScheduledPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) CancelScheduledPayment(ctx context.Context, in *CancelScheduledPaymentReq, opts ...grpc.CallOption) (*CancelScheduledPaymentResp, error) {
+	out := new(CancelScheduledPaymentResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/CancelScheduledPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) PayPeer(ctx context.Context, in *PayPeerReq, opts ...grpc.CallOption) (*PayPeerResp, error) {
+	out := new(PayPeerResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/PayPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) SendPayChUpdate(ctx context.Context, in *SendPayChUpdateReq, opts ...grpc.CallOption) (*SendPayChUpdateResp, error) {
+	out := new(SendPayChUpdateResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/SendPayChUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) PayInvoice(ctx context.Context, in *PayInvoiceReq, opts ...grpc.CallOption) (*PayInvoiceResp, error) {
+	out := new(PayInvoiceResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/PayInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) ProposeSwap(ctx context.Context, in *ProposeSwapReq, opts ...grpc.CallOption) (*ProposeSwapResp, error) {
+	out := new(ProposeSwapResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/ProposeSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) SubPayChUpdates(ctx context.Context, in *SubpayChUpdatesReq, opts ...grpc.CallOption) (Payment_API_SubPayChUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Payment_API_serviceDesc.Streams[2], "/pb.Payment_API/SubPayChUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &payment_APISubPayChUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Payment_API_SubPayChUpdatesClient interface {
+	Recv() (*SubPayChUpdatesResp, error)
+	grpc.ClientStream
+}
+
+type payment_APISubPayChUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *payment_APISubPayChUpdatesClient) Recv() (*SubPayChUpdatesResp, error) {
+	m := new(SubPayChUpdatesResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *payment_APIClient) UnsubPayChUpdates(ctx context.Context, in *UnsubPayChUpdatesReq, opts ...grpc.CallOption) (*UnsubPayChUpdatesResp, error) {
+	out := new(UnsubPayChUpdatesResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/UnsubPayChUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) RespondPayChUpdate(ctx context.Context, in *RespondPayChUpdateReq, opts ...grpc.CallOption) (*RespondPayChUpdateResp, error) {
+	out := new(RespondPayChUpdateResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/RespondPayChUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) GetPayChInfo(ctx context.Context, in *GetPayChInfoReq, opts ...grpc.CallOption) (*GetPayChInfoResp, error) {
+	out := new(GetPayChInfoResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetPayChInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) GetPayChHistory(ctx context.Context, in *GetPayChHistoryReq, opts ...grpc.CallOption) (*GetPayChHistoryResp, error) {
+	out := new(GetPayChHistoryResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetPayChHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) ExportPayChReceipt(ctx context.Context, in *ExportPayChReceiptReq, opts ...grpc.CallOption) (*ExportPayChReceiptResp, error) {
+	out := new(ExportPayChReceiptResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/ExportPayChReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) StartPayStream(ctx context.Context, in *StartPayStreamReq, opts ...grpc.CallOption) (*StartPayStreamResp, error) {
+	out := new(StartPayStreamResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/StartPayStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) PausePayStream(ctx context.Context, in *PausePayStreamReq, opts ...grpc.CallOption) (*PausePayStreamResp, error) {
+	out := new(PausePayStreamResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/PausePayStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) ResumePayStream(ctx context.Context, in *ResumePayStreamReq, opts ...grpc.CallOption) (*ResumePayStreamResp, error) {
+	out := new(ResumePayStreamResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/ResumePayStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payment_APIClient) StopPayStream(ctx context.Context, in *StopPayStreamReq, opts ...grpc.CallOption) (*StopPayStreamResp, error) {
+	out := new(StopPayStreamResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/StopPayStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[37].OneofWrappers = []interface{}{
-		(*SubPayChProposalsResp_Notify_)(nil),
-		(*SubPayChProposalsResp_Error)(nil),
+	return out, nil
+}
+
+func (c *payment_APIClient) GetPayStreams(ctx context.Context, in *GetPayStreamsReq, opts ...grpc.CallOption) (*GetPayStreamsResp, error) {
+	out := new(GetPayStreamsResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetPayStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[39].OneofWrappers = []interface{}{
-		(*UnsubPayChProposalsResp_MsgSuccess_)(nil),
-		(*UnsubPayChProposalsResp_Error)(nil),
+	return out, nil
+}
+
+func (c *payment_APIClient) SubPayStreams(ctx context.Context, in *SubPayStreamsReq, opts ...grpc.CallOption) (Payment_API_SubPayStreamsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Payment_API_serviceDesc.Streams[3], "/pb.Payment_API/SubPayStreams", opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[41].OneofWrappers = []interface{}{
-		(*RespondPayChProposalResp_MsgSuccess_)(nil),
-		(*RespondPayChProposalResp_Error)(nil),
+	x := &payment_APISubPayStreamsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[43].OneofWrappers = []interface{}{
-		(*CloseSessionResp_MsgSuccess_)(nil),
-		(*CloseSessionResp_Error)(nil),
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[45].OneofWrappers = []interface{}{
-		(*DeployAssetERC20Resp_MsgSuccess_)(nil),
-		(*DeployAssetERC20Resp_Error)(nil),
+	return x, nil
+}
+
+type Payment_API_SubPayStreamsClient interface {
+	Recv() (*SubPayStreamsResp, error)
+	grpc.ClientStream
+}
+
+type payment_APISubPayStreamsClient struct {
+	grpc.ClientStream
+}
+
+func (x *payment_APISubPayStreamsClient) Recv() (*SubPayStreamsResp, error) {
+	m := new(SubPayStreamsResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[47].OneofWrappers = []interface{}{
-		(*SendPayChUpdateResp_MsgSuccess_)(nil),
-		(*SendPayChUpdateResp_Error)(nil),
+	return m, nil
+}
+
+func (c *payment_APIClient) UnsubPayStreams(ctx context.Context, in *UnsubPayStreamsReq, opts ...grpc.CallOption) (*UnsubPayStreamsResp, error) {
+	out := new(UnsubPayStreamsResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/UnsubPayStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[49].OneofWrappers = []interface{}{
-		(*SubPayChUpdatesResp_Notify_)(nil),
-		(*SubPayChUpdatesResp_Error)(nil),
+	return out, nil
+}
+
+func (c *payment_APIClient) ClosePayCh(ctx context.Context, in *ClosePayChReq, opts ...grpc.CallOption) (*ClosePayChResp, error) {
+	out := new(ClosePayChResp)
+	err := c.cc.Invoke(ctx, "/pb.Payment_API/ClosePayCh", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[51].OneofWrappers = []interface{}{
-		(*UnsubPayChUpdatesResp_MsgSuccess_)(nil),
-		(*UnsubPayChUpdatesResp_Error)(nil),
+	return out, nil
+}
+
+// Payment_APIServer is the server API for Payment_API service.
+type Payment_APIServer interface {
+	GetConfig(context.Context, *GetConfigReq) (*GetConfigResp, error)
+	OpenSession(context.Context, *OpenSessionReq) (*OpenSessionResp, error)
+	Time(context.Context, *TimeReq) (*TimeResp, error)
+	RegisterCurrency(context.Context, *RegisterCurrencyReq) (*RegisterCurrencyResp, error)
+	Help(context.Context, *HelpReq) (*HelpResp, error)
+	AddPeerID(context.Context, *AddPeerIDReq) (*AddPeerIDResp, error)
+	GetPeerID(context.Context, *GetPeerIDReq) (*GetPeerIDResp, error)
+	ExportPeerIDs(context.Context, *ExportPeerIDsReq) (*ExportPeerIDsResp, error)
+	ImportPeerIDs(context.Context, *ImportPeerIDsReq) (*ImportPeerIDsResp, error)
+	GetPeerStatus(context.Context, *GetPeerStatusReq) (*GetPeerStatusResp, error)
+	SubPeerStatus(*SubPeerStatusReq, Payment_API_SubPeerStatusServer) error
+	UnsubPeerStatus(context.Context, *UnsubPeerStatusReq) (*UnsubPeerStatusResp, error)
+	OpenPayCh(context.Context, *OpenPayChReq) (*OpenPayChResp, error)
+	GetPayChsInfo(context.Context, *GetPayChsInfoReq) (*GetPayChsInfoResp, error)
+	SubPayChProposals(*SubPayChProposalsReq, Payment_API_SubPayChProposalsServer) error
+	UnsubPayChProposals(context.Context, *UnsubPayChProposalsReq) (*UnsubPayChProposalsResp, error)
+	RespondPayChProposal(context.Context, *RespondPayChProposalReq) (*RespondPayChProposalResp, error)
+	CloseSession(context.Context, *CloseSessionReq) (*CloseSessionResp, error)
+	DeployAssetERC20(context.Context, *DeployAssetERC20Req) (*DeployAssetERC20Resp, error)
+	GetOnChainBal(context.Context, *GetOnChainBalReq) (*GetOnChainBalResp, error)
+	TransferOnChain(context.Context, *TransferOnChainReq) (*TransferOnChainResp, error)
+	GetAllowance(context.Context, *GetAllowanceReq) (*GetAllowanceResp, error)
+	SetAllowance(context.Context, *SetAllowanceReq) (*SetAllowanceResp, error)
+	GetTxCostReport(context.Context, *GetTxCostReportReq) (*GetTxCostReportResp, error)
+	SetSpendingLimits(context.Context, *SetSpendingLimitsReq) (*SetSpendingLimitsResp, error)
+	GetSpendingLimits(context.Context, *GetSpendingLimitsReq) (*GetSpendingLimitsResp, error)
+	CreateInvoice(context.Context, *CreateInvoiceReq) (*CreateInvoiceResp, error)
+	GetInvoice(context.Context, *GetInvoiceReq) (*GetInvoiceResp, error)
+	GetInvoices(context.Context, *GetInvoicesReq) (*GetInvoicesResp, error)
+	CreateScheduledPayment(context.Context, *CreateScheduledPaymentReq) (*CreateScheduledPaymentResp, error)
+	GetScheduledPayments(context.Context, *GetScheduledPaymentsReq) (*GetScheduledPaymentsResp, error)
+	CancelScheduledPayment(context.Context, *CancelScheduledPaymentReq) (*CancelScheduledPaymentResp, error)
+	PayPeer(context.Context, *PayPeerReq) (*PayPeerResp, error)
+	SendPayChUpdate(context.Context, *SendPayChUpdateReq) (*SendPayChUpdateResp, error)
+	PayInvoice(context.Context, *PayInvoiceReq) (*PayInvoiceResp, error)
+	ProposeSwap(context.Context, *ProposeSwapReq) (*ProposeSwapResp, error)
+	SubPayChUpdates(*SubpayChUpdatesReq, Payment_API_SubPayChUpdatesServer) error
+	UnsubPayChUpdates(context.Context, *UnsubPayChUpdatesReq) (*UnsubPayChUpdatesResp, error)
+	RespondPayChUpdate(context.Context, *RespondPayChUpdateReq) (*RespondPayChUpdateResp, error)
+	GetPayChInfo(context.Context, *GetPayChInfoReq) (*GetPayChInfoResp, error)
+	GetPayChHistory(context.Context, *GetPayChHistoryReq) (*GetPayChHistoryResp, error)
+	ExportPayChReceipt(context.Context, *ExportPayChReceiptReq) (*ExportPayChReceiptResp, error)
+	StartPayStream(context.Context, *StartPayStreamReq) (*StartPayStreamResp, error)
+	PausePayStream(context.Context, *PausePayStreamReq) (*PausePayStreamResp, error)
+	ResumePayStream(context.Context, *ResumePayStreamReq) (*ResumePayStreamResp, error)
+	StopPayStream(context.Context, *StopPayStreamReq) (*StopPayStreamResp, error)
+	GetPayStreams(context.Context, *GetPayStreamsReq) (*GetPayStreamsResp, error)
+	SubPayStreams(*SubPayStreamsReq, Payment_API_SubPayStreamsServer) error
+	UnsubPayStreams(context.Context, *UnsubPayStreamsReq) (*UnsubPayStreamsResp, error)
+	ClosePayCh(context.Context, *ClosePayChReq) (*ClosePayChResp, error)
+}
+
+// UnimplementedPayment_APIServer can be embedded to have forward compatible implementations.
+type UnimplementedPayment_APIServer struct {
+}
+
+func (*UnimplementedPayment_APIServer) GetConfig(context.Context, *GetConfigReq) (*GetConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (*UnimplementedPayment_APIServer) OpenSession(context.Context, *OpenSessionReq) (*OpenSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenSession not implemented")
+}
+func (*UnimplementedPayment_APIServer) Time(context.Context, *TimeReq) (*TimeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Time not implemented")
+}
+func (*UnimplementedPayment_APIServer) RegisterCurrency(context.Context, *RegisterCurrencyReq) (*RegisterCurrencyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCurrency not implemented")
+}
+func (*UnimplementedPayment_APIServer) Help(context.Context, *HelpReq) (*HelpResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Help not implemented")
+}
+func (*UnimplementedPayment_APIServer) AddPeerID(context.Context, *AddPeerIDReq) (*AddPeerIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeerID not implemented")
+}
+func (*UnimplementedPayment_APIServer) GetPeerID(context.Context, *GetPeerIDReq) (*GetPeerIDResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerID not implemented")
+}
+func (*UnimplementedPayment_APIServer) ExportPeerIDs(context.Context, *ExportPeerIDsReq) (*ExportPeerIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPeerIDs not implemented")
+}
+func (*UnimplementedPayment_APIServer) ImportPeerIDs(context.Context, *ImportPeerIDsReq) (*ImportPeerIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPeerIDs not implemented")
+}
+func (*UnimplementedPayment_APIServer) GetPeerStatus(context.Context, *GetPeerStatusReq) (*GetPeerStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerStatus not implemented")
+}
+func (*UnimplementedPayment_APIServer) SubPeerStatus(*SubPeerStatusReq, Payment_API_SubPeerStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubPeerStatus not implemented")
+}
+func (*UnimplementedPayment_APIServer) UnsubPeerStatus(context.Context, *UnsubPeerStatusReq) (*UnsubPeerStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubPeerStatus not implemented")
+}
+func (*UnimplementedPayment_APIServer) OpenPayCh(context.Context, *OpenPayChReq) (*OpenPayChResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenPayCh not implemented")
+}
+func (*UnimplementedPayment_APIServer) GetPayChsInfo(context.Context, *GetPayChsInfoReq) (*GetPayChsInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayChsInfo not implemented")
+}
+func (*UnimplementedPayment_APIServer) SubPayChProposals(*SubPayChProposalsReq, Payment_API_SubPayChProposalsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubPayChProposals not implemented")
+}
+func (*UnimplementedPayment_APIServer) UnsubPayChProposals(context.Context, *UnsubPayChProposalsReq) (*UnsubPayChProposalsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubPayChProposals not implemented")
+}
+func (*UnimplementedPayment_APIServer) RespondPayChProposal(context.Context, *RespondPayChProposalReq) (*RespondPayChProposalResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondPayChProposal not implemented")
+}
+func (*UnimplementedPayment_APIServer) CloseSession(context.Context, *CloseSessionReq) (*CloseSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSession not implemented")
+}
+func (*UnimplementedPayment_APIServer) DeployAssetERC20(context.Context, *DeployAssetERC20Req) (*DeployAssetERC20Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployAssetERC20 not implemented")
+}
+func (*UnimplementedPayment_APIServer) GetOnChainBal(context.Context, *GetOnChainBalReq) (*GetOnChainBalResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnChainBal not implemented")
+}
+func (*UnimplementedPayment_APIServer) TransferOnChain(context.Context, *TransferOnChainReq) (*TransferOnChainResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOnChain not implemented")
+}
+func (*UnimplementedPayment_APIServer) GetAllowance(context.Context, *GetAllowanceReq) (*GetAllowanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllowance not implemented")
+}
+func (*UnimplementedPayment_APIServer) SetAllowance(context.Context, *SetAllowanceReq) (*SetAllowanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowance not implemented")
+}
+func (*UnimplementedPayment_APIServer) GetTxCostReport(context.Context, *GetTxCostReportReq) (*GetTxCostReportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxCostReport not implemented")
+}
+func (*UnimplementedPayment_APIServer) SetSpendingLimits(context.Context, *SetSpendingLimitsReq) (*SetSpendingLimitsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendingLimits not implemented")
+}
+func (*UnimplementedPayment_APIServer) GetSpendingLimits(context.Context, *GetSpendingLimitsReq) (*GetSpendingLimitsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendingLimits not implemented")
+}
+func (*UnimplementedPayment_APIServer) CreateInvoice(context.Context, *CreateInvoiceReq) (*CreateInvoiceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvoice not implemented")
+}
+func (*UnimplementedPayment_APIServer) GetInvoice(context.Context, *GetInvoiceReq) (*GetInvoiceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoice not implemented")
+}
+func (*UnimplementedPayment_APIServer) GetInvoices(context.Context, *GetInvoicesReq) (*GetInvoicesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoices not implemented")
+}
+func (*UnimplementedPayment_APIServer) CreateScheduledPayment(context.Context, *CreateScheduledPaymentReq) (*CreateScheduledPaymentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledPayment not implemented")
+}
+func (*UnimplementedPayment_APIServer) GetScheduledPayments(context.Context, *GetScheduledPaymentsReq) (*GetScheduledPaymentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledPayments not implemented")
+}
+func (*UnimplementedPayment_APIServer) CancelScheduledPayment(context.Context, *CancelScheduledPaymentReq) (*CancelScheduledPaymentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledPayment not implemented")
+}
+func (*UnimplementedPayment_APIServer) PayPeer(context.Context, *PayPeerReq) (*PayPeerResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayPeer not implemented")
+}
+func (*UnimplementedPayment_APIServer) SendPayChUpdate(context.Context, *SendPayChUpdateReq) (*SendPayChUpdateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPayChUpdate not implemented")
+}
+func (*UnimplementedPayment_APIServer) PayInvoice(context.Context, *PayInvoiceReq) (*PayInvoiceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayInvoice not implemented")
+}
+func (*UnimplementedPayment_APIServer) ProposeSwap(context.Context, *ProposeSwapReq) (*ProposeSwapResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeSwap not implemented")
+}
+func (*UnimplementedPayment_APIServer) SubPayChUpdates(*SubpayChUpdatesReq, Payment_API_SubPayChUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubPayChUpdates not implemented")
+}
+func (*UnimplementedPayment_APIServer) UnsubPayChUpdates(context.Context, *UnsubPayChUpdatesReq) (*UnsubPayChUpdatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubPayChUpdates not implemented")
+}
+func (*UnimplementedPayment_APIServer) RespondPayChUpdate(context.Context, *RespondPayChUpdateReq) (*RespondPayChUpdateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondPayChUpdate not implemented")
+}
+func (*UnimplementedPayment_APIServer) GetPayChInfo(context.Context, *GetPayChInfoReq) (*GetPayChInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayChInfo not implemented")
+}
+func (*UnimplementedPayment_APIServer) GetPayChHistory(context.Context, *GetPayChHistoryReq) (*GetPayChHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayChHistory not implemented")
+}
+func (*UnimplementedPayment_APIServer) ExportPayChReceipt(context.Context, *ExportPayChReceiptReq) (*ExportPayChReceiptResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPayChReceipt not implemented")
+}
+func (*UnimplementedPayment_APIServer) StartPayStream(context.Context, *StartPayStreamReq) (*StartPayStreamResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPayStream not implemented")
+}
+func (*UnimplementedPayment_APIServer) PausePayStream(context.Context, *PausePayStreamReq) (*PausePayStreamResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausePayStream not implemented")
+}
+func (*UnimplementedPayment_APIServer) ResumePayStream(context.Context, *ResumePayStreamReq) (*ResumePayStreamResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumePayStream not implemented")
+}
+func (*UnimplementedPayment_APIServer) StopPayStream(context.Context, *StopPayStreamReq) (*StopPayStreamResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopPayStream not implemented")
+}
+func (*UnimplementedPayment_APIServer) GetPayStreams(context.Context, *GetPayStreamsReq) (*GetPayStreamsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayStreams not implemented")
+}
+func (*UnimplementedPayment_APIServer) SubPayStreams(*SubPayStreamsReq, Payment_API_SubPayStreamsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubPayStreams not implemented")
+}
+func (*UnimplementedPayment_APIServer) UnsubPayStreams(context.Context, *UnsubPayStreamsReq) (*UnsubPayStreamsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubPayStreams not implemented")
+}
+func (*UnimplementedPayment_APIServer) ClosePayCh(context.Context, *ClosePayChReq) (*ClosePayChResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePayCh not implemented")
+}
+
+func RegisterPayment_APIServer(s *grpc.Server, srv Payment_APIServer) {
+	s.RegisterService(&_Payment_API_serviceDesc, srv)
+}
+
+func _Payment_API_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	file_api_proto_msgTypes[53].OneofWrappers = []interface{}{
-		(*RespondPayChUpdateResp_MsgSuccess_)(nil),
-		(*RespondPayChUpdateResp_Error)(nil),
+	if interceptor == nil {
+		return srv.(Payment_APIServer).GetConfig(ctx, in)
 	}
-	file_api_proto_msgTypes[55].OneofWrappers = []interface{}{
-		(*GetPayChInfoResp_MsgSuccess_)(nil),
-		(*GetPayChInfoResp_Error)(nil),
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/GetConfig",
 	}
-	file_api_proto_msgTypes[57].OneofWrappers = []interface{}{
-		(*ClosePayChResp_MsgSuccess_)(nil),
-		(*ClosePayChResp_Error)(nil),
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).GetConfig(ctx, req.(*GetConfigReq))
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_api_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   76,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_api_proto_goTypes,
-		DependencyIndexes: file_api_proto_depIdxs,
-		EnumInfos:         file_api_proto_enumTypes,
-		MessageInfos:      file_api_proto_msgTypes,
-	}.Build()
-	File_api_proto = out.File
-	file_api_proto_rawDesc = nil
-	file_api_proto_goTypes = nil
-	file_api_proto_depIdxs = nil
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
-
-// Payment_APIClient is the client API for Payment_API service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type Payment_APIClient interface {
-	GetConfig(ctx context.Context, in *GetConfigReq, opts ...grpc.CallOption) (*GetConfigResp, error)
-	OpenSession(ctx context.Context, in *OpenSessionReq, opts ...grpc.CallOption) (*OpenSessionResp, error)
-	Time(ctx context.Context, in *TimeReq, opts ...grpc.CallOption) (*TimeResp, error)
-	RegisterCurrency(ctx context.Context, in *RegisterCurrencyReq, opts ...grpc.CallOption) (*RegisterCurrencyResp, error)
-	Help(ctx context.Context, in *HelpReq, opts ...grpc.CallOption) (*HelpResp, error)
-	AddPeerID(ctx context.Context, in *AddPeerIDReq, opts ...grpc.CallOption) (*AddPeerIDResp, error)
-	GetPeerID(ctx context.Context, in *GetPeerIDReq, opts ...grpc.CallOption) (*GetPeerIDResp, error)
-	OpenPayCh(ctx context.Context, in *OpenPayChReq, opts ...grpc.CallOption) (*OpenPayChResp, error)
-	GetPayChsInfo(ctx context.Context, in *GetPayChsInfoReq, opts ...grpc.CallOption) (*GetPayChsInfoResp, error)
-	SubPayChProposals(ctx context.Context, in *SubPayChProposalsReq, opts ...grpc.CallOption) (Payment_API_SubPayChProposalsClient, error)
-	UnsubPayChProposals(ctx context.Context, in *UnsubPayChProposalsReq, opts ...grpc.CallOption) (*UnsubPayChProposalsResp, error)
-	RespondPayChProposal(ctx context.Context, in *RespondPayChProposalReq, opts ...grpc.CallOption) (*RespondPayChProposalResp, error)
-	CloseSession(ctx context.Context, in *CloseSessionReq, opts ...grpc.CallOption) (*CloseSessionResp, error)
-	DeployAssetERC20(ctx context.Context, in *DeployAssetERC20Req, opts ...grpc.CallOption) (*DeployAssetERC20Resp, error)
-	SendPayChUpdate(ctx context.Context, in *SendPayChUpdateReq, opts ...grpc.CallOption) (*SendPayChUpdateResp, error)
-	SubPayChUpdates(ctx context.Context, in *SubpayChUpdatesReq, opts ...grpc.CallOption) (Payment_API_SubPayChUpdatesClient, error)
-	UnsubPayChUpdates(ctx context.Context, in *UnsubPayChUpdatesReq, opts ...grpc.CallOption) (*UnsubPayChUpdatesResp, error)
-	RespondPayChUpdate(ctx context.Context, in *RespondPayChUpdateReq, opts ...grpc.CallOption) (*RespondPayChUpdateResp, error)
-	GetPayChInfo(ctx context.Context, in *GetPayChInfoReq, opts ...grpc.CallOption) (*GetPayChInfoResp, error)
-	ClosePayCh(ctx context.Context, in *ClosePayChReq, opts ...grpc.CallOption) (*ClosePayChResp, error)
+func _Payment_API_OpenSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).OpenSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/OpenSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).OpenSession(ctx, req.(*OpenSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type payment_APIClient struct {
-	cc grpc.ClientConnInterface
+func _Payment_API_Time_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).Time(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/Time",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).Time(ctx, req.(*TimeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewPayment_APIClient(cc grpc.ClientConnInterface) Payment_APIClient {
-	return &payment_APIClient{cc}
+func _Payment_API_RegisterCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCurrencyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).RegisterCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/RegisterCurrency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).RegisterCurrency(ctx, req.(*RegisterCurrencyReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) GetConfig(ctx context.Context, in *GetConfigReq, opts ...grpc.CallOption) (*GetConfigResp, error) {
-	out := new(GetConfigResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetConfig", in, out, opts...)
-	if err != nil {
+func _Payment_API_Help_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HelpReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).Help(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/Help",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).Help(ctx, req.(*HelpReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) OpenSession(ctx context.Context, in *OpenSessionReq, opts ...grpc.CallOption) (*OpenSessionResp, error) {
-	out := new(OpenSessionResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/OpenSession", in, out, opts...)
-	if err != nil {
+func _Payment_API_AddPeerID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPeerIDReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).AddPeerID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/AddPeerID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).AddPeerID(ctx, req.(*AddPeerIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) Time(ctx context.Context, in *TimeReq, opts ...grpc.CallOption) (*TimeResp, error) {
-	out := new(TimeResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/Time", in, out, opts...)
-	if err != nil {
+func _Payment_API_GetPeerID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerIDReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).GetPeerID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/GetPeerID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).GetPeerID(ctx, req.(*GetPeerIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) RegisterCurrency(ctx context.Context, in *RegisterCurrencyReq, opts ...grpc.CallOption) (*RegisterCurrencyResp, error) {
-	out := new(RegisterCurrencyResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/RegisterCurrency", in, out, opts...)
-	if err != nil {
+func _Payment_API_ExportPeerIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPeerIDsReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).ExportPeerIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/ExportPeerIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).ExportPeerIDs(ctx, req.(*ExportPeerIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) Help(ctx context.Context, in *HelpReq, opts ...grpc.CallOption) (*HelpResp, error) {
-	out := new(HelpResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/Help", in, out, opts...)
-	if err != nil {
+func _Payment_API_ImportPeerIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPeerIDsReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).ImportPeerIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/ImportPeerIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).ImportPeerIDs(ctx, req.(*ImportPeerIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) AddPeerID(ctx context.Context, in *AddPeerIDReq, opts ...grpc.CallOption) (*AddPeerIDResp, error) {
-	out := new(AddPeerIDResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/AddPeerID", in, out, opts...)
-	if err != nil {
+func _Payment_API_GetPeerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerStatusReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).GetPeerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/GetPeerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).GetPeerStatus(ctx, req.(*GetPeerStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) GetPeerID(ctx context.Context, in *GetPeerIDReq, opts ...grpc.CallOption) (*GetPeerIDResp, error) {
-	out := new(GetPeerIDResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetPeerID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _Payment_API_SubPeerStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubPeerStatusReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(Payment_APIServer).SubPeerStatus(m, &payment_APISubPeerStatusServer{stream})
 }
 
-func (c *payment_APIClient) OpenPayCh(ctx context.Context, in *OpenPayChReq, opts ...grpc.CallOption) (*OpenPayChResp, error) {
-	out := new(OpenPayChResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/OpenPayCh", in, out, opts...)
-	if err != nil {
+type Payment_API_SubPeerStatusServer interface {
+	Send(*SubPeerStatusResp) error
+	grpc.ServerStream
+}
+
+type payment_APISubPeerStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *payment_APISubPeerStatusServer) Send(m *SubPeerStatusResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Payment_API_UnsubPeerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubPeerStatusReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).UnsubPeerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/UnsubPeerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).UnsubPeerStatus(ctx, req.(*UnsubPeerStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) GetPayChsInfo(ctx context.Context, in *GetPayChsInfoReq, opts ...grpc.CallOption) (*GetPayChsInfoResp, error) {
-	out := new(GetPayChsInfoResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetPayChsInfo", in, out, opts...)
-	if err != nil {
+func _Payment_API_OpenPayCh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenPayChReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).OpenPayCh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/OpenPayCh",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).OpenPayCh(ctx, req.(*OpenPayChReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) SubPayChProposals(ctx context.Context, in *SubPayChProposalsReq, opts ...grpc.CallOption) (Payment_API_SubPayChProposalsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Payment_API_serviceDesc.Streams[0], "/pb.Payment_API/SubPayChProposals", opts...)
-	if err != nil {
+func _Payment_API_GetPayChsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayChsInfoReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &payment_APISubPayChProposalsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(Payment_APIServer).GetPayChsInfo(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/GetPayChsInfo",
 	}
-	return x, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).GetPayChsInfo(ctx, req.(*GetPayChsInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Payment_API_SubPayChProposalsClient interface {
-	Recv() (*SubPayChProposalsResp, error)
-	grpc.ClientStream
+func _Payment_API_SubPayChProposals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubPayChProposalsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Payment_APIServer).SubPayChProposals(m, &payment_APISubPayChProposalsServer{stream})
 }
 
-type payment_APISubPayChProposalsClient struct {
-	grpc.ClientStream
+type Payment_API_SubPayChProposalsServer interface {
+	Send(*SubPayChProposalsResp) error
+	grpc.ServerStream
 }
 
-func (x *payment_APISubPayChProposalsClient) Recv() (*SubPayChProposalsResp, error) {
-	m := new(SubPayChProposalsResp)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+type payment_APISubPayChProposalsServer struct {
+	grpc.ServerStream
 }
 
-func (c *payment_APIClient) UnsubPayChProposals(ctx context.Context, in *UnsubPayChProposalsReq, opts ...grpc.CallOption) (*UnsubPayChProposalsResp, error) {
-	out := new(UnsubPayChProposalsResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/UnsubPayChProposals", in, out, opts...)
-	if err != nil {
+func (x *payment_APISubPayChProposalsServer) Send(m *SubPayChProposalsResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Payment_API_UnsubPayChProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubPayChProposalsReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).UnsubPayChProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/UnsubPayChProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).UnsubPayChProposals(ctx, req.(*UnsubPayChProposalsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) RespondPayChProposal(ctx context.Context, in *RespondPayChProposalReq, opts ...grpc.CallOption) (*RespondPayChProposalResp, error) {
-	out := new(RespondPayChProposalResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/RespondPayChProposal", in, out, opts...)
-	if err != nil {
+func _Payment_API_RespondPayChProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondPayChProposalReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).RespondPayChProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/RespondPayChProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).RespondPayChProposal(ctx, req.(*RespondPayChProposalReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) CloseSession(ctx context.Context, in *CloseSessionReq, opts ...grpc.CallOption) (*CloseSessionResp, error) {
-	out := new(CloseSessionResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/CloseSession", in, out, opts...)
-	if err != nil {
+func _Payment_API_CloseSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseSessionReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).CloseSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/CloseSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).CloseSession(ctx, req.(*CloseSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) DeployAssetERC20(ctx context.Context, in *DeployAssetERC20Req, opts ...grpc.CallOption) (*DeployAssetERC20Resp, error) {
-	out := new(DeployAssetERC20Resp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/DeployAssetERC20", in, out, opts...)
-	if err != nil {
+func _Payment_API_DeployAssetERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployAssetERC20Req)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).DeployAssetERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/DeployAssetERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).DeployAssetERC20(ctx, req.(*DeployAssetERC20Req))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) SendPayChUpdate(ctx context.Context, in *SendPayChUpdateReq, opts ...grpc.CallOption) (*SendPayChUpdateResp, error) {
-	out := new(SendPayChUpdateResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/SendPayChUpdate", in, out, opts...)
-	if err != nil {
+func _Payment_API_GetOnChainBal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOnChainBalReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).GetOnChainBal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/GetOnChainBal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).GetOnChainBal(ctx, req.(*GetOnChainBalReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) SubPayChUpdates(ctx context.Context, in *SubpayChUpdatesReq, opts ...grpc.CallOption) (Payment_API_SubPayChUpdatesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Payment_API_serviceDesc.Streams[1], "/pb.Payment_API/SubPayChUpdates", opts...)
-	if err != nil {
+func _Payment_API_TransferOnChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOnChainReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &payment_APISubPayChUpdatesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(Payment_APIServer).TransferOnChain(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/TransferOnChain",
 	}
-	return x, nil
-}
-
-type Payment_API_SubPayChUpdatesClient interface {
-	Recv() (*SubPayChUpdatesResp, error)
-	grpc.ClientStream
-}
-
-type payment_APISubPayChUpdatesClient struct {
-	grpc.ClientStream
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).TransferOnChain(ctx, req.(*TransferOnChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *payment_APISubPayChUpdatesClient) Recv() (*SubPayChUpdatesResp, error) {
-	m := new(SubPayChUpdatesResp)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _Payment_API_GetAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllowanceReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).GetAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/GetAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).GetAllowance(ctx, req.(*GetAllowanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) UnsubPayChUpdates(ctx context.Context, in *UnsubPayChUpdatesReq, opts ...grpc.CallOption) (*UnsubPayChUpdatesResp, error) {
-	out := new(UnsubPayChUpdatesResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/UnsubPayChUpdates", in, out, opts...)
-	if err != nil {
+func _Payment_API_SetAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAllowanceReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).SetAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/SetAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).SetAllowance(ctx, req.(*SetAllowanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) RespondPayChUpdate(ctx context.Context, in *RespondPayChUpdateReq, opts ...grpc.CallOption) (*RespondPayChUpdateResp, error) {
-	out := new(RespondPayChUpdateResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/RespondPayChUpdate", in, out, opts...)
-	if err != nil {
+func _Payment_API_GetTxCostReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxCostReportReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).GetTxCostReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/GetTxCostReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).GetTxCostReport(ctx, req.(*GetTxCostReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) GetPayChInfo(ctx context.Context, in *GetPayChInfoReq, opts ...grpc.CallOption) (*GetPayChInfoResp, error) {
-	out := new(GetPayChInfoResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/GetPayChInfo", in, out, opts...)
-	if err != nil {
+func _Payment_API_SetSpendingLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSpendingLimitsReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(Payment_APIServer).SetSpendingLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/SetSpendingLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).SetSpendingLimits(ctx, req.(*SetSpendingLimitsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *payment_APIClient) ClosePayCh(ctx context.Context, in *ClosePayChReq, opts ...grpc.CallOption) (*ClosePayChResp, error) {
-	out := new(ClosePayChResp)
-	err := c.cc.Invoke(ctx, "/pb.Payment_API/ClosePayCh", in, out, opts...)
-	if err != nil {
+func _Payment_API_GetSpendingLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingLimitsReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// Payment_APIServer is the server API for Payment_API service.
-type Payment_APIServer interface {
-	GetConfig(context.Context, *GetConfigReq) (*GetConfigResp, error)
-	OpenSession(context.Context, *OpenSessionReq) (*OpenSessionResp, error)
-	Time(context.Context, *TimeReq) (*TimeResp, error)
-	RegisterCurrency(context.Context, *RegisterCurrencyReq) (*RegisterCurrencyResp, error)
-	Help(context.Context, *HelpReq) (*HelpResp, error)
-	AddPeerID(context.Context, *AddPeerIDReq) (*AddPeerIDResp, error)
-	GetPeerID(context.Context, *GetPeerIDReq) (*GetPeerIDResp, error)
-	OpenPayCh(context.Context, *OpenPayChReq) (*OpenPayChResp, error)
-	GetPayChsInfo(context.Context, *GetPayChsInfoReq) (*GetPayChsInfoResp, error)
-	SubPayChProposals(*SubPayChProposalsReq, Payment_API_SubPayChProposalsServer) error
-	UnsubPayChProposals(context.Context, *UnsubPayChProposalsReq) (*UnsubPayChProposalsResp, error)
-	RespondPayChProposal(context.Context, *RespondPayChProposalReq) (*RespondPayChProposalResp, error)
-	CloseSession(context.Context, *CloseSessionReq) (*CloseSessionResp, error)
-	DeployAssetERC20(context.Context, *DeployAssetERC20Req) (*DeployAssetERC20Resp, error)
-	SendPayChUpdate(context.Context, *SendPayChUpdateReq) (*SendPayChUpdateResp, error)
-	SubPayChUpdates(*SubpayChUpdatesReq, Payment_API_SubPayChUpdatesServer) error
-	UnsubPayChUpdates(context.Context, *UnsubPayChUpdatesReq) (*UnsubPayChUpdatesResp, error)
-	RespondPayChUpdate(context.Context, *RespondPayChUpdateReq) (*RespondPayChUpdateResp, error)
-	GetPayChInfo(context.Context, *GetPayChInfoReq) (*GetPayChInfoResp, error)
-	ClosePayCh(context.Context, *ClosePayChReq) (*ClosePayChResp, error)
-}
-
-// UnimplementedPayment_APIServer can be embedded to have forward compatible implementations.
-type UnimplementedPayment_APIServer struct {
-}
-
-func (*UnimplementedPayment_APIServer) GetConfig(context.Context, *GetConfigReq) (*GetConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
-}
-func (*UnimplementedPayment_APIServer) OpenSession(context.Context, *OpenSessionReq) (*OpenSessionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OpenSession not implemented")
-}
-func (*UnimplementedPayment_APIServer) Time(context.Context, *TimeReq) (*TimeResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Time not implemented")
-}
-func (*UnimplementedPayment_APIServer) RegisterCurrency(context.Context, *RegisterCurrencyReq) (*RegisterCurrencyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterCurrency not implemented")
-}
-func (*UnimplementedPayment_APIServer) Help(context.Context, *HelpReq) (*HelpResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Help not implemented")
-}
-func (*UnimplementedPayment_APIServer) AddPeerID(context.Context, *AddPeerIDReq) (*AddPeerIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPeerID not implemented")
-}
-func (*UnimplementedPayment_APIServer) GetPeerID(context.Context, *GetPeerIDReq) (*GetPeerIDResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPeerID not implemented")
-}
-func (*UnimplementedPayment_APIServer) OpenPayCh(context.Context, *OpenPayChReq) (*OpenPayChResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OpenPayCh not implemented")
-}
-func (*UnimplementedPayment_APIServer) GetPayChsInfo(context.Context, *GetPayChsInfoReq) (*GetPayChsInfoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPayChsInfo not implemented")
-}
-func (*UnimplementedPayment_APIServer) SubPayChProposals(*SubPayChProposalsReq, Payment_API_SubPayChProposalsServer) error {
-	return status.Errorf(codes.Unimplemented, "method SubPayChProposals not implemented")
-}
-func (*UnimplementedPayment_APIServer) UnsubPayChProposals(context.Context, *UnsubPayChProposalsReq) (*UnsubPayChProposalsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsubPayChProposals not implemented")
-}
-func (*UnimplementedPayment_APIServer) RespondPayChProposal(context.Context, *RespondPayChProposalReq) (*RespondPayChProposalResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RespondPayChProposal not implemented")
-}
-func (*UnimplementedPayment_APIServer) CloseSession(context.Context, *CloseSessionReq) (*CloseSessionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseSession not implemented")
-}
-func (*UnimplementedPayment_APIServer) DeployAssetERC20(context.Context, *DeployAssetERC20Req) (*DeployAssetERC20Resp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeployAssetERC20 not implemented")
-}
-func (*UnimplementedPayment_APIServer) SendPayChUpdate(context.Context, *SendPayChUpdateReq) (*SendPayChUpdateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendPayChUpdate not implemented")
-}
-func (*UnimplementedPayment_APIServer) SubPayChUpdates(*SubpayChUpdatesReq, Payment_API_SubPayChUpdatesServer) error {
-	return status.Errorf(codes.Unimplemented, "method SubPayChUpdates not implemented")
-}
-func (*UnimplementedPayment_APIServer) UnsubPayChUpdates(context.Context, *UnsubPayChUpdatesReq) (*UnsubPayChUpdatesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsubPayChUpdates not implemented")
-}
-func (*UnimplementedPayment_APIServer) RespondPayChUpdate(context.Context, *RespondPayChUpdateReq) (*RespondPayChUpdateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RespondPayChUpdate not implemented")
-}
-func (*UnimplementedPayment_APIServer) GetPayChInfo(context.Context, *GetPayChInfoReq) (*GetPayChInfoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPayChInfo not implemented")
-}
-func (*UnimplementedPayment_APIServer) ClosePayCh(context.Context, *ClosePayChReq) (*ClosePayChResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClosePayCh not implemented")
+	if interceptor == nil {
+		return srv.(Payment_APIServer).GetSpendingLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/GetSpendingLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).GetSpendingLimits(ctx, req.(*GetSpendingLimitsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterPayment_APIServer(s *grpc.Server, srv Payment_APIServer) {
-	s.RegisterService(&_Payment_API_serviceDesc, srv)
+func _Payment_API_CreateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvoiceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).CreateInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/CreateInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).CreateInvoice(ctx, req.(*CreateInvoiceReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigReq)
+func _Payment_API_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).GetConfig(ctx, in)
+		return srv.(Payment_APIServer).GetInvoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/GetConfig",
+		FullMethod: "/pb.Payment_API/GetInvoice",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).GetConfig(ctx, req.(*GetConfigReq))
+		return srv.(Payment_APIServer).GetInvoice(ctx, req.(*GetInvoiceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_OpenSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OpenSessionReq)
+func _Payment_API_GetInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoicesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).OpenSession(ctx, in)
+		return srv.(Payment_APIServer).GetInvoices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/OpenSession",
+		FullMethod: "/pb.Payment_API/GetInvoices",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).OpenSession(ctx, req.(*OpenSessionReq))
+		return srv.(Payment_APIServer).GetInvoices(ctx, req.(*GetInvoicesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_Time_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TimeReq)
+func _Payment_API_CreateScheduledPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledPaymentReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).Time(ctx, in)
+		return srv.(Payment_APIServer).CreateScheduledPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/Time",
+		FullMethod: "/pb.Payment_API/CreateScheduledPayment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).Time(ctx, req.(*TimeReq))
+		return srv.(Payment_APIServer).CreateScheduledPayment(ctx, req.(*CreateScheduledPaymentReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_RegisterCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterCurrencyReq)
+func _Payment_API_GetScheduledPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledPaymentsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).RegisterCurrency(ctx, in)
+		return srv.(Payment_APIServer).GetScheduledPayments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/RegisterCurrency",
+		FullMethod: "/pb.Payment_API/GetScheduledPayments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).RegisterCurrency(ctx, req.(*RegisterCurrencyReq))
+		return srv.(Payment_APIServer).GetScheduledPayments(ctx, req.(*GetScheduledPaymentsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_Help_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HelpReq)
+func _Payment_API_CancelScheduledPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledPaymentReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).Help(ctx, in)
+		return srv.(Payment_APIServer).CancelScheduledPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/Help",
+		FullMethod: "/pb.Payment_API/CancelScheduledPayment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).Help(ctx, req.(*HelpReq))
+		return srv.(Payment_APIServer).CancelScheduledPayment(ctx, req.(*CancelScheduledPaymentReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_AddPeerID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPeerIDReq)
+func _Payment_API_PayPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayPeerReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).AddPeerID(ctx, in)
+		return srv.(Payment_APIServer).PayPeer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/AddPeerID",
+		FullMethod: "/pb.Payment_API/PayPeer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).AddPeerID(ctx, req.(*AddPeerIDReq))
+		return srv.(Payment_APIServer).PayPeer(ctx, req.(*PayPeerReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_GetPeerID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPeerIDReq)
+func _Payment_API_SendPayChUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPayChUpdateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).GetPeerID(ctx, in)
+		return srv.(Payment_APIServer).SendPayChUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/GetPeerID",
+		FullMethod: "/pb.Payment_API/SendPayChUpdate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).GetPeerID(ctx, req.(*GetPeerIDReq))
+		return srv.(Payment_APIServer).SendPayChUpdate(ctx, req.(*SendPayChUpdateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_OpenPayCh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OpenPayChReq)
+func _Payment_API_PayInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayInvoiceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).OpenPayCh(ctx, in)
+		return srv.(Payment_APIServer).PayInvoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/OpenPayCh",
+		FullMethod: "/pb.Payment_API/PayInvoice",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).OpenPayCh(ctx, req.(*OpenPayChReq))
+		return srv.(Payment_APIServer).PayInvoice(ctx, req.(*PayInvoiceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_GetPayChsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPayChsInfoReq)
+func _Payment_API_ProposeSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeSwapReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).GetPayChsInfo(ctx, in)
+		return srv.(Payment_APIServer).ProposeSwap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/GetPayChsInfo",
+		FullMethod: "/pb.Payment_API/ProposeSwap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).GetPayChsInfo(ctx, req.(*GetPayChsInfoReq))
+		return srv.(Payment_APIServer).ProposeSwap(ctx, req.(*ProposeSwapReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_SubPayChProposals_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubPayChProposalsReq)
+func _Payment_API_SubPayChUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubpayChUpdatesReq)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(Payment_APIServer).SubPayChProposals(m, &payment_APISubPayChProposalsServer{stream})
+	return srv.(Payment_APIServer).SubPayChUpdates(m, &payment_APISubPayChUpdatesServer{stream})
 }
 
-type Payment_API_SubPayChProposalsServer interface {
-	Send(*SubPayChProposalsResp) error
+type Payment_API_SubPayChUpdatesServer interface {
+	Send(*SubPayChUpdatesResp) error
 	grpc.ServerStream
 }
 
-type payment_APISubPayChProposalsServer struct {
+type payment_APISubPayChUpdatesServer struct {
 	grpc.ServerStream
 }
 
-func (x *payment_APISubPayChProposalsServer) Send(m *SubPayChProposalsResp) error {
+func (x *payment_APISubPayChUpdatesServer) Send(m *SubPayChUpdatesResp) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Payment_API_UnsubPayChProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsubPayChProposalsReq)
+func _Payment_API_UnsubPayChUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubPayChUpdatesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).UnsubPayChProposals(ctx, in)
+		return srv.(Payment_APIServer).UnsubPayChUpdates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/UnsubPayChProposals",
+		FullMethod: "/pb.Payment_API/UnsubPayChUpdates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).UnsubPayChProposals(ctx, req.(*UnsubPayChProposalsReq))
+		return srv.(Payment_APIServer).UnsubPayChUpdates(ctx, req.(*UnsubPayChUpdatesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_RespondPayChProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RespondPayChProposalReq)
+func _Payment_API_RespondPayChUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondPayChUpdateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).RespondPayChProposal(ctx, in)
+		return srv.(Payment_APIServer).RespondPayChUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/RespondPayChProposal",
+		FullMethod: "/pb.Payment_API/RespondPayChUpdate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).RespondPayChProposal(ctx, req.(*RespondPayChProposalReq))
+		return srv.(Payment_APIServer).RespondPayChUpdate(ctx, req.(*RespondPayChUpdateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_CloseSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CloseSessionReq)
+func _Payment_API_GetPayChInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayChInfoReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).CloseSession(ctx, in)
+		return srv.(Payment_APIServer).GetPayChInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/CloseSession",
+		FullMethod: "/pb.Payment_API/GetPayChInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).CloseSession(ctx, req.(*CloseSessionReq))
+		return srv.(Payment_APIServer).GetPayChInfo(ctx, req.(*GetPayChInfoReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_DeployAssetERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeployAssetERC20Req)
+func _Payment_API_GetPayChHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayChHistoryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).DeployAssetERC20(ctx, in)
+		return srv.(Payment_APIServer).GetPayChHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/DeployAssetERC20",
+		FullMethod: "/pb.Payment_API/GetPayChHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).DeployAssetERC20(ctx, req.(*DeployAssetERC20Req))
+		return srv.(Payment_APIServer).GetPayChHistory(ctx, req.(*GetPayChHistoryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_SendPayChUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendPayChUpdateReq)
+func _Payment_API_ExportPayChReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPayChReceiptReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).SendPayChUpdate(ctx, in)
+		return srv.(Payment_APIServer).ExportPayChReceipt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/SendPayChUpdate",
+		FullMethod: "/pb.Payment_API/ExportPayChReceipt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).SendPayChUpdate(ctx, req.(*SendPayChUpdateReq))
+		return srv.(Payment_APIServer).ExportPayChReceipt(ctx, req.(*ExportPayChReceiptReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_SubPayChUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubpayChUpdatesReq)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Payment_API_StartPayStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPayStreamReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(Payment_APIServer).SubPayChUpdates(m, &payment_APISubPayChUpdatesServer{stream})
-}
-
-type Payment_API_SubPayChUpdatesServer interface {
-	Send(*SubPayChUpdatesResp) error
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(Payment_APIServer).StartPayStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/StartPayStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).StartPayStream(ctx, req.(*StartPayStreamReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type payment_APISubPayChUpdatesServer struct {
-	grpc.ServerStream
+func _Payment_API_PausePayStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PausePayStreamReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).PausePayStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/PausePayStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).PausePayStream(ctx, req.(*PausePayStreamReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *payment_APISubPayChUpdatesServer) Send(m *SubPayChUpdatesResp) error {
-	return x.ServerStream.SendMsg(m)
+func _Payment_API_ResumePayStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumePayStreamReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Payment_APIServer).ResumePayStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Payment_API/ResumePayStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Payment_APIServer).ResumePayStream(ctx, req.(*ResumePayStreamReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_UnsubPayChUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsubPayChUpdatesReq)
+func _Payment_API_StopPayStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopPayStreamReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).UnsubPayChUpdates(ctx, in)
+		return srv.(Payment_APIServer).StopPayStream(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/UnsubPayChUpdates",
+		FullMethod: "/pb.Payment_API/StopPayStream",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).UnsubPayChUpdates(ctx, req.(*UnsubPayChUpdatesReq))
+		return srv.(Payment_APIServer).StopPayStream(ctx, req.(*StopPayStreamReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_RespondPayChUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RespondPayChUpdateReq)
+func _Payment_API_GetPayStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayStreamsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).RespondPayChUpdate(ctx, in)
+		return srv.(Payment_APIServer).GetPayStreams(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/RespondPayChUpdate",
+		FullMethod: "/pb.Payment_API/GetPayStreams",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).RespondPayChUpdate(ctx, req.(*RespondPayChUpdateReq))
+		return srv.(Payment_APIServer).GetPayStreams(ctx, req.(*GetPayStreamsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Payment_API_GetPayChInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPayChInfoReq)
+func _Payment_API_SubPayStreams_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubPayStreamsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Payment_APIServer).SubPayStreams(m, &payment_APISubPayStreamsServer{stream})
+}
+
+type Payment_API_SubPayStreamsServer interface {
+	Send(*SubPayStreamsResp) error
+	grpc.ServerStream
+}
+
+type payment_APISubPayStreamsServer struct {
+	grpc.ServerStream
+}
+
+func (x *payment_APISubPayStreamsServer) Send(m *SubPayStreamsResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Payment_API_UnsubPayStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubPayStreamsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(Payment_APIServer).GetPayChInfo(ctx, in)
+		return srv.(Payment_APIServer).UnsubPayStreams(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Payment_API/GetPayChInfo",
+		FullMethod: "/pb.Payment_API/UnsubPayStreams",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(Payment_APIServer).GetPayChInfo(ctx, req.(*GetPayChInfoReq))
+		return srv.(Payment_APIServer).UnsubPayStreams(ctx, req.(*UnsubPayStreamsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -7568,6 +18589,22 @@ var _Payment_API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPeerID",
 			Handler:    _Payment_API_GetPeerID_Handler,
 		},
+		{
+			MethodName: "ExportPeerIDs",
+			Handler:    _Payment_API_ExportPeerIDs_Handler,
+		},
+		{
+			MethodName: "ImportPeerIDs",
+			Handler:    _Payment_API_ImportPeerIDs_Handler,
+		},
+		{
+			MethodName: "GetPeerStatus",
+			Handler:    _Payment_API_GetPeerStatus_Handler,
+		},
+		{
+			MethodName: "UnsubPeerStatus",
+			Handler:    _Payment_API_UnsubPeerStatus_Handler,
+		},
 		{
 			MethodName: "OpenPayCh",
 			Handler:    _Payment_API_OpenPayCh_Handler,
@@ -7592,10 +18629,74 @@ var _Payment_API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeployAssetERC20",
 			Handler:    _Payment_API_DeployAssetERC20_Handler,
 		},
+		{
+			MethodName: "GetOnChainBal",
+			Handler:    _Payment_API_GetOnChainBal_Handler,
+		},
+		{
+			MethodName: "TransferOnChain",
+			Handler:    _Payment_API_TransferOnChain_Handler,
+		},
+		{
+			MethodName: "GetAllowance",
+			Handler:    _Payment_API_GetAllowance_Handler,
+		},
+		{
+			MethodName: "SetAllowance",
+			Handler:    _Payment_API_SetAllowance_Handler,
+		},
+		{
+			MethodName: "GetTxCostReport",
+			Handler:    _Payment_API_GetTxCostReport_Handler,
+		},
+		{
+			MethodName: "SetSpendingLimits",
+			Handler:    _Payment_API_SetSpendingLimits_Handler,
+		},
+		{
+			MethodName: "GetSpendingLimits",
+			Handler:    _Payment_API_GetSpendingLimits_Handler,
+		},
+		{
+			MethodName: "CreateInvoice",
+			Handler:    _Payment_API_CreateInvoice_Handler,
+		},
+		{
+			MethodName: "GetInvoice",
+			Handler:    _Payment_API_GetInvoice_Handler,
+		},
+		{
+			MethodName: "GetInvoices",
+			Handler:    _Payment_API_GetInvoices_Handler,
+		},
+		{
+			MethodName: "CreateScheduledPayment",
+			Handler:    _Payment_API_CreateScheduledPayment_Handler,
+		},
+		{
+			MethodName: "GetScheduledPayments",
+			Handler:    _Payment_API_GetScheduledPayments_Handler,
+		},
+		{
+			MethodName: "CancelScheduledPayment",
+			Handler:    _Payment_API_CancelScheduledPayment_Handler,
+		},
+		{
+			MethodName: "PayPeer",
+			Handler:    _Payment_API_PayPeer_Handler,
+		},
 		{
 			MethodName: "SendPayChUpdate",
 			Handler:    _Payment_API_SendPayChUpdate_Handler,
 		},
+		{
+			MethodName: "PayInvoice",
+			Handler:    _Payment_API_PayInvoice_Handler,
+		},
+		{
+			MethodName: "ProposeSwap",
+			Handler:    _Payment_API_ProposeSwap_Handler,
+		},
 		{
 			MethodName: "UnsubPayChUpdates",
 			Handler:    _Payment_API_UnsubPayChUpdates_Handler,
@@ -7608,12 +18709,49 @@ var _Payment_API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPayChInfo",
 			Handler:    _Payment_API_GetPayChInfo_Handler,
 		},
+		{
+			MethodName: "GetPayChHistory",
+			Handler:    _Payment_API_GetPayChHistory_Handler,
+		},
+		{
+			MethodName: "ExportPayChReceipt",
+			Handler:    _Payment_API_ExportPayChReceipt_Handler,
+		},
+		{
+			MethodName: "StartPayStream",
+			Handler:    _Payment_API_StartPayStream_Handler,
+		},
+		{
+			MethodName: "PausePayStream",
+			Handler:    _Payment_API_PausePayStream_Handler,
+		},
+		{
+			MethodName: "ResumePayStream",
+			Handler:    _Payment_API_ResumePayStream_Handler,
+		},
+		{
+			MethodName: "StopPayStream",
+			Handler:    _Payment_API_StopPayStream_Handler,
+		},
+		{
+			MethodName: "GetPayStreams",
+			Handler:    _Payment_API_GetPayStreams_Handler,
+		},
+		{
+			MethodName: "UnsubPayStreams",
+			Handler:    _Payment_API_UnsubPayStreams_Handler,
+		},
 		{
 			MethodName: "ClosePayCh",
 			Handler:    _Payment_API_ClosePayCh_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubPeerStatus",
+			Handler:       _Payment_API_SubPeerStatus_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubPayChProposals",
 			Handler:       _Payment_API_SubPayChProposals_Handler,
@@ -7624,6 +18762,11 @@ var _Payment_API_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Payment_API_SubPayChUpdates_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubPayStreams",
+			Handler:       _Payment_API_SubPayStreams_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api.proto",
 }
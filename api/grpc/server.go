@@ -19,6 +19,7 @@ package grpc
 import (
 	"context"
 	"net"
+	"time"
 
 	"github.com/pkg/errors"
 	grpclib "google.golang.org/grpc"
@@ -46,9 +47,15 @@ type payChAPIServer struct {
 	// of session id to signaling channel.
 	// chUpdatesNotif works on a per channel basis and hence this is a map of session id to
 	// channel id to signaling channel.
+	// peerStatusNotif works on per session basis and hence this is a map of session id to
+	// signaling channel.
+	// payStreamsNotif works on per session basis and hence this is a map of session id to
+	// signaling channel.
 
 	chProposalsNotif map[string]chan bool
 	chUpdatesNotif   map[string]map[string]chan bool
+	peerStatusNotif  map[string]chan bool
+	payStreamsNotif  map[string]chan bool
 }
 
 // ListenAndServePayChAPI starts a payment channel API server that listens for incoming grpc
@@ -58,6 +65,8 @@ func ListenAndServePayChAPI(n perun.NodeAPI, grpcPort string) error {
 		n:                n,
 		chProposalsNotif: make(map[string]chan bool),
 		chUpdatesNotif:   make(map[string]map[string]chan bool),
+		peerStatusNotif:  make(map[string]chan bool),
+		payStreamsNotif:  make(map[string]chan bool),
 	}
 
 	listener, err := net.Listen("tcp", grpcPort)
@@ -169,6 +178,8 @@ func (a *payChAPIServer) AddPeerID(ctx context.Context, req *pb.AddPeerIDReq) (*
 		OffChainAddrString: req.PeerID.OffChainAddress,
 		CommAddr:           req.PeerID.CommAddress,
 		CommType:           req.PeerID.CommType,
+		Signature:          req.PeerID.Signature,
+		AltCommEndpoints:   fromGrpcCommEndpoints(req.PeerID.AltCommEndpoints),
 	})
 	if err != nil {
 		return errResponse(err), nil
@@ -210,12 +221,190 @@ func (a *payChAPIServer) GetPeerID(ctx context.Context, req *pb.GetPeerIDReq) (*
 					OffChainAddress: peerID.OffChainAddrString,
 					CommAddress:     peerID.CommAddr,
 					CommType:        peerID.CommType,
+					Signature:       peerID.Signature,
+
+					AltCommEndpoints: toGrpcCommEndpoints(peerID.AltCommEndpoints),
 				},
 			},
 		},
 	}, nil
 }
 
+// ExportPeerIDs wraps session.ExportPeerIDs.
+func (a *payChAPIServer) ExportPeerIDs(ctx context.Context, req *pb.ExportPeerIDsReq) (*pb.ExportPeerIDsResp, error) {
+	errResponse := func(err perun.APIError) *pb.ExportPeerIDsResp {
+		return &pb.ExportPeerIDsResp{
+			Response: &pb.ExportPeerIDsResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	data, err := sess.ExportPeerIDs(req.Format)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.ExportPeerIDsResp{
+		Response: &pb.ExportPeerIDsResp_MsgSuccess_{
+			MsgSuccess: &pb.ExportPeerIDsResp_MsgSuccess{
+				Data: string(data),
+			},
+		},
+	}, nil
+}
+
+// ImportPeerIDs wraps session.ImportPeerIDs.
+func (a *payChAPIServer) ImportPeerIDs(ctx context.Context, req *pb.ImportPeerIDsReq) (*pb.ImportPeerIDsResp, error) {
+	errResponse := func(err perun.APIError) *pb.ImportPeerIDsResp {
+		return &pb.ImportPeerIDsResp{
+			Response: &pb.ImportPeerIDsResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	strategy := perun.PeerIDMergeStrategy(req.MergeStrategy)
+	report, err := sess.ImportPeerIDs([]byte(req.Data), req.Format, strategy, req.DryRun)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	conflicts := make([]*pb.PeerIDImportConflict, len(report.Conflicts))
+	for i, c := range report.Conflicts {
+		conflicts[i] = &pb.PeerIDImportConflict{Alias: c.Alias, ExistingAlias: c.ExistingAlias, Reason: c.Reason}
+	}
+	invalid := make([]*pb.PeerIDImportInvalid, len(report.Invalid))
+	for i, inv := range report.Invalid {
+		invalid[i] = &pb.PeerIDImportInvalid{Index: int64(inv.Index), Alias: inv.Alias, Reason: inv.Reason}
+	}
+	return &pb.ImportPeerIDsResp{
+		Response: &pb.ImportPeerIDsResp_MsgSuccess_{
+			MsgSuccess: &pb.ImportPeerIDsResp_MsgSuccess{
+				Added:       report.Added,
+				Overwritten: report.Overwritten,
+				Unchanged:   report.Unchanged,
+				Conflicts:   conflicts,
+				Invalid:     invalid,
+				Applied:     report.Applied,
+			},
+		},
+	}, nil
+}
+
+// GetPeerStatus wraps session.GetPeerStatus.
+func (a *payChAPIServer) GetPeerStatus(ctx context.Context, req *pb.GetPeerStatusReq) (*pb.GetPeerStatusResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetPeerStatusResp {
+		return &pb.GetPeerStatusResp{
+			Response: &pb.GetPeerStatusResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	peerStatuses, err := sess.GetPeerStatus(req.PeerAliases)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	grpcPeerStatuses := make([]*pb.PeerStatus, len(peerStatuses))
+	for i := range peerStatuses {
+		grpcPeerStatuses[i] = toGrpcPeerStatus(peerStatuses[i])
+	}
+	return &pb.GetPeerStatusResp{
+		Response: &pb.GetPeerStatusResp_MsgSuccess_{
+			MsgSuccess: &pb.GetPeerStatusResp_MsgSuccess{
+				PeerStatuses: grpcPeerStatuses,
+			},
+		},
+	}, nil
+}
+
+// SubPeerStatus wraps session.SubPeerStatus.
+func (a *payChAPIServer) SubPeerStatus(req *pb.SubPeerStatusReq, srv pb.Payment_API_SubPeerStatusServer) error {
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		// TODO: (mano) Return a error response and not a protocol error
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+
+	notifier := func(notif perun.PeerStatus) {
+		err := srv.Send(&pb.SubPeerStatusResp{Response: &pb.SubPeerStatusResp_Notify_{
+			Notify: &pb.SubPeerStatusResp_Notify{
+				PeerStatus: toGrpcPeerStatus(notif),
+			},
+		}})
+		_ = err
+		// if err != nil {
+		// TODO: (mano) Handle error while sending.
+		// }
+	}
+	err = sess.SubPeerStatus(notifier)
+	if err != nil {
+		// TODO: (mano) Return a error response and not a protocol error
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+
+	signal := make(chan bool)
+	a.Lock()
+	a.peerStatusNotif[req.SessionID] = signal
+	a.Unlock()
+
+	<-signal
+	return nil
+}
+
+// UnsubPeerStatus wraps session.UnsubPeerStatus.
+func (a *payChAPIServer) UnsubPeerStatus(ctx context.Context, req *pb.UnsubPeerStatusReq) (
+	*pb.UnsubPeerStatusResp, error) {
+	errResponse := func(err perun.APIError) *pb.UnsubPeerStatusResp {
+		return &pb.UnsubPeerStatusResp{
+			Response: &pb.UnsubPeerStatusResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	err = sess.UnsubPeerStatus()
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	a.closeGrpcPeerStatusSub(req.SessionID)
+
+	return &pb.UnsubPeerStatusResp{
+		Response: &pb.UnsubPeerStatusResp_MsgSuccess_{
+			MsgSuccess: &pb.UnsubPeerStatusResp_MsgSuccess{
+				Success: true,
+			},
+		},
+	}, nil
+}
+
+func (a *payChAPIServer) closeGrpcPeerStatusSub(sessionID string) {
+	a.Lock()
+	signal := a.peerStatusNotif[sessionID]
+	delete(a.peerStatusNotif, sessionID)
+	a.Unlock()
+	close(signal)
+}
+
 // OpenPayCh wraps payment.OpenPayCh.
 func (a *payChAPIServer) OpenPayCh(ctx context.Context, req *pb.OpenPayChReq) (*pb.OpenPayChResp, error) {
 	errResponse := func(err perun.APIError) *pb.OpenPayChResp {
@@ -440,12 +629,527 @@ func (a *payChAPIServer) DeployAssetERC20(ctx context.Context, req *pb.DeployAss
 	}, nil
 }
 
-// SendPayChUpdate wraps payment.SendPayChUpdate.
-func (a *payChAPIServer) SendPayChUpdate(ctx context.Context, req *pb.SendPayChUpdateReq) (
-	*pb.SendPayChUpdateResp, error) {
-	errResponse := func(err perun.APIError) *pb.SendPayChUpdateResp {
-		return &pb.SendPayChUpdateResp{
-			Response: &pb.SendPayChUpdateResp_Error{
+// GetOnChainBal wraps session.GetOnChainBal.
+func (a *payChAPIServer) GetOnChainBal(ctx context.Context, req *pb.GetOnChainBalReq) (
+	*pb.GetOnChainBalResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetOnChainBalResp {
+		return &pb.GetOnChainBalResp{
+			Response: &pb.GetOnChainBalResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	bal, err := sess.GetOnChainBal(req.Currency)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.GetOnChainBalResp{
+		Response: &pb.GetOnChainBalResp_MsgSuccess_{
+			MsgSuccess: &pb.GetOnChainBalResp_MsgSuccess{
+				Bal: bal,
+			},
+		},
+	}, nil
+}
+
+// TransferOnChain wraps session.TransferOnChain.
+func (a *payChAPIServer) TransferOnChain(ctx context.Context, req *pb.TransferOnChainReq) (
+	*pb.TransferOnChainResp, error) {
+	errResponse := func(err perun.APIError) *pb.TransferOnChainResp {
+		return &pb.TransferOnChainResp{
+			Response: &pb.TransferOnChainResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	txID, err := sess.TransferOnChain(req.Currency, req.To, req.Amount)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.TransferOnChainResp{
+		Response: &pb.TransferOnChainResp_MsgSuccess_{
+			MsgSuccess: &pb.TransferOnChainResp_MsgSuccess{
+				TxID: txID,
+			},
+		},
+	}, nil
+}
+
+// GetAllowance wraps session.GetAllowance.
+func (a *payChAPIServer) GetAllowance(ctx context.Context, req *pb.GetAllowanceReq) (
+	*pb.GetAllowanceResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetAllowanceResp {
+		return &pb.GetAllowanceResp{
+			Response: &pb.GetAllowanceResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	allowance, err := sess.GetAllowance(req.Currency)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.GetAllowanceResp{
+		Response: &pb.GetAllowanceResp_MsgSuccess_{
+			MsgSuccess: &pb.GetAllowanceResp_MsgSuccess{
+				Allowance: allowance,
+			},
+		},
+	}, nil
+}
+
+// SetAllowance wraps session.SetAllowance.
+func (a *payChAPIServer) SetAllowance(ctx context.Context, req *pb.SetAllowanceReq) (
+	*pb.SetAllowanceResp, error) {
+	errResponse := func(err perun.APIError) *pb.SetAllowanceResp {
+		return &pb.SetAllowanceResp{
+			Response: &pb.SetAllowanceResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	txID, err := sess.SetAllowance(req.Currency, req.Amount)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.SetAllowanceResp{
+		Response: &pb.SetAllowanceResp_MsgSuccess_{
+			MsgSuccess: &pb.SetAllowanceResp_MsgSuccess{
+				TxID: txID,
+			},
+		},
+	}, nil
+}
+
+// GetTxCostReport wraps session.GetTxCostReport.
+func (a *payChAPIServer) GetTxCostReport(ctx context.Context, req *pb.GetTxCostReportReq) (
+	*pb.GetTxCostReportResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetTxCostReportResp {
+		return &pb.GetTxCostReportResp{
+			Response: &pb.GetTxCostReportResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	report, err := sess.GetTxCostReport()
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	txCosts := make([]*pb.TxCostInfo, len(report.TxCosts))
+	for i := range report.TxCosts {
+		txCosts[i] = &pb.TxCostInfo{
+			ChID:    report.TxCosts[i].ChID,
+			TxType:  report.TxCosts[i].TxType,
+			TxID:    report.TxCosts[i].TxID,
+			GasUsed: report.TxCosts[i].GasUsed,
+			Fee:     report.TxCosts[i].Fee,
+		}
+	}
+	return &pb.GetTxCostReportResp{
+		Response: &pb.GetTxCostReportResp_MsgSuccess_{
+			MsgSuccess: &pb.GetTxCostReportResp_MsgSuccess{
+				TxCosts:  txCosts,
+				TotalFee: report.TotalFee,
+			},
+		},
+	}, nil
+}
+
+// SetSpendingLimits wraps session.SetSpendingLimits.
+func (a *payChAPIServer) SetSpendingLimits(ctx context.Context, req *pb.SetSpendingLimitsReq) (
+	*pb.SetSpendingLimitsResp, error) {
+	errResponse := func(err perun.APIError) *pb.SetSpendingLimitsResp {
+		return &pb.SetSpendingLimitsResp{
+			Response: &pb.SetSpendingLimitsResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	limits := make([]perun.SpendingLimit, len(req.Limits))
+	for i := range req.Limits {
+		limits[i] = perun.SpendingLimit{
+			Currency:     req.Limits[i].Currency,
+			PeerAlias:    req.Limits[i].PeerAlias,
+			MaxPayment:   req.Limits[i].MaxPayment,
+			MaxPerHour:   req.Limits[i].MaxPerHour,
+			MaxPerDay:    req.Limits[i].MaxPerDay,
+			MaxChFunding: req.Limits[i].MaxChFunding,
+			Fiat:         req.Limits[i].Fiat,
+		}
+	}
+	err = sess.SetSpendingLimits(limits)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.SetSpendingLimitsResp{
+		Response: &pb.SetSpendingLimitsResp_MsgSuccess_{
+			MsgSuccess: &pb.SetSpendingLimitsResp_MsgSuccess{
+				Success: true,
+			},
+		},
+	}, nil
+}
+
+// GetSpendingLimits wraps session.GetSpendingLimits.
+func (a *payChAPIServer) GetSpendingLimits(ctx context.Context, req *pb.GetSpendingLimitsReq) (
+	*pb.GetSpendingLimitsResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetSpendingLimitsResp {
+		return &pb.GetSpendingLimitsResp{
+			Response: &pb.GetSpendingLimitsResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	limits := sess.GetSpendingLimits()
+
+	grpcLimits := make([]*pb.SpendingLimit, len(limits))
+	for i := range limits {
+		grpcLimits[i] = &pb.SpendingLimit{
+			Currency:     limits[i].Currency,
+			PeerAlias:    limits[i].PeerAlias,
+			MaxPayment:   limits[i].MaxPayment,
+			MaxPerHour:   limits[i].MaxPerHour,
+			MaxPerDay:    limits[i].MaxPerDay,
+			MaxChFunding: limits[i].MaxChFunding,
+			Fiat:         limits[i].Fiat,
+		}
+	}
+	return &pb.GetSpendingLimitsResp{
+		Response: &pb.GetSpendingLimitsResp_MsgSuccess_{
+			MsgSuccess: &pb.GetSpendingLimitsResp_MsgSuccess{
+				Limits: grpcLimits,
+			},
+		},
+	}, nil
+}
+
+// CreateInvoice wraps payment.CreateInvoice.
+func (a *payChAPIServer) CreateInvoice(ctx context.Context, req *pb.CreateInvoiceReq) (
+	*pb.CreateInvoiceResp, error) {
+	errResponse := func(err perun.APIError) *pb.CreateInvoiceResp {
+		return &pb.CreateInvoiceResp{
+			Response: &pb.CreateInvoiceResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	invoice, err := payment.CreateInvoice(sess, req.Currency, req.Amount, req.Memo, req.ExpirySecs)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.CreateInvoiceResp{
+		Response: &pb.CreateInvoiceResp_MsgSuccess_{
+			MsgSuccess: &pb.CreateInvoiceResp_MsgSuccess{
+				Invoice: toGrpcInvoice(invoice),
+			},
+		},
+	}, nil
+}
+
+// GetInvoice wraps payment.GetInvoice.
+func (a *payChAPIServer) GetInvoice(ctx context.Context, req *pb.GetInvoiceReq) (*pb.GetInvoiceResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetInvoiceResp {
+		return &pb.GetInvoiceResp{
+			Response: &pb.GetInvoiceResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	invoice, err := payment.GetInvoice(sess, req.InvoiceID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.GetInvoiceResp{
+		Response: &pb.GetInvoiceResp_MsgSuccess_{
+			MsgSuccess: &pb.GetInvoiceResp_MsgSuccess{
+				Invoice: toGrpcInvoice(invoice),
+			},
+		},
+	}, nil
+}
+
+// GetInvoices wraps payment.GetInvoices.
+func (a *payChAPIServer) GetInvoices(ctx context.Context, req *pb.GetInvoicesReq) (*pb.GetInvoicesResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetInvoicesResp {
+		return &pb.GetInvoicesResp{
+			Response: &pb.GetInvoicesResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	invoices := payment.GetInvoices(sess)
+
+	grpcInvoices := make([]*pb.Invoice, len(invoices))
+	for i := range invoices {
+		grpcInvoices[i] = toGrpcInvoice(invoices[i])
+	}
+	return &pb.GetInvoicesResp{
+		Response: &pb.GetInvoicesResp_MsgSuccess_{
+			MsgSuccess: &pb.GetInvoicesResp_MsgSuccess{
+				Invoices: grpcInvoices,
+			},
+		},
+	}, nil
+}
+
+// CreateScheduledPayment wraps payment.CreateScheduledPayment.
+func (a *payChAPIServer) CreateScheduledPayment(ctx context.Context, req *pb.CreateScheduledPaymentReq) (
+	*pb.CreateScheduledPaymentResp, error) {
+	errResponse := func(err perun.APIError) *pb.CreateScheduledPaymentResp {
+		return &pb.CreateScheduledPaymentResp{
+			Response: &pb.CreateScheduledPaymentResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	scheduledPayment, err := payment.CreateScheduledPayment(sess, fromGrpcScheduledPaymentParams(req.Params))
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.CreateScheduledPaymentResp{
+		Response: &pb.CreateScheduledPaymentResp_MsgSuccess_{
+			MsgSuccess: &pb.CreateScheduledPaymentResp_MsgSuccess{
+				ScheduledPayment: toGrpcScheduledPayment(scheduledPayment),
+			},
+		},
+	}, nil
+}
+
+// GetScheduledPayments wraps payment.GetScheduledPayments.
+func (a *payChAPIServer) GetScheduledPayments(ctx context.Context, req *pb.GetScheduledPaymentsReq) (
+	*pb.GetScheduledPaymentsResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetScheduledPaymentsResp {
+		return &pb.GetScheduledPaymentsResp{
+			Response: &pb.GetScheduledPaymentsResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	scheduledPayments := payment.GetScheduledPayments(sess)
+
+	grpcScheduledPayments := make([]*pb.ScheduledPayment, len(scheduledPayments))
+	for i := range scheduledPayments {
+		grpcScheduledPayments[i] = toGrpcScheduledPayment(scheduledPayments[i])
+	}
+	return &pb.GetScheduledPaymentsResp{
+		Response: &pb.GetScheduledPaymentsResp_MsgSuccess_{
+			MsgSuccess: &pb.GetScheduledPaymentsResp_MsgSuccess{
+				ScheduledPayments: grpcScheduledPayments,
+			},
+		},
+	}, nil
+}
+
+// CancelScheduledPayment wraps payment.CancelScheduledPayment.
+func (a *payChAPIServer) CancelScheduledPayment(ctx context.Context, req *pb.CancelScheduledPaymentReq) (
+	*pb.CancelScheduledPaymentResp, error) {
+	errResponse := func(err perun.APIError) *pb.CancelScheduledPaymentResp {
+		return &pb.CancelScheduledPaymentResp{
+			Response: &pb.CancelScheduledPaymentResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	scheduledPayment, err := payment.CancelScheduledPayment(sess, req.ScheduledPaymentID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.CancelScheduledPaymentResp{
+		Response: &pb.CancelScheduledPaymentResp_MsgSuccess_{
+			MsgSuccess: &pb.CancelScheduledPaymentResp_MsgSuccess{
+				ScheduledPayment: toGrpcScheduledPayment(scheduledPayment),
+			},
+		},
+	}, nil
+}
+
+// PayPeer wraps payment.PayPeer. If a split payment fails in between, the parts made until then are sent along
+// with the error.
+func (a *payChAPIServer) PayPeer(ctx context.Context, req *pb.PayPeerReq) (*pb.PayPeerResp, error) {
+	errResponse := func(err perun.APIError, completedParts []payment.PayPeerPart) *pb.PayPeerResp {
+		return &pb.PayPeerResp{
+			Response: &pb.PayPeerResp_Error{
+				Error: toGrpcError(err),
+			},
+			CompletedParts: toGrpcPayPeerParts(completedParts),
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err, nil), nil
+	}
+	parts, err := payment.PayPeer(ctx, sess, payment.PayPeerParams{
+		PeerAlias:         req.PeerAlias,
+		Currency:          req.Currency,
+		Amount:            req.Amount,
+		ChSelectionPolicy: payment.ChSelectionPolicy(req.ChSelectionPolicy),
+		AllowSplit:        req.AllowSplit,
+	})
+	if err != nil {
+		return errResponse(err, parts), nil
+	}
+
+	return &pb.PayPeerResp{
+		Response: &pb.PayPeerResp_MsgSuccess_{
+			MsgSuccess: &pb.PayPeerResp_MsgSuccess{
+				Parts: toGrpcPayPeerParts(parts),
+			},
+		},
+	}, nil
+}
+
+// SendPayChUpdate wraps payment.SendPayChUpdate and payment.SendPayChUpdateWMeta, if metadata is sent.
+func (a *payChAPIServer) SendPayChUpdate(ctx context.Context, req *pb.SendPayChUpdateReq) (
+	*pb.SendPayChUpdateResp, error) {
+	errResponse := func(err perun.APIError) *pb.SendPayChUpdateResp {
+		return &pb.SendPayChUpdateResp{
+			Response: &pb.SendPayChUpdateResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	ch, err := sess.GetCh(req.ChID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	var updatedPayChInfo payment.PayChInfo
+	if req.Meta == nil {
+		updatedPayChInfo, err = payment.SendPayChUpdate(ctx, ch, fromGrpcPayments(req.Payments))
+	} else {
+		updatedPayChInfo, err = payment.SendPayChUpdateWMeta(ctx, ch, fromGrpcPayments(req.Payments),
+			fromGrpcPayChUpdateMeta(req.Meta))
+	}
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.SendPayChUpdateResp{
+		Response: &pb.SendPayChUpdateResp_MsgSuccess_{
+			MsgSuccess: &pb.SendPayChUpdateResp_MsgSuccess{
+				UpdatedPayChInfo: toGrpcPayChInfo(updatedPayChInfo),
+			},
+		},
+	}, nil
+}
+
+// PayInvoice wraps payment.PayInvoice.
+func (a *payChAPIServer) PayInvoice(ctx context.Context, req *pb.PayInvoiceReq) (*pb.PayInvoiceResp, error) {
+	errResponse := func(err perun.APIError) *pb.PayInvoiceResp {
+		return &pb.PayInvoiceResp{
+			Response: &pb.PayInvoiceResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	ch, err := sess.GetCh(req.ChID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	updatedPayChInfo, err := payment.PayInvoice(ctx, ch, req.InvoiceID, req.Currency, req.Amount)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.PayInvoiceResp{
+		Response: &pb.PayInvoiceResp_MsgSuccess_{
+			MsgSuccess: &pb.PayInvoiceResp_MsgSuccess{
+				UpdatedPayChInfo: toGrpcPayChInfo(updatedPayChInfo),
+			},
+		},
+	}, nil
+}
+
+// ProposeSwap wraps payment.ProposeSwap.
+func (a *payChAPIServer) ProposeSwap(ctx context.Context, req *pb.ProposeSwapReq) (*pb.ProposeSwapResp, error) {
+	errResponse := func(err perun.APIError) *pb.ProposeSwapResp {
+		return &pb.ProposeSwapResp{
+			Response: &pb.ProposeSwapResp_Error{
 				Error: toGrpcError(err),
 			},
 		}
@@ -459,15 +1163,22 @@ func (a *payChAPIServer) SendPayChUpdate(ctx context.Context, req *pb.SendPayChU
 	if err != nil {
 		return errResponse(err), nil
 	}
-	updatedPayChInfo, err := payment.SendPayChUpdate(ctx, ch, fromGrpcPayments(req.Payments))
+	updatedPayChInfo, quote, err := payment.ProposeSwap(ctx, ch, payment.SwapOffer{
+		GiveCurrency: req.GiveCurrency,
+		GiveAmount:   req.GiveAmount,
+		TakeCurrency: req.TakeCurrency,
+		TakeAmount:   req.TakeAmount,
+		ExpirySecs:   req.ExpirySecs,
+	})
 	if err != nil {
 		return errResponse(err), nil
 	}
 
-	return &pb.SendPayChUpdateResp{
-		Response: &pb.SendPayChUpdateResp_MsgSuccess_{
-			MsgSuccess: &pb.SendPayChUpdateResp_MsgSuccess{
+	return &pb.ProposeSwapResp{
+		Response: &pb.ProposeSwapResp_MsgSuccess_{
+			MsgSuccess: &pb.ProposeSwapResp_MsgSuccess{
 				UpdatedPayChInfo: toGrpcPayChInfo(updatedPayChInfo),
+				Quote:            toGrpcSwapQuote(&quote),
 			},
 		},
 	}, nil
@@ -498,6 +1209,10 @@ func (a *payChAPIServer) SubPayChUpdates(req *pb.SubpayChUpdatesReq, srv pb.Paym
 				Type:              ToGrpcChUpdateType[notif.Type],
 				Expiry:            notif.Expiry,
 				Error:             notifErr,
+				Info:              notif.Info,
+				InvoiceID:         notif.InvoiceID,
+				Meta:              toGrpcPayChUpdateMeta(notif.Meta),
+				Swap:              toGrpcSwapQuote(notif.Swap),
 			},
 		}})
 		_ = err
@@ -640,6 +1355,306 @@ func (a *payChAPIServer) GetPayChInfo(ctx context.Context, req *pb.GetPayChInfoR
 	}, nil
 }
 
+// GetPayChHistory wraps payment.GetPayChHistory.
+func (a *payChAPIServer) GetPayChHistory(ctx context.Context, req *pb.GetPayChHistoryReq) (
+	*pb.GetPayChHistoryResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetPayChHistoryResp {
+		return &pb.GetPayChHistoryResp{
+			Response: &pb.GetPayChHistoryResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	ch, err := sess.GetCh(req.ChID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.GetPayChHistoryResp{
+		Response: &pb.GetPayChHistoryResp_MsgSuccess_{
+			MsgSuccess: &pb.GetPayChHistoryResp_MsgSuccess{
+				Records: toGrpcPayChUpdateRecords(payment.GetPayChHistory(ch)),
+			},
+		},
+	}, nil
+}
+
+// ExportPayChReceipt wraps payment.ExportPayChReceipt.
+func (a *payChAPIServer) ExportPayChReceipt(ctx context.Context, req *pb.ExportPayChReceiptReq) (
+	*pb.ExportPayChReceiptResp, error) {
+	errResponse := func(err perun.APIError) *pb.ExportPayChReceiptResp {
+		return &pb.ExportPayChReceiptResp{
+			Response: &pb.ExportPayChReceiptResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	ch, err := sess.GetCh(req.ChID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	receipt, err := payment.ExportPayChReceipt(ch, req.Version)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.ExportPayChReceiptResp{
+		Response: &pb.ExportPayChReceiptResp_MsgSuccess_{
+			MsgSuccess: &pb.ExportPayChReceiptResp_MsgSuccess{
+				Receipt: &pb.ChReceipt{
+					ChID:         receipt.ChID,
+					Version:      receipt.Version,
+					BalInfo:      ToGrpcBalInfo(receipt.BalInfo),
+					Participants: receipt.Participants,
+					Params:       receipt.Params,
+					State:        receipt.State,
+					Sigs:         receipt.Sigs,
+				},
+			},
+		},
+	}, nil
+}
+
+// StartPayStream wraps payment.Streams.Start.
+func (a *payChAPIServer) StartPayStream(ctx context.Context, req *pb.StartPayStreamReq) (
+	*pb.StartPayStreamResp, error) {
+	errResponse := func(err perun.APIError) *pb.StartPayStreamResp {
+		return &pb.StartPayStreamResp{
+			Response: &pb.StartPayStreamResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	ch, err := sess.GetCh(req.ChID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	params := payment.StreamParams{
+		Currency: req.Currency,
+		Amount:   req.Amount,
+		Interval: time.Duration(req.IntervalMillis) * time.Millisecond,
+		Cap:      req.Cap,
+		EndTime:  req.EndTime,
+	}
+	status, err := payment.SessionStreams(sess).Start(ch, params)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.StartPayStreamResp{
+		Response: &pb.StartPayStreamResp_MsgSuccess_{
+			MsgSuccess: &pb.StartPayStreamResp_MsgSuccess{
+				Status: toGrpcPayStreamStatus(status),
+			},
+		},
+	}, nil
+}
+
+// PausePayStream wraps payment.Streams.Pause.
+func (a *payChAPIServer) PausePayStream(ctx context.Context, req *pb.PausePayStreamReq) (
+	*pb.PausePayStreamResp, error) {
+	errResponse := func(err perun.APIError) *pb.PausePayStreamResp {
+		return &pb.PausePayStreamResp{
+			Response: &pb.PausePayStreamResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	status, err := payment.SessionStreams(sess).Pause(req.StreamID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.PausePayStreamResp{
+		Response: &pb.PausePayStreamResp_MsgSuccess_{
+			MsgSuccess: &pb.PausePayStreamResp_MsgSuccess{
+				Status: toGrpcPayStreamStatus(status),
+			},
+		},
+	}, nil
+}
+
+// ResumePayStream wraps payment.Streams.Resume.
+func (a *payChAPIServer) ResumePayStream(ctx context.Context, req *pb.ResumePayStreamReq) (
+	*pb.ResumePayStreamResp, error) {
+	errResponse := func(err perun.APIError) *pb.ResumePayStreamResp {
+		return &pb.ResumePayStreamResp{
+			Response: &pb.ResumePayStreamResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	status, err := payment.SessionStreams(sess).Resume(req.StreamID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.ResumePayStreamResp{
+		Response: &pb.ResumePayStreamResp_MsgSuccess_{
+			MsgSuccess: &pb.ResumePayStreamResp_MsgSuccess{
+				Status: toGrpcPayStreamStatus(status),
+			},
+		},
+	}, nil
+}
+
+// StopPayStream wraps payment.Streams.Stop.
+func (a *payChAPIServer) StopPayStream(ctx context.Context, req *pb.StopPayStreamReq) (
+	*pb.StopPayStreamResp, error) {
+	errResponse := func(err perun.APIError) *pb.StopPayStreamResp {
+		return &pb.StopPayStreamResp{
+			Response: &pb.StopPayStreamResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	status, err := payment.SessionStreams(sess).Stop(req.StreamID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	return &pb.StopPayStreamResp{
+		Response: &pb.StopPayStreamResp_MsgSuccess_{
+			MsgSuccess: &pb.StopPayStreamResp_MsgSuccess{
+				Status: toGrpcPayStreamStatus(status),
+			},
+		},
+	}, nil
+}
+
+// GetPayStreams wraps payment.Streams.List.
+func (a *payChAPIServer) GetPayStreams(ctx context.Context, req *pb.GetPayStreamsReq) (
+	*pb.GetPayStreamsResp, error) {
+	errResponse := func(err perun.APIError) *pb.GetPayStreamsResp {
+		return &pb.GetPayStreamsResp{
+			Response: &pb.GetPayStreamsResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	statuses := payment.SessionStreams(sess).List()
+	grpcStatuses := make([]*pb.PayStreamStatus, len(statuses))
+	for i := range statuses {
+		grpcStatuses[i] = toGrpcPayStreamStatus(statuses[i])
+	}
+
+	return &pb.GetPayStreamsResp{
+		Response: &pb.GetPayStreamsResp_MsgSuccess_{
+			MsgSuccess: &pb.GetPayStreamsResp_MsgSuccess{
+				Statuses: grpcStatuses,
+			},
+		},
+	}, nil
+}
+
+// SubPayStreams wraps payment.Streams.Sub.
+func (a *payChAPIServer) SubPayStreams(req *pb.SubPayStreamsReq, srv pb.Payment_API_SubPayStreamsServer) error {
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		// TODO: (mano) Return a error response and not a protocol error
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+
+	notifier := func(notif payment.StreamStatus) {
+		err := srv.Send(&pb.SubPayStreamsResp{Response: &pb.SubPayStreamsResp_Notify_{
+			Notify: &pb.SubPayStreamsResp_Notify{
+				Status: toGrpcPayStreamStatus(notif),
+			},
+		}})
+		_ = err
+		// if err != nil {
+		// TODO: (mano) Handle error while sending.
+		// }
+	}
+	err = payment.SessionStreams(sess).Sub(notifier)
+	if err != nil {
+		// TODO: (mano) Return a error response and not a protocol error
+		return errors.WithMessage(err, "cannot register subscription")
+	}
+
+	signal := make(chan bool)
+	a.Lock()
+	a.payStreamsNotif[req.SessionID] = signal
+	a.Unlock()
+
+	<-signal
+	return nil
+}
+
+// UnsubPayStreams wraps payment.Streams.Unsub.
+func (a *payChAPIServer) UnsubPayStreams(ctx context.Context, req *pb.UnsubPayStreamsReq) (
+	*pb.UnsubPayStreamsResp, error) {
+	errResponse := func(err perun.APIError) *pb.UnsubPayStreamsResp {
+		return &pb.UnsubPayStreamsResp{
+			Response: &pb.UnsubPayStreamsResp_Error{
+				Error: toGrpcError(err),
+			},
+		}
+	}
+
+	sess, err := a.n.GetSession(req.SessionID)
+	if err != nil {
+		return errResponse(err), nil
+	}
+	err = payment.SessionStreams(sess).Unsub()
+	if err != nil {
+		return errResponse(err), nil
+	}
+
+	a.closeGrpcPayStreamsSub(req.SessionID)
+
+	return &pb.UnsubPayStreamsResp{
+		Response: &pb.UnsubPayStreamsResp_MsgSuccess_{
+			MsgSuccess: &pb.UnsubPayStreamsResp_MsgSuccess{
+				Success: true,
+			},
+		},
+	}, nil
+}
+
+func (a *payChAPIServer) closeGrpcPayStreamsSub(sessionID string) {
+	a.Lock()
+	signal := a.payStreamsNotif[sessionID]
+	delete(a.payStreamsNotif, sessionID)
+	a.Unlock()
+	close(signal)
+}
+
 // ClosePayCh wraps payment.ClosePayCh.
 func (a *payChAPIServer) ClosePayCh(ctx context.Context, req *pb.ClosePayChReq) (*pb.ClosePayChResp, error) {
 	errResponse := func(err perun.APIError) *pb.ClosePayChResp {
@@ -713,6 +1728,19 @@ func fromGrpcPayment(src *pb.Payment) payment.Payment {
 	}
 }
 
+// toGrpcPayPeerParts is a helper function to convert slice of PayPeerPart struct defined in payment package
+// to a slice of PayPeerPart struct defined in grpc package.
+func toGrpcPayPeerParts(parts []payment.PayPeerPart) []*pb.PayPeerPart {
+	grpcParts := make([]*pb.PayPeerPart, len(parts))
+	for i := range parts {
+		grpcParts[i] = &pb.PayPeerPart{
+			Amount:           parts[i].Amount,
+			UpdatedPayChInfo: toGrpcPayChInfo(parts[i].PayChInfo),
+		}
+	}
+	return grpcParts
+}
+
 // toGrpcPayChInfo is a helper function to convert slice of PayChInfo struct defined in perun-node
 // to a slice of PayChInfo struct defined in grpc package.
 func toGrpcPayChsInfo(payChsInfo []payment.PayChInfo) []*pb.PayChInfo {
@@ -727,9 +1755,206 @@ func toGrpcPayChsInfo(payChsInfo []payment.PayChInfo) []*pb.PayChInfo {
 // to PayChInfo struct defined in grpc package.
 func toGrpcPayChInfo(src payment.PayChInfo) *pb.PayChInfo {
 	return &pb.PayChInfo{
-		ChID:    src.ChID,
-		BalInfo: ToGrpcBalInfo(src.BalInfo),
-		Version: src.Version,
+		ChID:        src.ChID,
+		BalInfo:     ToGrpcBalInfo(src.BalInfo),
+		Version:     src.Version,
+		TxFee:       src.TxFee,
+		FiatBalInfo: toGrpcFiatBalInfo(src.FiatBalInfo),
+	}
+}
+
+// toGrpcFiatBalInfo is a helper function to convert FiatBalInfo struct defined in perun-node
+// to FiatBalInfo struct defined in grpc package. It returns nil, if the fiat bal info is nil.
+func toGrpcFiatBalInfo(src *perun.FiatBalInfo) *pb.FiatBalInfo {
+	if src == nil {
+		return nil
+	}
+	bals := make([]*pb.BalInfoBal, len(src.Bals))
+	for i := range src.Bals {
+		bals[i] = &pb.BalInfoBal{Bal: src.Bals[i]}
+	}
+	return &pb.FiatBalInfo{
+		Fiat:       src.Fiat,
+		Rates:      src.Rates,
+		Timestamps: src.Timestamps,
+		Bals:       bals,
+	}
+}
+
+// fromGrpcCommEndpoints is a helper function to convert slice of CommEndpoint struct defined in grpc package
+// to slice of CommEndpoint struct defined in perun-node. It returns nil for an empty slice.
+func fromGrpcCommEndpoints(src []*pb.CommEndpoint) []perun.CommEndpoint {
+	if len(src) == 0 {
+		return nil
+	}
+	endpoints := make([]perun.CommEndpoint, len(src))
+	for i := range src {
+		endpoints[i] = perun.CommEndpoint{CommAddr: src[i].CommAddress, CommType: src[i].CommType}
+	}
+	return endpoints
+}
+
+// toGrpcCommEndpoints is a helper function to convert slice of CommEndpoint struct defined in perun-node
+// to slice of CommEndpoint struct defined in grpc package.
+func toGrpcCommEndpoints(src []perun.CommEndpoint) []*pb.CommEndpoint {
+	endpoints := make([]*pb.CommEndpoint, len(src))
+	for i := range src {
+		endpoints[i] = &pb.CommEndpoint{CommAddress: src[i].CommAddr, CommType: src[i].CommType}
+	}
+	return endpoints
+}
+
+// toGrpcInvoice is a helper function to convert Invoice struct defined in perun-node
+// to Invoice struct defined in grpc package.
+func toGrpcInvoice(src perun.Invoice) *pb.Invoice {
+	payments := make([]*pb.InvoicePayment, len(src.Payments))
+	for i := range src.Payments {
+		payments[i] = &pb.InvoicePayment{
+			ChID:       src.Payments[i].ChID,
+			Version:    src.Payments[i].Version,
+			PayerAlias: src.Payments[i].PayerAlias,
+			Amount:     src.Payments[i].Amount,
+			PaidAt:     src.Payments[i].PaidAt,
+		}
+	}
+	return &pb.Invoice{
+		InvoiceID:  src.ID,
+		Currency:   src.Currency,
+		Amount:     src.Amount,
+		Memo:       src.Memo,
+		CreatedAt:  src.CreatedAt,
+		Expiry:     src.Expiry,
+		Status:     string(src.Status),
+		AmountPaid: src.AmountPaid,
+		Payments:   payments,
+	}
+}
+
+func fromGrpcScheduledPaymentParams(src *pb.ScheduledPaymentParams) perun.ScheduledPaymentParams {
+	return perun.ScheduledPaymentParams{
+		ChID:      src.GetChID(),
+		PeerAlias: src.GetPeerAlias(),
+		Currency:  src.GetCurrency(),
+		Amount:    src.GetAmount(),
+		Schedule:  src.GetSchedule(),
+		EndTime:   src.GetEndTime(),
+	}
+}
+
+func toGrpcScheduledPayment(src perun.ScheduledPayment) *pb.ScheduledPayment {
+	runs := make([]*pb.ScheduledPaymentRun, len(src.Runs))
+	for i := range src.Runs {
+		runs[i] = &pb.ScheduledPaymentRun{
+			DueAt:   src.Runs[i].DueAt,
+			RanAt:   src.Runs[i].RanAt,
+			Status:  string(src.Runs[i].Status),
+			ChID:    src.Runs[i].ChID,
+			Version: src.Runs[i].Version,
+			Error:   src.Runs[i].Error,
+		}
+	}
+	return &pb.ScheduledPayment{
+		ScheduledPaymentID: src.ID,
+		Params: &pb.ScheduledPaymentParams{
+			ChID:      src.Params.ChID,
+			PeerAlias: src.Params.PeerAlias,
+			Currency:  src.Params.Currency,
+			Amount:    src.Params.Amount,
+			Schedule:  src.Params.Schedule,
+			EndTime:   src.Params.EndTime,
+		},
+		Status:    string(src.Status),
+		CreatedAt: src.CreatedAt,
+		NextRun:   src.NextRun,
+		Runs:      runs,
+	}
+}
+
+func toGrpcPeerStatus(src perun.PeerStatus) *pb.PeerStatus {
+	return &pb.PeerStatus{
+		Alias:           src.Alias,
+		OffChainAddress: src.OffChainAddr,
+		Connected:       src.Connected,
+		LastSeen:        src.LastSeen,
+		LastError:       src.LastError,
+		RttMicros:       src.RTT.Microseconds(),
+	}
+}
+
+// fromGrpcPayChUpdateMeta is a helper function to convert PayChUpdateMeta struct defined in grpc package
+// to PayChUpdateMeta struct defined in perun-node.
+func fromGrpcPayChUpdateMeta(src *pb.PayChUpdateMeta) payment.PayChUpdateMeta {
+	return payment.PayChUpdateMeta{
+		Memo:      src.Memo,
+		Reference: src.Reference,
+		Metadata:  src.Metadata,
+	}
+}
+
+// toGrpcPayChUpdateMeta is a helper function to convert PayChUpdateMeta struct defined in perun-node
+// to PayChUpdateMeta struct defined in grpc package.
+func toGrpcPayChUpdateMeta(src payment.PayChUpdateMeta) *pb.PayChUpdateMeta {
+	return &pb.PayChUpdateMeta{
+		Memo:      src.Memo,
+		Reference: src.Reference,
+		Metadata:  src.Metadata,
+	}
+}
+
+// toGrpcSwapQuote is a helper function to convert SwapQuote struct defined in perun-node
+// to SwapQuote struct defined in grpc package. It returns nil, if the quote is nil.
+func toGrpcSwapQuote(src *payment.SwapQuote) *pb.SwapQuote {
+	if src == nil {
+		return nil
+	}
+	return &pb.SwapQuote{
+		GiveCurrency: src.GiveCurrency,
+		GiveAmount:   src.GiveAmount,
+		TakeCurrency: src.TakeCurrency,
+		TakeAmount:   src.TakeAmount,
+		Rate:         src.Rate,
+		Expiry:       src.Expiry,
+	}
+}
+
+// toGrpcPayChUpdateRecords is a helper function to convert slice of PayChUpdateRecord struct defined in
+// perun-node to slice of PayChUpdateRecord struct defined in grpc package.
+func toGrpcPayChUpdateRecords(src []payment.PayChUpdateRecord) []*pb.PayChUpdateRecord {
+	records := make([]*pb.PayChUpdateRecord, len(src))
+	for i := range src {
+		records[i] = &pb.PayChUpdateRecord{
+			Version:    src[i].Version,
+			BalInfo:    ToGrpcBalInfo(src[i].BalInfo),
+			ProposedBy: src[i].ProposedBy,
+			InvoiceID:  src[i].InvoiceID,
+			Meta:       toGrpcPayChUpdateMeta(src[i].Meta),
+			AcceptedAt: src[i].AcceptedAt,
+		}
+	}
+	return records
+}
+
+// toGrpcPayStreamStatus is a helper function to convert StreamStatus struct defined in perun-node
+// to PayStreamStatus struct defined in grpc package.
+func toGrpcPayStreamStatus(src payment.StreamStatus) *pb.PayStreamStatus {
+	var grpcErr *pb.MsgError
+	if src.Error != nil {
+		grpcErr = toGrpcError(src.Error)
+	}
+	return &pb.PayStreamStatus{
+		StreamID:       src.ID,
+		ChID:           src.ChID,
+		Currency:       src.Params.Currency,
+		Amount:         src.Params.Amount,
+		IntervalMillis: uint64(src.Params.Interval.Milliseconds()),
+		Cap:            src.Params.Cap,
+		EndTime:        src.Params.EndTime,
+		State:          string(src.State),
+		AmountSent:     src.AmountSent,
+		PaymentsSent:   src.PaymentsSent,
+		StartedAt:      src.StartedAt,
+		UpdatedAt:      src.UpdatedAt,
+		Error:          grpcErr,
 	}
 }
 
@@ -852,6 +2077,17 @@ func toGrpcError(err perun.APIError) *pb.MsgError { //nolint: funlen
 				ChainURL: info.ChainURL,
 			},
 		}
+	case perun.ErrInfoSpendingLimitExceeded:
+		grpcErr.AddInfo = &pb.MsgError_ErrInfoSpendingLimitExceeded{
+			ErrInfoSpendingLimitExceeded: &pb.ErrInfoSpendingLimitExceeded{
+				LimitType: info.LimitType,
+				PeerAlias: info.PeerAlias,
+				Currency:  info.Currency,
+				Limit:     info.Limit,
+				Usage:     info.Usage,
+				Amount:    info.Amount,
+			},
+		}
 	default:
 		// It is Unknonwn Internal Error which has no additional info.
 		grpcErr.AddInfo = nil
@@ -19,6 +19,7 @@ package payment
 import (
 	"context"
 	"math/big"
+	"strings"
 
 	pchannel "perun.network/go-perun/channel"
 
@@ -38,8 +39,10 @@ func (e Error) Error() string {
 
 // Definition of error constants for this package.
 const (
-	ErrInvalidAmount Error = "invalid amount"
-	ErrInvalidPayee  Error = "invalid payee"
+	ErrInvalidAmount      Error = "invalid amount"
+	ErrInvalidPayee       Error = "invalid payee"
+	ErrNoOpenChInCurrency Error = "no open channel with the peer in the currency"
+	ErrInsufficientBal    Error = "insufficient balance in the channels with the peer"
 )
 
 type (
@@ -52,9 +55,11 @@ type (
 
 	// PayChInfo represents the interpretation of channelInfo for payment app.
 	PayChInfo struct {
-		ChID    string
-		BalInfo perun.BalInfo
-		Version string
+		ChID        string
+		BalInfo     perun.BalInfo
+		Version     string
+		TxFee       string
+		FiatBalInfo *perun.FiatBalInfo // Nil, if no price source is configured for the session.
 	}
 
 	// PayChUpdateNotifier represents the interpretation of channel update notifier for payment app.
@@ -64,12 +69,39 @@ type (
 	// ProposedChInfo (of ChUpdateNotif) is sent in the ChInfo field for regular updates and
 	// CurrChInfo (of ChCloseNotif) is sent in the ChInfo field for channel close update.
 	// See perun.ChUpdateNotif for documentation on the other struct fields.
+	//
+	// InvoiceID is the ID of the invoice paid by the update, if it was tagged with one by the peer.
+	// Meta is the metadata sent by the peer along with the update.
+	// Swap is the quote of the swap made by the update, if it was proposed as a swap using ProposeSwap.
 	PayChUpdateNotif struct {
 		UpdateID          string
 		ProposedPayChInfo PayChInfo
 		Type              perun.ChUpdateType
 		Expiry            int64
 		Error             perun.APIError
+		Info              string
+		InvoiceID         string
+		Meta              PayChUpdateMeta
+		Swap              *SwapQuote
+	}
+
+	// PayChUpdateMeta is the metadata sent to the peer along with a payment update, for reconciling the
+	// payment with the records outside the node. It is not a part of the channel state.
+	PayChUpdateMeta struct {
+		Memo      string            // Free-form description of the payment.
+		Reference string            // Reference such as an order number.
+		Metadata  map[string]string // Keys should not have the perun.ReservedMetaKeyPrefix.
+	}
+
+	// PayChUpdateRecord represents the interpretation of the channel update record for payment app.
+	// See perun.ChUpdateRecord for documentation on the other struct fields.
+	PayChUpdateRecord struct {
+		Version    string
+		BalInfo    perun.BalInfo
+		ProposedBy string
+		InvoiceID  string
+		Meta       PayChUpdateMeta
+		AcceptedAt int64
 	}
 )
 
@@ -82,32 +114,102 @@ type (
 // - ErrInvalidArgument with Name:"Amount" when the amount is invalid.
 // or any of the errors returned by the session.SendChUpdate API.
 func SendPayChUpdate(pctx context.Context, ch perun.ChAPI, payments []Payment) (PayChInfo, perun.APIError) {
+	updater, apiErr := newPayChUpdater(ch, payments)
+	if apiErr != nil {
+		return PayChInfo{}, apiErr
+	}
+	chInfo, apiErr := ch.SendChUpdate(pctx, updater)
+	return toPayChInfo(chInfo), apiErr
+}
+
+// SendPayChUpdateWMeta is same as SendPayChUpdate, except that the metadata is sent to the peer along with the
+// update. The peer receives it in the payment update notification. Both the participants store it in the
+// channel history, once the update is accepted.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrInvalidArgument with Name:"metadata" when any of the keys in the metadata is empty or reserved.
+// or any of the errors returned by the SendPayChUpdate API.
+func SendPayChUpdateWMeta(pctx context.Context, ch perun.ChAPI, payments []Payment, meta PayChUpdateMeta) (
+	PayChInfo, perun.APIError) {
+	chUpdateMeta, apiErr := toChUpdateMeta(meta)
+	if apiErr != nil {
+		return PayChInfo{}, apiErr
+	}
+	updater, apiErr := newPayChUpdater(ch, payments)
+	if apiErr != nil {
+		return PayChInfo{}, apiErr
+	}
+	chInfo, apiErr := ch.SendChUpdateWMeta(pctx, updater, chUpdateMeta)
+	return toPayChInfo(chInfo), apiErr
+}
+
+// toChUpdateMeta validates the payment metadata and converts it to channel update metadata.
+func toChUpdateMeta(meta PayChUpdateMeta) (perun.ChUpdateMeta, perun.APIError) {
+	chUpdateMeta := make(perun.ChUpdateMeta, len(meta.Metadata)+2)
+	for key, value := range meta.Metadata {
+		if key == "" || strings.HasPrefix(key, perun.ReservedMetaKeyPrefix) {
+			err := errors.Errorf("metadata key should be non empty and not have prefix %q",
+				perun.ReservedMetaKeyPrefix)
+			return nil, perun.NewAPIErrInvalidArgument(err, session.ArgNameMetadata, key)
+		}
+		chUpdateMeta[key] = value
+	}
+	if meta.Memo != "" {
+		chUpdateMeta[perun.MemoMetaKey] = meta.Memo
+	}
+	if meta.Reference != "" {
+		chUpdateMeta[perun.ReferenceMetaKey] = meta.Reference
+	}
+	return chUpdateMeta, nil
+}
+
+// fromChUpdateMeta interprets the channel update metadata as payment metadata. Keys reserved for the node,
+// other than those for memo and reference, are dropped.
+func fromChUpdateMeta(chUpdateMeta perun.ChUpdateMeta) PayChUpdateMeta {
+	var meta PayChUpdateMeta
+	for key, value := range chUpdateMeta {
+		switch {
+		case key == perun.MemoMetaKey:
+			meta.Memo = value
+		case key == perun.ReferenceMetaKey:
+			meta.Reference = value
+		case !strings.HasPrefix(key, perun.ReservedMetaKeyPrefix):
+			if meta.Metadata == nil {
+				meta.Metadata = make(map[string]string)
+			}
+			meta.Metadata[key] = value
+		}
+	}
+	return meta
+}
+
+// newPayChUpdater validates the payments and returns a state updater that applies them.
+func newPayChUpdater(ch perun.ChAPI, payments []Payment) (perun.StateUpdater, perun.APIError) {
 	updates := make([]func(state *pchannel.State), len(payments))
 
 	for i := range payments {
 		idxOfCurrencyInBals, currency, found := ch.Currency(payments[i].Currency)
 		if !found {
-			return PayChInfo{}, perun.NewAPIErrResourceNotFound(session.ResTypeCurrency, payments[i].Currency)
+			return nil, perun.NewAPIErrResourceNotFound(session.ResTypeCurrency, payments[i].Currency)
 		}
 		parsedAmount, err := currency.Parse(payments[i].Amount)
 		if err != nil {
 			err = errors.WithMessage(err, ErrInvalidAmount.Error())
-			return PayChInfo{}, perun.NewAPIErrInvalidArgument(err, session.ArgNameAmount, payments[i].Amount)
+			return nil, perun.NewAPIErrInvalidArgument(err, session.ArgNameAmount, payments[i].Amount)
 		}
 		payerIdx, payeeIdx, err := getPayerPayeeIdx(ch.Parts(), payments[i].Payee)
 		if err != nil {
-			return PayChInfo{}, perun.NewAPIErrInvalidArgument(err, session.ArgNamePayee, payments[i].Payee)
+			return nil, perun.NewAPIErrInvalidArgument(err, session.ArgNamePayee, payments[i].Payee)
 		}
 		updates[i] = newUpdate(payerIdx, payeeIdx, idxOfCurrencyInBals, parsedAmount)
 	}
 
-	chInfo, apiErr := ch.SendChUpdate(pctx, func(state *pchannel.State) error {
+	return func(state *pchannel.State) error {
 		for i := range updates {
 			updates[i](state)
 		}
 		return nil
-	})
-	return toPayChInfo(chInfo), apiErr
+	}, nil
 }
 
 func getPayerPayeeIdx(parts []string, payee string) (payerIdx, payeeIdx int, _ error) {
@@ -135,6 +237,32 @@ func GetPayChInfo(ch perun.ChAPI) PayChInfo {
 	return toPayChInfo(ch.GetChInfo())
 }
 
+// GetPayChHistory fetches the records of the updates accepted on the channel and interprets them as payment
+// update records.
+func GetPayChHistory(ch perun.ChAPI) []PayChUpdateRecord {
+	records := ch.GetHistory()
+	payRecords := make([]PayChUpdateRecord, len(records))
+	for i := range records {
+		payRecords[i] = PayChUpdateRecord{
+			Version:    records[i].Version,
+			BalInfo:    records[i].BalInfo,
+			ProposedBy: records[i].ProposedBy,
+			InvoiceID:  records[i].Meta[perun.InvoiceIDMetaKey],
+			Meta:       fromChUpdateMeta(records[i].Meta),
+			AcceptedAt: records[i].AcceptedAt,
+		}
+	}
+	return payRecords
+}
+
+// ExportPayChReceipt exports a receipt for the state of the channel at the version, that contains the signatures
+// of all the participants and can be verified offline.
+//
+// See session.ExportReceipt for the list of errors returned by this API.
+func ExportPayChReceipt(ch perun.ChAPI, version string) (perun.ChReceipt, perun.APIError) {
+	return ch.ExportReceipt(version)
+}
+
 // SubPayChUpdates sets up a subscription for incoming channel updates and
 // interprets the notifications as payment update notifiations.
 //
@@ -153,6 +281,10 @@ func SubPayChUpdates(ch perun.ChAPI, notifier PayChUpdateNotifier) perun.APIErro
 			Type:              notif.Type,
 			Expiry:            notif.Expiry,
 			Error:             notif.Error,
+			Info:              notif.Info,
+			InvoiceID:         notif.Meta[perun.InvoiceIDMetaKey],
+			Meta:              fromChUpdateMeta(notif.Meta),
+			Swap:              swapQuoteFromNotif(notif),
 		})
 	})
 }
@@ -195,8 +327,10 @@ func toPayChsInfo(chsInfo []perun.ChInfo) []PayChInfo {
 // toPayChInfo converts ChInfo to PayChInfo.
 func toPayChInfo(chInfo perun.ChInfo) PayChInfo {
 	return PayChInfo{
-		ChID:    chInfo.ChID,
-		BalInfo: chInfo.BalInfo,
-		Version: chInfo.Version,
+		ChID:        chInfo.ChID,
+		BalInfo:     chInfo.BalInfo,
+		Version:     chInfo.Version,
+		TxFee:       chInfo.TxFee,
+		FiatBalInfo: chInfo.FiatBalInfo,
 	}
 }
@@ -120,6 +120,113 @@ func Test_SendPayChUpdate(t *testing.T) {
 	})
 }
 
+func Test_SendPayChUpdateWMeta(t *testing.T) {
+	currencies := currency.NewRegistry()
+	//nolint: errcheck	// Safe to ignore the error, as it is first register after init.
+	ethCurrency, _ := currencies.Register(currency.ETHSymbol, currency.ETHMaxDecimals)
+
+	newChAPIMock := func() *mocks.ChAPI {
+		chAPI := &mocks.ChAPI{}
+		chAPI.On("Currency", currency.ETHSymbol).Return(0, ethCurrency, true)
+		chAPI.On("Parts").Return(parts)
+		return chAPI
+	}
+	payments := []payment.Payment{
+		makePayment(currency.ETHSymbol, peerAlias, amountToSend),
+	}
+
+	t.Run("happy", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		wantMeta := perun.ChUpdateMeta{
+			perun.MemoMetaKey:      "memo",
+			perun.ReferenceMetaKey: "ref",
+			"orderID":              "42",
+		}
+		chAPI.On("SendChUpdateWMeta", context.Background(), mock.Anything, wantMeta).Return(updatedChInfo, nil)
+
+		meta := payment.PayChUpdateMeta{Memo: "memo", Reference: "ref", Metadata: map[string]string{"orderID": "42"}}
+		gotPayChInfo, gotErr := payment.SendPayChUpdateWMeta(context.Background(), chAPI, payments, meta)
+		require.NoError(t, gotErr)
+		assert.Equal(t, wantUpdatedPayChInfo, gotPayChInfo)
+	})
+
+	t.Run("happy_emptyMeta", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		chAPI.On("SendChUpdateWMeta", context.Background(), mock.Anything, perun.ChUpdateMeta{}).Return(
+			updatedChInfo, nil)
+
+		_, gotErr := payment.SendPayChUpdateWMeta(context.Background(), chAPI, payments, payment.PayChUpdateMeta{})
+		require.NoError(t, gotErr)
+	})
+
+	for _, key := range []string{"", perun.InvoiceIDMetaKey, "perun.any"} {
+		key := key
+		t.Run("error_invalidMetadataKey_"+key, func(t *testing.T) {
+			chAPI := newChAPIMock()
+
+			meta := payment.PayChUpdateMeta{Metadata: map[string]string{key: "value"}}
+			_, gotErr := payment.SendPayChUpdateWMeta(context.Background(), chAPI, payments, meta)
+			peruntest.AssertAPIError(t, gotErr, perun.ClientError, perun.ErrInvalidArgument)
+			peruntest.AssertErrInfoInvalidArgument(t, gotErr.AddInfo(), session.ArgNameMetadata, key)
+		})
+	}
+}
+
+func Test_GetPayChHistory(t *testing.T) {
+	chAPI := &mocks.ChAPI{}
+	chAPI.On("GetHistory").Return([]perun.ChUpdateRecord{
+		{
+			Version:    "1",
+			BalInfo:    updatedChInfo.BalInfo,
+			ProposedBy: peerAlias,
+			Meta:       perun.ChUpdateMeta{perun.InvoiceIDMetaKey: invoiceID, perun.MemoMetaKey: "memo"},
+			AcceptedAt: 10,
+		},
+		{
+			Version:    "2",
+			BalInfo:    updatedChInfo.BalInfo,
+			ProposedBy: perun.OwnAlias,
+			AcceptedAt: 20,
+		},
+	})
+
+	gotRecords := payment.GetPayChHistory(chAPI)
+	assert.Equal(t, []payment.PayChUpdateRecord{
+		{
+			Version:    "1",
+			BalInfo:    updatedChInfo.BalInfo,
+			ProposedBy: peerAlias,
+			InvoiceID:  invoiceID,
+			Meta:       payment.PayChUpdateMeta{Memo: "memo"},
+			AcceptedAt: 10,
+		},
+		{
+			Version:    "2",
+			BalInfo:    updatedChInfo.BalInfo,
+			ProposedBy: perun.OwnAlias,
+			AcceptedAt: 20,
+		},
+	}, gotRecords)
+}
+
+func Test_ExportPayChReceipt(t *testing.T) {
+	receipt := perun.ChReceipt{
+		ChID:         chID,
+		Version:      "1",
+		BalInfo:      updatedChInfo.BalInfo,
+		Participants: []string{"0x01", "0x02"},
+		Params:       "0a",
+		State:        "0b",
+		Sigs:         []string{"0c", "0d"},
+	}
+	chAPI := &mocks.ChAPI{}
+	chAPI.On("ExportReceipt", "1").Return(receipt, nil)
+
+	gotReceipt, gotErr := payment.ExportPayChReceipt(chAPI, "1")
+	require.NoError(t, gotErr)
+	assert.Equal(t, receipt, gotReceipt)
+}
+
 func Test_GetPayChInfo(t *testing.T) {
 	t.Run("happy1", func(t *testing.T) {
 		chAPI := &mocks.ChAPI{}
@@ -135,6 +242,23 @@ func Test_GetPayChInfo(t *testing.T) {
 		gotPayChInfo := payment.GetPayChInfo(chAPI)
 		assert.Equal(t, wantUpdatedPayChInfo, gotPayChInfo)
 	})
+	t.Run("happy_withFiatBalInfo", func(t *testing.T) {
+		fiatBalInfo := &perun.FiatBalInfo{
+			Fiat:       "USD",
+			Rates:      []string{"2000"},
+			Timestamps: []int64{expiry},
+			Bals:       [][]string{{"1000.00", "5000.00"}},
+		}
+		chInfoWFiat := updatedChInfo
+		chInfoWFiat.FiatBalInfo = fiatBalInfo
+		chAPI := &mocks.ChAPI{}
+		chAPI.On("GetChInfo").Return(chInfoWFiat)
+
+		wantPayChInfo := wantUpdatedPayChInfo
+		wantPayChInfo.FiatBalInfo = fiatBalInfo
+		gotPayChInfo := payment.GetPayChInfo(chAPI)
+		assert.Equal(t, wantPayChInfo, gotPayChInfo)
+	})
 }
 
 func Test_SubPayChUpdates(t *testing.T) {
@@ -168,6 +292,33 @@ func Test_SubPayChUpdates(t *testing.T) {
 			notifier(chUpdateNotifFinal)
 			require.Equal(t, wantPayChUpdateNotifFinal, notif)
 		})
+		t.Run("notifier_withInvoiceID", func(t *testing.T) {
+			chUpdateNotifWInvoice := chUpdateNotif
+			chUpdateNotifWInvoice.Meta = perun.ChUpdateMeta{perun.InvoiceIDMetaKey: invoiceID}
+			wantPayChUpdateNotifWInvoice := wantPayChUpdateNotif
+			wantPayChUpdateNotifWInvoice.InvoiceID = invoiceID
+
+			notifier(chUpdateNotifWInvoice)
+			require.Equal(t, wantPayChUpdateNotifWInvoice, notif)
+		})
+		t.Run("notifier_withMeta", func(t *testing.T) {
+			chUpdateNotifWMeta := chUpdateNotif
+			chUpdateNotifWMeta.Meta = perun.ChUpdateMeta{
+				perun.MemoMetaKey:      "memo",
+				perun.ReferenceMetaKey: "ref",
+				"orderID":              "42",
+				"perun.unknown":        "dropped",
+			}
+			wantPayChUpdateNotifWMeta := wantPayChUpdateNotif
+			wantPayChUpdateNotifWMeta.Meta = payment.PayChUpdateMeta{
+				Memo:      "memo",
+				Reference: "ref",
+				Metadata:  map[string]string{"orderID": "42"},
+			}
+
+			notifier(chUpdateNotifWMeta)
+			require.Equal(t, wantPayChUpdateNotifWMeta, notif)
+		})
 		t.Run("notifier_typeClosed", func(t *testing.T) {
 			chUpdateNotifClosed := chUpdateNotif
 			chUpdateNotifClosed.Type = perun.ChUpdateTypeClosed
@@ -0,0 +1,80 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/session"
+)
+
+// CreateInvoice creates an invoice for receiving the amount in the currency, that expires after the given
+// duration if it is non zero. Its ID should be shared with the payer, who can pay it using PayInvoice.
+//
+// See session.CreateInvoice for the list of errors returned by this API.
+func CreateInvoice(s perun.SessionAPI, currency, amount, memo string, expirySecs uint64) (
+	perun.Invoice, perun.APIError) {
+	return s.CreateInvoice(currency, amount, memo, expirySecs)
+}
+
+// GetInvoice fetches the invoice along with its settlement status and the payments received for it.
+//
+// See session.GetInvoice for the list of errors returned by this API.
+func GetInvoice(s perun.SessionAPI, invoiceID string) (perun.Invoice, perun.APIError) {
+	return s.GetInvoice(invoiceID)
+}
+
+// GetInvoices fetches all the invoices created in the session.
+func GetInvoices(s perun.SessionAPI) []perun.Invoice {
+	return s.GetInvoices()
+}
+
+// PayInvoice sends a payment update on the channel that pays the amount in the currency to the peer and
+// tags it with the invoice ID. The payee's node records the payment for the invoice, when the update is
+// accepted. An invoice can be paid in parts, using multiple updates.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrInvalidArgument with Name:"invoiceID" when the invoice ID is empty.
+// or any of the errors returned by the SendPayChUpdate API.
+func PayInvoice(pctx context.Context, ch perun.ChAPI, invoiceID, currency, amount string) (
+	PayChInfo, perun.APIError) {
+	if invoiceID == "" {
+		err := errors.New("invoice id should not be empty")
+		return PayChInfo{}, perun.NewAPIErrInvalidArgument(err, session.ArgNameInvoiceID, invoiceID)
+	}
+	payments := []Payment{{Currency: currency, Payee: peerAlias(ch.Parts()), Amount: amount}}
+	updater, apiErr := newPayChUpdater(ch, payments)
+	if apiErr != nil {
+		return PayChInfo{}, apiErr
+	}
+	meta := perun.ChUpdateMeta{perun.InvoiceIDMetaKey: invoiceID}
+	chInfo, apiErr := ch.SendChUpdateWMeta(pctx, updater, meta)
+	return toPayChInfo(chInfo), apiErr
+}
+
+// peerAlias returns the alias of the peer in a two party channel.
+func peerAlias(parts []string) string {
+	for i := range parts {
+		if parts[i] != perun.OwnAlias {
+			return parts[i]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+	"github.com/hyperledger-labs/perun-node/session"
+)
+
+const invoiceID = "invoice1"
+
+var invoice = perun.Invoice{
+	ID:         invoiceID,
+	Currency:   curr,
+	Amount:     amountToSend,
+	Memo:       "memo",
+	Status:     perun.InvoiceOpen,
+	AmountPaid: "0.000000",
+}
+
+func Test_CreateInvoice(t *testing.T) {
+	sessionAPI := &mocks.SessionAPI{}
+	sessionAPI.On("CreateInvoice", curr, amountToSend, "memo", uint64(60)).Return(invoice, nil)
+
+	gotInvoice, gotErr := payment.CreateInvoice(sessionAPI, curr, amountToSend, "memo", 60)
+	require.NoError(t, gotErr)
+	assert.Equal(t, invoice, gotInvoice)
+}
+
+func Test_GetInvoice(t *testing.T) {
+	sessionAPI := &mocks.SessionAPI{}
+	sessionAPI.On("GetInvoice", invoiceID).Return(invoice, nil)
+	sessionAPI.On("GetInvoices").Return([]perun.Invoice{invoice})
+
+	gotInvoice, gotErr := payment.GetInvoice(sessionAPI, invoiceID)
+	require.NoError(t, gotErr)
+	assert.Equal(t, invoice, gotInvoice)
+	assert.Equal(t, []perun.Invoice{invoice}, payment.GetInvoices(sessionAPI))
+}
+
+func Test_PayInvoice(t *testing.T) {
+	currencies := currency.NewRegistry()
+	//nolint: errcheck	// Safe to ignore the error, as it is first register after init.
+	ethCurrency, _ := currencies.Register(currency.ETHSymbol, currency.ETHMaxDecimals)
+
+	newChAPIMock := func() *mocks.ChAPI {
+		chAPI := &mocks.ChAPI{}
+		chAPI.On("Currency", currency.ETHSymbol).Return(0, ethCurrency, true)
+		chAPI.On("Parts").Return(parts)
+		return chAPI
+	}
+
+	t.Run("happy", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		wantMeta := perun.ChUpdateMeta{perun.InvoiceIDMetaKey: invoiceID}
+		chAPI.On("SendChUpdateWMeta", context.Background(), mock.Anything, wantMeta).Return(updatedChInfo, nil)
+
+		gotPayChInfo, gotErr := payment.PayInvoice(context.Background(), chAPI, invoiceID, curr, amountToSend)
+		require.NoError(t, gotErr)
+		assert.Equal(t, wantUpdatedPayChInfo, gotPayChInfo)
+	})
+
+	t.Run("error_emptyInvoiceID", func(t *testing.T) {
+		chAPI := newChAPIMock()
+
+		_, gotErr := payment.PayInvoice(context.Background(), chAPI, "", curr, amountToSend)
+		wantMessage := "invoice id should not be empty"
+		peruntest.AssertAPIError(t, gotErr, perun.ClientError, perun.ErrInvalidArgument, wantMessage)
+		peruntest.AssertErrInfoInvalidArgument(t, gotErr.AddInfo(), session.ArgNameInvoiceID, "")
+	})
+
+	t.Run("error_invalidAmount", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		invalidAmount := "abc"
+
+		_, gotErr := payment.PayInvoice(context.Background(), chAPI, invoiceID, curr, invalidAmount)
+		peruntest.AssertAPIError(t, gotErr, perun.ClientError, perun.ErrInvalidArgument, payment.ErrInvalidAmount.Error())
+		peruntest.AssertErrInfoInvalidArgument(t, gotErr.AddInfo(), session.ArgNameAmount, invalidAmount)
+	})
+}
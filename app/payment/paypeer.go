@@ -0,0 +1,229 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/session"
+)
+
+// ChSelectionPolicy is the policy for selecting the channels on which a peer is paid using PayPeer.
+type ChSelectionPolicy string
+
+// Enumeration of valid channel selection policies.
+const (
+	// ChSelectionFewestUpdates selects the channels with the fewest updates (lowest version) first.
+	ChSelectionFewestUpdates ChSelectionPolicy = "fewestUpdates"
+	// ChSelectionLargestBal selects the channels with the largest balance of the user first.
+	ChSelectionLargestBal ChSelectionPolicy = "largestBalance"
+)
+
+type (
+	// PayPeerParams are the parameters for paying a peer on any of the open channels with it.
+	//
+	// ChSelectionPolicy defaults to ChSelectionFewestUpdates, if empty. If AllowSplit is true and no single
+	// channel has enough balance, the payment is split across several channels in the order of the policy.
+	PayPeerParams struct {
+		PeerAlias         string
+		Currency          string
+		Amount            string
+		ChSelectionPolicy ChSelectionPolicy
+		AllowSplit        bool
+	}
+
+	// PayPeerPart is the part of the payment made on a single channel. PayChInfo is the updated channel info,
+	// that contains the ID and the version of the channel after the payment.
+	PayPeerPart struct {
+		Amount    string
+		PayChInfo PayChInfo
+	}
+
+	// payPeerCandidate is an open channel with the peer, in which the payment could be made.
+	payPeerCandidate struct {
+		ch      perun.ChAPI
+		bal     *big.Int
+		version uint64
+	}
+
+	// payPeerSplit is the part of the payment to be made on a channel.
+	payPeerSplit struct {
+		ch     perun.ChAPI
+		amount *big.Int
+	}
+)
+
+// PayPeer pays the amount to the peer on an open channel with the peer, in the currency, that has enough
+// balance. The channel is selected as per the selection policy. If no single channel has enough balance and
+// splitting is allowed, the payment is split across several channels.
+//
+// The parts of the payment are made one after the other. If one of them fails, the parts made until then are
+// returned along with the error; these are not reverted.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "peerID" when the peer alias is not known.
+// - ErrInvalidArgument with Name:"chSelectionPolicy" when the channel selection policy is invalid.
+// - ErrInvalidArgument with Name:"amount" when the amount is invalid.
+// - ErrFailedPreCondition when there is no open channel with the peer in the currency.
+// - ErrFailedPreCondition when the channels with the peer do not have enough balance.
+// or any of the errors returned by the SendPayChUpdate API.
+func PayPeer(pctx context.Context, s perun.SessionAPI, params PayPeerParams) ([]PayPeerPart, perun.APIError) {
+	if _, apiErr := s.GetPeerID(params.PeerAlias); apiErr != nil {
+		return nil, apiErr
+	}
+	policy := params.ChSelectionPolicy
+	if policy == "" {
+		policy = ChSelectionFewestUpdates
+	}
+	if policy != ChSelectionFewestUpdates && policy != ChSelectionLargestBal {
+		err := errors.Errorf("channel selection policy should be %s or %s",
+			ChSelectionFewestUpdates, ChSelectionLargestBal)
+		return nil, perun.NewAPIErrInvalidArgument(err, session.ArgNameChSelectionPolicy, string(policy))
+	}
+
+	candidates, curr := payPeerCandidates(s.GetOpenChsWithPeer(params.PeerAlias), params.Currency)
+	if len(candidates) == 0 {
+		return nil, perun.NewAPIErrFailedPreCondition(ErrNoOpenChInCurrency)
+	}
+	amount, err := curr.Parse(params.Amount)
+	if err != nil {
+		err = errors.WithMessage(err, ErrInvalidAmount.Error())
+		return nil, perun.NewAPIErrInvalidArgument(err, session.ArgNameAmount, params.Amount)
+	}
+	sortPayPeerCandidates(candidates, policy)
+
+	splits, apiErr := splitPayment(candidates, amount, params.AllowSplit, curr)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	parts := make([]PayPeerPart, 0, len(splits))
+	for i := range splits {
+		partAmount := curr.Print(splits[i].amount)
+		payments := []Payment{{Currency: params.Currency, Payee: params.PeerAlias, Amount: partAmount}}
+		payChInfo, apiErr := SendPayChUpdate(pctx, splits[i].ch, payments)
+		if apiErr != nil {
+			return parts, apiErr
+		}
+		parts = append(parts, PayPeerPart{Amount: partAmount, PayChInfo: payChInfo})
+	}
+	return parts, nil
+}
+
+// payPeerCandidates returns the channels that have the currency, along with the balance of the user in it and
+// the version of the channel. It also returns the currency, that can be used for parsing the amount.
+func payPeerCandidates(chs []perun.ChAPI, symbol string) ([]payPeerCandidate, perun.Currency) {
+	var curr perun.Currency
+	candidates := []payPeerCandidate{}
+	for _, ch := range chs {
+		chCurr, bal, version, found := ownBalInCh(ch, symbol)
+		if !found {
+			continue
+		}
+		curr = chCurr
+		candidates = append(candidates, payPeerCandidate{ch: ch, bal: bal, version: version})
+	}
+	return candidates, curr
+}
+
+// ownBalInCh returns the balance of the user in the currency and the version of the channel. Found is false,
+// if the currency is not used in the channel.
+func ownBalInCh(ch perun.ChAPI, symbol string) (_ perun.Currency, bal *big.Int, version uint64, found bool) {
+	_, curr, found := ch.Currency(symbol)
+	if !found {
+		return nil, nil, 0, false
+	}
+	chInfo := ch.GetChInfo()
+	version, _ = strconv.ParseUint(chInfo.Version, 10, 64) //nolint: errcheck	// Version is always valid.
+	bal = big.NewInt(0)
+	for i := range chInfo.BalInfo.Currencies {
+		if chInfo.BalInfo.Currencies[i] != symbol {
+			continue
+		}
+		for j := range chInfo.BalInfo.Parts {
+			if chInfo.BalInfo.Parts[j] == perun.OwnAlias {
+				// Parse returns an error for zero balance, in which case it is left as zero.
+				if parsedBal, err := curr.Parse(chInfo.BalInfo.Bals[i][j]); err == nil {
+					bal = parsedBal
+				}
+			}
+		}
+	}
+	return curr, bal, version, true
+}
+
+// sortPayPeerCandidates sorts the candidates in the order of preference as per the policy. Ties are broken
+// using the other criterion and then the order in which the channels were retrieved.
+func sortPayPeerCandidates(candidates []payPeerCandidate, policy ChSelectionPolicy) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		balCmp := candidates[i].bal.Cmp(candidates[j].bal)
+		if policy == ChSelectionLargestBal && balCmp != 0 {
+			return balCmp > 0
+		}
+		if candidates[i].version != candidates[j].version {
+			return candidates[i].version < candidates[j].version
+		}
+		return balCmp > 0
+	})
+}
+
+// splitPayment returns the parts of the payment to be made on each channel. It is a single part on the first
+// candidate with enough balance. If there is no such candidate and splitting is allowed, the amount is split
+// across the candidates in their order.
+func splitPayment(candidates []payPeerCandidate, amount *big.Int, allowSplit bool, curr perun.Currency) (
+	[]payPeerSplit, perun.APIError) {
+	maxBal, total := big.NewInt(0), big.NewInt(0)
+	for i := range candidates {
+		if candidates[i].bal.Cmp(amount) >= 0 {
+			return []payPeerSplit{{ch: candidates[i].ch, amount: amount}}, nil
+		}
+		if candidates[i].bal.Cmp(maxBal) > 0 {
+			maxBal = candidates[i].bal
+		}
+		total.Add(total, candidates[i].bal)
+	}
+	available := maxBal
+	if allowSplit {
+		available = total
+	}
+	if available.Cmp(amount) < 0 {
+		err := errors.Errorf("%s: available %s, requested %s", ErrInsufficientBal, curr.Print(available),
+			curr.Print(amount))
+		return nil, perun.NewAPIErrFailedPreCondition(err)
+	}
+
+	splits := []payPeerSplit{}
+	remaining := new(big.Int).Set(amount)
+	for i := 0; remaining.Sign() > 0; i++ {
+		if candidates[i].bal.Sign() == 0 {
+			continue
+		}
+		partAmount := new(big.Int).Set(candidates[i].bal)
+		if partAmount.Cmp(remaining) > 0 {
+			partAmount.Set(remaining)
+		}
+		splits = append(splits, payPeerSplit{ch: candidates[i].ch, amount: partAmount})
+		remaining.Sub(remaining, partAmount)
+	}
+	return splits, nil
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+	"github.com/hyperledger-labs/perun-node/session"
+)
+
+func Test_PayPeer(t *testing.T) {
+	currencies := currency.NewRegistry()
+	//nolint: errcheck	// Safe to ignore the error, as it is first register after init.
+	ethCurrency, _ := currencies.Register(currency.ETHSymbol, currency.ETHMaxDecimals)
+
+	// Returns a mock for an open channel with the peer, with the given balance of the user and version.
+	newChAPIMock := func(id, ownBal, version string) *mocks.ChAPI {
+		chAPI := &mocks.ChAPI{}
+		chAPI.On("ID").Return(id)
+		chAPI.On("Currency", currency.ETHSymbol).Return(0, ethCurrency, true)
+		chAPI.On("Parts").Return(parts)
+		chAPI.On("GetChInfo").Return(perun.ChInfo{
+			ChID:    id,
+			BalInfo: perun.BalInfo{Currencies: []string{curr}, Parts: parts, Bals: [][]string{{ownBal, "1"}}},
+			Version: version,
+		})
+		return chAPI
+	}
+	// Sets up the channel to accept a payment update and return the info with incremented version.
+	expectPayment := func(chAPI *mocks.ChAPI, version string) {
+		chAPI.On("SendChUpdate", context.Background(), mock.Anything).Return(perun.ChInfo{
+			ChID:    chAPI.ID(),
+			Version: version,
+		}, nil)
+	}
+	newSessionAPIMock := func(chs ...perun.ChAPI) *mocks.SessionAPI {
+		sessionAPI := &mocks.SessionAPI{}
+		sessionAPI.On("GetPeerID", peerAlias).Return(perun.PeerID{Alias: peerAlias}, nil)
+		sessionAPI.On("GetOpenChsWithPeer", peerAlias).Return(chs)
+		return sessionAPI
+	}
+	params := payment.PayPeerParams{PeerAlias: peerAlias, Currency: curr, Amount: "1.5"}
+
+	t.Run("happy_fewestUpdates", func(t *testing.T) {
+		ch1, ch2, ch3 := newChAPIMock("ch1", "2", "5"), newChAPIMock("ch2", "1", "1"), newChAPIMock("ch3", "2", "3")
+		expectPayment(ch3, "4")
+
+		parts, err := payment.PayPeer(context.Background(), newSessionAPIMock(ch1, ch2, ch3), params)
+		require.NoError(t, err)
+		want := []payment.PayPeerPart{{Amount: "1.5", PayChInfo: payment.PayChInfo{ChID: "ch3", Version: "4"}}}
+		assert.Equal(t, want, parts)
+		ch1.AssertNotCalled(t, "SendChUpdate", mock.Anything, mock.Anything)
+	})
+
+	t.Run("happy_largestBalance", func(t *testing.T) {
+		ch1, ch2, ch3 := newChAPIMock("ch1", "2", "5"), newChAPIMock("ch2", "1", "1"), newChAPIMock("ch3", "3", "3")
+		expectPayment(ch3, "4")
+
+		largestBalParams := params
+		largestBalParams.ChSelectionPolicy = payment.ChSelectionLargestBal
+		parts, err := payment.PayPeer(context.Background(), newSessionAPIMock(ch1, ch2, ch3), largestBalParams)
+		require.NoError(t, err)
+		want := []payment.PayPeerPart{{Amount: "1.5", PayChInfo: payment.PayChInfo{ChID: "ch3", Version: "4"}}}
+		assert.Equal(t, want, parts)
+	})
+
+	t.Run("happy_split", func(t *testing.T) {
+		ch1, ch2, ch3 := newChAPIMock("ch1", "1", "5"), newChAPIMock("ch2", "0", "1"), newChAPIMock("ch3", "1", "3")
+		expectPayment(ch3, "4")
+		expectPayment(ch1, "6")
+
+		splitParams := params
+		splitParams.AllowSplit = true
+		parts, err := payment.PayPeer(context.Background(), newSessionAPIMock(ch1, ch2, ch3), splitParams)
+		require.NoError(t, err)
+		want := []payment.PayPeerPart{
+			{Amount: "1", PayChInfo: payment.PayChInfo{ChID: "ch3", Version: "4"}},
+			{Amount: "0.5", PayChInfo: payment.PayChInfo{ChID: "ch1", Version: "6"}},
+		}
+		assert.Equal(t, want, parts)
+		ch2.AssertNotCalled(t, "SendChUpdate", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error_split_partFailed", func(t *testing.T) {
+		ch1, ch2 := newChAPIMock("ch1", "1", "1"), newChAPIMock("ch2", "1", "2")
+		expectPayment(ch1, "2")
+		ch2.On("SendChUpdate", context.Background(), mock.Anything).Return(perun.ChInfo{},
+			perun.NewAPIErrPeerRequestTimedOut(errors.New("timed out"), peerAlias, "10s"))
+
+		splitParams := params
+		splitParams.AllowSplit = true
+		parts, err := payment.PayPeer(context.Background(), newSessionAPIMock(ch1, ch2), splitParams)
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ParticipantError, perun.ErrPeerRequestTimedOut)
+		want := []payment.PayPeerPart{{Amount: "1", PayChInfo: payment.PayChInfo{ChID: "ch1", Version: "2"}}}
+		assert.Equal(t, want, parts)
+	})
+
+	t.Run("error_insufficientBal", func(t *testing.T) {
+		ch1, ch2 := newChAPIMock("ch1", "1", "1"), newChAPIMock("ch2", "0.25", "2")
+
+		_, err := payment.PayPeer(context.Background(), newSessionAPIMock(ch1, ch2), params)
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition,
+			payment.ErrInsufficientBal.Error())
+		assert.Contains(t, err.Message(), "available 1, requested 1.5")
+
+		splitParams := params
+		splitParams.AllowSplit = true
+		_, err = payment.PayPeer(context.Background(), newSessionAPIMock(ch1, ch2), splitParams)
+		require.Error(t, err)
+		assert.Contains(t, err.Message(), "available 1.25, requested 1.5")
+	})
+
+	t.Run("error_noOpenChInCurrency", func(t *testing.T) {
+		ch := &mocks.ChAPI{}
+		ch.On("Currency", currency.ETHSymbol).Return(0, nil, false)
+
+		_, err := payment.PayPeer(context.Background(), newSessionAPIMock(ch), params)
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition,
+			payment.ErrNoOpenChInCurrency.Error())
+
+		_, err = payment.PayPeer(context.Background(), newSessionAPIMock(), params)
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+	})
+
+	t.Run("error_invalidAmount", func(t *testing.T) {
+		invalidParams := params
+		invalidParams.Amount = "abc"
+
+		_, err := payment.PayPeer(context.Background(), newSessionAPIMock(newChAPIMock("ch1", "2", "1")),
+			invalidParams)
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameAmount, invalidParams.Amount)
+	})
+
+	t.Run("error_invalidPolicy", func(t *testing.T) {
+		invalidParams := params
+		invalidParams.ChSelectionPolicy = "random"
+
+		_, err := payment.PayPeer(context.Background(), newSessionAPIMock(), invalidParams)
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameChSelectionPolicy, "random")
+	})
+
+	t.Run("error_unknownPeer", func(t *testing.T) {
+		sessionAPI := &mocks.SessionAPI{}
+		sessionAPI.On("GetPeerID", peerAlias).Return(perun.PeerID{},
+			perun.NewAPIErrResourceNotFound(session.ResTypePeerID, peerAlias))
+
+		_, err := payment.PayPeer(context.Background(), sessionAPI, params)
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	})
+}
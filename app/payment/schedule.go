@@ -0,0 +1,52 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"context"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// CreateScheduledPayment creates a recurring payment to the peer, that is made on the channel or on any open
+// channel with the peer, as per the schedule. The payments are made by the session using SendPayChUpdate,
+// once the session is opened using OpenSession.
+//
+// See session.CreateScheduledPayment for the list of errors returned by this API.
+func CreateScheduledPayment(s perun.SessionAPI, params perun.ScheduledPaymentParams) (
+	perun.ScheduledPayment, perun.APIError) {
+	return s.CreateScheduledPayment(params)
+}
+
+// GetScheduledPayments fetches all the scheduled payments in the session along with their recent runs.
+func GetScheduledPayments(s perun.SessionAPI) []perun.ScheduledPayment {
+	return s.GetScheduledPayments()
+}
+
+// CancelScheduledPayment cancels the scheduled payment, so that no further payments are made.
+//
+// See session.CancelScheduledPayment for the list of errors returned by this API.
+func CancelScheduledPayment(s perun.SessionAPI, scheduledPaymentID string) (perun.ScheduledPayment, perun.APIError) {
+	return s.CancelScheduledPayment(scheduledPaymentID)
+}
+
+// runScheduledPayment makes a scheduled payment by sending an update that pays the amount to the peer.
+func runScheduledPayment(ctx context.Context, ch perun.ChAPI, currency, amount string) perun.APIError {
+	payments := []Payment{{Currency: currency, Payee: peerAlias(ch.Parts()), Amount: amount}}
+	_, apiErr := SendPayChUpdate(ctx, ch, payments)
+	return apiErr
+}
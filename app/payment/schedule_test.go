@@ -0,0 +1,67 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+)
+
+var scheduledPayment = perun.ScheduledPayment{
+	ID: "scheduledPayment1",
+	Params: perun.ScheduledPaymentParams{
+		PeerAlias: "peer",
+		Currency:  curr,
+		Amount:    amountToSend,
+		Schedule:  "@daily",
+	},
+	Status:  perun.ScheduledPaymentActive,
+	NextRun: 1000,
+}
+
+func Test_CreateScheduledPayment(t *testing.T) {
+	sessionAPI := &mocks.SessionAPI{}
+	sessionAPI.On("CreateScheduledPayment", scheduledPayment.Params).Return(scheduledPayment, nil)
+
+	gotScheduledPayment, gotErr := payment.CreateScheduledPayment(sessionAPI, scheduledPayment.Params)
+	require.NoError(t, gotErr)
+	assert.Equal(t, scheduledPayment, gotScheduledPayment)
+}
+
+func Test_GetScheduledPayments(t *testing.T) {
+	sessionAPI := &mocks.SessionAPI{}
+	sessionAPI.On("GetScheduledPayments").Return([]perun.ScheduledPayment{scheduledPayment})
+
+	assert.Equal(t, []perun.ScheduledPayment{scheduledPayment}, payment.GetScheduledPayments(sessionAPI))
+}
+
+func Test_CancelScheduledPayment(t *testing.T) {
+	cancelled := scheduledPayment
+	cancelled.Status, cancelled.NextRun = perun.ScheduledPaymentCancelled, 0
+	sessionAPI := &mocks.SessionAPI{}
+	sessionAPI.On("CancelScheduledPayment", scheduledPayment.ID).Return(cancelled, nil)
+
+	gotScheduledPayment, gotErr := payment.CancelScheduledPayment(sessionAPI, scheduledPayment.ID)
+	require.NoError(t, gotErr)
+	assert.Equal(t, cancelled, gotScheduledPayment)
+}
@@ -38,12 +38,21 @@ type (
 )
 
 // OpenSession opens a session and interprets the restored channels info as
-// payment channels info.
+// payment channels info. It also starts the scheduler of the session, that
+// makes the scheduled payments.
 //
 // See node.OpenSession for the list of errors returned by this API.
 func OpenSession(n perun.NodeAPI, configFile string) (string, []PayChInfo, perun.APIError) {
 	sessionID, restoredChsInfo, err := n.OpenSession(configFile)
-	return sessionID, toPayChsInfo(restoredChsInfo), err
+	if err != nil {
+		return sessionID, toPayChsInfo(restoredChsInfo), err
+	}
+	sess, err := n.GetSession(sessionID)
+	if err != nil {
+		return "", nil, err
+	}
+	sess.StartScheduler(runScheduledPayment)
+	return sessionID, toPayChsInfo(restoredChsInfo), nil
 }
 
 // OpenPayCh opens a channel with payment app with the specified parameters. It
@@ -0,0 +1,459 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/session"
+)
+
+// Definition of error constants for payment streams.
+const (
+	ErrStreamNotRunning Error = "stream is not running"
+	ErrStreamNotPaused  Error = "stream is not paused"
+	ErrStreamEnded      Error = "stream has already ended"
+)
+
+// StreamState is the state of a payment stream.
+type StreamState string
+
+// Enumeration of payment stream states.
+const (
+	StreamRunning   StreamState = "running"   // Payments are sent in each interval.
+	StreamPaused    StreamState = "paused"    // No payments are sent until it is resumed.
+	StreamStopped   StreamState = "stopped"   // Stopped by the user or due to an error. It cannot be resumed.
+	StreamCompleted StreamState = "completed" // Cap is reached or end time has passed.
+)
+
+const streamIDLen = 16 // Length of the random stream IDs in bytes.
+
+type (
+	// StreamParams are the parameters of a payment stream.
+	StreamParams struct {
+		Currency string
+		Amount   string        // Amount paid to the peer in each interval.
+		Interval time.Duration // Interval between the payments.
+		Cap      string        // Total amount that is paid, after which the stream completes.
+		EndTime  int64         // Time (in unix timestamp) after which the stream completes. Zero, if none.
+	}
+
+	// StreamStatus represents the progress of a payment stream.
+	StreamStatus struct {
+		ID     string
+		ChID   string
+		Params StreamParams
+		State  StreamState
+		// Total amount paid in the stream so far. When the stream is stopped while a payment is being sent,
+		// that payment is not included, as its outcome is not known. See Stop.
+		AmountSent   string
+		PaymentsSent uint64
+		StartedAt    int64 // Time (in unix timestamp) at which the stream was started.
+		UpdatedAt    int64 // Time (in unix timestamp) at which the status last changed.
+		// Error that caused the stream to pause or stop. It is nil, if the stream is running, completed or
+		// was paused or stopped by the user.
+		Error perun.APIError
+	}
+
+	// StreamNotifier is the notifier function that is used for sending payment stream notifications.
+	StreamNotifier func(StreamStatus)
+
+	// Streams holds the payment streams started in a session. It sends the payments for each running stream
+	// in a separate go-routine. Notifications are sent when the status of a stream changes.
+	Streams struct {
+		mutex    sync.Mutex
+		streams  map[string]*stream
+		ids      []string // IDs of the streams in the order of start.
+		notifier StreamNotifier
+	}
+
+	stream struct {
+		ch       perun.ChAPI
+		currency perun.Currency
+		amount   *big.Int
+		cap      *big.Int
+		sent     *big.Int
+		status   StreamStatus
+
+		ctx    context.Context // Cancelled when the stream is stopped.
+		cancel context.CancelFunc
+	}
+)
+
+// NewStreams returns an empty registry of payment streams.
+func NewStreams() *Streams {
+	return &Streams{
+		streams: make(map[string]*stream),
+	}
+}
+
+// SessionStreams returns the payment streams of the session. These are owned by the session, so that they are
+// stopped when the channel or the session is closed.
+func SessionStreams(s perun.SessionAPI) *Streams {
+	return s.PayStreams(func() perun.PayStreams { return NewStreams() }).(*Streams)
+}
+
+// Start starts a payment stream on the channel that pays the amount to the peer in each interval, until the
+// cap is reached or the end time passes. The first payment is sent after one interval.
+//
+// The stream is paused, if the peer rejects a payment or does not respond in time. It can be resumed by the
+// user. It is stopped on any other error.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "currency" when the currency is not used in the channel.
+// - ErrInvalidArgument with Name:"amount" when the amount is invalid.
+// - ErrInvalidArgument with Name:"cap" when the cap is invalid or less than the amount.
+// - ErrInvalidArgument with Name:"interval" when the interval is not positive.
+// - ErrInvalidArgument with Name:"endTime" when the end time is not in the future.
+// - ErrUnknownInternal.
+func (s *Streams) Start(ch perun.ChAPI, params StreamParams) (StreamStatus, perun.APIError) {
+	_, currency, found := ch.Currency(params.Currency)
+	if !found {
+		return StreamStatus{}, perun.NewAPIErrResourceNotFound(session.ResTypeCurrency, params.Currency)
+	}
+	amount, err := currency.Parse(params.Amount)
+	if err != nil {
+		err = errors.WithMessage(err, ErrInvalidAmount.Error())
+		return StreamStatus{}, perun.NewAPIErrInvalidArgument(err, session.ArgNameAmount, params.Amount)
+	}
+	streamCap, err := currency.Parse(params.Cap)
+	if err != nil {
+		err = errors.WithMessage(err, ErrInvalidAmount.Error())
+		return StreamStatus{}, perun.NewAPIErrInvalidArgument(err, session.ArgNameCap, params.Cap)
+	}
+	if streamCap.Cmp(amount) < 0 {
+		err = errors.New("cap should not be less than the amount")
+		return StreamStatus{}, perun.NewAPIErrInvalidArgument(err, session.ArgNameCap, params.Cap)
+	}
+	if params.Interval <= 0 {
+		err = errors.New("interval should be positive")
+		return StreamStatus{}, perun.NewAPIErrInvalidArgument(err, session.ArgNameInterval, params.Interval.String())
+	}
+	now := time.Now().UTC().Unix()
+	if params.EndTime != 0 && params.EndTime <= now {
+		err = errors.New("end time should be in the future")
+		return StreamStatus{}, perun.NewAPIErrInvalidArgument(err, session.ArgNameEndTime,
+			fmt.Sprintf("%d", params.EndTime))
+	}
+	id, err := newStreamID()
+	if err != nil {
+		return StreamStatus{}, perun.NewAPIErrUnknownInternal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	st := &stream{
+		ch:       ch,
+		currency: currency,
+		amount:   amount,
+		cap:      streamCap,
+		sent:     big.NewInt(0),
+		status: StreamStatus{
+			ID:         id,
+			ChID:       ch.ID(),
+			Params:     params,
+			State:      StreamRunning,
+			AmountSent: currency.Print(big.NewInt(0)),
+			StartedAt:  now,
+			UpdatedAt:  now,
+		},
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	s.mutex.Lock()
+	s.streams[id] = st
+	s.ids = append(s.ids, id)
+	status := st.status
+	s.mutex.Unlock()
+
+	go s.run(st)
+	return status, nil
+}
+
+// Pause pauses a running stream.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "stream" when the stream is not known.
+// - ErrFailedPreCondition when the stream is not running.
+func (s *Streams) Pause(streamID string) (StreamStatus, perun.APIError) {
+	return s.setState(streamID, StreamRunning, ErrStreamNotRunning, StreamPaused)
+}
+
+// Resume resumes a paused stream. The next payment is sent at the end of the current interval.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "stream" when the stream is not known.
+// - ErrFailedPreCondition when the stream is not paused.
+func (s *Streams) Resume(streamID string) (StreamStatus, perun.APIError) {
+	return s.setState(streamID, StreamPaused, ErrStreamNotPaused, StreamRunning)
+}
+
+// Stop stops a running or paused stream. If a payment is being sent, it is aborted. Since the outcome of the
+// aborted payment is not known, it is not included in the AmountSent and PaymentsSent of the stream, even if
+// it was accepted by the peer. So these can under-count the payments by at most one. The balance of the
+// channel is always accurate.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "stream" when the stream is not known.
+// - ErrFailedPreCondition when the stream has already ended.
+func (s *Streams) Stop(streamID string) (StreamStatus, perun.APIError) {
+	s.mutex.Lock()
+	st, ok := s.streams[streamID]
+	if !ok {
+		s.mutex.Unlock()
+		return StreamStatus{}, perun.NewAPIErrResourceNotFound(session.ResTypeStream, streamID)
+	}
+	if st.status.State != StreamRunning && st.status.State != StreamPaused {
+		status := st.status
+		s.mutex.Unlock()
+		return status, perun.NewAPIErrFailedPreCondition(ErrStreamEnded)
+	}
+	st.cancel()
+	st.status.Error = nil
+	status := s.updateState(st, StreamStopped)
+	notifier := s.notifier
+	s.mutex.Unlock()
+
+	notify(notifier, status)
+	return status, nil
+}
+
+// StopCh stops the running and paused streams on the channel, as it has been closed. The error in the status
+// of these streams is set to indicate this.
+func (s *Streams) StopCh(chID string) {
+	s.stopWhere(func(st *stream) bool { return st.status.ChID == chID },
+		perun.NewAPIErrFailedPreCondition(session.ErrChClosed))
+}
+
+// StopAll stops all the running and paused streams, as the session has been closed. The error in the status
+// of these streams is set to indicate this.
+func (s *Streams) StopAll() {
+	s.stopWhere(func(*stream) bool { return true }, perun.NewAPIErrFailedPreCondition(session.ErrSessionClosed))
+}
+
+// stopWhere stops the running and paused streams that match the filter, with the given error.
+func (s *Streams) stopWhere(matches func(*stream) bool, apiErr perun.APIError) {
+	s.mutex.Lock()
+	var statuses []StreamStatus
+	for _, id := range s.ids {
+		st := s.streams[id]
+		if (st.status.State != StreamRunning && st.status.State != StreamPaused) || !matches(st) {
+			continue
+		}
+		st.cancel()
+		st.status.Error = apiErr
+		statuses = append(statuses, s.updateState(st, StreamStopped))
+	}
+	notifier := s.notifier
+	s.mutex.Unlock()
+
+	for _, status := range statuses {
+		notify(notifier, status)
+	}
+}
+
+// Status returns the status of the stream.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "stream" when the stream is not known.
+func (s *Streams) Status(streamID string) (StreamStatus, perun.APIError) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	st, ok := s.streams[streamID]
+	if !ok {
+		return StreamStatus{}, perun.NewAPIErrResourceNotFound(session.ResTypeStream, streamID)
+	}
+	return st.status, nil
+}
+
+// List returns the status of all the streams, in the order in which they were started.
+func (s *Streams) List() []StreamStatus {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	statuses := make([]StreamStatus, len(s.ids))
+	for i, id := range s.ids {
+		statuses[i] = s.streams[id].status
+	}
+	return statuses
+}
+
+// Sub sets up a subscription for notifications on the status of the streams. Only one subscription is
+// allowed at a time.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceExists with ResourceType: "streamsSub" when a subscription already exists.
+func (s *Streams) Sub(notifier StreamNotifier) perun.APIError {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.notifier != nil {
+		return perun.NewAPIErrResourceExists(session.ResTypeStreamsSub, "")
+	}
+	s.notifier = notifier
+	return nil
+}
+
+// Unsub deletes the existing subscription for notifications on the status of the streams.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType: "streamsSub" when there is no subscription.
+func (s *Streams) Unsub() perun.APIError {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.notifier == nil {
+		return perun.NewAPIErrResourceNotFound(session.ResTypeStreamsSub, "")
+	}
+	s.notifier = nil
+	return nil
+}
+
+func (s *Streams) setState(streamID string, from StreamState, errNotFrom Error, to StreamState) (
+	StreamStatus, perun.APIError) {
+	s.mutex.Lock()
+	st, ok := s.streams[streamID]
+	if !ok {
+		s.mutex.Unlock()
+		return StreamStatus{}, perun.NewAPIErrResourceNotFound(session.ResTypeStream, streamID)
+	}
+	if st.status.State != from {
+		status := st.status
+		s.mutex.Unlock()
+		return status, perun.NewAPIErrFailedPreCondition(errNotFrom)
+	}
+	st.status.Error = nil
+	status := s.updateState(st, to)
+	notifier := s.notifier
+	s.mutex.Unlock()
+
+	notify(notifier, status)
+	return status, nil
+}
+
+// updateState updates the state of the stream and returns the status. It should be called with the mutex
+// locked.
+func (s *Streams) updateState(st *stream, state StreamState) StreamStatus {
+	st.status.State = state
+	st.status.UpdatedAt = time.Now().UTC().Unix()
+	return st.status
+}
+
+// run sends the payments for the stream in each interval, until it ends.
+func (s *Streams) run(st *stream) {
+	ticker := time.NewTicker(st.status.Params.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-st.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if !s.pay(st) {
+			return
+		}
+	}
+}
+
+// pay sends the payment for the current interval, if the stream is running. It returns false, if the stream
+// has ended.
+func (s *Streams) pay(st *stream) bool {
+	s.mutex.Lock()
+	switch {
+	case st.status.State == StreamPaused:
+		s.mutex.Unlock()
+		return true
+	case st.status.State != StreamRunning:
+		s.mutex.Unlock()
+		return false
+	case st.status.Params.EndTime != 0 && time.Now().UTC().Unix() >= st.status.Params.EndTime:
+		status := s.updateState(st, StreamCompleted)
+		notifier := s.notifier
+		s.mutex.Unlock()
+		notify(notifier, status)
+		return false
+	}
+	amount := new(big.Int).Sub(st.cap, st.sent)
+	if amount.Cmp(st.amount) > 0 {
+		amount.Set(st.amount)
+	}
+	s.mutex.Unlock()
+
+	payments := []Payment{{
+		Currency: st.status.Params.Currency,
+		Payee:    peerAlias(st.ch.Parts()),
+		Amount:   st.currency.Print(amount),
+	}}
+	_, apiErr := SendPayChUpdate(st.ctx, st.ch, payments)
+
+	s.mutex.Lock()
+	if st.status.State == StreamStopped {
+		s.mutex.Unlock()
+		return false // Stopped by the user, while the payment was being sent.
+	}
+	var ended bool
+	switch {
+	case apiErr == nil:
+		st.sent.Add(st.sent, amount)
+		st.status.AmountSent = st.currency.Print(st.sent)
+		st.status.PaymentsSent++
+		if st.sent.Cmp(st.cap) >= 0 {
+			s.updateState(st, StreamCompleted)
+			ended = true
+		} else {
+			s.updateState(st, st.status.State)
+		}
+	case apiErr.Code() == perun.ErrPeerRejected || apiErr.Code() == perun.ErrPeerRequestTimedOut:
+		st.status.Error = apiErr
+		s.updateState(st, StreamPaused)
+	default:
+		st.status.Error = apiErr
+		st.cancel()
+		s.updateState(st, StreamStopped)
+		ended = true
+	}
+	status := st.status
+	notifier := s.notifier
+	s.mutex.Unlock()
+
+	notify(notifier, status)
+	return !ended
+}
+
+func notify(notifier StreamNotifier, status StreamStatus) {
+	if notifier != nil {
+		notifier(status)
+	}
+}
+
+func newStreamID() (string, error) {
+	id := make([]byte, streamIDLen)
+	if _, err := rand.Read(id); err != nil {
+		return "", errors.Wrap(err, "generating stream id")
+	}
+	return hex.EncodeToString(id), nil
+}
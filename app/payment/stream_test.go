@@ -0,0 +1,285 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+	"github.com/hyperledger-labs/perun-node/session"
+)
+
+func Test_Streams(t *testing.T) {
+	currencies := currency.NewRegistry()
+	//nolint: errcheck	// Safe to ignore the error, as it is first register after init.
+	ethCurrency, _ := currencies.Register(currency.ETHSymbol, currency.ETHMaxDecimals)
+
+	newChAPIMock := func() *mocks.ChAPI {
+		chAPI := &mocks.ChAPI{}
+		chAPI.On("ID").Return(updatedChInfo.ChID)
+		chAPI.On("Currency", currency.ETHSymbol).Return(0, ethCurrency, true)
+		chAPI.On("Currency", mock.Anything).Return(0, nil, false)
+		chAPI.On("Parts").Return(parts)
+		return chAPI
+	}
+	params := payment.StreamParams{
+		Currency: currency.ETHSymbol,
+		Amount:   "0.4",
+		Interval: 10 * time.Millisecond,
+		Cap:      "1",
+	}
+
+	// subscribe returns streams with a subscription, whose notifications are sent on the returned channel.
+	subscribe := func(t *testing.T) (*payment.Streams, chan payment.StreamStatus) {
+		streams := payment.NewStreams()
+		notifs := make(chan payment.StreamStatus, 10)
+		require.NoError(t, streams.Sub(func(status payment.StreamStatus) { notifs <- status }))
+		return streams, notifs
+	}
+	waitForNotif := func(t *testing.T, notifs chan payment.StreamStatus) payment.StreamStatus {
+		select {
+		case status := <-notifs:
+			return status
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for notification")
+		}
+		return payment.StreamStatus{}
+	}
+
+	t.Run("happy_completed_cap", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		chAPI.On("SendChUpdate", mock.Anything, mock.Anything).Return(updatedChInfo, nil)
+		streams, notifs := subscribe(t)
+
+		status, err := streams.Start(chAPI, params)
+		require.NoError(t, err)
+		assert.NotEmpty(t, status.ID)
+		assert.Equal(t, updatedChInfo.ChID, status.ChID)
+		assert.Equal(t, payment.StreamRunning, status.State)
+		assert.Equal(t, "0", status.AmountSent)
+
+		for i, wantSent := range []string{"0.4", "0.8", "1"} {
+			status = waitForNotif(t, notifs)
+			assert.Equal(t, wantSent, status.AmountSent)
+			assert.Equal(t, uint64(i+1), status.PaymentsSent)
+		}
+		assert.Equal(t, payment.StreamCompleted, status.State)
+		gotStatus, err := streams.Status(status.ID)
+		require.NoError(t, err)
+		assert.Equal(t, status, gotStatus)
+		assert.Equal(t, []payment.StreamStatus{status}, streams.List())
+	})
+
+	t.Run("happy_completed_endTime", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		chAPI.On("SendChUpdate", mock.Anything, mock.Anything).Return(updatedChInfo, nil)
+		streams, notifs := subscribe(t)
+
+		endTimeParams := params
+		endTimeParams.Cap = "1000"
+		endTimeParams.Interval = 400 * time.Millisecond
+		endTimeParams.EndTime = time.Now().Add(2 * time.Second).Unix() // At least a second, as it is rounded down.
+		_, err := streams.Start(chAPI, endTimeParams)
+		require.NoError(t, err)
+
+		var status payment.StreamStatus
+		for status.State != payment.StreamCompleted {
+			select {
+			case status = <-notifs:
+			case <-time.After(2 * time.Second):
+				t.Fatal("timed out waiting for the stream to complete")
+			}
+		}
+		assert.NotZero(t, status.PaymentsSent)
+	})
+
+	t.Run("happy_pause_resume_stop", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		chAPI.On("SendChUpdate", mock.Anything, mock.Anything).Return(updatedChInfo, nil)
+		streams, notifs := subscribe(t)
+		slowParams := params
+		slowParams.Interval = time.Hour
+		started, err := streams.Start(chAPI, slowParams)
+		require.NoError(t, err)
+
+		status, err := streams.Pause(started.ID)
+		require.NoError(t, err)
+		assert.Equal(t, payment.StreamPaused, status.State)
+		assert.Equal(t, status, waitForNotif(t, notifs))
+
+		status, err = streams.Resume(started.ID)
+		require.NoError(t, err)
+		assert.Equal(t, payment.StreamRunning, status.State)
+		assert.Equal(t, status, waitForNotif(t, notifs))
+
+		status, err = streams.Stop(started.ID)
+		require.NoError(t, err)
+		assert.Equal(t, payment.StreamStopped, status.State)
+		assert.Nil(t, status.Error)
+		assert.Equal(t, status, waitForNotif(t, notifs))
+	})
+
+	t.Run("happy_paused_onPeerRejected", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		rejectedErr := perun.NewAPIErrPeerRejected(assert.AnError, peerAlias, "no funds")
+		chAPI.On("SendChUpdate", mock.Anything, mock.Anything).Return(perun.ChInfo{}, rejectedErr)
+		streams, notifs := subscribe(t)
+
+		_, err := streams.Start(chAPI, params)
+		require.NoError(t, err)
+		status := waitForNotif(t, notifs)
+		assert.Equal(t, payment.StreamPaused, status.State)
+		assert.Equal(t, rejectedErr, status.Error)
+		assert.Equal(t, "0", status.AmountSent)
+	})
+
+	t.Run("happy_stopped_onOtherError", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		internalErr := perun.NewAPIErrUnknownInternal(assert.AnError)
+		chAPI.On("SendChUpdate", mock.Anything, mock.Anything).Return(perun.ChInfo{}, internalErr)
+		streams, notifs := subscribe(t)
+
+		started, err := streams.Start(chAPI, params)
+		require.NoError(t, err)
+		status := waitForNotif(t, notifs)
+		assert.Equal(t, payment.StreamStopped, status.State)
+		assert.Equal(t, internalErr, status.Error)
+
+		_, err = streams.Resume(started.ID)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition,
+			payment.ErrStreamNotPaused.Error())
+		_, err = streams.Stop(started.ID)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition,
+			payment.ErrStreamEnded.Error())
+	})
+
+	t.Run("happy_stopCh_stopAll", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		otherChAPI := &mocks.ChAPI{}
+		otherChAPI.On("ID").Return("other-ch")
+		otherChAPI.On("Currency", currency.ETHSymbol).Return(0, ethCurrency, true)
+		streams, notifs := subscribe(t)
+		slowParams := params
+		slowParams.Interval = time.Hour
+		started, err := streams.Start(chAPI, slowParams)
+		require.NoError(t, err)
+		otherStarted, err := streams.Start(otherChAPI, slowParams)
+		require.NoError(t, err)
+
+		streams.StopCh(updatedChInfo.ChID)
+		status := waitForNotif(t, notifs)
+		assert.Equal(t, started.ID, status.ID)
+		assert.Equal(t, payment.StreamStopped, status.State)
+		peruntest.AssertAPIError(t, status.Error, perun.ClientError, perun.ErrFailedPreCondition,
+			session.ErrChClosed.Error())
+		otherStatus, err := streams.Status(otherStarted.ID)
+		require.NoError(t, err)
+		assert.Equal(t, payment.StreamRunning, otherStatus.State)
+
+		streams.StopAll()
+		status = waitForNotif(t, notifs)
+		assert.Equal(t, otherStarted.ID, status.ID)
+		assert.Equal(t, payment.StreamStopped, status.State)
+		peruntest.AssertAPIError(t, status.Error, perun.ClientError, perun.ErrFailedPreCondition,
+			session.ErrSessionClosed.Error())
+		assert.Empty(t, notifs, "already stopped streams should not be stopped again")
+	})
+
+	t.Run("session_streams", func(t *testing.T) {
+		var sessionStreams perun.PayStreams
+		sessionAPI := &mocks.SessionAPI{}
+		sessionAPI.On("PayStreams", mock.Anything).Return(func(newPayStreams func() perun.PayStreams) perun.PayStreams {
+			if sessionStreams == nil {
+				sessionStreams = newPayStreams()
+			}
+			return sessionStreams
+		})
+
+		streams := payment.SessionStreams(sessionAPI)
+		require.NotNil(t, streams)
+		assert.Same(t, streams, payment.SessionStreams(sessionAPI))
+	})
+
+	t.Run("error_invalidParams", func(t *testing.T) {
+		streams := payment.NewStreams()
+		chAPI := newChAPIMock()
+
+		invalidParams := params
+		invalidParams.Currency = "unknown"
+		_, err := streams.Start(chAPI, invalidParams)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), session.ResTypeCurrency, "unknown")
+
+		invalidParams = params
+		invalidParams.Amount = "abc"
+		_, err = streams.Start(chAPI, invalidParams)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameAmount, "abc")
+
+		invalidParams = params
+		invalidParams.Cap = "0.1"
+		_, err = streams.Start(chAPI, invalidParams)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameCap, "0.1")
+
+		invalidParams = params
+		invalidParams.Interval = 0
+		_, err = streams.Start(chAPI, invalidParams)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameInterval, "0s")
+
+		invalidParams = params
+		invalidParams.EndTime = 1
+		_, err = streams.Start(chAPI, invalidParams)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameEndTime, fmt.Sprint(1))
+	})
+
+	t.Run("error_unknownStream", func(t *testing.T) {
+		streams := payment.NewStreams()
+		_, err := streams.Status("unknown")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), session.ResTypeStream, "unknown")
+		_, err = streams.Pause("unknown")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		_, err = streams.Resume("unknown")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		_, err = streams.Stop("unknown")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	})
+
+	t.Run("sub_unsub", func(t *testing.T) {
+		streams := payment.NewStreams()
+		dummyNotifier := func(payment.StreamStatus) {}
+		require.NoError(t, streams.Sub(dummyNotifier))
+		err := streams.Sub(dummyNotifier)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceExists)
+		require.NoError(t, streams.Unsub())
+		err = streams.Unsub()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	})
+}
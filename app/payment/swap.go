@@ -0,0 +1,176 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/session"
+)
+
+type (
+	// SwapOffer is an offer to give an amount in one currency for an amount in another currency, to the peer
+	// in a multi-asset channel. The offer is valid for ExpirySecs after it is proposed.
+	SwapOffer struct {
+		GiveCurrency string
+		GiveAmount   string
+		TakeCurrency string
+		TakeAmount   string
+		ExpirySecs   uint64
+	}
+
+	// SwapQuote is the quote of a swap, that is sent to the peer along with the update. Give and take are from
+	// the perspective of the proposer of the swap. Rate is the amount of TakeCurrency per unit of GiveCurrency.
+	// Expiry is the time (in unix timestamp) until which the quote is valid.
+	SwapQuote struct {
+		GiveCurrency string `json:"giveCurrency"`
+		GiveAmount   string `json:"giveAmount"`
+		TakeCurrency string `json:"takeCurrency"`
+		TakeAmount   string `json:"takeAmount"`
+		Rate         string `json:"rate"`
+		Expiry       int64  `json:"expiry"`
+	}
+)
+
+// ProposeSwap sends an update on the channel that swaps the amounts in the offer: the user gives the amount in
+// GiveCurrency to the peer and takes the amount in TakeCurrency from the peer. Both the legs of the swap are
+// made in a single update, so either both apply or neither does.
+//
+// The quote is sent to the peer along with the update and is shown in the update notification. The peer
+// should respond before the quote expires, after which the update is not awaited anymore.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrInvalidArgument with Name:"currency" when the give and take currencies are the same.
+// - ErrInvalidArgument with Name:"expiry" when the expiry is zero.
+// - ErrInvalidArgument with Name:"amount" when any of the amounts is invalid.
+// - ErrResourceNotFound with ResourceType:"currency" when any of the currencies is not used in the channel.
+// or any of the errors returned by the session.SendChUpdate API.
+func ProposeSwap(pctx context.Context, ch perun.ChAPI, offer SwapOffer) (PayChInfo, SwapQuote, perun.APIError) {
+	if offer.GiveCurrency == offer.TakeCurrency {
+		err := errors.New("give and take currencies should be different")
+		return PayChInfo{}, SwapQuote{}, perun.NewAPIErrInvalidArgument(err, session.ArgNameCurrency,
+			offer.TakeCurrency)
+	}
+	if offer.ExpirySecs == 0 {
+		err := errors.New("expiry should be greater than zero")
+		return PayChInfo{}, SwapQuote{}, perun.NewAPIErrInvalidArgument(err, session.ArgNameExpiry, "0")
+	}
+	payments := []Payment{
+		{Currency: offer.GiveCurrency, Payee: peerAlias(ch.Parts()), Amount: offer.GiveAmount},
+		{Currency: offer.TakeCurrency, Payee: perun.OwnAlias, Amount: offer.TakeAmount},
+	}
+	updater, apiErr := newPayChUpdater(ch, payments)
+	if apiErr != nil {
+		return PayChInfo{}, SwapQuote{}, apiErr
+	}
+
+	quote := SwapQuote{
+		GiveCurrency: offer.GiveCurrency,
+		GiveAmount:   offer.GiveAmount,
+		TakeCurrency: offer.TakeCurrency,
+		TakeAmount:   offer.TakeAmount,
+		Rate:         swapRate(offer.GiveAmount, offer.TakeAmount),
+		Expiry:       time.Now().UTC().Add(time.Duration(offer.ExpirySecs) * time.Second).Unix(),
+	}
+	encodedQuote, err := json.Marshal(quote)
+	if err != nil {
+		return PayChInfo{}, SwapQuote{}, perun.NewAPIErrUnknownInternal(errors.Wrap(err, "encoding swap quote"))
+	}
+	meta := perun.ChUpdateMeta{
+		perun.SwapMetaKey:   string(encodedQuote),
+		perun.ExpiryMetaKey: fmt.Sprintf("%d", quote.Expiry),
+	}
+	chInfo, apiErr := ch.SendChUpdateWMeta(pctx, updater, meta)
+	return toPayChInfo(chInfo), quote, apiErr
+}
+
+// swapRate returns the amount of take currency per unit of give currency. The amounts should be valid.
+func swapRate(giveAmount, takeAmount string) string {
+	give, _ := decimal.NewFromString(giveAmount) //nolint: errcheck	// Amounts are validated by the caller.
+	take, _ := decimal.NewFromString(takeAmount) //nolint: errcheck	// Amounts are validated by the caller.
+	return take.Div(give).String()
+}
+
+// swapQuoteFromNotif returns the swap quote sent along with the update proposed by the peer, only if the update
+// makes exactly that swap. Otherwise, it returns nil and the update is shown as a regular payment update.
+func swapQuoteFromNotif(notif perun.ChUpdateNotif) *SwapQuote {
+	encodedQuote, ok := notif.Meta[perun.SwapMetaKey]
+	if !ok || notif.Type == perun.ChUpdateTypeClosed {
+		return nil
+	}
+	var quote SwapQuote
+	if err := json.Unmarshal([]byte(encodedQuote), &quote); err != nil {
+		return nil
+	}
+	give, err := decimal.NewFromString(quote.GiveAmount)
+	if err != nil || !give.IsPositive() || quote.Rate != swapRate(quote.GiveAmount, quote.TakeAmount) {
+		return nil
+	}
+	take, err := decimal.NewFromString(quote.TakeAmount)
+	if err != nil || !take.IsPositive() {
+		return nil
+	}
+
+	wantChanges := map[string]decimal.Decimal{quote.GiveCurrency: give.Neg(), quote.TakeCurrency: take}
+	if len(wantChanges) != 2 {
+		return nil
+	}
+	curr, proposed := notif.CurrChInfo.BalInfo, notif.ProposedChInfo.BalInfo
+	proposer := peerAlias(proposed.Parts)
+	if len(curr.Currencies) != len(proposed.Currencies) {
+		return nil
+	}
+	for i := range proposed.Currencies {
+		change, err := balChange(curr, proposed, i, proposer)
+		if err != nil || curr.Currencies[i] != proposed.Currencies[i] ||
+			!change.Equal(wantChanges[proposed.Currencies[i]]) {
+			return nil
+		}
+		delete(wantChanges, proposed.Currencies[i])
+	}
+	if len(wantChanges) != 0 {
+		return nil
+	}
+	return &quote
+}
+
+// balChange returns the change in the balance of the participant in the currency at the index, between the
+// current and the proposed balances.
+func balChange(curr, proposed perun.BalInfo, currencyIdx int, part string) (decimal.Decimal, error) {
+	for j := range proposed.Parts {
+		if proposed.Parts[j] != part {
+			continue
+		}
+		currBal, err := decimal.NewFromString(curr.Bals[currencyIdx][j])
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		proposedBal, err := decimal.NewFromString(proposed.Bals[currencyIdx][j])
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		return proposedBal.Sub(currBal), nil
+	}
+	return decimal.Decimal{}, errors.Errorf("participant %s not found", part)
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payment_test
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/app/payment"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+	"github.com/hyperledger-labs/perun-node/session"
+)
+
+func Test_ProposeSwap(t *testing.T) {
+	tokenSymbol := "PRN"
+	currencies := currency.NewRegistry()
+	//nolint: errcheck	// Safe to ignore the error, as it is first register after init.
+	ethCurrency, _ := currencies.Register(currency.ETHSymbol, currency.ETHMaxDecimals)
+	//nolint: errcheck	// Safe to ignore the error, as it is a different symbol.
+	tokenCurrency, _ := currencies.Register(tokenSymbol, 18)
+
+	newChAPIMock := func() *mocks.ChAPI {
+		chAPI := &mocks.ChAPI{}
+		chAPI.On("Currency", currency.ETHSymbol).Return(0, ethCurrency, true)
+		chAPI.On("Currency", tokenSymbol).Return(1, tokenCurrency, true)
+		chAPI.On("Parts").Return(parts)
+		return chAPI
+	}
+	offer := payment.SwapOffer{
+		GiveCurrency: currency.ETHSymbol,
+		GiveAmount:   "0.5",
+		TakeCurrency: tokenSymbol,
+		TakeAmount:   "100",
+		ExpirySecs:   60,
+	}
+
+	t.Run("happy", func(t *testing.T) {
+		var gotMeta perun.ChUpdateMeta
+		chAPI := newChAPIMock()
+		chAPI.On("SendChUpdateWMeta", context.Background(), mock.Anything, mock.MatchedBy(
+			func(meta perun.ChUpdateMeta) bool {
+				gotMeta = meta
+				return true
+			})).Return(updatedChInfo, nil)
+
+		before := time.Now().Unix()
+		gotPayChInfo, gotQuote, gotErr := payment.ProposeSwap(context.Background(), chAPI, offer)
+		require.NoError(t, gotErr)
+		assert.Equal(t, wantUpdatedPayChInfo, gotPayChInfo)
+		assert.Equal(t, "200", gotQuote.Rate)
+		assert.GreaterOrEqual(t, gotQuote.Expiry, before+60)
+
+		var sentQuote payment.SwapQuote
+		require.NoError(t, json.Unmarshal([]byte(gotMeta[perun.SwapMetaKey]), &sentQuote))
+		assert.Equal(t, gotQuote, sentQuote)
+		assert.Equal(t, strconv.FormatInt(gotQuote.Expiry, 10), gotMeta[perun.ExpiryMetaKey])
+	})
+
+	t.Run("error_sameCurrency", func(t *testing.T) {
+		sameCurrencyOffer := offer
+		sameCurrencyOffer.TakeCurrency = currency.ETHSymbol
+		_, _, gotErr := payment.ProposeSwap(context.Background(), newChAPIMock(), sameCurrencyOffer)
+		peruntest.AssertAPIError(t, gotErr, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, gotErr.AddInfo(), session.ArgNameCurrency, currency.ETHSymbol)
+	})
+
+	t.Run("error_zeroExpiry", func(t *testing.T) {
+		zeroExpiryOffer := offer
+		zeroExpiryOffer.ExpirySecs = 0
+		_, _, gotErr := payment.ProposeSwap(context.Background(), newChAPIMock(), zeroExpiryOffer)
+		peruntest.AssertAPIError(t, gotErr, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, gotErr.AddInfo(), session.ArgNameExpiry, "0")
+	})
+
+	t.Run("error_invalidAmount", func(t *testing.T) {
+		invalidAmountOffer := offer
+		invalidAmountOffer.TakeAmount = "abc"
+		_, _, gotErr := payment.ProposeSwap(context.Background(), newChAPIMock(), invalidAmountOffer)
+		peruntest.AssertAPIError(t, gotErr, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, gotErr.AddInfo(), session.ArgNameAmount, "abc")
+	})
+
+	t.Run("error_SendChUpdateWMeta", func(t *testing.T) {
+		chAPI := newChAPIMock()
+		chAPI.On("SendChUpdateWMeta", context.Background(), mock.Anything, mock.Anything).Return(
+			perun.ChInfo{}, perun.NewAPIErrUnknownInternal(assert.AnError))
+		_, _, gotErr := payment.ProposeSwap(context.Background(), chAPI, offer)
+		require.Error(t, gotErr)
+		t.Log(gotErr)
+	})
+}
+
+func Test_SubPayChUpdates_Swap(t *testing.T) {
+	var notifier perun.ChUpdateNotifier
+	var notif payment.PayChUpdateNotif
+	chAPI := &mocks.ChAPI{}
+	chAPI.On("SubChUpdates", mock.MatchedBy(func(gotNotifier perun.ChUpdateNotifier) bool {
+		notifier = gotNotifier
+		return true
+	})).Return(nil)
+	require.NoError(t, payment.SubPayChUpdates(chAPI, func(gotNotif payment.PayChUpdateNotif) {
+		notif = gotNotif
+	}))
+	require.NotNil(t, notifier)
+
+	// Peer gives 0.5 ETH and takes 100 PRN.
+	swapNotif := perun.ChUpdateNotif{
+		UpdateID: updateID,
+		CurrChInfo: perun.ChInfo{BalInfo: perun.BalInfo{
+			Currencies: []string{curr, "PRN"},
+			Parts:      parts,
+			Bals:       [][]string{{"1.000000", "2.000000"}, {"150", "50"}},
+		}},
+		ProposedChInfo: perun.ChInfo{BalInfo: perun.BalInfo{
+			Currencies: []string{curr, "PRN"},
+			Parts:      parts,
+			Bals:       [][]string{{"1.500000", "1.500000"}, {"50", "150"}},
+		}},
+		Type:   perun.ChUpdateTypeOpen,
+		Expiry: expiry,
+	}
+	quote := payment.SwapQuote{
+		GiveCurrency: curr,
+		GiveAmount:   "0.5",
+		TakeCurrency: "PRN",
+		TakeAmount:   "100",
+		Rate:         "200",
+		Expiry:       expiry,
+	}
+	withQuote := func(quote payment.SwapQuote) perun.ChUpdateNotif {
+		encoded, err := json.Marshal(quote)
+		require.NoError(t, err)
+		n := swapNotif
+		n.Meta = perun.ChUpdateMeta{perun.SwapMetaKey: string(encoded)}
+		return n
+	}
+
+	t.Run("matching_quote", func(t *testing.T) {
+		notifier(withQuote(quote))
+		require.NotNil(t, notif.Swap)
+		assert.Equal(t, quote, *notif.Swap)
+		assert.Equal(t, payment.PayChUpdateMeta{}, notif.Meta)
+	})
+
+	t.Run("mismatched_amount", func(t *testing.T) {
+		mismatched := quote
+		mismatched.TakeAmount, mismatched.Rate = "90", "180"
+		notifier(withQuote(mismatched))
+		assert.Nil(t, notif.Swap)
+	})
+
+	t.Run("mismatched_rate", func(t *testing.T) {
+		mismatched := quote
+		mismatched.Rate = "250"
+		notifier(withQuote(mismatched))
+		assert.Nil(t, notif.Swap)
+	})
+
+	t.Run("invalid_quote", func(t *testing.T) {
+		n := swapNotif
+		n.Meta = perun.ChUpdateMeta{perun.SwapMetaKey: "invalid"}
+		notifier(n)
+		assert.Nil(t, notif.Swap)
+	})
+}
@@ -39,12 +39,24 @@ import (
 // reading on-chain data. So make it as a package level constant.
 const roChainBackendTxTimeout = 1 * time.Second
 
+// txQueues is the node-wide registry of transaction queues. All chain
+// backends share it, so that transactions from the same on-chain account in
+// different sessions (or concurrent operations within a session) do not
+// collide on nonces.
+var txQueues = internal.NewTxQueues()
+
 // NewChainBackend initializes a connection to blockchain node and sets up a
 // wallet with given credentials for funding on-chain transactions and channel
 // balances.
 //
 // It uses the provided credentials to initialize a new keystore wallet.
 //
+// Nonces for and submission of the transactions are managed by a node-wide
+// queue for each on-chain account, which is shared by all chain backends
+// connected to the same url. The nonces are assigned by the queue when the
+// transactions are signed. Costs of the transactions are recorded when the
+// context used for sending them carries a blockchain.TxCostRecorder.
+//
 // The function signature uses only types defined in the root package of this
 // project and types from std lib.  This enables the function to be loaded as
 // symbol without importing this package when it is compiled as plugin.
@@ -71,8 +83,11 @@ func NewChainBackend(url string,
 	if err != nil {
 		return nil, err
 	}
-	tr := pkeystore.NewTransactor(*ksWallet, types.NewEIP155Signer(big.NewInt(int64(chainID))))
-	cb := pethchannel.NewContractBackend(ethereumBackend, tr)
+	signer := types.NewEIP155Signer(big.NewInt(int64(chainID)))
+	tr := pkeystore.NewTransactor(*ksWallet, signer)
+	queuedTr := txQueues.WrapTransactor(tr, ethereumBackend, url)
+	cb := pethchannel.NewContractBackend(internal.RecordTxCosts(txQueues.WrapBackend(ethereumBackend, url, signer)),
+		queuedTr)
 	return &internal.ChainBackend{Cb: &cb, TxTimeout: onChainTxTimeout}, nil
 }
 
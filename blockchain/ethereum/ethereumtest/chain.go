@@ -109,5 +109,5 @@ func newSimContractBackend(t *testing.T, accs []pwallet.Account, ks *keystore.Ke
 	require.NoError(t, err)
 
 	tr := pkeystore.NewTransactor(*ksWallet, types.NewEIP155Signer(big.NewInt(int64(ChainID))))
-	return pethchannel.NewContractBackend(simBackend, tr)
+	return pethchannel.NewContractBackend(internal.RecordTxCosts(simBackend), tr)
 }
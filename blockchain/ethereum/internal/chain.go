@@ -24,17 +24,47 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+	padjudicator "perun.network/go-perun/backend/ethereum/bindings/adjudicator"
 	pperuntoken "perun.network/go-perun/backend/ethereum/bindings/peruntoken"
 	pethchannel "perun.network/go-perun/backend/ethereum/channel"
+	petherrors "perun.network/go-perun/backend/ethereum/channel/errors"
 	pethwallet "perun.network/go-perun/backend/ethereum/wallet"
 	pchannel "perun.network/go-perun/channel"
+	pclient "perun.network/go-perun/client"
 	pwallet "perun.network/go-perun/wallet"
 
 	"github.com/hyperledger-labs/perun-node"
 	"github.com/hyperledger-labs/perun-node/blockchain"
 )
 
+// Enumeration of tx types used in TxTimedoutError for on-chain account
+// operations.
+const (
+	txTypeTransferETH   = "TransferETH"
+	txTypeTransferERC20 = "TransferERC20"
+	txTypeApproveERC20  = "ApproveERC20"
+)
+
+// Enumeration of dispute phases in the channel update events emitted by the
+// adjudicator contract.
+const (
+	adjPhaseDispute = iota
+	adjPhaseForceExec
+	adjPhaseConcluded
+)
+
+// transferETHGasLimit is the gas limit for a plain ETH transfer to an
+// externally owned account.
+const transferETHGasLimit = 21000
+
+// balanceReader is implemented by contract interfaces that can read the
+// balance of an account.
+type balanceReader interface {
+	BalanceAt(ctx context.Context, addr common.Address, blockNum *big.Int) (*big.Int, error)
+}
+
 // Funder implements a wrapper around ETH Funder.
 //
 // See perun.Funder for more info.
@@ -75,6 +105,21 @@ type ChainBackend struct {
 	// If this expires, a transactions is considered failed.
 	// Use sufficiently large values when connecting to mainnet.
 	TxTimeout time.Duration
+
+	txCosts blockchain.TxCostRecorder // Costs of txs sent by the chain backend itself.
+}
+
+// TxCosts returns the costs of transactions sent for deploying contracts and
+// on-chain account operations using this chain backend.
+func (cb *ChainBackend) TxCosts() []perun.TxCost {
+	return cb.txCosts.Costs()
+}
+
+// txContext returns a context for sending transactions that times out after
+// the TxTimeout and records the cost of transactions in the chain backend.
+func (cb *ChainBackend) txContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), cb.TxTimeout)
+	return blockchain.WithTxCostRecorder(ctx, &cb.txCosts), cancel
 }
 
 // NewFunder initializes and returns an instance of ethereum funder.
@@ -165,7 +210,7 @@ func (cb *ChainBackend) ValidateAssetERC20(adj, tokenERC20, assetERC20 pwallet.A
 
 // DeployAdjudicator deploys the adjudicator contract.
 func (cb *ChainBackend) DeployAdjudicator(txSender pwallet.Address) (pwallet.Address, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), cb.TxTimeout)
+	ctx, cancel := cb.txContext()
 	defer cancel()
 
 	txSenderAcc := accounts.Account{Address: pethwallet.AsEthAddr(txSender)}
@@ -175,7 +220,7 @@ func (cb *ChainBackend) DeployAdjudicator(txSender pwallet.Address) (pwallet.Add
 
 // DeployAssetETH deploys the asset ETH contract, setting the adjudicator address to given value.
 func (cb *ChainBackend) DeployAssetETH(adjAddr, txSender pwallet.Address) (pwallet.Address, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), cb.TxTimeout)
+	ctx, cancel := cb.txContext()
 	defer cancel()
 
 	txSenderAcc := accounts.Account{Address: pethwallet.AsEthAddr(txSender)}
@@ -191,7 +236,7 @@ func (cb *ChainBackend) DeployPerunToken(initAccs []pwallet.Address, initBal *bi
 		initAccsETH[i] = pethwallet.AsEthAddr(initAccs[i])
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), cb.TxTimeout)
+	ctx, cancel := cb.txContext()
 	defer cancel()
 	txSenderAcc := accounts.Account{Address: pethwallet.AsEthAddr(txSender)}
 	addr, err := pethchannel.DeployPerunToken(ctx, *cb.Cb, txSenderAcc, initAccsETH, initBal)
@@ -201,7 +246,7 @@ func (cb *ChainBackend) DeployPerunToken(initAccs []pwallet.Address, initBal *bi
 // DeployAssetERC20 deploys the asset ERC20 contract, setting the adjudicator
 // and erc20 token addresses to given values.
 func (cb *ChainBackend) DeployAssetERC20(adj, tokenERC20, txSender pwallet.Address) (pwallet.Address, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), cb.TxTimeout)
+	ctx, cancel := cb.txContext()
 	defer cancel()
 
 	txSenderAcc := accounts.Account{Address: pethwallet.AsEthAddr(txSender)}
@@ -209,3 +254,183 @@ func (cb *ChainBackend) DeployAssetERC20(adj, tokenERC20, txSender pwallet.Addre
 		pethwallet.AsEthAddr(adj), pethwallet.AsEthAddr(tokenERC20), txSenderAcc)
 	return pethwallet.AsWalletAddr(addr), errors.Wrap(err, "deploying asset ERC20 contract")
 }
+
+// BalanceAt reads the on-chain ETH balance of the given address.
+func (cb *ChainBackend) BalanceAt(addr pwallet.Address) (*big.Int, error) {
+	stateReader, ok := cb.Cb.ContractInterface.(balanceReader)
+	if !ok {
+		return nil, errors.New("contract backend does not support reading account state")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cb.TxTimeout)
+	defer cancel()
+	bal, err := stateReader.BalanceAt(ctx, pethwallet.AsEthAddr(addr), nil)
+	return bal, errors.Wrap(petherrors.CheckIsChainNotReachableError(err), "reading on-chain balance")
+}
+
+// BalanceAtERC20 reads the balance of the given address in the ERC20 token
+// contract.
+func (cb *ChainBackend) BalanceAtERC20(token, addr pwallet.Address) (*big.Int, error) {
+	tokenERC20, err := pperuntoken.NewPerunToken(pethwallet.AsEthAddr(token), *cb.Cb)
+	if err != nil {
+		return nil, errors.Wrap(err, "binding to erc20 token contract")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cb.TxTimeout)
+	defer cancel()
+	bal, err := tokenERC20.BalanceOf(&bind.CallOpts{Context: ctx}, pethwallet.AsEthAddr(addr))
+	return bal, errors.WithMessage(petherrors.CheckIsChainNotReachableError(err), "reading balance from the contract")
+}
+
+// AllowanceERC20 reads the amount of tokens, the spender is allowed to
+// withdraw from the owner's balance in the ERC20 token contract.
+func (cb *ChainBackend) AllowanceERC20(token, owner, spender pwallet.Address) (*big.Int, error) {
+	tokenERC20, err := pperuntoken.NewPerunToken(pethwallet.AsEthAddr(token), *cb.Cb)
+	if err != nil {
+		return nil, errors.Wrap(err, "binding to erc20 token contract")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cb.TxTimeout)
+	defer cancel()
+	allowance, err := tokenERC20.Allowance(&bind.CallOpts{Context: ctx},
+		pethwallet.AsEthAddr(owner), pethwallet.AsEthAddr(spender))
+	return allowance, errors.WithMessage(petherrors.CheckIsChainNotReachableError(err),
+		"reading allowance from the contract")
+}
+
+// BlockNumber returns the number of the latest block on the blockchain.
+func (cb *ChainBackend) BlockNumber() (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cb.TxTimeout)
+	defer cancel()
+	header, err := cb.Cb.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, errors.WithMessage(petherrors.CheckIsChainNotReachableError(err), "reading latest block")
+	}
+	return header.Number.Uint64(), nil
+}
+
+// PastAdjEvents reads all the events emitted by the adjudicator contract for
+// the given channel, from the given block until the latest block. The events
+// are returned in the order in which they were emitted.
+//
+// Since filtering events over a large range of blocks is expensive, fromBlock
+// should be as recent as possible, e.g. the block at which the channel was
+// opened.
+//
+// Progressed events contain only the version and timeout, the progressed
+// state is not fetched.
+func (cb *ChainBackend) PastAdjEvents(adjAddr pwallet.Address, chID pchannel.ID, fromBlock uint64) (
+	[]pchannel.AdjudicatorEvent, error) {
+	adj, err := padjudicator.NewAdjudicatorFilterer(pethwallet.AsEthAddr(adjAddr), cb.Cb)
+	if err != nil {
+		return nil, errors.Wrap(err, "binding to adjudicator contract")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cb.TxTimeout)
+	defer cancel()
+	iter, err := adj.FilterChannelUpdate(&bind.FilterOpts{Start: fromBlock, Context: ctx}, [][32]byte{chID})
+	if err != nil {
+		return nil, errors.WithMessage(petherrors.CheckIsChainNotReachableError(err), "filtering adjudicator events")
+	}
+	defer iter.Close() // nolint: errcheck
+
+	events := []pchannel.AdjudicatorEvent{}
+	for iter.Next() {
+		e := iter.Event
+		base := pchannel.NewAdjudicatorEventBase(e.ChannelID, pethchannel.NewBlockTimeout(cb.Cb, e.Timeout), e.Version)
+		switch e.Phase {
+		case adjPhaseDispute:
+			events = append(events, &pchannel.RegisteredEvent{AdjudicatorEventBase: *base})
+		case adjPhaseForceExec:
+			events = append(events, &pchannel.ProgressedEvent{AdjudicatorEventBase: *base})
+		case adjPhaseConcluded:
+			events = append(events, &pchannel.ConcludedEvent{AdjudicatorEventBase: *base})
+		default:
+			return nil, errors.Errorf("unknown phase %d in adjudicator event", e.Phase)
+		}
+	}
+	return events, errors.WithMessage(petherrors.CheckIsChainNotReachableError(iter.Error()),
+		"reading adjudicator events")
+}
+
+// TransferETH transfers the given amount of ETH from the tx sender to the
+// given address and returns the ID of the transaction.
+func (cb *ChainBackend) TransferETH(to pwallet.Address, amount *big.Int, txSender pwallet.Address) (string, error) {
+	return cb.sendTx(txTypeTransferETH, txSender, transferETHGasLimit,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			gasPrice, err := cb.Cb.SuggestGasPrice(opts.Context)
+			if err != nil {
+				return nil, errors.WithMessage(petherrors.CheckIsChainNotReachableError(err), "suggesting gas price")
+			}
+			tx := types.NewTransaction(opts.Nonce.Uint64(), pethwallet.AsEthAddr(to), amount,
+				opts.GasLimit, gasPrice, nil)
+			signedTx, err := opts.Signer(opts.From, tx)
+			if err != nil {
+				return nil, errors.Wrap(err, "signing transaction")
+			}
+			err = cb.Cb.SendTransaction(opts.Context, signedTx)
+			return signedTx, errors.WithMessage(petherrors.CheckIsChainNotReachableError(err), "sending transaction")
+		})
+}
+
+// TransferERC20 transfers the given amount of ERC20 tokens from the tx sender
+// to the given address and returns the ID of the transaction.
+func (cb *ChainBackend) TransferERC20(token, to pwallet.Address, amount *big.Int, txSender pwallet.Address) (
+	string, error) {
+	tokenERC20, err := pperuntoken.NewPerunToken(pethwallet.AsEthAddr(token), *cb.Cb)
+	if err != nil {
+		return "", errors.Wrap(err, "binding to erc20 token contract")
+	}
+	return cb.sendTx(txTypeTransferERC20, txSender, pethchannel.GasLimit,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			tx, err := tokenERC20.Transfer(opts, pethwallet.AsEthAddr(to), amount)
+			return tx, errors.WithMessage(petherrors.CheckIsChainNotReachableError(err), "sending transaction")
+		})
+}
+
+// ApproveERC20 sets the amount of tokens, the spender is allowed to withdraw
+// from the tx sender's balance in the ERC20 token contract and returns the ID
+// of the transaction.
+func (cb *ChainBackend) ApproveERC20(token, spender pwallet.Address, amount *big.Int, txSender pwallet.Address) (
+	string, error) {
+	tokenERC20, err := pperuntoken.NewPerunToken(pethwallet.AsEthAddr(token), *cb.Cb)
+	if err != nil {
+		return "", errors.Wrap(err, "binding to erc20 token contract")
+	}
+	return cb.sendTx(txTypeApproveERC20, txSender, pethchannel.GasLimit,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			tx, err := tokenERC20.Approve(opts, pethwallet.AsEthAddr(spender), amount)
+			return tx, errors.WithMessage(petherrors.CheckIsChainNotReachableError(err), "sending transaction")
+		})
+}
+
+// sendTx sends a transaction using the send function and waits until it is
+// confirmed on the blockchain or the tx timeout expires.
+//
+// If the tx timeout expires, a TxTimedoutError with the given tx type is
+// returned.
+func (cb *ChainBackend) sendTx(txType string, txSender pwallet.Address, gasLimit uint64,
+	send func(*bind.TransactOpts) (*types.Transaction, error)) (txID string, _ error) {
+	ctx, cancel := cb.txContext()
+	defer cancel()
+
+	txSenderAcc := accounts.Account{Address: pethwallet.AsEthAddr(txSender)}
+	opts, err := cb.Cb.NewTransactor(ctx, gasLimit, txSenderAcc)
+	if err != nil {
+		return "", errors.WithMessage(err, "creating transactor")
+	}
+	tx, err := send(opts)
+	if err != nil {
+		return "", err
+	}
+	txID = tx.Hash().Hex()
+	receipt, err := cb.Cb.ConfirmTransaction(ctx, tx, txSenderAcc)
+	if err != nil {
+		if ctx.Err() != nil {
+			return txID, pclient.NewTxTimedoutError(txType, txID, err.Error())
+		}
+		return txID, errors.WithMessage(err, "confirming transaction")
+	}
+	// The tx may have been re-signed with a fresh nonce in the tx queue.
+	return receipt.TxHash.Hex(), nil
+}
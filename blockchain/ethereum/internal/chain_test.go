@@ -17,6 +17,7 @@
 package internal_test
 
 import (
+	"context"
 	"errors"
 	"math/big"
 	"math/rand"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
 	pwallet "perun.network/go-perun/wallet"
 
 	"github.com/hyperledger-labs/perun-node"
@@ -195,3 +197,145 @@ func Test_ChainBackend_NewAdjudicator(t *testing.T) {
 
 	assert.NotNil(t, setup.ChainBackend.NewAdjudicator(randomAddr1, randomAddr2))
 }
+
+func Test_ChainBackend_TransferETH_BalanceAt(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	setup := ethereumtest.NewSimChainBackendSetup(t, rng, 1)
+	onChainAcc := setup.Accs[0].Address()
+	randomAddr1 := ethereumtest.NewRandomAddress(rng)
+
+	amount := big.NewInt(1e18)
+	txID, err := setup.ChainBackend.TransferETH(randomAddr1, amount, onChainAcc)
+	require.NoError(t, err)
+	assert.NotZero(t, txID)
+
+	bal, err := setup.ChainBackend.BalanceAt(randomAddr1)
+	require.NoError(t, err)
+	assert.Equal(t, amount, bal)
+}
+
+func Test_ChainBackend_TxCosts(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	setup := ethereumtest.NewSimChainBackendSetup(t, rng, 1)
+	onChainAcc := setup.Accs[0].Address()
+	randomAddr1 := ethereumtest.NewRandomAddress(rng)
+
+	txCostsBefore := setup.ChainBackend.TxCosts()
+	txID, err := setup.ChainBackend.TransferETH(randomAddr1, big.NewInt(1e18), onChainAcc)
+	require.NoError(t, err)
+	_, err = setup.ChainBackend.DeployAdjudicator(onChainAcc)
+	require.NoError(t, err)
+
+	txCosts := setup.ChainBackend.TxCosts()
+	require.Len(t, txCosts, len(txCostsBefore)+2)
+	transferCost := txCosts[len(txCostsBefore)]
+	assert.Equal(t, "transferETH", transferCost.TxType)
+	assert.Equal(t, txID, transferCost.TxID)
+	assert.EqualValues(t, 21000, transferCost.GasUsed)
+	assert.Positive(t, transferCost.Fee.Sign())
+
+	deployCost := txCosts[len(txCostsBefore)+1]
+	assert.Equal(t, "deploy", deployCost.TxType)
+	assert.NotZero(t, deployCost.GasUsed)
+}
+
+func Test_ChainBackend_TransferERC20_ApproveERC20(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	setup := ethereumtest.NewSimChainBackendSetup(t, rng, 1)
+	onChainAcc := setup.Accs[0].Address()
+	randomAddr1 := ethereumtest.NewRandomAddress(rng)
+
+	require.Len(t, setup.AssetERC20s, 1)
+	for tokenERC20, assetERC20 := range setup.AssetERC20s {
+		t.Run("happy_transfer", func(t *testing.T) {
+			amount := big.NewInt(4)
+			_, err := setup.ChainBackend.TransferERC20(tokenERC20, randomAddr1, amount, onChainAcc)
+			require.NoError(t, err)
+
+			bal, err := setup.ChainBackend.BalanceAtERC20(tokenERC20, randomAddr1)
+			require.NoError(t, err)
+			assert.Equal(t, amount, bal)
+			bal, err = setup.ChainBackend.BalanceAtERC20(tokenERC20, onChainAcc)
+			require.NoError(t, err)
+			assert.Equal(t, big.NewInt(6), bal) // Initial balance of the account is 10.
+		})
+		t.Run("transfer_insufficient_balance", func(t *testing.T) {
+			_, err := setup.ChainBackend.TransferERC20(tokenERC20, randomAddr1, big.NewInt(100), onChainAcc)
+			assert.Error(t, err)
+			t.Log(err)
+		})
+		t.Run("happy_approve", func(t *testing.T) {
+			amount := big.NewInt(3)
+			_, err := setup.ChainBackend.ApproveERC20(tokenERC20, assetERC20, amount, onChainAcc)
+			require.NoError(t, err)
+
+			allowance, err := setup.ChainBackend.AllowanceERC20(tokenERC20, onChainAcc, assetERC20)
+			require.NoError(t, err)
+			assert.Equal(t, amount, allowance)
+		})
+	}
+}
+
+func Test_ChainBackend_PastAdjEvents(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	setup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+	parts := []pwallet.Address{setup.Accs[0].Address(), setup.Accs[1].Address()}
+	params, err := pchannel.NewParams(60, parts, pchannel.NoApp(), pchannel.NonceFromBytes([]byte{1}), true, false)
+	require.NoError(t, err)
+
+	t.Run("no_events", func(t *testing.T) {
+		events, err := setup.ChainBackend.PastAdjEvents(setup.Adjudicator, params.ID(), 0)
+		require.NoError(t, err)
+		assert.Len(t, events, 0)
+	})
+
+	t.Run("registered_and_refuted", func(t *testing.T) {
+		adjudicator := setup.ChainBackend.NewAdjudicator(setup.Adjudicator, setup.Accs[0].Address())
+		register := func(version uint64) {
+			state := &pchannel.State{
+				ID:      params.ID(),
+				Version: version,
+				App:     pchannel.NoApp(),
+				Allocation: pchannel.Allocation{
+					Assets:   []pchannel.Asset{setup.AssetETH},
+					Balances: [][]*big.Int{{big.NewInt(0), big.NewInt(0)}},
+				},
+				Data: pchannel.NoData(),
+			}
+			sigs := make([]pwallet.Sig, len(setup.Accs))
+			for i := range setup.Accs {
+				sigs[i], err = pchannel.Sign(setup.Accs[i], params, state)
+				require.NoError(t, err)
+			}
+			req := pchannel.AdjudicatorReq{
+				Params: params,
+				Acc:    setup.Accs[0],
+				Tx:     pchannel.Transaction{State: state, Sigs: sigs},
+				Idx:    0,
+			}
+			require.NoError(t, adjudicator.Register(context.Background(), req, nil))
+		}
+
+		startBlock, err := setup.ChainBackend.BlockNumber()
+		require.NoError(t, err)
+		register(1)
+		blockAfterFirstEvent, err := setup.ChainBackend.BlockNumber()
+		require.NoError(t, err)
+		register(2)
+
+		events, err := setup.ChainBackend.PastAdjEvents(setup.Adjudicator, params.ID(), startBlock)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		for i := range events {
+			assert.IsType(t, &pchannel.RegisteredEvent{}, events[i])
+			assert.Equal(t, params.ID(), events[i].ID())
+			assert.Equal(t, uint64(i+1), events[i].Version())
+		}
+
+		// Events emitted before the start block should not be read.
+		events, err = setup.ChainBackend.PastAdjEvents(setup.Adjudicator, params.ID(), blockAfterFirstEvent+1)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, uint64(2), events[0].Version())
+	})
+}
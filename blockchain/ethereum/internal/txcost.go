@@ -0,0 +1,133 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	padjudicator "perun.network/go-perun/backend/ethereum/bindings/adjudicator"
+	passetholder "perun.network/go-perun/backend/ethereum/bindings/assetholder"
+	pperuntoken "perun.network/go-perun/backend/ethereum/bindings/peruntoken"
+	pethchannel "perun.network/go-perun/backend/ethereum/channel"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain"
+)
+
+// Enumeration of tx types recorded for transactions that do not call a
+// contract method.
+const (
+	txCostTypeDeploy      = "deploy"
+	txCostTypeTransferETH = "transferETH"
+	txCostTypeUnknown     = "unknown"
+)
+
+// contractABIs are used to find the name of the contract method called by a
+// transaction, which is recorded as its tx type.
+var contractABIs = mustParseABIs(padjudicator.AdjudicatorABI, passetholder.AssetHolderABI, pperuntoken.PerunTokenABI)
+
+// txCostBackend is a contract interface that records the cost of each
+// transaction, when its receipt is retrieved using a context that carries a
+// blockchain.TxCostRecorder.
+type txCostBackend struct {
+	pethchannel.ContractInterface
+}
+
+// RecordTxCosts wraps the contract interface, so that the cost of each
+// transaction is recorded into the blockchain.TxCostRecorder carried by the
+// context used for waiting on the transaction to be mined.
+func RecordTxCosts(cf pethchannel.ContractInterface) pethchannel.ContractInterface {
+	return &txCostBackend{ContractInterface: cf}
+}
+
+// TransactionReceipt returns the receipt of the transaction. If the receipt is
+// available and the context carries a recorder, the cost of the transaction
+// is recorded.
+func (b *txCostBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, err := b.ContractInterface.TransactionReceipt(ctx, txHash)
+	if err != nil || receipt == nil {
+		return receipt, err
+	}
+	recorder, ok := blockchain.TxCostRecorderFromContext(ctx)
+	if !ok {
+		return receipt, nil
+	}
+
+	// Receipt holds the hash of the tx that was mined, which differs from the
+	// given one, if the tx was re-signed in the tx queue.
+	tx, _, txErr := b.ContractInterface.TransactionByHash(ctx, receipt.TxHash)
+	if txErr != nil {
+		// Receipt is still returned as the transaction was mined, only its cost is not recorded.
+		return receipt, nil
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), tx.GasPrice())
+	recorder.Record(perun.TxCost{
+		TxType:  txCostType(tx),
+		TxID:    receipt.TxHash.Hex(),
+		GasUsed: receipt.GasUsed,
+		Fee:     fee,
+	})
+	return receipt, nil
+}
+
+// BalanceAt returns the balance of the account, if the wrapped contract
+// interface supports reading it.
+func (b *txCostBackend) BalanceAt(ctx context.Context, addr common.Address, blockNum *big.Int) (*big.Int, error) {
+	stateReader, ok := b.ContractInterface.(balanceReader)
+	if !ok {
+		return nil, errors.New("contract backend does not support reading account state")
+	}
+	return stateReader.BalanceAt(ctx, addr, blockNum)
+}
+
+// txCostType returns the name of the contract method called by the
+// transaction.
+func txCostType(tx *types.Transaction) string {
+	data := tx.Data()
+	switch {
+	case tx.To() == nil:
+		return txCostTypeDeploy
+	case len(data) == 0:
+		return txCostTypeTransferETH
+	case len(data) < 4:
+		return txCostTypeUnknown
+	}
+	for i := range contractABIs {
+		if method, err := contractABIs[i].MethodById(data[:4]); err == nil {
+			return method.Name
+		}
+	}
+	return txCostTypeUnknown
+}
+
+func mustParseABIs(abiJSONs ...string) []abi.ABI {
+	abis := make([]abi.ABI, len(abiJSONs))
+	for i := range abiJSONs {
+		parsed, err := abi.JSON(strings.NewReader(abiJSONs[i]))
+		if err != nil {
+			panic("parsing contract abi: " + err.Error())
+		}
+		abis[i] = parsed
+	}
+	return abis
+}
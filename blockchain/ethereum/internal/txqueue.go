@@ -0,0 +1,456 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	pethchannel "perun.network/go-perun/backend/ethereum/channel"
+)
+
+// ReservationTimeout is the duration after which a nonce that was handed out
+// for signing a transaction is released, if the signed transaction was not
+// sent by then. Once sending has started, the nonce is not released until the
+// send completes.
+const ReservationTimeout = 10 * time.Second
+
+// maxNonceTooLowRetries is the number of times a tx is re-signed with a fresh
+// nonce and re-sent, if sending it failed because its nonce was already used.
+const maxNonceTooLowRetries = 2
+
+// TxQueues is a registry of transaction queues, one for each on-chain account
+// on each blockchain. It is safe for concurrent use.
+//
+// All contract backends that send transactions from the same account (for
+// example, in different sessions using the same on-chain account) should be
+// wrapped using the same instance of TxQueues. The queue for each account then
+// assigns the nonces when transactions are signed, sends them one after the
+// other in the order of their nonces and tracks the pending transactions until
+// they are mined.
+type TxQueues struct {
+	mtx                sync.Mutex
+	queues             map[string]*txQueue
+	pending            map[common.Hash]*txQueue    // Index of pending transactions in all queues.
+	replaced           map[common.Hash]common.Hash // Hashes of re-signed txs, indexed by the hash of the original tx.
+	reservationTimeout time.Duration
+}
+
+// txQueue holds the nonce reserved for and transactions sent from an account.
+//
+// Only one nonce is reserved at a time: it is reserved when a transaction is
+// signed and released once that transaction is sent. This way, a failed send
+// does not leave a gap in the nonces, as the next transaction is signed using
+// the nonce that was not used.
+type txQueue struct {
+	mtx      sync.Mutex
+	reserved *reservation             // Nonce handed out for a tx that is not yet sent.
+	expired  map[common.Hash]struct{} // Txs signed using reservations that expired before they were sent.
+	pending  map[common.Hash]uint64   // Txs sent, but not yet mined.
+
+	// changed is closed and replaced whenever the reservation is released, so
+	// that signers waiting for their turn can re-check.
+	changed chan struct{}
+}
+
+type reservation struct {
+	nonce      uint64
+	reservedAt time.Time
+	txHash     common.Hash // Hash of the tx signed using this nonce, zero until it is signed.
+
+	// sign signs the tx again using the given nonce, nil until it is signed.
+	sign func(nonce uint64) (*types.Transaction, error)
+}
+
+// NewTxQueues returns an empty registry of transaction queues.
+func NewTxQueues() *TxQueues {
+	return &TxQueues{
+		queues:             make(map[string]*txQueue),
+		pending:            make(map[common.Hash]*txQueue),
+		replaced:           make(map[common.Hash]common.Hash),
+		reservationTimeout: ReservationTimeout,
+	}
+}
+
+// WrapBackend wraps the contract interface, so that transactions from any
+// account are sent via the queue for that account. ChainKey identifies the
+// blockchain (e.g. its URL) and signer is used for recovering the sender of
+// transactions.
+//
+// The transactor used with the contract interface should be wrapped using
+// WrapTransactor with the same chain key.
+func (r *TxQueues) WrapBackend(cf pethchannel.ContractInterface, chainKey string,
+	signer types.Signer) pethchannel.ContractInterface {
+	return &queuedBackend{
+		ContractInterface: cf,
+		queues:            r,
+		chainKey:          chainKey,
+		signer:            signer,
+	}
+}
+
+// WrapTransactor wraps the transactor, so that the nonce of each transaction
+// is assigned by the queue for the account when it is signed. The nonce set
+// in the transact opts, which go-perun derives from the nonces it expects to
+// be used next, is ignored.
+//
+// ChainKey identifies the blockchain and the contract interface is used for
+// reading the pending nonce of the account on it.
+func (r *TxQueues) WrapTransactor(tr pethchannel.Transactor, cf pethchannel.ContractInterface,
+	chainKey string) pethchannel.Transactor {
+	return &queuedTransactor{
+		Transactor: tr,
+		cf:         cf,
+		queues:     r,
+		chainKey:   chainKey,
+	}
+}
+
+// PendingTxs returns the number of transactions sent from the account on the
+// blockchain that are not yet known to be mined.
+func (r *TxQueues) PendingTxs(chainKey string, addr common.Address) int {
+	q := r.queue(chainKey, addr)
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	return len(q.pending)
+}
+
+func (r *TxQueues) queue(chainKey string, addr common.Address) *txQueue {
+	key := chainKey + "/" + addr.Hex()
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	q, ok := r.queues[key]
+	if !ok {
+		q = &txQueue{
+			expired: make(map[common.Hash]struct{}),
+			pending: make(map[common.Hash]uint64),
+			changed: make(chan struct{}),
+		}
+		r.queues[key] = q
+	}
+	return q
+}
+
+func (r *TxQueues) addPending(q *txQueue, txHash common.Hash) {
+	r.mtx.Lock()
+	r.pending[txHash] = q
+	r.mtx.Unlock()
+}
+
+// addReplaced records that the tx with the original hash was re-signed and
+// sent as the tx with the new hash.
+func (r *TxQueues) addReplaced(origHash, newHash common.Hash) {
+	r.mtx.Lock()
+	r.replaced[origHash] = newHash
+	r.mtx.Unlock()
+}
+
+// sentHash returns the hash of the tx that was sent in place of the given tx.
+// If the tx was not re-signed, this is its own hash.
+func (r *TxQueues) sentHash(txHash common.Hash) common.Hash {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if newHash, ok := r.replaced[txHash]; ok {
+		return newHash
+	}
+	return txHash
+}
+
+func (r *TxQueues) removeReplaced(origHash common.Hash) {
+	r.mtx.Lock()
+	delete(r.replaced, origHash)
+	r.mtx.Unlock()
+}
+
+func (r *TxQueues) removePending(txHash common.Hash) {
+	r.mtx.Lock()
+	q, ok := r.pending[txHash]
+	delete(r.pending, txHash)
+	r.mtx.Unlock()
+	if ok {
+		q.mtx.Lock()
+		delete(q.pending, txHash)
+		q.mtx.Unlock()
+	}
+}
+
+// queuedTransactor is a transactor that signs transactions using the nonces
+// assigned by the transaction queues.
+type queuedTransactor struct {
+	pethchannel.Transactor
+	cf       pethchannel.ContractInterface
+	queues   *TxQueues
+	chainKey string
+}
+
+// NewTransactor returns transact opts for the account, whose signer replaces
+// the nonce of the transaction with the one reserved in the queue before
+// signing it.
+func (t *queuedTransactor) NewTransactor(acc accounts.Account) (*bind.TransactOpts, error) {
+	opts, err := t.Transactor.NewTransactor(acc)
+	if err != nil {
+		return nil, err
+	}
+	q := t.queues.queue(t.chainKey, acc.Address)
+	sign := opts.Signer
+	opts.Signer = func(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		ctx := opts.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		res, err := q.reserve(ctx, t.cf, addr, t.queues)
+		if err != nil {
+			return nil, errors.WithMessage(err, "reserving nonce")
+		}
+		signWithNonce := func(nonce uint64) (*types.Transaction, error) {
+			return sign(addr, withNonce(tx, nonce))
+		}
+		signedTx, err := signWithNonce(res.nonce)
+		q.signed(res, signedTx, err, signWithNonce)
+		return signedTx, err
+	}
+	return opts, nil
+}
+
+// queuedBackend is a contract interface that uses the transaction queues for
+// sending transactions.
+type queuedBackend struct {
+	pethchannel.ContractInterface
+	queues   *TxQueues
+	chainKey string
+	signer   types.Signer
+}
+
+// SendTransaction sends the transaction, if it was signed using the nonce
+// reserved in the queue for the account. Since only one nonce is reserved at
+// a time, the transactions are sent in the order of their nonces. Once the
+// send completes, successfully or not, the nonce is released.
+//
+// If the nonce was already used (e.g. by a tx sent from the same account
+// outside this node), the pending nonce on the blockchain is reserved again,
+// the tx is re-signed using it and re-sent. This is retried up to
+// maxNonceTooLowRetries times. The receipt of the re-signed tx can be
+// retrieved using the hash of the original tx.
+//
+// Transactions that were not signed using a queued transactor are sent
+// directly.
+func (b *queuedBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	from, err := types.Sender(b.signer, tx)
+	if err != nil {
+		return b.ContractInterface.SendTransaction(ctx, tx)
+	}
+	q := b.queues.queue(b.chainKey, from)
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if q.reserved == nil || q.reserved.txHash != tx.Hash() {
+		if _, ok := q.expired[tx.Hash()]; ok {
+			delete(q.expired, tx.Hash())
+			return errors.New("nonce reservation expired before the tx was sent, it may be used by another tx")
+		}
+		return b.ContractInterface.SendTransaction(ctx, tx)
+	}
+	delete(q.expired, tx.Hash())
+	defer q.release()
+
+	origHash := tx.Hash()
+	for i := 0; ; i++ {
+		err = b.ContractInterface.SendTransaction(ctx, tx)
+		if err == nil {
+			break
+		}
+		if !isErrNonceTooLow(err) || i == maxNonceTooLowRetries {
+			return err
+		}
+		if tx, err = q.resign(ctx, b.ContractInterface, from, b.queues); err != nil {
+			return errors.WithMessage(err, "re-signing tx with fresh nonce")
+		}
+	}
+	if tx.Hash() != origHash {
+		b.queues.addReplaced(origHash, tx.Hash())
+	}
+	q.pending[tx.Hash()] = tx.Nonce()
+	b.queues.addPending(q, tx.Hash())
+	return nil
+}
+
+// TransactionReceipt returns the receipt of the transaction or, if it was
+// re-signed with a fresh nonce, that of the re-signed transaction. If the
+// receipt is available, the transaction is no longer tracked as pending.
+func (b *queuedBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	sentHash := b.queues.sentHash(txHash)
+	receipt, err := b.ContractInterface.TransactionReceipt(ctx, sentHash)
+	if err == nil && receipt != nil {
+		b.queues.removePending(sentHash)
+		b.queues.removeReplaced(txHash)
+	}
+	return receipt, err
+}
+
+// BalanceAt returns the balance of the account, if the wrapped contract
+// interface supports reading it.
+func (b *queuedBackend) BalanceAt(ctx context.Context, addr common.Address, blockNum *big.Int) (*big.Int, error) {
+	stateReader, ok := b.ContractInterface.(balanceReader)
+	if !ok {
+		return nil, errors.New("contract backend does not support reading account state")
+	}
+	return stateReader.BalanceAt(ctx, addr, blockNum)
+}
+
+// reserve waits until no nonce is reserved for the account and then reserves
+// the pending nonce on the blockchain. Because the nonce is re-synced with the
+// blockchain each time, nonces used by transactions sent outside this node are
+// skipped and the nonces of transactions that failed to be sent are re-used.
+//
+// A reservation for which the signed tx was not sent within the reservation
+// timeout is released.
+func (q *txQueue) reserve(ctx context.Context, cf pethchannel.ContractInterface, addr common.Address,
+	r *TxQueues) (*reservation, error) {
+	for {
+		q.mtx.Lock()
+		if q.reserved == nil {
+			break
+		}
+		expiresIn := r.reservationTimeout - time.Since(q.reserved.reservedAt)
+		if expiresIn <= 0 {
+			if q.reserved.txHash != (common.Hash{}) {
+				q.expired[q.reserved.txHash] = struct{}{}
+			}
+			q.release()
+			break
+		}
+		changed := q.changed
+		q.mtx.Unlock()
+
+		select {
+		case <-changed:
+		case <-time.After(expiresIn):
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "waiting for the previous tx to be sent")
+		}
+	}
+	defer q.mtx.Unlock()
+
+	chainNonce, err := cf.PendingNonceAt(ctx, addr)
+	if err != nil {
+		return nil, errors.WithMessage(err, "reading pending nonce")
+	}
+	q.prunePending(chainNonce, r)
+	q.reserved = &reservation{nonce: chainNonce, reservedAt: time.Now()}
+	return q.reserved, nil
+}
+
+// signed records the hash of the tx signed using the reservation and the
+// function for re-signing it. If signing failed, the nonce is released. If the
+// reservation has expired in the meanwhile, the tx is marked as expired, so
+// that it will not be sent.
+func (q *txQueue) signed(res *reservation, signedTx *types.Transaction, signErr error,
+	sign func(nonce uint64) (*types.Transaction, error)) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	if q.reserved != res {
+		if signErr == nil {
+			q.expired[signedTx.Hash()] = struct{}{}
+		}
+		return
+	}
+	if signErr != nil {
+		q.release()
+		return
+	}
+	q.reserved.txHash = signedTx.Hash()
+	q.reserved.sign = sign
+}
+
+// resign reserves the pending nonce on the blockchain again and re-signs the
+// tx of the current reservation using it. It must be called with the queue
+// locked.
+func (q *txQueue) resign(ctx context.Context, cf pethchannel.ContractInterface, addr common.Address,
+	r *TxQueues) (*types.Transaction, error) {
+	chainNonce, err := cf.PendingNonceAt(ctx, addr)
+	if err != nil {
+		return nil, errors.WithMessage(err, "reading pending nonce")
+	}
+	q.prunePending(chainNonce, r)
+	tx, err := q.reserved.sign(chainNonce)
+	if err != nil {
+		return nil, err
+	}
+	q.reserved.nonce = chainNonce
+	q.reserved.txHash = tx.Hash()
+	return tx, nil
+}
+
+func (q *txQueue) release() {
+	q.reserved = nil
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
+
+// prunePending stops tracking the pending transactions with nonces not lower
+// than the chain nonce. Because the pending nonce on the blockchain includes
+// the transactions in the tx pool, these transactions were dropped.
+func (q *txQueue) prunePending(chainNonce uint64, r *TxQueues) {
+	for txHash, nonce := range q.pending {
+		if nonce >= chainNonce {
+			delete(q.pending, txHash)
+			r.mtx.Lock()
+			delete(r.pending, txHash)
+			r.mtx.Unlock()
+		}
+	}
+}
+
+// withNonce returns a copy of the unsigned transaction with the given nonce.
+func withNonce(tx *types.Transaction, nonce uint64) *types.Transaction {
+	if tx.Nonce() == nonce {
+		return tx
+	}
+	if tx.Type() == types.AccessListTxType {
+		return types.NewTx(&types.AccessListTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      nonce,
+			GasPrice:   tx.GasPrice(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		})
+	}
+	return types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: tx.GasPrice(),
+		Gas:      tx.Gas(),
+		To:       tx.To(),
+		Value:    tx.Value(),
+		Data:     tx.Data(),
+	})
+}
+
+func isErrNonceTooLow(err error) bool {
+	return err != nil && strings.Contains(err.Error(), core.ErrNonceTooLow.Error())
+}
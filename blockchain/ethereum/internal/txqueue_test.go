@@ -0,0 +1,341 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal_test
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pethchannel "perun.network/go-perun/backend/ethereum/channel"
+	pethchanneltest "perun.network/go-perun/backend/ethereum/channel/test"
+	pethwallet "perun.network/go-perun/backend/ethereum/wallet"
+	pkeystore "perun.network/go-perun/backend/ethereum/wallet/keystore"
+	pchannel "perun.network/go-perun/channel"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/internal"
+)
+
+const chainKey = "simulated"
+
+// newQueuedChainBackends returns the given number of chain backends, each with
+// its own contract backend and transactor, connected to the same simulated
+// blockchain and sharing the same tx queues.
+func newQueuedChainBackends(t *testing.T, txQueues *internal.TxQueues, n int) (
+	[]*internal.ChainBackend, *ethereumtest.WalletSetup, *pethchanneltest.SimulatedBackend) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	walletSetup := ethereumtest.NewWalletSetupT(t, rng, 1)
+	simBackend := pethchanneltest.NewSimulatedBackend()
+	simBackend.FundAddress(context.Background(), pethwallet.AsEthAddr(walletSetup.Accs[0].Address()))
+
+	chainBackends := make([]*internal.ChainBackend, n)
+	for i := range chainBackends {
+		chainBackends[i] = newQueuedChainBackend(t, txQueues, walletSetup, simBackend)
+	}
+	return chainBackends, walletSetup, simBackend
+}
+
+// newQueuedChainBackend returns a chain backend that sends txs via the tx
+// queues, using the given contract interface.
+func newQueuedChainBackend(t *testing.T, txQueues *internal.TxQueues, walletSetup *ethereumtest.WalletSetup,
+	cf pethchannel.ContractInterface) *internal.ChainBackend {
+	signer := types.NewEIP155Signer(big.NewInt(int64(ethereumtest.ChainID)))
+	ksWallet, err := pkeystore.NewWallet(walletSetup.Keystore, "")
+	require.NoError(t, err)
+	tr := txQueues.WrapTransactor(pkeystore.NewTransactor(*ksWallet, signer), cf, chainKey)
+	cb := pethchannel.NewContractBackend(txQueues.WrapBackend(cf, chainKey, signer), tr)
+	return &internal.ChainBackend{Cb: &cb, TxTimeout: ethereumtest.OnChainTxTimeout}
+}
+
+// failingSendBackend is a contract interface, whose SendTransaction returns
+// the errors in errs (one for each call), before sending any tx.
+type failingSendBackend struct {
+	pethchannel.ContractInterface
+	mtx  sync.Mutex
+	errs []error
+}
+
+func (b *failingSendBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if len(b.errs) > 0 {
+		err := b.errs[0]
+		b.errs = b.errs[1:]
+		return err
+	}
+	return b.ContractInterface.SendTransaction(ctx, tx)
+}
+
+// outsideTxBackend is a contract interface that, before sending the first tx,
+// calls sendOutsideTx with the nonce of that tx. Like a node (and unlike the
+// simulated backend, which panics), it rejects txs with a used nonce.
+type outsideTxBackend struct {
+	pethchannel.ContractInterface
+	signer        types.Signer
+	once          sync.Once
+	sendOutsideTx func(nonce uint64)
+}
+
+func (b *outsideTxBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	b.once.Do(func() { b.sendOutsideTx(tx.Nonce()) })
+	from, err := types.Sender(b.signer, tx)
+	if err != nil {
+		return err
+	}
+	chainNonce, err := b.PendingNonceAt(ctx, from)
+	if err != nil {
+		return err
+	}
+	if tx.Nonce() < chainNonce {
+		return core.ErrNonceTooLow
+	}
+	return b.ContractInterface.SendTransaction(ctx, tx)
+}
+
+func Test_TxQueues_ConcurrentTxs(t *testing.T) {
+	txQueues := internal.NewTxQueues()
+	chainBackends, walletSetup, _ := newQueuedChainBackends(t, txQueues, 2)
+	txSender := walletSetup.Accs[0].Address()
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	receiver := ethereumtest.NewRandomAddress(rng)
+
+	// Send txs concurrently from the same account using different chain backends.
+	txsPerBackend := 5
+	amount := big.NewInt(1e9)
+	wg := sync.WaitGroup{}
+	errs := make(chan error, len(chainBackends)*txsPerBackend)
+	for i := range chainBackends {
+		for j := 0; j < txsPerBackend; j++ {
+			wg.Add(1)
+			go func(cb *internal.ChainBackend) {
+				defer wg.Done()
+				_, err := cb.TransferETH(receiver, amount, txSender)
+				errs <- err
+			}(chainBackends[i])
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+
+	bal, err := chainBackends[0].BalanceAt(receiver)
+	require.NoError(t, err)
+	wantBal := new(big.Int).Mul(amount, big.NewInt(int64(len(chainBackends)*txsPerBackend)))
+	assert.Equal(t, wantBal, bal)
+	assert.Zero(t, txQueues.PendingTxs(chainKey, pethwallet.AsEthAddr(txSender)))
+}
+
+func Test_TxQueues_TxSentOutsideQueue(t *testing.T) {
+	txQueues := internal.NewTxQueues()
+	chainBackends, walletSetup, simBackend := newQueuedChainBackends(t, txQueues, 1)
+	txSender := walletSetup.Accs[0].Address()
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	receiver := ethereumtest.NewRandomAddress(rng)
+	amount := big.NewInt(1e9)
+
+	_, err := chainBackends[0].TransferETH(receiver, amount, txSender)
+	require.NoError(t, err)
+
+	// Send a tx from the same account, without using the tx queues.
+	ksWallet, err := pkeystore.NewWallet(walletSetup.Keystore, "")
+	require.NoError(t, err)
+	tr := pkeystore.NewTransactor(*ksWallet, types.NewEIP155Signer(big.NewInt(int64(ethereumtest.ChainID))))
+	cb := pethchannel.NewContractBackend(simBackend, tr)
+	unqueuedChainBackend := &internal.ChainBackend{Cb: &cb, TxTimeout: ethereumtest.OnChainTxTimeout}
+	_, err = unqueuedChainBackend.TransferETH(receiver, amount, txSender)
+	require.NoError(t, err)
+
+	// Nonce should be re-synced with the blockchain for the next tx.
+	_, err = chainBackends[0].TransferETH(receiver, amount, txSender)
+	require.NoError(t, err)
+
+	bal, err := chainBackends[0].BalanceAt(receiver)
+	require.NoError(t, err)
+	assert.Equal(t, new(big.Int).Mul(amount, big.NewInt(3)), bal)
+}
+
+func Test_TxQueues_FailedSend(t *testing.T) {
+	txQueues := internal.NewTxQueues()
+	_, walletSetup, simBackend := newQueuedChainBackends(t, txQueues, 0)
+	failingBackend := &failingSendBackend{
+		ContractInterface: simBackend,
+		errs:              []error{errors.New("insufficient funds for gas * price + value")},
+	}
+	chainBackend := newQueuedChainBackend(t, txQueues, walletSetup, failingBackend)
+	chainBackend.TxTimeout = 2 * time.Second
+	txSender := walletSetup.Accs[0].Address()
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	receiver := ethereumtest.NewRandomAddress(rng)
+	amount := big.NewInt(1e9)
+
+	_, err := chainBackend.TransferETH(receiver, amount, txSender)
+	require.Error(t, err)
+
+	// Nonce of the failed tx should be re-used for the next txs, without waiting for any reservation to expire.
+	for i := 0; i < 2; i++ {
+		_, err = chainBackend.TransferETH(receiver, amount, txSender)
+		require.NoError(t, err)
+	}
+
+	bal, err := simBackend.BalanceAt(context.Background(), pethwallet.AsEthAddr(receiver), nil)
+	require.NoError(t, err)
+	assert.Equal(t, new(big.Int).Mul(amount, big.NewInt(2)), bal)
+	nonce, err := simBackend.PendingNonceAt(context.Background(), pethwallet.AsEthAddr(txSender))
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, nonce)
+}
+
+func Test_TxQueues_NonceTooLow(t *testing.T) {
+	txQueues := internal.NewTxQueues()
+	_, walletSetup, simBackend := newQueuedChainBackends(t, txQueues, 0)
+	failingBackend := &failingSendBackend{
+		ContractInterface: simBackend,
+		errs:              []error{core.ErrNonceTooLow},
+	}
+	chainBackend := newQueuedChainBackend(t, txQueues, walletSetup, failingBackend)
+	txSender := walletSetup.Accs[0].Address()
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	receiver := ethereumtest.NewRandomAddress(rng)
+	amount := big.NewInt(1e9)
+
+	// Tx should be re-sent after nonce too low error.
+	_, err := chainBackend.TransferETH(receiver, amount, txSender)
+	require.NoError(t, err)
+
+	bal, err := simBackend.BalanceAt(context.Background(), pethwallet.AsEthAddr(receiver), nil)
+	require.NoError(t, err)
+	assert.Equal(t, amount, bal)
+}
+
+func Test_TxQueues_NonceTooLow_Register(t *testing.T) {
+	txQueues := internal.NewTxQueues()
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	walletSetup := ethereumtest.NewWalletSetupT(t, rng, 2)
+	simBackend := pethchanneltest.NewSimulatedBackend()
+	txSender := walletSetup.Accs[0].Address()
+	simBackend.FundAddress(context.Background(), pethwallet.AsEthAddr(txSender))
+	adjAddr, err := newQueuedChainBackend(t, txQueues, walletSetup, simBackend).DeployAdjudicator(txSender)
+	require.NoError(t, err)
+
+	// Tx signed outside the queue, that takes the nonce of the queued register tx.
+	signer := types.NewEIP155Signer(big.NewInt(int64(ethereumtest.ChainID)))
+	ksWallet, err := pkeystore.NewWallet(walletSetup.Keystore, "")
+	require.NoError(t, err)
+	opts, err := pkeystore.NewTransactor(*ksWallet, signer).NewTransactor(
+		accounts.Account{Address: pethwallet.AsEthAddr(txSender)})
+	require.NoError(t, err)
+	receiver := ethereumtest.NewRandomAddress(rng)
+	outsideBackend := &outsideTxBackend{
+		ContractInterface: simBackend,
+		signer:            signer,
+		sendOutsideTx: func(nonce uint64) {
+			outsideTx, err := opts.Signer(opts.From,
+				types.NewTransaction(nonce, pethwallet.AsEthAddr(receiver), big.NewInt(1), 21000, big.NewInt(1), nil))
+			require.NoError(t, err)
+			require.NoError(t, simBackend.SendTransaction(context.Background(), outsideTx))
+		},
+	}
+	chainBackend := newQueuedChainBackend(t, txQueues, walletSetup, outsideBackend)
+
+	parts := []pwallet.Address{walletSetup.Accs[0].Address(), walletSetup.Accs[1].Address()}
+	params, err := pchannel.NewParams(60, parts, pchannel.NoApp(), pchannel.NonceFromBytes([]byte{1}), true, false)
+	require.NoError(t, err)
+	state := &pchannel.State{
+		ID:      params.ID(),
+		Version: 1,
+		App:     pchannel.NoApp(),
+		Allocation: pchannel.Allocation{
+			Assets:   []pchannel.Asset{ethereumtest.NewRandomAddress(rng)},
+			Balances: [][]*big.Int{{big.NewInt(0), big.NewInt(0)}},
+		},
+		Data: pchannel.NoData(),
+	}
+	sigs := make([]pwallet.Sig, len(walletSetup.Accs))
+	for i := range walletSetup.Accs {
+		sigs[i], err = pchannel.Sign(walletSetup.Accs[i], params, state)
+		require.NoError(t, err)
+	}
+	req := pchannel.AdjudicatorReq{
+		Params: params,
+		Acc:    walletSetup.Accs[0],
+		Tx:     pchannel.Transaction{State: state, Sigs: sigs},
+		Idx:    0,
+	}
+
+	// Register tx should be re-signed with a fresh nonce and re-sent.
+	startBlock, err := chainBackend.BlockNumber()
+	require.NoError(t, err)
+	adjudicator := chainBackend.NewAdjudicator(adjAddr, txSender)
+	require.NoError(t, adjudicator.Register(context.Background(), req, nil))
+
+	events, err := chainBackend.PastAdjEvents(adjAddr, params.ID(), startBlock)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.IsType(t, &pchannel.RegisteredEvent{}, events[0])
+	assert.Zero(t, txQueues.PendingTxs(chainKey, pethwallet.AsEthAddr(txSender)))
+
+	bal, err := simBackend.BalanceAt(context.Background(), pethwallet.AsEthAddr(receiver), nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1), bal)
+}
+
+func Test_TxQueues_ReservationExpired(t *testing.T) {
+	txQueues := internal.NewTxQueues()
+	txQueues.SetReservationTimeout(100 * time.Millisecond)
+	chainBackends, walletSetup, simBackend := newQueuedChainBackends(t, txQueues, 1)
+	txSender := walletSetup.Accs[0].Address()
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	receiver := ethereumtest.NewRandomAddress(rng)
+	amount := big.NewInt(1e9)
+
+	// Sign a tx, so that a nonce is reserved for it, but do not send it.
+	signer := types.NewEIP155Signer(big.NewInt(int64(ethereumtest.ChainID)))
+	ksWallet, err := pkeystore.NewWallet(walletSetup.Keystore, "")
+	require.NoError(t, err)
+	tr := txQueues.WrapTransactor(pkeystore.NewTransactor(*ksWallet, signer), simBackend, chainKey)
+	opts, err := tr.NewTransactor(accounts.Account{Address: pethwallet.AsEthAddr(txSender)})
+	require.NoError(t, err)
+	unsentTx, err := opts.Signer(opts.From,
+		types.NewTransaction(0, pethwallet.AsEthAddr(receiver), big.NewInt(1), 21000, big.NewInt(1), nil))
+	require.NoError(t, err)
+
+	// Reservation should expire and the nonce should be used for the next tx.
+	_, err = chainBackends[0].TransferETH(receiver, amount, txSender)
+	require.NoError(t, err)
+
+	// Tx signed with the expired reservation should not be sent.
+	queuedBackend := txQueues.WrapBackend(simBackend, chainKey, signer)
+	err = queuedBackend.SendTransaction(context.Background(), unsentTx)
+	require.Error(t, err)
+
+	bal, err := chainBackends[0].BalanceAt(receiver)
+	require.NoError(t, err)
+	assert.Equal(t, amount, bal)
+}
@@ -72,6 +72,11 @@ func (wb *WalletBackend) UnlockAccount(w pwallet.Wallet, addr pwallet.Address) (
 	return acc, errors.Wrap(err, "unlocking account")
 }
 
+// VerifySig verifies if the signature on the message was made by the account with the given address.
+func (wb *WalletBackend) VerifySig(msg, sig []byte, addr pwallet.Address) (bool, error) {
+	return pethwallet.VerifySignature(msg, sig, addr)
+}
+
 // ParseAddr parses the ethereum address from the given string. It should be in hexadecimal
 // representation of the address, optionally prefixed by "0x" or "0X".
 // It pads zeros in the beginning if the address string is less than required length and
@@ -0,0 +1,98 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockchain
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// TxCostRecorder records the costs of on-chain transactions. The zero value is
+// an empty recorder ready for use. It is safe for concurrent use.
+type TxCostRecorder struct {
+	mtx      sync.Mutex
+	costs    []perun.TxCost
+	onRecord func(perun.TxCost)
+}
+
+type txCostRecorderKey struct{}
+
+// NewTxCostRecorder returns a recorder with the given costs (for example,
+// those loaded from persistence), or an empty recorder if none are given.
+func NewTxCostRecorder(costs ...perun.TxCost) *TxCostRecorder {
+	return &TxCostRecorder{costs: costs}
+}
+
+// Record adds the cost of a transaction to the recorder.
+func (r *TxCostRecorder) Record(cost perun.TxCost) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.costs = append(r.costs, cost)
+	if r.onRecord != nil {
+		r.onRecord(cost)
+	}
+}
+
+// OnRecord sets the function that is called with the cost of each transaction
+// recorded from now on (for example, to persist it) and returns the costs
+// recorded so far. It replaces any function set before.
+func (r *TxCostRecorder) OnRecord(f func(perun.TxCost)) []perun.TxCost {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.onRecord = f
+	costs := make([]perun.TxCost, len(r.costs))
+	copy(costs, r.costs)
+	return costs
+}
+
+// Costs returns the costs of all the recorded transactions, in the order in
+// which they were recorded.
+func (r *TxCostRecorder) Costs() []perun.TxCost {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	costs := make([]perun.TxCost, len(r.costs))
+	copy(costs, r.costs)
+	return costs
+}
+
+// TotalFee returns the sum of the fees of all the recorded transactions.
+func (r *TxCostRecorder) TotalFee() *big.Int {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	total := big.NewInt(0)
+	for i := range r.costs {
+		total.Add(total, r.costs[i].Fee)
+	}
+	return total
+}
+
+// WithTxCostRecorder returns a copy of the parent context, that carries the
+// recorder. Chain backends record the cost of each transaction sent using this
+// context (or a context derived from it) into the recorder.
+func WithTxCostRecorder(parent context.Context, r *TxCostRecorder) context.Context {
+	return context.WithValue(parent, txCostRecorderKey{}, r)
+}
+
+// TxCostRecorderFromContext returns the recorder carried by the context, if
+// any.
+func TxCostRecorderFromContext(ctx context.Context) (*TxCostRecorder, bool) {
+	r, ok := ctx.Value(txCostRecorderKey{}).(*TxCostRecorder)
+	return r, ok && r != nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockchain_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain"
+)
+
+func Test_TxCostRecorder(t *testing.T) {
+	r := blockchain.NewTxCostRecorder()
+	assert.Empty(t, r.Costs())
+	assert.Equal(t, big.NewInt(0), r.TotalFee())
+
+	cost1 := perun.TxCost{TxType: "deposit", TxID: "0x1", GasUsed: 100, Fee: big.NewInt(10)}
+	cost2 := perun.TxCost{TxType: "withdraw", TxID: "0x2", GasUsed: 200, Fee: big.NewInt(20)}
+	r.Record(cost1)
+	r.Record(cost2)
+
+	costs := r.Costs()
+	assert.Equal(t, []perun.TxCost{cost1, cost2}, costs)
+	assert.Equal(t, big.NewInt(30), r.TotalFee())
+
+	costs[0].TxType = "modified"
+	assert.Equal(t, cost1, r.Costs()[0], "costs should be returned as a copy")
+}
+
+func Test_TxCostRecorder_OnRecord(t *testing.T) {
+	cost1 := perun.TxCost{TxType: "deposit", TxID: "0x1", GasUsed: 100, Fee: big.NewInt(10)}
+	cost2 := perun.TxCost{TxType: "withdraw", TxID: "0x2", GasUsed: 200, Fee: big.NewInt(20)}
+	r := blockchain.NewTxCostRecorder(cost1)
+	assert.Equal(t, big.NewInt(10), r.TotalFee())
+
+	recorded := []perun.TxCost{}
+	costsSoFar := r.OnRecord(func(cost perun.TxCost) {
+		recorded = append(recorded, cost)
+	})
+	assert.Equal(t, []perun.TxCost{cost1}, costsSoFar)
+
+	r.Record(cost2)
+	assert.Equal(t, []perun.TxCost{cost2}, recorded)
+	assert.Equal(t, []perun.TxCost{cost1, cost2}, r.Costs())
+}
+
+func Test_TxCostRecorderContext(t *testing.T) {
+	t.Run("with_recorder", func(t *testing.T) {
+		r := blockchain.NewTxCostRecorder()
+		ctx, cancel := context.WithCancel(blockchain.WithTxCostRecorder(context.Background(), r))
+		defer cancel()
+
+		gotR, ok := blockchain.TxCostRecorderFromContext(ctx)
+		require.True(t, ok)
+		assert.Same(t, r, gotR)
+	})
+	t.Run("without_recorder", func(t *testing.T) {
+		_, ok := blockchain.TxCostRecorderFromContext(context.Background())
+		assert.False(t, ok)
+	})
+	t.Run("nil_recorder", func(t *testing.T) {
+		_, ok := blockchain.TxCostRecorderFromContext(blockchain.WithTxCostRecorder(context.Background(), nil))
+		assert.False(t, ok)
+	})
+}
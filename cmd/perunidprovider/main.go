@@ -0,0 +1,109 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command perunidprovider runs the reference implementation of the directory service, that can be used by
+// perun nodes as an ID provider of type "http". Peer IDs are stored in a local ID provider file.
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/idprovider/local"
+	"github.com/hyperledger-labs/perun-node/idprovider/remote"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+const (
+	// flag names.
+	addressF        = "address"
+	idProviderFileF = "idproviderfile"
+	headerF         = "header"
+	requireSignedF  = "requiresigned"
+	loglevelF       = "loglevel"
+	logfileF        = "logfile"
+
+	// default values for flags.
+	defaultAddress        = "127.0.0.1:8090"
+	defaultIDProviderFile = "idprovider.yaml"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "perunidprovider",
+	Short: "Directory service for resolving peer IDs of perun nodes.",
+	Long: `
+Directory service for resolving peer IDs of perun nodes. It serves the peer IDs
+stored in a local ID provider file over HTTP and can be used by perun nodes as
+an ID provider of type "http". This is a reference implementation intended for
+running a directory service locally.`,
+	RunE: run,
+}
+
+func init() {
+	rootCmd.Flags().String(addressF, defaultAddress, "address to listen for HTTP requests")
+	rootCmd.Flags().String(idProviderFileF, defaultIDProviderFile,
+		"ID provider file for storing the peer IDs. It will be created if it does not exist")
+	rootCmd.Flags().StringToString(headerF, nil,
+		"header required in each request, as name=value. Use it multiple times for multiple headers")
+	rootCmd.Flags().Bool(requireSignedF, false, "accept only the peer IDs signed by their off-chain account")
+	rootCmd.Flags().String(loglevelF, "info", "Log level. Supported levels: debug, info, error")
+	rootCmd.Flags().String(logfileF, "", "Log file path. Use empty string for stdout")
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func run(cmd *cobra.Command, _ []string) error {
+	address, _ := cmd.Flags().GetString(addressF)                // nolint: errcheck  // flag is defined.
+	idProviderFile, _ := cmd.Flags().GetString(idProviderFileF)  // nolint: errcheck  // flag is defined.
+	requiredHeaders, _ := cmd.Flags().GetStringToString(headerF) // nolint: errcheck  // flag is defined.
+	requireSigned, _ := cmd.Flags().GetBool(requireSignedF)      // nolint: errcheck  // flag is defined.
+	loglevel, _ := cmd.Flags().GetString(loglevelF)              // nolint: errcheck  // flag is defined.
+	logfile, _ := cmd.Flags().GetString(logfileF)                // nolint: errcheck  // flag is defined.
+
+	if err := log.InitLogger(loglevel, logfile); err != nil {
+		return errors.WithMessage(err, "initializing logger")
+	}
+	if err := createIfNotExists(idProviderFile); err != nil {
+		return errors.WithMessage(err, "creating ID provider file")
+	}
+	wb := ethereum.NewWalletBackend()
+	store, err := local.NewIDprovider(idProviderFile, wb, requireSigned)
+	if err != nil {
+		return errors.WithMessage(err, "initializing ID provider")
+	}
+
+	fmt.Printf("Serving peer IDs in %s at http://%s\n", idProviderFile, address)
+	return http.ListenAndServe(address, remote.NewServer(store, wb, requiredHeaders))
+}
+
+func createIfNotExists(filePath string) error {
+	f, err := os.OpenFile(filepath.Clean(filePath), os.O_RDONLY|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
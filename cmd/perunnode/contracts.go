@@ -0,0 +1,354 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+)
+
+const (
+	// flag names for contracts command.
+	chainidF  = "chainid"
+	keystoreF = "keystore"
+	accountF  = "account"
+	passwordF = "password-file"
+	tokensF   = "tokens"
+
+	// default values for flags in contracts command.
+	defaultChainConnTimeout = 10 * time.Second
+	defaultOnChainTxTimeout = 1 * time.Minute
+
+	// keys in the node config file that are updated after deploying the contracts.
+	adjudicatorKey = "adjudicator"
+	assetETHKey    = "asseteth"
+	assetERC20sKey = "asseterc20s"
+
+	nodeConfigFileMode = os.FileMode(0o600) // file mode for creating the node config file.
+)
+
+var (
+	contractsCmd = &cobra.Command{
+		Use:   "contracts",
+		Short: "Deploy and validate perun contracts",
+		Long: `
+Deploy and validate the perun contracts (adjudicator and asset holders) used
+by the node.`,
+	}
+
+	contractsDeployCmd = &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploy perun contracts and update the node config file",
+		Long: `
+Deploy the adjudicator, asset ETH and ERC20 asset holder contracts (one for
+each token address specified) using the on-chain account in the given
+keystore.
+
+The addresses of the deployed contracts are written to the node config file.
+If the file exists, only the contract addresses are updated and all the other
+values are retained. If not, a new file is created.`,
+		Run: contractsDeploy,
+	}
+
+	contractsValidateCmd = &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the perun contracts in the node config file",
+		Long: `
+Validate the adjudicator, asset ETH and ERC20 asset holder contracts at the
+addresses specified in the node config file and print a report.
+
+The command exits with a non-zero status if any of the contracts is invalid.`,
+		Run: contractsValidate,
+	}
+)
+
+func init() {
+	rootCmd.AddCommand(contractsCmd)
+	contractsCmd.AddCommand(contractsDeployCmd)
+	contractsCmd.AddCommand(contractsValidateCmd)
+	defineContractsCmdFlags()
+}
+
+func defineContractsCmdFlags() {
+	contractsDeployCmd.Flags().String(configfileF, defaultConfigFile, "node config file to update")
+	contractsDeployCmd.Flags().String(chainurlF, "", "URL of the blockchain node")
+	contractsDeployCmd.Flags().Int(chainidF, 0, "Chain ID of the blockchain network")
+	contractsDeployCmd.Flags().String(keystoreF, "", "Path to the keystore directory of the on-chain account")
+	contractsDeployCmd.Flags().String(accountF, "", "Address of the on-chain account as hex string with 0x prefix")
+	contractsDeployCmd.Flags().String(passwordF, "",
+		"File containing the password for unlocking the on-chain account. If not set, password is prompted for")
+	contractsDeployCmd.Flags().StringSlice(tokensF, nil,
+		"Addresses of the ERC20 token contracts for which asset holders are to be deployed")
+	contractsDeployCmd.Flags().Duration(chainconntimeoutF, defaultChainConnTimeout,
+		"Connection timeout for connecting to the blockchain node")
+	contractsDeployCmd.Flags().Duration(onchaintxtimeoutF, defaultOnChainTxTimeout,
+		"Max duration to wait for an on-chain transaction to be mined.")
+	for _, f := range []string{chainurlF, chainidF, keystoreF, accountF} {
+		if err := contractsDeployCmd.MarkFlagRequired(f); err != nil {
+			panic(err)
+		}
+	}
+
+	contractsValidateCmd.Flags().String(configfileF, defaultConfigFile, "node config file")
+	contractsValidateCmd.Flags().String(chainurlF, "", "URL of the blockchain node. Overrides the value in config file")
+	contractsValidateCmd.Flags().Duration(chainconntimeoutF, defaultChainConnTimeout,
+		"Connection timeout for connecting to the blockchain node")
+}
+
+func contractsDeploy(cmd *cobra.Command, args []string) {
+	fs := cmd.Flags()
+	// Errors can be ignored, as the flags are defined on the command.
+	configFile, _ := fs.GetString(configfileF)          // nolint: errcheck
+	chainURL, _ := fs.GetString(chainurlF)              // nolint: errcheck
+	chainID, _ := fs.GetInt(chainidF)                   // nolint: errcheck
+	keystore, _ := fs.GetString(keystoreF)              // nolint: errcheck
+	account, _ := fs.GetString(accountF)                // nolint: errcheck
+	passwordFile, _ := fs.GetString(passwordF)          // nolint: errcheck
+	tokens, _ := fs.GetStringSlice(tokensF)             // nolint: errcheck
+	connTimeout, _ := fs.GetDuration(chainconntimeoutF) // nolint: errcheck
+	txTimeout, _ := fs.GetDuration(onchaintxtimeoutF)   // nolint: errcheck
+
+	walletBackend := ethereum.NewWalletBackend()
+	txSender, err := walletBackend.ParseAddr(account)
+	if err != nil {
+		exitWithError("Error parsing account address: %v\n", err)
+	}
+	tokenAddrs := make([]pwallet.Address, len(tokens))
+	for i := range tokens {
+		if tokenAddrs[i], err = walletBackend.ParseAddr(tokens[i]); err != nil {
+			exitWithError("Error parsing token address %s: %v\n", tokens[i], err)
+		}
+	}
+
+	password, err := readPassword(passwordFile, account)
+	if err != nil {
+		exitWithError("Error reading password: %v\n", err)
+	}
+	cred := perun.Credential{
+		Addr:     txSender,
+		Keystore: keystore,
+		Password: password,
+	}
+	chain, err := ethereum.NewChainBackend(chainURL, chainID, connTimeout, txTimeout, cred)
+	if err != nil {
+		exitWithError("Error initializing chain backend: %v\n", err)
+	}
+
+	adjudicator, err := chain.DeployAdjudicator(txSender)
+	if err != nil {
+		exitWithError("Error deploying adjudicator: %v\n", err)
+	}
+	fmt.Printf("Deployed adjudicator at %s\n", adjudicator)
+
+	assetETH, err := chain.DeployAssetETH(adjudicator, txSender)
+	if err != nil {
+		exitWithError("Error deploying asset ETH: %v\n", err)
+	}
+	fmt.Printf("Deployed asset ETH at %s\n", assetETH)
+
+	assetERC20s := make(map[string]string, len(tokenAddrs))
+	for _, token := range tokenAddrs {
+		assetERC20, err := chain.DeployAssetERC20(adjudicator, token, txSender)
+		if err != nil {
+			exitWithError("Error deploying asset ERC20 for token %s: %v\n", token, err)
+		}
+		fmt.Printf("Deployed asset ERC20 for token %s at %s\n", token, assetERC20)
+		assetERC20s[token.String()] = assetERC20.String()
+	}
+
+	if err = updateContractsInNodeConfig(configFile, chainURL, chainID,
+		adjudicator.String(), assetETH.String(), assetERC20s); err != nil {
+		exitWithError("Error updating node config file: %v\n", err)
+	}
+	fmt.Printf("Updated contract addresses in node config file: %s\n", configFile)
+}
+
+func contractsValidate(cmd *cobra.Command, args []string) {
+	fs := cmd.Flags()
+	// Errors can be ignored, as the flags are defined on the command.
+	configFile, _ := fs.GetString(configfileF)          // nolint: errcheck
+	connTimeout, _ := fs.GetDuration(chainconntimeoutF) // nolint: errcheck
+
+	v := viper.New()
+	v.SetConfigFile(filepath.Clean(configFile))
+	v.SetConfigType("yaml")
+	if err := v.ReadInConfig(); err != nil {
+		exitWithError("Error reading node config file: %v\n", err)
+	}
+	var nodeCfg perun.NodeConfig
+	if err := v.Unmarshal(&nodeCfg); err != nil {
+		exitWithError("Error parsing node config file: %v\n", err)
+	}
+	if fs.Changed(chainurlF) {
+		nodeCfg.ChainURL, _ = fs.GetString(chainurlF) // nolint: errcheck
+	}
+
+	chain, err := ethereum.NewROChainBackend(nodeCfg.ChainURL, nodeCfg.ChainID, connTimeout)
+	if err != nil {
+		exitWithError("Error connecting to blockchain: %v\n", err)
+	}
+
+	fmt.Printf("Validating contracts in %s against blockchain at %s\n\n", configFile, nodeCfg.ChainURL)
+	if isValid := validateContracts(chain, nodeCfg); !isValid {
+		fmt.Printf("\nValidation failed.\n")
+		os.Exit(1)
+	}
+	fmt.Printf("\nAll contracts are valid.\n")
+}
+
+// validateContracts validates each of the contracts in the node config and
+// prints a report. It returns true only if all the contracts are valid.
+func validateContracts(chain perun.ROChainBackend, nodeCfg perun.NodeConfig) bool {
+	walletBackend := ethereum.NewWalletBackend()
+	isValid := true
+	report := func(name, addr string, err error) {
+		if err != nil {
+			isValid = false
+			fmt.Printf("%-12s %s: INVALID (%v)\n", name, addr, err)
+			return
+		}
+		fmt.Printf("%-12s %s: OK\n", name, addr)
+	}
+
+	adjudicator, err := walletBackend.ParseAddr(nodeCfg.Adjudicator)
+	if err != nil {
+		report("adjudicator", nodeCfg.Adjudicator, err)
+		return false
+	}
+	report("adjudicator", nodeCfg.Adjudicator, chain.ValidateAdjudicator(adjudicator))
+
+	assetETH, err := walletBackend.ParseAddr(nodeCfg.AssetETH)
+	if err == nil {
+		err = chain.ValidateAssetETH(adjudicator, assetETH)
+	}
+	report("asset ETH", nodeCfg.AssetETH, err)
+
+	for token, asset := range nodeCfg.AssetERC20s {
+		name := "asset ERC20"
+		tokenAddr, err := walletBackend.ParseAddr(token)
+		if err != nil {
+			report(name, asset, errors.WithMessage(err, "parsing token address "+token))
+			continue
+		}
+		assetAddr, err := walletBackend.ParseAddr(asset)
+		if err != nil {
+			report(name, asset, err)
+			continue
+		}
+		symbol, _, err := chain.ValidateAssetERC20(adjudicator, tokenAddr, assetAddr)
+		if err == nil {
+			name = fmt.Sprintf("asset %s", symbol)
+		}
+		report(name, asset, err)
+	}
+	return isValid
+}
+
+// updateContractsInNodeConfig sets the contract addresses in the given node
+// config file. If the file exists, all the other values in it are retained.
+// If not, a new file with chain parameters and contract addresses is created.
+func updateContractsInNodeConfig(configFile, chainURL string, chainID int,
+	adjudicator, assetETH string, assetERC20s map[string]string) error {
+	doc := yaml.Node{}
+	data, err := ioutil.ReadFile(filepath.Clean(configFile))
+	switch {
+	case os.IsNotExist(err):
+		doc.Kind = yaml.DocumentNode
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode}}
+		setYAMLKey(doc.Content[0], "chainurl", chainURL)
+		setYAMLKey(doc.Content[0], "chainid", chainID)
+	case err != nil:
+		return errors.Wrap(err, "reading file")
+	default:
+		if err = yaml.Unmarshal(data, &doc); err != nil {
+			return errors.Wrap(err, "parsing file")
+		}
+		if len(doc.Content) != 1 || doc.Content[0].Kind != yaml.MappingNode {
+			return errors.New("parsing file: content is not a yaml mapping")
+		}
+	}
+
+	setYAMLKey(doc.Content[0], adjudicatorKey, adjudicator)
+	setYAMLKey(doc.Content[0], assetETHKey, assetETH)
+	setYAMLKey(doc.Content[0], assetERC20sKey, assetERC20s)
+
+	data, err = yaml.Marshal(&doc)
+	if err != nil {
+		return errors.Wrap(err, "encoding config")
+	}
+	return errors.Wrap(ioutil.WriteFile(configFile, data, nodeConfigFileMode), "writing file")
+}
+
+// setYAMLKey sets the value for the key in the yaml mapping node. Keys are
+// matched case insensitively, as viper (used for parsing config files) treats
+// them so. If the key is not present, it is appended to the mapping.
+func setYAMLKey(mapping *yaml.Node, key string, value interface{}) {
+	valueNode := &yaml.Node{}
+	// Encoding strings, ints and maps of strings does not fail.
+	valueNode.Encode(value) // nolint: errcheck, gosec
+
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if strings.EqualFold(mapping.Content[i].Value, key) {
+			mapping.Content[i+1] = valueNode
+			return
+		}
+	}
+	keyNode := &yaml.Node{Kind: yaml.ScalarNode, Value: key}
+	mapping.Content = append(mapping.Content, keyNode, valueNode)
+}
+
+// readPassword reads the password for the account from the first line of the
+// password file. If the file is not specified, it prompts for the password on
+// the terminal without echoing it. The password is not accepted as a flag
+// value, as it would then be visible in the process list and shell history.
+func readPassword(passwordFile, account string) (string, error) {
+	if passwordFile != "" {
+		data, err := ioutil.ReadFile(filepath.Clean(passwordFile))
+		if err != nil {
+			return "", errors.Wrap(err, "reading password file")
+		}
+		return strings.TrimRight(strings.SplitN(string(data), "\n", 2)[0], "\r"), nil
+	}
+
+	stdin := int(os.Stdin.Fd()) // nolint: gosec	// File descriptors fit into int.
+	if !term.IsTerminal(stdin) {
+		return "", errors.New("stdin is not a terminal, use --" + passwordF + " to specify the password")
+	}
+	fmt.Fprintf(os.Stderr, "Password for account %s: ", account)
+	password, err := term.ReadPassword(stdin)
+	fmt.Fprintln(os.Stderr)
+	return string(password), errors.Wrap(err, "reading password from terminal")
+}
+
+func exitWithError(format string, a ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, a...)
+	os.Exit(1)
+}
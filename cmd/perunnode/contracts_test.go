@@ -0,0 +1,142 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+)
+
+func Test_SetYAMLKey(t *testing.T) {
+	mapping := &yaml.Node{}
+	require.NoError(t, yaml.Unmarshal([]byte("chainURL: ws://127.0.0.1:8545\nAdjudicator: 0x1\n"), mapping))
+	mapping = mapping.Content[0]
+
+	t.Run("existing_key_matched_case_insensitively", func(t *testing.T) {
+		setYAMLKey(mapping, adjudicatorKey, "0x2")
+
+		require.Len(t, mapping.Content, 4)
+		assert.Equal(t, "Adjudicator", mapping.Content[2].Value)
+		assert.Equal(t, "0x2", mapping.Content[3].Value)
+	})
+	t.Run("new_key_appended", func(t *testing.T) {
+		setYAMLKey(mapping, assetERC20sKey, map[string]string{"0xa": "0xb"})
+
+		require.Len(t, mapping.Content, 6)
+		assert.Equal(t, assetERC20sKey, mapping.Content[4].Value)
+		assert.Equal(t, yaml.MappingNode, mapping.Content[5].Kind)
+	})
+}
+
+func Test_UpdateContractsInNodeConfig(t *testing.T) {
+	assetERC20s := map[string]string{"0xa": "0xb"}
+	readConfig := func(t *testing.T, configFile string) map[string]interface{} {
+		data, err := ioutil.ReadFile(configFile)
+		require.NoError(t, err)
+		cfg := make(map[string]interface{})
+		require.NoError(t, yaml.Unmarshal(data, &cfg))
+		return cfg
+	}
+
+	t.Run("happy_new_file", func(t *testing.T) {
+		configFile := filepath.Join(t.TempDir(), "node.yaml")
+
+		err := updateContractsInNodeConfig(configFile, "ws://127.0.0.1:8545", 1337, "0x1", "0x2", assetERC20s)
+		require.NoError(t, err)
+
+		cfg := readConfig(t, configFile)
+		assert.Equal(t, "ws://127.0.0.1:8545", cfg["chainurl"])
+		assert.Equal(t, 1337, cfg["chainid"])
+		assert.Equal(t, "0x1", cfg[adjudicatorKey])
+		assert.Equal(t, "0x2", cfg[assetETHKey])
+		assert.Equal(t, map[string]interface{}{"0xa": "0xb"}, cfg[assetERC20sKey])
+	})
+	t.Run("happy_existing_file_other_values_retained", func(t *testing.T) {
+		configFile := filepath.Join(t.TempDir(), "node.yaml")
+		existing := "loglevel: debug\nchainurl: ws://example.com\nadjudicator: 0x9\n"
+		require.NoError(t, ioutil.WriteFile(configFile, []byte(existing), nodeConfigFileMode))
+
+		err := updateContractsInNodeConfig(configFile, "ws://127.0.0.1:8545", 1337, "0x1", "0x2", assetERC20s)
+		require.NoError(t, err)
+
+		cfg := readConfig(t, configFile)
+		assert.Equal(t, "debug", cfg["loglevel"])
+		assert.Equal(t, "ws://example.com", cfg["chainurl"])
+		assert.NotContains(t, cfg, "chainid")
+		assert.Equal(t, "0x1", cfg[adjudicatorKey])
+		assert.Equal(t, "0x2", cfg[assetETHKey])
+	})
+	t.Run("invalid_file_content", func(t *testing.T) {
+		configFile := filepath.Join(t.TempDir(), "node.yaml")
+		require.NoError(t, ioutil.WriteFile(configFile, []byte("- a\n- b\n"), nodeConfigFileMode))
+
+		err := updateContractsInNodeConfig(configFile, "ws://127.0.0.1:8545", 1337, "0x1", "0x2", assetERC20s)
+		require.Error(t, err)
+	})
+}
+
+func Test_ValidateContracts(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	setup := ethereumtest.NewSimChainBackendSetup(t, rng, 1)
+	validCfg := perun.NodeConfig{
+		Adjudicator: setup.Adjudicator.String(),
+		AssetETH:    setup.AssetETH.String(),
+	}
+
+	t.Run("happy", func(t *testing.T) {
+		assert.True(t, validateContracts(setup.ChainBackend, validCfg))
+	})
+	t.Run("invalid_adjudicator", func(t *testing.T) {
+		cfg := validCfg
+		cfg.Adjudicator = ethereumtest.NewRandomAddress(rng).String()
+		assert.False(t, validateContracts(setup.ChainBackend, cfg))
+	})
+	t.Run("invalid_asset_eth", func(t *testing.T) {
+		cfg := validCfg
+		cfg.AssetETH = "invalid-address"
+		assert.False(t, validateContracts(setup.ChainBackend, cfg))
+	})
+	t.Run("invalid_asset_erc20", func(t *testing.T) {
+		cfg := validCfg
+		cfg.AssetERC20s = map[string]string{"invalid-address": setup.AssetETH.String()}
+		assert.False(t, validateContracts(setup.ChainBackend, cfg))
+	})
+}
+
+func Test_ReadPassword(t *testing.T) {
+	t.Run("happy_from_file", func(t *testing.T) {
+		passwordFile := filepath.Join(t.TempDir(), "password")
+		require.NoError(t, ioutil.WriteFile(passwordFile, []byte("secret\r\nignored\n"), 0o600))
+
+		password, err := readPassword(passwordFile, "0x1")
+		require.NoError(t, err)
+		assert.Equal(t, "secret", password)
+	})
+	t.Run("missing_file", func(t *testing.T) {
+		_, err := readPassword(filepath.Join(t.TempDir(), "password"), "0x1")
+		require.Error(t, err)
+	})
+}
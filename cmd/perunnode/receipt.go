@@ -0,0 +1,89 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/session"
+)
+
+var (
+	receiptCmd = &cobra.Command{
+		Use:   "receipt",
+		Short: "Work with the receipts exported for channel states",
+		Long: `
+Work with the receipts exported for channel states. A receipt contains the
+channel parameters, the state at a version and the signatures of all the
+participants on it. It serves as a proof of the payments made until that
+version, for example in disputes or audits.`,
+	}
+
+	receiptVerifyCmd = &cobra.Command{
+		Use:   "verify [receipt file]",
+		Short: "Verify a receipt offline",
+		Long: `
+Verify the receipt in the file offline, without a connection to the
+blockchain. It checks that the state and the parameters are of the channel
+and the version in the receipt and that each of the participants has signed
+the state.
+
+The balances in the signed state are printed in the base unit of each
+currency, along with the address of the asset holder contract of the currency
+in the signed state. The currency symbols in the receipt are not signed and
+hence not used. The command exits with a non-zero status if the receipt is
+invalid.`,
+		Args: cobra.ExactArgs(1),
+		Run:  receiptVerify,
+	}
+)
+
+func init() {
+	rootCmd.AddCommand(receiptCmd)
+	receiptCmd.AddCommand(receiptVerifyCmd)
+}
+
+func receiptVerify(cmd *cobra.Command, args []string) {
+	data, err := ioutil.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		exitWithError("Error reading receipt file: %v\n", err)
+	}
+	var receipt perun.ChReceipt
+	if err = json.Unmarshal(data, &receipt); err != nil {
+		exitWithError("Error parsing receipt file: %v\n", err)
+	}
+
+	fmt.Printf("Verifying receipt for version %s of channel %s\n\n", receipt.Version, receipt.ChID)
+	assets, bals, err := session.VerifyReceipt(receipt)
+	if err != nil {
+		fmt.Printf("Receipt is INVALID: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Participants: %s\n", strings.Join(receipt.Participants, ", "))
+	for i := range bals {
+		fmt.Printf("Signed balances (asset %s, base unit): %s\n", assets[i], strings.Join(bals[i], ", "))
+	}
+	fmt.Printf("\nReceipt is valid, signed by all the participants.\n")
+}
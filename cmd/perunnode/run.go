@@ -74,8 +74,8 @@ var (
 	// Currently this is fixed and hence hard coded here.
 	// It can be moved to config file or flags at the point when the user will
 	// be able to choose (when starting the node) which ones to load or support.
-	supportedCommTypes             = []string{"tcp"}
-	supportedIDProviderTypes       = []string{"local"}
+	supportedCommTypes             = []string{"tcp", "tls", "websocket", "relay"}
+	supportedIDProviderTypes       = []string{"local", "http"}
 	supportedCurrencyInterpretters = []string{"ETH"}
 )
 
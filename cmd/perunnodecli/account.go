@@ -0,0 +1,246 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"github.com/abiosoft/ishell"
+
+	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+)
+
+var (
+	accountCmdUsage = "Usage: account [sub-command]"
+	accountCmd      = &ishell.Cmd{
+		Name: "account",
+		Help: "Use this command to access the on-chain account of the session." + accountCmdUsage,
+		Func: accountFn,
+	}
+
+	accountBalanceCmdUsage = "Usage: account balance [currency]"
+	accountBalanceCmd      = &ishell.Cmd{
+		Name: "balance",
+		Help: "Get the on-chain balance of the session account in the given currency." + accountBalanceCmdUsage,
+		Completer: func([]string) []string {
+			return []string{"ETH"} // Provide default values as autocompletion.
+		},
+		Func: accountBalanceFn,
+	}
+
+	accountTransferCmdUsage = "Usage: account transfer [currency] [to address] [amount]"
+	accountTransferCmd      = &ishell.Cmd{
+		Name: "transfer",
+		Help: "Transfer funds from the session account to the given address." + accountTransferCmdUsage,
+		Completer: func([]string) []string {
+			return []string{"ETH"} // Provide default values as autocompletion.
+		},
+		Func: accountTransferFn,
+	}
+
+	accountAllowanceCmdUsage = "Usage: account allowance [currency]"
+	accountAllowanceCmd      = &ishell.Cmd{
+		Name: "allowance",
+		Help: "Get the allowance of the asset holder for the given ERC20 currency." + accountAllowanceCmdUsage,
+		Func: accountAllowanceFn,
+	}
+
+	accountApproveCmdUsage = "Usage: account approve [currency] [amount]"
+	accountApproveCmd      = &ishell.Cmd{
+		Name: "approve",
+		Help: "Set the allowance of the asset holder for the given ERC20 currency." + accountApproveCmdUsage,
+		Func: accountApproveFn,
+	}
+
+	accountTxCostsCmdUsage = "Usage: account tx-costs"
+	accountTxCostsCmd      = &ishell.Cmd{
+		Name: "tx-costs",
+		Help: "Get the costs of the on-chain transactions sent for the session." + accountTxCostsCmdUsage,
+		Func: accountTxCostsFn,
+	}
+)
+
+func init() {
+	accountCmd.AddCmd(accountBalanceCmd)
+	accountCmd.AddCmd(accountTransferCmd)
+	accountCmd.AddCmd(accountAllowanceCmd)
+	accountCmd.AddCmd(accountApproveCmd)
+	accountCmd.AddCmd(accountTxCostsCmd)
+}
+
+func accountFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	c.Println(c.Cmd.HelpText())
+}
+
+func accountBalanceFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.GetOnChainBalReq{
+		SessionID: sessionID,
+		Currency:  c.Args[0],
+	}
+	resp, err := client.GetOnChainBal(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.GetOnChainBalResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error reading on-chain balance : %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	msg := resp.Response.(*pb.GetOnChainBalResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("On-chain balance: %s %s", msg.MsgSuccess.Bal, c.Args[0]))
+}
+
+func accountTransferFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	countReqArgs := 3
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.TransferOnChainReq{
+		SessionID: sessionID,
+		Currency:  c.Args[0],
+		To:        c.Args[1],
+		Amount:    c.Args[2],
+	}
+	resp, err := client.TransferOnChain(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.TransferOnChainResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error transferring funds : %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	msg := resp.Response.(*pb.TransferOnChainResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("Funds transferred. Tx ID: %s", msg.MsgSuccess.TxID))
+}
+
+func accountAllowanceFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.GetAllowanceReq{
+		SessionID: sessionID,
+		Currency:  c.Args[0],
+	}
+	resp, err := client.GetAllowance(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.GetAllowanceResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error reading allowance : %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	msg := resp.Response.(*pb.GetAllowanceResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("Allowance: %s %s", msg.MsgSuccess.Allowance, c.Args[0]))
+}
+
+func accountApproveFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	countReqArgs := 2
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.SetAllowanceReq{
+		SessionID: sessionID,
+		Currency:  c.Args[0],
+		Amount:    c.Args[1],
+	}
+	resp, err := client.SetAllowance(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.SetAllowanceResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error setting allowance : %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	msg := resp.Response.(*pb.SetAllowanceResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("Allowance set. Tx ID: %s", msg.MsgSuccess.TxID))
+}
+
+func accountTxCostsFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	countReqArgs := 0
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.GetTxCostReportReq{
+		SessionID: sessionID,
+	}
+	resp, err := client.GetTxCostReport(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.GetTxCostReportResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error reading tx costs : %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	msg := resp.Response.(*pb.GetTxCostReportResp_MsgSuccess_)
+	for _, txCost := range msg.MsgSuccess.TxCosts {
+		chID := txCost.ChID
+		if chID == "" {
+			chID = "-"
+		}
+		c.Printf("Channel: %s, Tx type: %s, Gas used: %d, Fee: %s ETH, Tx ID: %s\n",
+			chID, txCost.TxType, txCost.GasUsed, txCost.Fee, txCost.TxID)
+	}
+	c.Printf("%s\n\n", greenf("Total fee: %s ETH", msg.MsgSuccess.TotalFee))
+}
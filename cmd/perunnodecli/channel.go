@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/abiosoft/ishell"
 
@@ -583,8 +584,23 @@ func prettifyBalanceInfo(balInfo *pb.BalInfo, v string) string {
 }
 
 func prettifyPayChInfo(chInfo *pb.PayChInfo) string {
-	return fmt.Sprintf("ID: %s, Currency: %s, Version: %s, Balance %v", chInfo.ChID,
+	info := fmt.Sprintf("ID: %s, Currency: %s, Version: %s, Balance %v", chInfo.ChID,
 		chInfo.BalInfo.Currencies, chInfo.Version, toBalanceMap(chInfo.BalInfo.Parts, chInfo.BalInfo.Bals[0].Bal))
+	if value := prettifyFiatBalInfo(chInfo.FiatBalInfo, chInfo.BalInfo); value != "" {
+		info += ", " + value
+	}
+	return info
+}
+
+// prettifyFiatBalInfo returns the value of the balances in fiat, along with the rate used and the time at which
+// it was last updated. It returns an empty string, if the value is not available.
+func prettifyFiatBalInfo(fiatBalInfo *pb.FiatBalInfo, balInfo *pb.BalInfo) string {
+	if fiatBalInfo == nil || len(fiatBalInfo.Rates) == 0 || fiatBalInfo.Rates[0] == "" {
+		return ""
+	}
+	return fmt.Sprintf("Value in %s %v, Rate: %s %s/%s as of %s", fiatBalInfo.Fiat,
+		toBalanceMap(balInfo.Parts, fiatBalInfo.Bals[0].Bal), fiatBalInfo.Rates[0], fiatBalInfo.Fiat,
+		balInfo.Currencies[0], time.Unix(fiatBalInfo.Timestamps[0], 0).Format(time.RFC3339))
 }
 
 func toBalanceMap(parts, bal []string) string {
@@ -19,6 +19,11 @@ package main
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/abiosoft/ishell"
 
@@ -29,14 +34,14 @@ var (
 	peerIDCmdUsage = "Usage: peer-id [sub-command]"
 	peerIDCmd      = &ishell.Cmd{
 		Name: "peer-id",
-		Help: "Use this command to add/get peer ID." + peerIDCmdUsage,
+		Help: "Use this command to add/get/export/import peer ID." + peerIDCmdUsage,
 		Func: peerIDFn,
 	}
 
-	peerIDAddCmdUsage = "Usage: peer-id add [peer alias] [off-chain address] [comm address] [comm type]"
+	peerIDAddCmdUsage = "Usage: peer-id add [peer alias] [off-chain address] [comm address] [comm type] [signature]"
 	peerIDAddCmd      = &ishell.Cmd{
 		Name: "add",
-		Help: "Add a peer ID to the ID provider." + peerIDAddCmdUsage,
+		Help: "Add a peer ID to the ID provider. Signature is optional." + peerIDAddCmdUsage,
 		Func: peerIDAddFn,
 	}
 
@@ -47,6 +52,43 @@ var (
 		Func: peerIDGetFn,
 	}
 
+	peerIDExportOwnCmdUsage = "Usage: peer-id export-own [alias]"
+	peerIDExportOwnCmd      = &ishell.Cmd{
+		Name: "export-own",
+		Help: "Print own signed peer ID as a command, that peers can use to add it with the given alias." +
+			peerIDExportOwnCmdUsage,
+		Func: peerIDExportOwnFn,
+	}
+
+	peerIDExportCmdUsage = "Usage: peer-id export [format] [file]"
+	peerIDExportCmd      = &ishell.Cmd{
+		Name: "export",
+		Help: "Export all the peer IDs in the ID provider to a file. Format should be json or csv." +
+			peerIDExportCmdUsage,
+		Func: peerIDExportFn,
+	}
+
+	peerIDImportCmdUsage = "Usage: peer-id import [format] [file] [merge strategy] [dry run]"
+	peerIDImportCmd      = &ishell.Cmd{
+		Name: "import",
+		Help: "Import the peer IDs in a file to the ID provider. Format should be json or csv." +
+			" Merge strategy should be skip, overwrite or abort. Dry run (true/false) is optional" +
+			" and defaults to false; if true, only the report is printed and nothing is imported." +
+			peerIDImportCmdUsage,
+		Func: peerIDImportFn,
+	}
+
+	peerIDStatusCmdUsage = "Usage: peer-id status [peer alias...]"
+	peerIDStatusCmd      = &ishell.Cmd{
+		Name: "status",
+		Help: "Get the connectivity status of the peers with given aliases. If no alias is given, status of all" +
+			" the peers that were dialed or connected in this session is printed." + peerIDStatusCmdUsage,
+		Func: peerIDStatusFn,
+		Completer: func([]string) []string {
+			return knownAliasesList
+		},
+	}
+
 	// List of known aliases that will be used for autocompletion. Entries will be
 	// added when "peer-id get" or "peer-id add" commands return without error.
 	knownAliasesList = []string{}
@@ -55,6 +97,10 @@ var (
 func init() {
 	peerIDCmd.AddCmd(peerIDAddCmd)
 	peerIDCmd.AddCmd(peerIDGetCmd)
+	peerIDCmd.AddCmd(peerIDExportOwnCmd)
+	peerIDCmd.AddCmd(peerIDExportCmd)
+	peerIDCmd.AddCmd(peerIDImportCmd)
+	peerIDCmd.AddCmd(peerIDStatusCmd)
 }
 
 // Add alias to known aliases list for autocompletion.
@@ -86,9 +132,10 @@ func peerIDAddFn(c *ishell.Context) {
 		return
 	}
 
-	// Usage: peer-id add [peer alias] [off-chain address] [comm address] [comm type]",
+	// Usage: peer-id add [peer alias] [off-chain address] [comm address] [comm type] [signature]",
 	countReqArgs := 4
-	if len(c.Args) != countReqArgs {
+	countOptArgs := 1
+	if len(c.Args) != countReqArgs && len(c.Args) != countReqArgs+countOptArgs {
 		printArgCountError(c, countReqArgs)
 		return
 	}
@@ -103,6 +150,9 @@ func peerIDAddFn(c *ishell.Context) {
 			CommType:        c.Args[3],
 		},
 	}
+	if len(c.Args) == countReqArgs+countOptArgs {
+		req.PeerID.Signature = c.Args[4]
+	}
 	resp, err := client.AddPeerID(context.Background(), &req)
 	if err != nil {
 		printCommandSendingError(c, err)
@@ -149,7 +199,210 @@ func peerIDGetFn(c *ishell.Context) {
 	c.Printf("%s\n\n", greenf("%s", prettifyPeer(msg.MsgSuccess.PeerID)))
 }
 
+func peerIDExportOwnFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: peer-id export-own [alias]
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.GetPeerIDReq{
+		SessionID: sessionID,
+		Alias:     "self",
+	}
+	resp, err := client.GetPeerID(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.GetPeerIDResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error getting own peer ID : %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	p := resp.Response.(*pb.GetPeerIDResp_MsgSuccess_).MsgSuccess.PeerID
+	c.Printf("%s\n\n", greenf("peer-id add %s %s %s %s %s",
+		c.Args[0], p.OffChainAddress, p.CommAddress, p.CommType, p.Signature))
+}
+
+func peerIDExportFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: peer-id export [format] [file]
+	countReqArgs := 2
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.ExportPeerIDsReq{
+		SessionID: sessionID,
+		Format:    c.Args[0],
+	}
+	resp, err := client.ExportPeerIDs(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.ExportPeerIDsResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error exporting peer IDs : %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	data := resp.Response.(*pb.ExportPeerIDsResp_MsgSuccess_).MsgSuccess.Data
+	if err = ioutil.WriteFile(filepath.Clean(c.Args[1]), []byte(data), 0o600); err != nil {
+		c.Printf("%s\n\n", redf("Error writing peer IDs to file : %v", err))
+		return
+	}
+	c.Printf("%s\n\n", greenf("Peer IDs exported to %s.", c.Args[1]))
+}
+
+func peerIDImportFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: peer-id import [format] [file] [merge strategy] [dry run]
+	countReqArgs := 3
+	countOptArgs := 1
+	if len(c.Args) != countReqArgs && len(c.Args) != countReqArgs+countOptArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	data, err := ioutil.ReadFile(filepath.Clean(c.Args[1]))
+	if err != nil {
+		c.Printf("%s\n\n", redf("Error reading peer IDs from file : %v", err))
+		return
+	}
+	dryRun := false
+	if len(c.Args) == countReqArgs+countOptArgs {
+		if dryRun, err = strconv.ParseBool(c.Args[3]); err != nil {
+			c.Printf("%s\n\n", redf("Invalid value for dry run, should be true or false : %v", c.Args[3]))
+			return
+		}
+	}
+
+	req := pb.ImportPeerIDsReq{
+		SessionID:     sessionID,
+		Format:        c.Args[0],
+		Data:          string(data),
+		MergeStrategy: c.Args[2],
+		DryRun:        dryRun,
+	}
+	resp, err := client.ImportPeerIDs(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.ImportPeerIDsResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error importing peer IDs : %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	report := resp.Response.(*pb.ImportPeerIDsResp_MsgSuccess_).MsgSuccess
+	for _, alias := range report.Added {
+		addPeerAlias(alias)
+	}
+	for _, alias := range report.Overwritten {
+		addPeerAlias(alias)
+	}
+	c.Printf("%s\n\n", prettifyImportReport(report))
+}
+
+func peerIDStatusFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: peer-id status [peer alias...]
+	req := pb.GetPeerStatusReq{
+		SessionID:   sessionID,
+		PeerAliases: c.Args,
+	}
+	resp, err := client.GetPeerStatus(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.GetPeerStatusResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error getting peer status : %v", apiErrorString(msgErr.Error)))
+		return
+	}
+	peerStatuses := resp.Response.(*pb.GetPeerStatusResp_MsgSuccess_).MsgSuccess.PeerStatuses
+	if len(peerStatuses) == 0 {
+		c.Printf("%s\n\n", greenf("No peers were dialed or connected in this session."))
+		return
+	}
+	for _, peerStatus := range peerStatuses {
+		if peerStatus.Connected {
+			c.Printf("%s\n", greenf("%s", prettifyPeerStatus(peerStatus)))
+		} else {
+			c.Printf("%s\n", redf("%s", prettifyPeerStatus(peerStatus)))
+		}
+	}
+	c.Println()
+}
+
+func prettifyPeerStatus(p *pb.PeerStatus) string {
+	alias := p.Alias
+	if alias == "" {
+		alias = p.OffChainAddress
+	}
+	s := fmt.Sprintf("%s: Connected: %t", alias, p.Connected)
+	if p.LastSeen != 0 {
+		s += fmt.Sprintf(", Last seen: %s", time.Unix(p.LastSeen, 0).Format(time.RFC3339))
+	}
+	if p.RttMicros != 0 {
+		s += fmt.Sprintf(", RTT: %s", time.Duration(p.RttMicros)*time.Microsecond)
+	}
+	if p.LastError != "" {
+		s += fmt.Sprintf(", Last error: %s", p.LastError)
+	}
+	return s
+}
+
+func prettifyImportReport(r *pb.ImportPeerIDsResp_MsgSuccess) string {
+	lines := []string{
+		fmt.Sprintf("Added: %v", r.Added),
+		fmt.Sprintf("Overwritten: %v", r.Overwritten),
+		fmt.Sprintf("Unchanged: %v", r.Unchanged),
+	}
+	for _, conflict := range r.Conflicts {
+		lines = append(lines, redf("Conflict: %s with existing %s: %s",
+			conflict.Alias, conflict.ExistingAlias, conflict.Reason))
+	}
+	for _, invalid := range r.Invalid {
+		lines = append(lines, redf("Invalid record %d (%s): %s", invalid.Index, invalid.Alias, invalid.Reason))
+	}
+	if r.Applied {
+		lines = append(lines, greenf("Peer IDs imported successfully."))
+	} else {
+		lines = append(lines, redf("Peer IDs were not imported."))
+	}
+	return strings.Join(lines, "\n")
+}
+
 func prettifyPeer(p *pb.PeerID) string {
-	return fmt.Sprintf("Alias: %s, Off-chain Addr: %s, Comm Addr: %s, Comm Type: %s",
+	s := fmt.Sprintf("Alias: %s, Off-chain Addr: %s, Comm Addr: %s, Comm Type: %s",
 		p.Alias, p.OffChainAddress, p.CommAddress, p.CommType)
+	for _, endpoint := range p.AltCommEndpoints {
+		s += fmt.Sprintf(", Alt Comm Endpoint: %s (%s)", endpoint.CommAddress, endpoint.CommType)
+	}
+	if p.Signature != "" {
+		s += fmt.Sprintf(", Signature: %s", p.Signature)
+	}
+	return s
 }
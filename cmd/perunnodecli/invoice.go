@@ -0,0 +1,248 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abiosoft/ishell"
+
+	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+	"github.com/hyperledger-labs/perun-node/currency"
+)
+
+var (
+	invoiceCmdUsage = "Usage: invoice [sub-command]"
+	invoiceCmd      = &ishell.Cmd{
+		Name: "invoice",
+		Help: "Use this command to create/get/list/pay invoices." + invoiceCmdUsage,
+		Func: invoiceFn,
+	}
+	invoiceCreateCmdUsage = "Usage: invoice create [amount] [expiry in secs] [memo]"
+	invoiceCreateCmd      = &ishell.Cmd{
+		Name: "create",
+		Help: "Create an invoice for receiving a payment. Expiry of 0 means no expiry." + invoiceCreateCmdUsage,
+		Func: invoiceCreateFn,
+	}
+	invoiceGetCmdUsage = "Usage: invoice get [invoice id]"
+	invoiceGetCmd      = &ishell.Cmd{
+		Name: "get",
+		Help: "Get the invoice and the payments made against it." + invoiceGetCmdUsage,
+		Func: invoiceGetFn,
+	}
+	invoiceListCmdUsage = "Usage: invoice list"
+	invoiceListCmd      = &ishell.Cmd{
+		Name: "list",
+		Help: "List all invoices created in the session." + invoiceListCmdUsage,
+		Func: invoiceListFn,
+	}
+	invoicePayCmdUsage = "Usage: invoice pay [channel alias] [invoice id] [amount]"
+	invoicePayCmd      = &ishell.Cmd{
+		Name: "pay",
+		Help: "Pay an invoice issued by the peer on the channel." + invoicePayCmdUsage,
+		Completer: func([]string) []string {
+			return openChannelsList
+		},
+		Func: invoicePayFn,
+	}
+)
+
+func init() {
+	invoiceCmd.AddCmd(invoiceCreateCmd)
+	invoiceCmd.AddCmd(invoiceGetCmd)
+	invoiceCmd.AddCmd(invoiceListCmd)
+	invoiceCmd.AddCmd(invoicePayCmd)
+}
+
+func invoiceFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	c.Println(c.Cmd.HelpText())
+}
+
+func invoiceCreateFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: invoice create [amount] [expiry in secs] [memo]
+	countReqArgs := 3
+	if len(c.Args) < countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+	expirySecs, err := strconv.ParseUint(c.Args[1], 10, 64)
+	if err != nil {
+		c.Printf("%s\n\n", redf("Expiry should be a non-negative integer: %v.", err))
+		return
+	}
+
+	req := pb.CreateInvoiceReq{
+		SessionID:  sessionID,
+		Currency:   currency.ETHSymbol,
+		Amount:     c.Args[0],
+		Memo:       strings.Join(c.Args[2:], " "),
+		ExpirySecs: expirySecs,
+	}
+	resp, err := client.CreateInvoice(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.CreateInvoiceResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error creating invoice: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.CreateInvoiceResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("Invoice created. Share the invoice ID with the payer.\n%s",
+		prettifyInvoice(msg.MsgSuccess.Invoice)))
+}
+
+func invoiceGetFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: invoice get [invoice id]
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.GetInvoiceReq{
+		SessionID: sessionID,
+		InvoiceID: c.Args[0],
+	}
+	resp, err := client.GetInvoice(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.GetInvoiceResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error getting invoice: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.GetInvoiceResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("%s", prettifyInvoice(msg.MsgSuccess.Invoice)))
+}
+
+func invoiceListFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: invoice list
+	countReqArgs := 0
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.GetInvoicesReq{
+		SessionID: sessionID,
+	}
+	resp, err := client.GetInvoices(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.GetInvoicesResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error listing invoices: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.GetInvoicesResp_MsgSuccess_)
+	if len(msg.MsgSuccess.Invoices) == 0 {
+		c.Printf("%s\n\n", greenf("No invoices created in this session."))
+		return
+	}
+	for _, invoice := range msg.MsgSuccess.Invoices {
+		c.Printf("%s\n\n", greenf("%s", prettifyInvoice(invoice)))
+	}
+}
+
+func invoicePayFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: invoice pay [channel alias] [invoice id] [amount]
+	countReqArgs := 3
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+	chInfo, ok := openChannelsMap[c.Args[0]]
+	if !ok {
+		printUnknownChannelAliasError(c, c.Args[0])
+		return
+	}
+
+	req := pb.PayInvoiceReq{
+		SessionID: sessionID,
+		ChID:      chInfo.id,
+		InvoiceID: c.Args[1],
+		Currency:  currency.ETHSymbol,
+		Amount:    c.Args[2],
+	}
+	resp, err := client.PayInvoice(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.PayInvoiceResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error paying invoice: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.PayInvoiceResp_MsgSuccess_)
+	chAlias := openChannelsRevMap[msg.MsgSuccess.UpdatedPayChInfo.ChID]
+	c.Printf("%s\n\n", greenf("Invoice paid on channel %s. Updated channel Info:\n%s.",
+		chAlias, prettifyPayChInfo(msg.MsgSuccess.UpdatedPayChInfo)))
+}
+
+func prettifyInvoice(invoice *pb.Invoice) string {
+	expiry := "never"
+	if invoice.Expiry != 0 {
+		expiry = time.Unix(invoice.Expiry, 0).String()
+	}
+	s := fmt.Sprintf("ID: %s, Status: %s, Amount: %s %s, Paid: %s %s, Memo: %q, Expiry: %s",
+		invoice.InvoiceID, invoice.Status, invoice.Amount, invoice.Currency,
+		invoice.AmountPaid, invoice.Currency, invoice.Memo, expiry)
+	for _, payment := range invoice.Payments {
+		s += fmt.Sprintf("\n\tPaid %s by %s on channel %s (version %s) at %s",
+			payment.Amount, payment.PayerAlias, payment.ChID, payment.Version, time.Unix(payment.PaidAt, 0))
+	}
+	return s
+}
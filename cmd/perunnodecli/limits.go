@@ -0,0 +1,201 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/abiosoft/ishell"
+
+	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+	"github.com/hyperledger-labs/perun-node/currency"
+)
+
+// noLimit is the placeholder used in the limits command to denote that a limit is not set.
+const noLimit = "-"
+
+var (
+	limitsCmdUsage = "Usage: limits [sub-command]"
+	limitsCmd      = &ishell.Cmd{
+		Name: "limits",
+		Help: "Use this command to set/get the spending limits for the session." + limitsCmdUsage,
+		Func: limitsFn,
+	}
+	limitsSetCmdUsage = "Usage: limits set [peer alias|default] [max payment] [max per hour] [max per day]" +
+		" [max ch funding] [fiat (optional)]"
+	limitsSetCmd = &ishell.Cmd{
+		Name: "set",
+		Help: "Set the spending limits for the peer, or the default limits for all peers. Use - for no limit." +
+			" Limits set for the same peer are replaced, those for other peers are retained. If fiat is given," +
+			" the amounts are in fiat and are converted at the current price." +
+			" Example: limits set alice 1 5 20 -, limits set default 100 - 1000 - USD." + limitsSetCmdUsage,
+		Completer: func(args []string) []string {
+			if len(args) == 0 {
+				return append([]string{"default"}, knownAliasesList...)
+			}
+			return nil
+		},
+		Func: limitsSetFn,
+	}
+	limitsGetCmdUsage = "Usage: limits get"
+	limitsGetCmd      = &ishell.Cmd{
+		Name: "get",
+		Help: "Get the spending limits currently set in the session." + limitsGetCmdUsage,
+		Func: limitsGetFn,
+	}
+)
+
+func init() {
+	limitsCmd.AddCmd(limitsSetCmd)
+	limitsCmd.AddCmd(limitsGetCmd)
+}
+
+func limitsFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	c.Println(c.Cmd.HelpText())
+}
+
+func limitsSetFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: limits set [peer alias|default] [max payment] [max per hour] [max per day] [max ch funding]
+	// [fiat (optional)]
+	countReqArgs := 5
+	if len(c.Args) < countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+	limit := &pb.SpendingLimit{
+		Currency:     currency.ETHSymbol,
+		MaxPayment:   parseLimit(c.Args[1]),
+		MaxPerHour:   parseLimit(c.Args[2]),
+		MaxPerDay:    parseLimit(c.Args[3]),
+		MaxChFunding: parseLimit(c.Args[4]),
+	}
+	if c.Args[0] != "default" {
+		limit.PeerAlias = c.Args[0]
+	}
+	if len(c.Args) > countReqArgs {
+		limit.Fiat = c.Args[countReqArgs]
+	}
+
+	limits, ok := getSpendingLimits(c)
+	if !ok {
+		return
+	}
+	updatedLimits := []*pb.SpendingLimit{}
+	for _, l := range limits {
+		if l.Currency != limit.Currency || l.PeerAlias != limit.PeerAlias {
+			updatedLimits = append(updatedLimits, l)
+		}
+	}
+	updatedLimits = append(updatedLimits, limit)
+
+	req := pb.SetSpendingLimitsReq{
+		SessionID: sessionID,
+		Limits:    updatedLimits,
+	}
+	resp, err := client.SetSpendingLimits(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.SetSpendingLimitsResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error setting spending limits: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+	c.Printf("%s\n\n", greenf("Spending limits set.\n%s", prettifySpendingLimit(limit)))
+}
+
+func limitsGetFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: limits get
+	countReqArgs := 0
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	limits, ok := getSpendingLimits(c)
+	if !ok {
+		return
+	}
+	if len(limits) == 0 {
+		c.Printf("%s\n\n", greenf("No spending limits set in this session."))
+		return
+	}
+	for _, limit := range limits {
+		c.Printf("%s\n", greenf("%s", prettifySpendingLimit(limit)))
+	}
+	c.Println()
+}
+
+func getSpendingLimits(c *ishell.Context) ([]*pb.SpendingLimit, bool) {
+	req := pb.GetSpendingLimitsReq{
+		SessionID: sessionID,
+	}
+	resp, err := client.GetSpendingLimits(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return nil, false
+	}
+	msgErr, ok := resp.Response.(*pb.GetSpendingLimitsResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error getting spending limits: %v.", apiErrorString(msgErr.Error)))
+		return nil, false
+	}
+	return resp.Response.(*pb.GetSpendingLimitsResp_MsgSuccess_).MsgSuccess.Limits, true
+}
+
+func parseLimit(arg string) string {
+	if arg == noLimit {
+		return ""
+	}
+	return arg
+}
+
+func prettifySpendingLimit(limit *pb.SpendingLimit) string {
+	peer := limit.PeerAlias
+	if peer == "" {
+		peer = "default"
+	}
+	printLimit := func(l string) string {
+		if l == "" {
+			return noLimit
+		}
+		return l
+	}
+	denomination := limit.Currency
+	if limit.Fiat != "" {
+		denomination = limit.Fiat
+	}
+	return fmt.Sprintf("Peer: %s, Currency: %s, Max payment: %s, Max per hour: %s, Max per day: %s,"+
+		" Max ch funding: %s (in %s)", peer, limit.Currency, printLimit(limit.MaxPayment),
+		printLimit(limit.MaxPerHour), printLimit(limit.MaxPerDay), printLimit(limit.MaxChFunding), denomination)
+}
@@ -69,6 +69,11 @@ func main() {
 	sh.AddCmd(peerIDCmd)
 	sh.AddCmd(channelCmd)
 	sh.AddCmd(paymentCmd)
+	sh.AddCmd(invoiceCmd)
+	sh.AddCmd(streamCmd)
+	sh.AddCmd(scheduleCmd)
+	sh.AddCmd(limitsCmd)
+	sh.AddCmd(accountCmd)
 
 	sh.Printf("Perun node cli application.\n\n")
 	sh.Printf("%s\n\n", greenf("Connect to a perun node instance using 'node connect' for making any transactions."))
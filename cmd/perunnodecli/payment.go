@@ -18,8 +18,14 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/abiosoft/ishell"
 
@@ -35,7 +41,7 @@ var (
 		Help: "Use this command to send/receive payments." + paymentCmdUsage,
 		Func: paymentFn,
 	}
-	paymentSendCmdUsage = "Usage: payment send [channel alias] [amount]"
+	paymentSendCmdUsage = "Usage: payment send [channel alias] [amount] [memo (optional)]"
 	paymentSendCmd      = &ishell.Cmd{
 		Name: "send-to-peer",
 		Help: "Send a payment to the peer." + paymentSendCmdUsage,
@@ -44,6 +50,36 @@ var (
 		},
 		Func: paymentSendFn,
 	}
+	paymentPayPeerCmdUsage = "Usage: payment pay-peer [peer alias] [amount] [fewestUpdates|largestBalance (optional)]" +
+		" [split (optional)]"
+	paymentPayPeerCmd = &ishell.Cmd{
+		Name: "pay-peer",
+		Help: "Send a payment to the peer on any open channel with enough balance. The channel with the fewest" +
+			" updates is selected by default, use largestBalance to select the one with the largest balance. Use" +
+			" split to split the payment across several channels, if no single channel has enough balance." +
+			paymentPayPeerCmdUsage,
+		Completer: func(args []string) []string {
+			if len(args) == 0 {
+				return knownAliasesList
+			}
+			return nil
+		},
+		Func: paymentPayPeerFn,
+	}
+	paymentSwapCmdUsage = "Usage: payment swap [channel alias] [give amount] [give currency] [take amount]" +
+		" [take currency] [expiry secs]"
+	paymentSwapCmd = &ishell.Cmd{
+		Name: "swap",
+		Help: "Propose a swap to the peer, of the give amount for the take amount, in a single update. The peer" +
+			" is shown the quoted rate and can accept it until the expiry." + paymentSwapCmdUsage,
+		Completer: func(args []string) []string {
+			if len(args) == 0 {
+				return openChannelsList
+			}
+			return nil
+		},
+		Func: paymentSwapFn,
+	}
 	paymentRequestCmdUsage = "Usage: payment request [channel alias] [amount]"
 	paymentRequestCmd      = &ishell.Cmd{
 		Name: "request-from-peer",
@@ -71,6 +107,29 @@ var (
 		},
 		Func: paymentUnsubFn,
 	}
+	paymentHistoryCmdUsage = "Usage: payment history [channel alias]"
+	paymentHistoryCmd      = &ishell.Cmd{
+		Name: "history",
+		Help: "Print the updates accepted on the channel, along with their metadata." + paymentHistoryCmdUsage,
+		Completer: func([]string) []string {
+			return openChannelsList
+		},
+		Func: paymentHistoryFn,
+	}
+	paymentReceiptCmdUsage = "Usage: payment export-receipt [channel alias|channel id] [version] [file]"
+	paymentReceiptCmd      = &ishell.Cmd{
+		Name: "export-receipt",
+		Help: "Export a receipt for the state of the channel at the version to the file, that contains the" +
+			" signatures of both the participants. It can be verified offline using perunnode receipt verify." +
+			" Use the channel id for channels that are closed." + paymentReceiptCmdUsage,
+		Completer: func(args []string) []string {
+			if len(args) == 0 {
+				return openChannelsList
+			}
+			return nil
+		},
+		Func: paymentReceiptFn,
+	}
 	paymentAcceptCmdUsage = "Usage: payment accept [channel alias]"
 	paymentAcceptCmd      = &ishell.Cmd{
 		Name: "accept-payment-update-from-peer",
@@ -93,11 +152,15 @@ var (
 
 func init() {
 	paymentCmd.AddCmd(paymentSendCmd)
+	paymentCmd.AddCmd(paymentPayPeerCmd)
+	paymentCmd.AddCmd(paymentSwapCmd)
 	paymentCmd.AddCmd(paymentRequestCmd)
 	paymentCmd.AddCmd(paymentSubCmd)
 	paymentCmd.AddCmd(paymentUnsubCmd)
 	paymentCmd.AddCmd(paymentAcceptCmd)
 	paymentCmd.AddCmd(paymentRejectCmd)
+	paymentCmd.AddCmd(paymentHistoryCmd)
+	paymentCmd.AddCmd(paymentReceiptCmd)
 }
 
 func paymentFn(c *ishell.Context) {
@@ -115,9 +178,9 @@ func paymentSendFn(c *ishell.Context) {
 		return
 	}
 
-	// Usage: payment send/request [channel alias] [amount]
+	// Usage: payment send [channel alias] [amount] [memo (optional)]
 	countReqArgs := 2
-	if len(c.Args) != countReqArgs {
+	if len(c.Args) < countReqArgs {
 		printArgCountError(c, countReqArgs)
 		return
 	}
@@ -138,6 +201,9 @@ func paymentSendFn(c *ishell.Context) {
 			},
 		},
 	}
+	if len(c.Args) > countReqArgs {
+		req.Meta = &pb.PayChUpdateMeta{Memo: strings.Join(c.Args[countReqArgs:], " ")}
+	}
 	resp, err := client.SendPayChUpdate(context.Background(), &req)
 	if err != nil {
 		printCommandSendingError(c, err)
@@ -155,6 +221,113 @@ func paymentSendFn(c *ishell.Context) {
 		chAlias, prettifyPayChInfo(msg.MsgSuccess.UpdatedPayChInfo)))
 }
 
+func paymentPayPeerFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: payment pay-peer [peer alias] [amount] [fewestUpdates|largestBalance (optional)] [split (optional)]
+	countReqArgs := 2
+	if len(c.Args) < countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+	req := pb.PayPeerReq{
+		SessionID: sessionID,
+		PeerAlias: c.Args[0],
+		Currency:  currency.ETHSymbol,
+		Amount:    c.Args[1],
+	}
+	for _, arg := range c.Args[countReqArgs:] {
+		if arg == "split" {
+			req.AllowSplit = true
+		} else {
+			req.ChSelectionPolicy = arg
+		}
+	}
+	resp, err := client.PayPeer(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.PayPeerResp_Error)
+	if ok {
+		c.Printf("%s\n", redf("Error sending payment to peer: %v.", apiErrorString(msgErr.Error)))
+		for _, part := range resp.CompletedParts {
+			c.Printf("%s\n", redf("Part of the payment was sent and is not reverted: %s",
+				prettifyPayPeerPart(part)))
+		}
+		c.Println()
+		return
+	}
+
+	msg := resp.Response.(*pb.PayPeerResp_MsgSuccess_)
+	for _, part := range msg.MsgSuccess.Parts {
+		c.Printf("%s\n", greenf("Payment sent to peer: %s", prettifyPayPeerPart(part)))
+	}
+	c.Println()
+}
+
+func prettifyPayPeerPart(part *pb.PayPeerPart) string {
+	return fmt.Sprintf("%s %s on channel %s (version %s)", part.Amount, currency.ETHSymbol,
+		openChannelsRevMap[part.UpdatedPayChInfo.ChID], part.UpdatedPayChInfo.Version)
+}
+
+func paymentSwapFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: payment swap [channel alias] [give amount] [give currency] [take amount] [take currency] [expiry secs]
+	countReqArgs := 6
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+	chInfo, ok := openChannelsMap[c.Args[0]]
+	if !ok {
+		printUnknownChannelAliasError(c, c.Args[0])
+		return
+	}
+	expirySecs, err := strconv.ParseUint(c.Args[5], 10, 64)
+	if err != nil {
+		c.Printf("%s\n\n", redf("Expiry should be a non-negative integer: %v.", err))
+		return
+	}
+
+	req := pb.ProposeSwapReq{
+		SessionID:    sessionID,
+		ChID:         chInfo.id,
+		GiveAmount:   c.Args[1],
+		GiveCurrency: c.Args[2],
+		TakeAmount:   c.Args[3],
+		TakeCurrency: c.Args[4],
+		ExpirySecs:   expirySecs,
+	}
+	resp, err := client.ProposeSwap(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.ProposeSwapResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error proposing swap to peer: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.ProposeSwapResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("Swap accepted by peer on channel %s: %s. Updated channel Info:\n%s.",
+		c.Args[0], prettifySwapQuote(msg.MsgSuccess.Quote),
+		prettifyPayChInfo(msg.MsgSuccess.UpdatedPayChInfo)))
+}
+
+func prettifySwapQuote(quote *pb.SwapQuote) string {
+	return fmt.Sprintf("give %s %s for %s %s at rate %s %s/%s", quote.GiveAmount, quote.GiveCurrency,
+		quote.TakeAmount, quote.TakeCurrency, quote.Rate, quote.TakeCurrency, quote.GiveCurrency)
+}
+
 // nolint: dupl		// not a duplicate of paymentSendFn
 func paymentRequestFn(c *ishell.Context) {
 	if client == nil {
@@ -281,6 +454,16 @@ func handleUpdateTypeOpenNotif(chAlias string, notif *pb.SubPayChUpdatesResp_Not
 		prettifyBalanceInfo(currPayChInfo.BalInfo, currPayChInfo.Version),
 		prettifyBalanceInfo(notif.Notify.ProposedPayChInfo.BalInfo, notif.Notify.ProposedPayChInfo.Version),
 		notif.Notify.Expiry-nodeTime))
+	printProposedFiatValue(notif.Notify.ProposedPayChInfo)
+	if notif.Notify.InvoiceID != "" {
+		sh.Printf("%s\n\n", greenf("Payment is for invoice %s.", notif.Notify.InvoiceID))
+	}
+	if meta := prettifyPayChUpdateMeta(notif.Notify.Meta); meta != "" {
+		sh.Printf("%s\n\n", greenf("Metadata: %s.", meta))
+	}
+	if notif.Notify.Swap != nil {
+		sh.Printf("%s\n\n", greenf("Swap offered by peer: %s.", prettifySwapQuote(notif.Notify.Swap)))
+	}
 
 	if openChannelsMap[chAlias].latestPaymentNotifID != "" {
 		sh.Printf("%s", greenf("Dropping older (now obselete) notification received on this channel.",
@@ -299,6 +482,7 @@ func handleUpdateTypeFinalNotif(chAlias string, notif *pb.SubPayChUpdatesResp_No
 		prettifyBalanceInfo(currPayChInfo.BalInfo, currPayChInfo.Version),
 		prettifyBalanceInfo(notif.Notify.ProposedPayChInfo.BalInfo, notif.Notify.ProposedPayChInfo.Version),
 		notif.Notify.Expiry-nodeTime))
+	printProposedFiatValue(notif.Notify.ProposedPayChInfo)
 
 	if openChannelsMap[chAlias].latestPaymentNotifID != "" {
 		sh.Printf("%s", greenf("Dropping older (now obselete) notification received on this channel.",
@@ -307,6 +491,13 @@ func handleUpdateTypeFinalNotif(chAlias string, notif *pb.SubPayChUpdatesResp_No
 	openChannelsMap[chAlias].latestPaymentNotifID = notif.Notify.UpdateID
 }
 
+// printProposedFiatValue prints the value of the proposed balances in fiat, if it is available.
+func printProposedFiatValue(proposed *pb.PayChInfo) {
+	if value := prettifyFiatBalInfo(proposed.FiatBalInfo, proposed.BalInfo); value != "" {
+		sh.Printf("%s\n\n", greenf("Proposed:\t%s.", value))
+	}
+}
+
 func handleUpdateTypeClosedNotif(chAlias string, notif *pb.SubPayChUpdatesResp_Notify_) {
 	if notif.Notify.Error == nil {
 		sh.Printf("%s", greenf("Payment channel close notification received on channel %s (ID: %s)\n",
@@ -320,6 +511,9 @@ func handleUpdateTypeClosedNotif(chAlias string, notif *pb.SubPayChUpdatesResp_N
 			prettifyBalanceInfo(notif.Notify.ProposedPayChInfo.BalInfo, notif.Notify.ProposedPayChInfo.Version),
 			apiErrorString(notif.Notify.Error)))
 	}
+	if notif.Notify.Info != "" {
+		sh.Printf("%s\n\n", greenf("Events handled after restoring the channel: %s.", notif.Notify.Info))
+	}
 
 	removeOpenChannelID(chAlias)
 }
@@ -447,3 +641,136 @@ func paymentReject(c *ishell.Context) {
 
 	chInfo.latestPaymentNotifID = ""
 }
+
+func paymentHistoryFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: payment history [channel alias]
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+	chInfo, ok := openChannelsMap[c.Args[0]]
+	if !ok {
+		printUnknownChannelAliasError(c, c.Args[0])
+		return
+	}
+
+	req := pb.GetPayChHistoryReq{
+		SessionID: sessionID,
+		ChID:      chInfo.id,
+	}
+	resp, err := client.GetPayChHistory(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.GetPayChHistoryResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error getting channel history: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.GetPayChHistoryResp_MsgSuccess_)
+	if len(msg.MsgSuccess.Records) == 0 {
+		c.Printf("%s\n\n", greenf("No updates accepted on channel %s.", c.Args[0]))
+		return
+	}
+	for _, record := range msg.MsgSuccess.Records {
+		s := fmt.Sprintf("Version %s, proposed by %s, accepted at %s: %s", record.Version, record.ProposedBy,
+			time.Unix(record.AcceptedAt, 0), toBalanceMap(record.BalInfo.Parts, record.BalInfo.Bals[0].Bal))
+		if record.InvoiceID != "" {
+			s += fmt.Sprintf(", invoice: %s", record.InvoiceID)
+		}
+		if meta := prettifyPayChUpdateMeta(record.Meta); meta != "" {
+			s += ", " + meta
+		}
+		c.Printf("%s\n", greenf("%s", s))
+	}
+	c.Println()
+}
+
+// prettifyPayChUpdateMeta returns the metadata as a string. It returns an empty string, if there is no metadata.
+func prettifyPayChUpdateMeta(meta *pb.PayChUpdateMeta) string {
+	if meta == nil {
+		return ""
+	}
+	var parts []string
+	if meta.Memo != "" {
+		parts = append(parts, fmt.Sprintf("memo: %q", meta.Memo))
+	}
+	if meta.Reference != "" {
+		parts = append(parts, fmt.Sprintf("reference: %q", meta.Reference))
+	}
+	if len(meta.Metadata) != 0 {
+		parts = append(parts, fmt.Sprintf("metadata: %v", meta.Metadata))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func paymentReceiptFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: payment export-receipt [channel alias|channel id] [version] [file]
+	countReqArgs := 3
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+	chID := c.Args[0]
+	if chInfo, ok := openChannelsMap[c.Args[0]]; ok {
+		chID = chInfo.id
+	}
+
+	req := pb.ExportPayChReceiptReq{
+		SessionID: sessionID,
+		ChID:      chID,
+		Version:   c.Args[1],
+	}
+	resp, err := client.ExportPayChReceipt(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.ExportPayChReceiptResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error exporting receipt: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	receipt := resp.Response.(*pb.ExportPayChReceiptResp_MsgSuccess_).MsgSuccess.Receipt
+	bals := make([][]string, len(receipt.BalInfo.Bals))
+	for i := range receipt.BalInfo.Bals {
+		bals[i] = receipt.BalInfo.Bals[i].Bal
+	}
+	data, err := json.MarshalIndent(perun.ChReceipt{
+		ChID:    receipt.ChID,
+		Version: receipt.Version,
+		BalInfo: perun.BalInfo{
+			Currencies: receipt.BalInfo.Currencies,
+			Parts:      receipt.BalInfo.Parts,
+			Bals:       bals,
+		},
+		Participants: receipt.Participants,
+		Params:       receipt.Params,
+		State:        receipt.State,
+		Sigs:         receipt.Sigs,
+	}, "", "  ")
+	if err != nil {
+		c.Printf("%s\n\n", redf("Error encoding receipt: %v.", err))
+		return
+	}
+	if err = ioutil.WriteFile(c.Args[2], data, 0o600); err != nil {
+		c.Printf("%s\n\n", redf("Error writing receipt to file: %v.", err))
+		return
+	}
+	c.Printf("%s\n\n", greenf("Receipt for version %s of channel %s written to %s.", receipt.Version, c.Args[0],
+		c.Args[2]))
+}
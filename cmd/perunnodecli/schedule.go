@@ -0,0 +1,244 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abiosoft/ishell"
+
+	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+	"github.com/hyperledger-labs/perun-node/currency"
+)
+
+var (
+	scheduleCmdUsage = "Usage: schedule [sub-command]"
+	scheduleCmd      = &ishell.Cmd{
+		Name: "schedule",
+		Help: "Use this command to create/list/cancel scheduled recurring payments." + scheduleCmdUsage,
+		Func: scheduleFn,
+	}
+	scheduleCreateCmdUsage = "Usage: schedule create [peer|channel] [alias] [amount] [end after secs] [schedule]"
+	scheduleCreateCmd      = &ishell.Cmd{
+		Name: "create",
+		Help: "Schedule a recurring payment to the peer, either on the channel or on any open channel with the" +
+			" peer. End after 0 secs means no end time. Schedule is in cron format with five fields (minute hour" +
+			" day-of-month month day-of-week) in UTC, or one of @hourly, @daily, @weekly, @monthly, @yearly." +
+			" Example: schedule create peer alice 0.5 0 0 9 * * 1." + scheduleCreateCmdUsage,
+		Completer: func(args []string) []string {
+			switch len(args) {
+			case 0:
+				return []string{"peer", "channel"}
+			case 1:
+				if args[0] == "channel" {
+					return openChannelsList
+				}
+				return knownAliasesList
+			}
+			return nil
+		},
+		Func: scheduleCreateFn,
+	}
+	scheduleListCmdUsage = "Usage: schedule list"
+	scheduleListCmd      = &ishell.Cmd{
+		Name: "list",
+		Help: "List all scheduled payments in the session along with their recent runs." + scheduleListCmdUsage,
+		Func: scheduleListFn,
+	}
+	scheduleCancelCmdUsage = "Usage: schedule cancel [scheduled payment id]"
+	scheduleCancelCmd      = &ishell.Cmd{
+		Name: "cancel",
+		Help: "Cancel a scheduled payment, so that no further payments are made." + scheduleCancelCmdUsage,
+		Func: scheduleCancelFn,
+	}
+)
+
+func init() {
+	scheduleCmd.AddCmd(scheduleCreateCmd)
+	scheduleCmd.AddCmd(scheduleListCmd)
+	scheduleCmd.AddCmd(scheduleCancelCmd)
+}
+
+func scheduleFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	c.Println(c.Cmd.HelpText())
+}
+
+func scheduleCreateFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: schedule create [peer|channel] [alias] [amount] [end after secs] [schedule]
+	countReqArgs := 5
+	if len(c.Args) < countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+	params := pb.ScheduledPaymentParams{
+		Currency: currency.ETHSymbol,
+		Amount:   c.Args[2],
+		Schedule: strings.Join(c.Args[4:], " "),
+	}
+	switch c.Args[0] {
+	case "peer":
+		params.PeerAlias = c.Args[1]
+	case "channel":
+		chInfo, ok := openChannelsMap[c.Args[1]]
+		if !ok {
+			printUnknownChannelAliasError(c, c.Args[1])
+			return
+		}
+		params.ChID = chInfo.id
+	default:
+		c.Printf("%s\n\n", redf("Payee should be either peer or channel, got %s.", c.Args[0]))
+		return
+	}
+	endAfterSecs, err := strconv.ParseUint(c.Args[3], 10, 64)
+	if err != nil {
+		c.Printf("%s\n\n", redf("End after should be a non-negative integer: %v.", err))
+		return
+	}
+	if endAfterSecs != 0 {
+		params.EndTime = time.Now().Add(time.Duration(endAfterSecs) * time.Second).Unix()
+	}
+
+	req := pb.CreateScheduledPaymentReq{
+		SessionID: sessionID,
+		Params:    &params,
+	}
+	resp, err := client.CreateScheduledPayment(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.CreateScheduledPaymentResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error creating scheduled payment: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.CreateScheduledPaymentResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("Scheduled payment created.\n%s",
+		prettifyScheduledPayment(msg.MsgSuccess.ScheduledPayment)))
+}
+
+func scheduleListFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: schedule list
+	countReqArgs := 0
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.GetScheduledPaymentsReq{
+		SessionID: sessionID,
+	}
+	resp, err := client.GetScheduledPayments(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.GetScheduledPaymentsResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error listing scheduled payments: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.GetScheduledPaymentsResp_MsgSuccess_)
+	if len(msg.MsgSuccess.ScheduledPayments) == 0 {
+		c.Printf("%s\n\n", greenf("No scheduled payments in this session."))
+		return
+	}
+	for _, scheduledPayment := range msg.MsgSuccess.ScheduledPayments {
+		c.Printf("%s\n\n", greenf("%s", prettifyScheduledPayment(scheduledPayment)))
+	}
+}
+
+func scheduleCancelFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: schedule cancel [scheduled payment id]
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.CancelScheduledPaymentReq{
+		SessionID:          sessionID,
+		ScheduledPaymentID: c.Args[0],
+	}
+	resp, err := client.CancelScheduledPayment(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.CancelScheduledPaymentResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error cancelling scheduled payment: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.CancelScheduledPaymentResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("Scheduled payment cancelled.\n%s",
+		prettifyScheduledPayment(msg.MsgSuccess.ScheduledPayment)))
+}
+
+func prettifyScheduledPayment(scheduledPayment *pb.ScheduledPayment) string {
+	params := scheduledPayment.Params
+	payee := "peer " + params.PeerAlias
+	if params.ChID != "" {
+		payee = "channel " + openChannelsRevMap[params.ChID]
+	}
+	nextRun, endTime := "none", "never"
+	if scheduledPayment.NextRun != 0 {
+		nextRun = time.Unix(scheduledPayment.NextRun, 0).String()
+	}
+	if params.EndTime != 0 {
+		endTime = time.Unix(params.EndTime, 0).String()
+	}
+	s := fmt.Sprintf("ID: %s, Status: %s, Pay: %s %s to %s, Schedule: %q, Next run: %s, Ends: %s",
+		scheduledPayment.ScheduledPaymentID, scheduledPayment.Status, params.Amount, params.Currency, payee,
+		params.Schedule, nextRun, endTime)
+	for _, run := range scheduledPayment.Runs {
+		s += fmt.Sprintf("\n\tRun due at %s: %s", time.Unix(run.DueAt, 0), run.Status)
+		switch {
+		case run.Error != "":
+			s += fmt.Sprintf(" (%s)", run.Error)
+		case run.ChID != "":
+			s += fmt.Sprintf(" on channel %s (version %s)", openChannelsRevMap[run.ChID], run.Version)
+		}
+	}
+	return s
+}
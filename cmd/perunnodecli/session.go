@@ -166,4 +166,6 @@ func resetLocalCache() {
 	openChannelsList = []string{}
 
 	knownAliasesList = []string{}
+
+	streamsSubscribed = false
 }
@@ -0,0 +1,344 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/abiosoft/ishell"
+
+	"github.com/hyperledger-labs/perun-node/api/grpc/pb"
+	"github.com/hyperledger-labs/perun-node/currency"
+)
+
+var (
+	streamCmdUsage = "Usage: stream [sub-command]"
+	streamCmd      = &ishell.Cmd{
+		Name: "stream",
+		Help: "Use this command to stream payments to the peer." + streamCmdUsage,
+		Func: streamFn,
+	}
+	streamStartCmdUsage = "Usage: stream start [channel alias] [amount] [interval in ms] [cap] [duration in secs]"
+	streamStartCmd      = &ishell.Cmd{
+		Name: "start",
+		Help: "Start paying the amount to the peer in each interval, until the cap is reached or the duration " +
+			"ends. Duration of 0 means no end time." + streamStartCmdUsage,
+		Completer: func([]string) []string {
+			return openChannelsList
+		},
+		Func: streamStartFn,
+	}
+	streamPauseCmdUsage = "Usage: stream pause [stream id]"
+	streamPauseCmd      = &ishell.Cmd{
+		Name: "pause",
+		Help: "Pause a running payment stream." + streamPauseCmdUsage,
+		Func: streamPauseFn,
+	}
+	streamResumeCmdUsage = "Usage: stream resume [stream id]"
+	streamResumeCmd      = &ishell.Cmd{
+		Name: "resume",
+		Help: "Resume a paused payment stream." + streamResumeCmdUsage,
+		Func: streamResumeFn,
+	}
+	streamStopCmdUsage = "Usage: stream stop [stream id]"
+	streamStopCmd      = &ishell.Cmd{
+		Name: "stop",
+		Help: "Stop a running or paused payment stream." + streamStopCmdUsage,
+		Func: streamStopFn,
+	}
+	streamListCmdUsage = "Usage: stream list"
+	streamListCmd      = &ishell.Cmd{
+		Name: "list",
+		Help: "Print the status of all payment streams in the session." + streamListCmdUsage,
+		Func: streamListFn,
+	}
+
+	// Notifications on the payment streams are subscribed to, when the first stream is started.
+	streamsSubscribed bool
+)
+
+func init() {
+	streamCmd.AddCmd(streamStartCmd)
+	streamCmd.AddCmd(streamPauseCmd)
+	streamCmd.AddCmd(streamResumeCmd)
+	streamCmd.AddCmd(streamStopCmd)
+	streamCmd.AddCmd(streamListCmd)
+}
+
+func streamFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+	c.Println(c.Cmd.HelpText())
+}
+
+func streamStartFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: stream start [channel alias] [amount] [interval in ms] [cap] [duration in secs]
+	countReqArgs := 5
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+	chInfo, ok := openChannelsMap[c.Args[0]]
+	if !ok {
+		printUnknownChannelAliasError(c, c.Args[0])
+		return
+	}
+	intervalMillis, err := strconv.ParseUint(c.Args[2], 10, 64)
+	if err != nil {
+		c.Printf("%s\n\n", redf("Interval should be a non-negative integer: %v.", err))
+		return
+	}
+	durationSecs, err := strconv.ParseInt(c.Args[4], 10, 64)
+	if err != nil || durationSecs < 0 {
+		c.Printf("%s\n\n", redf("Duration should be a non-negative integer."))
+		return
+	}
+	var endTime int64
+	if durationSecs != 0 {
+		nodeTime, err := getNodeTime()
+		if err != nil {
+			printCommandSendingError(c, err)
+			return
+		}
+		endTime = nodeTime + durationSecs
+	}
+
+	if !streamsSubscribed {
+		if err = streamSub(); err != nil {
+			printCommandSendingError(c, err)
+			return
+		}
+	}
+	req := pb.StartPayStreamReq{
+		SessionID:      sessionID,
+		ChID:           chInfo.id,
+		Currency:       currency.ETHSymbol,
+		Amount:         c.Args[1],
+		IntervalMillis: intervalMillis,
+		Cap:            c.Args[3],
+		EndTime:        endTime,
+	}
+	resp, err := client.StartPayStream(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.StartPayStreamResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error starting payment stream: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.StartPayStreamResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("Payment stream started.\n%s", prettifyPayStreamStatus(msg.MsgSuccess.Status)))
+}
+
+func streamSub() error {
+	req := pb.SubPayStreamsReq{
+		SessionID: sessionID,
+	}
+	sub, err := client.SubPayStreams(context.Background(), &req)
+	if err != nil {
+		return err
+	}
+	streamsSubscribed = true
+	go streamNotifHandler(sub)
+	return nil
+}
+
+func streamNotifHandler(sub pb.Payment_API_SubPayStreamsClient) {
+	for {
+		notifMsg, err := sub.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				sh.Printf("%s\n\n", redf("Subscription to payment stream notifications closed."))
+			} else {
+				sh.Printf("%s\n\n", redf("Error receiving payment stream notification: %v", err))
+			}
+			streamsSubscribed = false
+			return
+		}
+		msgErr, ok := notifMsg.Response.(*pb.SubPayStreamsResp_Error)
+		if ok {
+			sh.Printf("%s\n\n", redf("Error message received in stream notification : %v",
+				apiErrorString(msgErr.Error)))
+			return
+		}
+		notif := notifMsg.Response.(*pb.SubPayStreamsResp_Notify_)
+		sh.Printf("%s\n\n", greenf("Payment stream notification received.\n%s",
+			prettifyPayStreamStatus(notif.Notify.Status)))
+	}
+}
+
+func streamPauseFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: stream pause [stream id]
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.PausePayStreamReq{
+		SessionID: sessionID,
+		StreamID:  c.Args[0],
+	}
+	resp, err := client.PausePayStream(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.PausePayStreamResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error pausing payment stream: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.PausePayStreamResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("Payment stream paused.\n%s", prettifyPayStreamStatus(msg.MsgSuccess.Status)))
+}
+
+func streamResumeFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: stream resume [stream id]
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.ResumePayStreamReq{
+		SessionID: sessionID,
+		StreamID:  c.Args[0],
+	}
+	resp, err := client.ResumePayStream(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.ResumePayStreamResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error resuming payment stream: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.ResumePayStreamResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("Payment stream resumed.\n%s", prettifyPayStreamStatus(msg.MsgSuccess.Status)))
+}
+
+func streamStopFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: stream stop [stream id]
+	countReqArgs := 1
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.StopPayStreamReq{
+		SessionID: sessionID,
+		StreamID:  c.Args[0],
+	}
+	resp, err := client.StopPayStream(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.StopPayStreamResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error stopping payment stream: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.StopPayStreamResp_MsgSuccess_)
+	c.Printf("%s\n\n", greenf("Payment stream stopped.\n%s", prettifyPayStreamStatus(msg.MsgSuccess.Status)))
+}
+
+func streamListFn(c *ishell.Context) {
+	if client == nil {
+		printNodeNotConnectedError(c)
+		return
+	}
+
+	// Usage: stream list
+	countReqArgs := 0
+	if len(c.Args) != countReqArgs {
+		printArgCountError(c, countReqArgs)
+		return
+	}
+
+	req := pb.GetPayStreamsReq{
+		SessionID: sessionID,
+	}
+	resp, err := client.GetPayStreams(context.Background(), &req)
+	if err != nil {
+		printCommandSendingError(c, err)
+		return
+	}
+	msgErr, ok := resp.Response.(*pb.GetPayStreamsResp_Error)
+	if ok {
+		c.Printf("%s\n\n", redf("Error listing payment streams: %v.", apiErrorString(msgErr.Error)))
+		return
+	}
+
+	msg := resp.Response.(*pb.GetPayStreamsResp_MsgSuccess_)
+	if len(msg.MsgSuccess.Statuses) == 0 {
+		c.Printf("%s\n\n", greenf("No payment streams started in this session."))
+		return
+	}
+	for _, status := range msg.MsgSuccess.Statuses {
+		c.Printf("%s\n\n", greenf("%s", prettifyPayStreamStatus(status)))
+	}
+}
+
+func prettifyPayStreamStatus(status *pb.PayStreamStatus) string {
+	chAlias := openChannelsRevMap[status.ChID]
+	s := fmt.Sprintf("ID: %s, Channel: %s, State: %s, Sent: %s of %s %s in %d payments, Rate: %s %s per %s",
+		status.StreamID, chAlias, status.State, status.AmountSent, status.Cap, status.Currency,
+		status.PaymentsSent, status.Amount, status.Currency, time.Duration(status.IntervalMillis)*time.Millisecond)
+	if status.EndTime != 0 {
+		s += fmt.Sprintf(", Ends at: %s", time.Unix(status.EndTime, 0))
+	}
+	if status.Error != nil {
+		s += fmt.Sprintf("\nError: %s", apiErrorString(status.Error))
+	}
+	return s
+}
@@ -48,6 +48,8 @@ type (
 		sNo        int
 		row        *text.Text
 		proposerFn proposerFn
+
+		loggedFiat string // Last logged value of the balance in fiat, to log only the changes.
 	}
 
 	responseStatus int
@@ -56,6 +58,7 @@ type (
 		ours    string
 		theirs  string
 		version string
+		fiat    string // Value of own balance in fiat, along with the rate. Empty, if not available.
 	}
 
 	proposerFn func() (bool, string, balInfo, error)
@@ -415,6 +418,12 @@ func (p *channel) refreshEntry() {
 	if err != nil {
 		logErrorf("writing proposal #%d to table", p.sNo)
 	}
+
+	// Values in fiat do not fit in the table, hence they are logged.
+	if p.current.fiat != "" && p.current.fiat != p.loggedFiat {
+		logInfof("Channel #%d: Value of own balance is %s.", p.sNo, p.current.fiat)
+		p.loggedFiat = p.current.fiat
+	}
 }
 
 func trimZeros(s *string) {
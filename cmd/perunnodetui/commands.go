@@ -17,8 +17,10 @@
 package main
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -186,9 +188,22 @@ func findPeerIndex(parts []string) int {
 func grpcPayChInfotoBalInfo(grpcPayChInfo *pb.PayChInfo) balInfo {
 	b := grpcBalInfotoBalInfo(grpcPayChInfo.BalInfo)
 	b.version = grpcPayChInfo.Version
+	b.fiat = ownFiatValue(grpcPayChInfo.FiatBalInfo, grpcPayChInfo.BalInfo)
 	return b
 }
 
+// ownFiatValue returns the value of own balance in fiat, along with the rate and the time at which it was last
+// updated. It returns an empty string, if the value is not available.
+func ownFiatValue(fiatBalInfo *pb.FiatBalInfo, grpcBalInfo *pb.BalInfo) string {
+	if fiatBalInfo == nil || len(fiatBalInfo.Rates) == 0 || fiatBalInfo.Rates[0] == "" {
+		return ""
+	}
+	ourIdx := findPeerIndex(grpcBalInfo.Parts) ^ 1
+	return fmt.Sprintf("%s %s (rate %s %s/%s as of %s)", fiatBalInfo.Bals[0].Bal[ourIdx], fiatBalInfo.Fiat,
+		fiatBalInfo.Rates[0], fiatBalInfo.Fiat, grpcBalInfo.Currencies[0],
+		time.Unix(fiatBalInfo.Timestamps[0], 0).Format("15:04:05"))
+}
+
 func grpcBalInfotoBalInfo(grpcPayChInfo *pb.BalInfo) balInfo {
 	peerIdx := findPeerIndex(grpcPayChInfo.Parts)
 	ourIdx := peerIdx ^ 1
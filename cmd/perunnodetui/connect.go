@@ -198,6 +198,12 @@ func (s *connectScreen) getConnectFn(c *container.Container, quitter chan bool)
 		}
 		logInfo("Subscribed to incoming proposals")
 
+		err = subPeerStatus()
+		if err != nil {
+			return errors.WithMessage(err, "subscribing to peer status")
+		}
+		logInfo("Subscribed to peer status")
+
 		// Render dashboard screen.
 		d, err := newDashboardScreen()
 		if err != nil {
@@ -140,6 +140,55 @@ func incomingChannelsHandler(sub pb.Payment_API_SubPayChProposalsClient) {
 	}
 }
 
+func subPeerStatus() error {
+	if client == nil {
+		return errors.WithStack(errNotConnectedToNode)
+	}
+	req := pb.SubPeerStatusReq{
+		SessionID: sessionID,
+	}
+	sub, err := client.SubPeerStatus(context.Background(), &req)
+	if err != nil {
+		return errors.Wrap(err, "subscribing to peer status")
+	}
+	go peerStatusHandler(sub)
+	return nil
+}
+
+func peerStatusHandler(sub pb.Payment_API_SubPeerStatusClient) {
+	for {
+		notifMsg, err := sub.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				logInfo("Subscription to peer status closed")
+			} else {
+				logErrorf("Subscription to peer status closed with error %v", err)
+			}
+			return
+		}
+
+		msgErr, ok := notifMsg.Response.(*pb.SubPeerStatusResp_Error)
+		if ok {
+			logErrorf("In peer status notification %v", printAPIError(msgErr.Error))
+			return
+		}
+
+		peerStatus := notifMsg.Response.(*pb.SubPeerStatusResp_Notify_).Notify.PeerStatus
+		peer := peerStatus.Alias
+		if peer == "" {
+			peer = peerStatus.OffChainAddress
+		}
+		switch {
+		case peerStatus.Connected:
+			logInfof("Peer %s is online", peer)
+		case peerStatus.LastError != "":
+			logErrorf("Peer %s is offline: %s", peer, peerStatus.LastError)
+		default:
+			logInfof("Peer %s is offline", peer)
+		}
+	}
+}
+
 func respondToProposal(proposalID string, accept bool) (string, balInfo, error) {
 	if client == nil {
 		return "", balInfo{}, errors.WithStack(errNotConnectedToNode)
@@ -0,0 +1,108 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command perunrelay runs a relay server, that forwards the off-chain connections between perun nodes which
+// do not have a reachable comm address. Nodes using the relay should use the comm type "relay" and the address
+// of the relay as their comm address.
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/comm/relay"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+const (
+	// flag names.
+	addressF          = "address"
+	handshakeTimeoutF = "handshaketimeout"
+	acceptTimeoutF    = "accepttimeout"
+	loglevelF         = "loglevel"
+	logfileF          = "logfile"
+
+	// default values for flags.
+	defaultAddress          = "127.0.0.1:5750"
+	defaultHandshakeTimeout = 10 * time.Second
+	defaultAcceptTimeout    = 10 * time.Second
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "perunrelay",
+	Short: "Relay server for off-chain connections between perun nodes.",
+	Long: `
+Relay server for off-chain connections between perun nodes. Nodes that cannot
+be reached directly (for example, behind NAT) stay connected to the relay,
+authenticated with their off-chain key, and the relay forwards the connections
+from other nodes to them.
+
+The relay forwards the connections as such. When the nodes use end-to-end
+encryption, the relay does not see the plaintext messages.`,
+	RunE: run,
+}
+
+func init() {
+	rootCmd.Flags().String(addressF, defaultAddress, "address to listen for connections from the nodes")
+	rootCmd.Flags().Duration(handshakeTimeoutF, defaultHandshakeTimeout,
+		"time allowed for the nodes to complete the handshake on each connection")
+	rootCmd.Flags().Duration(acceptTimeoutF, defaultAcceptTimeout,
+		"time allowed for a node to accept an incoming connection")
+	rootCmd.Flags().String(loglevelF, "info", "Log level. Supported levels: debug, info, error")
+	rootCmd.Flags().String(logfileF, "", "Log file path. Use empty string for stdout")
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func run(cmd *cobra.Command, _ []string) error {
+	address, _ := cmd.Flags().GetString(addressF)                     // nolint: errcheck  // flag is defined.
+	handshakeTimeout, _ := cmd.Flags().GetDuration(handshakeTimeoutF) // nolint: errcheck  // flag is defined.
+	acceptTimeout, _ := cmd.Flags().GetDuration(acceptTimeoutF)       // nolint: errcheck  // flag is defined.
+	loglevel, _ := cmd.Flags().GetString(loglevelF)                   // nolint: errcheck  // flag is defined.
+	logfile, _ := cmd.Flags().GetString(logfileF)                     // nolint: errcheck  // flag is defined.
+
+	if err := log.InitLogger(loglevel, logfile); err != nil {
+		return errors.WithMessage(err, "initializing logger")
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrap(err, "listening for connections")
+	}
+
+	server := relay.NewServer(ethereum.NewWalletBackend(), handshakeTimeout, acceptTimeout)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-signals
+		server.Close() // nolint: errcheck, gosec  // program is exiting.
+	}()
+
+	fmt.Printf("Relaying connections at %s\n", address)
+	return server.Serve(listener)
+}
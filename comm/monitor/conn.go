@@ -0,0 +1,188 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+)
+
+type (
+	// dialer wraps a dialer and reports the connections established by it and the dial errors to the monitor.
+	dialer struct {
+		pnet.Dialer
+		m *Monitor
+	}
+
+	// listener wraps a listener and reports the connections accepted by it to the monitor.
+	listener struct {
+		pnet.Listener
+		m *Monitor
+	}
+
+	// conn wraps a connection and reports the messages received and the errors on it to the monitor.
+	// It also answers and consumes the ping messages.
+	//
+	// The connection is reported as open to the monitor, when the first message is received on it. This way,
+	// the peer is considered connected only after it has responded and the address of the peer is also known
+	// for the accepted connections.
+	conn struct {
+		pnet.Conn
+		m *Monitor
+
+		sendMutex sync.Mutex // Serializes the messages sent by the bus and the ping messages.
+		closeOnce sync.Once
+
+		mutex      sync.Mutex // Protects the fields below.
+		peer       pwire.Address
+		opened     bool
+		pingSentAt time.Time // Time at which the pending ping was sent. Zero, if there is no pending ping.
+	}
+)
+
+// Dial dials the peer using the wrapped dialer and returns a monitored connection.
+func (d *dialer) Dial(ctx context.Context, addr pwire.Address) (pnet.Conn, error) {
+	c, err := d.Dialer.Dial(ctx, addr)
+	if err != nil {
+		d.m.dialFailed(addr, err)
+		return nil, err
+	}
+	return &conn{Conn: c, m: d.m, peer: addr}, nil
+}
+
+// Accept accepts a connection using the wrapped listener and returns a monitored connection.
+func (l *listener) Accept() (pnet.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &conn{Conn: c, m: l.m}, nil
+}
+
+// Send sends the envelope on the connection.
+func (c *conn) Send(e *pwire.Envelope) error {
+	c.sendMutex.Lock()
+	defer c.sendMutex.Unlock()
+
+	if err := c.Conn.Send(e); err != nil {
+		c.closed(err)
+		return err
+	}
+	return nil
+}
+
+// Recv receives the next envelope on the connection. Ping messages are answered and, along with the
+// pong messages, are not returned to the caller.
+func (c *conn) Recv() (*pwire.Envelope, error) {
+	for {
+		e, err := c.Conn.Recv()
+		if err != nil {
+			if errors.Cause(err) == io.EOF {
+				err = nil
+			}
+			c.closed(err)
+			return nil, err
+		}
+		c.received(e.Sender)
+
+		switch e.Msg.(type) {
+		case *pwire.PingMsg:
+			pong := &pwire.Envelope{Sender: e.Recipient, Recipient: e.Sender, Msg: pwire.NewPongMsg()}
+			if err := c.Send(pong); err != nil {
+				return nil, errors.WithMessage(err, "answering ping")
+			}
+		case *pwire.PongMsg:
+			c.pongReceived()
+		default:
+			return e, nil
+		}
+	}
+}
+
+// Close closes the connection.
+func (c *conn) Close() error {
+	c.closed(nil)
+	return c.Conn.Close()
+}
+
+// received reports the message received from the peer to the monitor. For accepted connections, the
+// sender of the first message is taken as the peer.
+func (c *conn) received(sender pwire.Address) {
+	c.mutex.Lock()
+	if c.peer == nil {
+		c.peer = sender
+	}
+	peer, opened := c.peer, c.opened
+	c.opened = true
+	c.mutex.Unlock()
+
+	if !opened {
+		c.m.connOpened(c, peer)
+	}
+	c.m.msgReceived(peer)
+}
+
+// closed reports to the monitor that the connection was closed because of the error, if not nil. Only the
+// first call is reported, as the subsequent errors are caused by the connection being closed.
+func (c *conn) closed(err error) {
+	c.closeOnce.Do(func() {
+		if peer := c.peerAddr(); peer != nil {
+			c.m.connClosed(c, peer, err)
+		}
+	})
+}
+
+// ping sends a ping message to the peer.
+func (c *conn) ping(ownAddr pwire.Address) {
+	c.mutex.Lock()
+	peer := c.peer
+	c.pingSentAt = time.Now()
+	c.mutex.Unlock()
+
+	c.Send(&pwire.Envelope{Sender: ownAddr, Recipient: peer, Msg: pwire.NewPingMsg()}) // nolint: errcheck, gosec
+}
+
+// pongReceived reports the round trip time of the pending ping to the monitor.
+func (c *conn) pongReceived() {
+	c.mutex.Lock()
+	peer, sentAt := c.peer, c.pingSentAt
+	c.pingSentAt = time.Time{}
+	c.mutex.Unlock()
+
+	if !sentAt.IsZero() {
+		c.m.rttMeasured(peer, time.Since(sentAt))
+	}
+}
+
+// pingPending returns true, if a ping was sent and not answered.
+func (c *conn) pingPending() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return !c.pingSentAt.IsZero()
+}
+
+func (c *conn) peerAddr() pwire.Address {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.peer
+}
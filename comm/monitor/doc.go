@@ -0,0 +1,28 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package monitor implements a connectivity monitor for the off-chain communication, that tracks the state of
+// the connections to each of the peers.
+//
+// The dialer and the listener used by the wire bus are wrapped, so that all the connections established by the
+// bus pass through the monitor. A peer is considered connected as long as there is at least one open connection
+// to it. The time at which a message was last received, the last error that occurred when dialing the peer or on
+// one of its connections and the round trip time measured with ping messages are also tracked.
+//
+// Optionally, ping messages can be sent to the connected peers periodically. These are answered and consumed
+// by the monitor of the peer and are not passed to the bus. Peers that do not run a monitor do not answer the
+// pings and the round trip time is not known for them.
+package monitor
@@ -0,0 +1,278 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pkgsync "perun.network/go-perun/pkg/sync"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+// notifsBufferSize is the number of status changes that are buffered for sending to the notifier.
+// Status changes are dropped, when the buffer is full.
+const notifsBufferSize = 64
+
+// ErrPingTimedOut is recorded as the last error of a peer, when a ping sent to it was not answered
+// before the next ping is due.
+var ErrPingTimedOut = errors.New("ping timed out")
+
+type (
+	// Monitor tracks the connectivity status of the peers over the connections established using the wrapped
+	// dialer and listener.
+	Monitor struct {
+		log.Logger
+
+		ownAddr      pwire.Address
+		pingInterval time.Duration
+
+		mutex    sync.Mutex
+		peers    map[pwallet.AddrKey]*peerState
+		notifier Notifier
+
+		notifs chan Status
+		pkgsync.Closer
+	}
+
+	// Status represents the connectivity status of a peer.
+	Status struct {
+		Peer      pwire.Address
+		Connected bool          // True, if there is at least one open connection to the peer.
+		LastSeen  time.Time     // Time at which a message was last received from the peer. Zero, if never.
+		LastError error         // Last error when dialing or communicating with the peer. Nil, if none.
+		RTT       time.Duration // Round trip time measured by the last answered ping. Zero, if not known.
+	}
+
+	// Notifier is the function that is called when a peer is connected or disconnected, or dialing it fails.
+	Notifier func(Status)
+
+	// peerState represents the status of a peer along with its open connections.
+	peerState struct {
+		status Status
+		conns  map[*conn]struct{}
+	}
+)
+
+// NewMonitor returns a monitor for the connections of the node with the given off-chain address.
+//
+// If ping interval is not zero, each connected peer is pinged at this interval for measuring the round trip
+// time and for detecting broken connections.
+func NewMonitor(ownAddr pwire.Address, pingInterval time.Duration) *Monitor {
+	m := &Monitor{
+		Logger:       log.NewLoggerWithField("component", "conn-monitor"),
+		ownAddr:      ownAddr,
+		pingInterval: pingInterval,
+		peers:        make(map[pwallet.AddrKey]*peerState),
+		notifs:       make(chan Status, notifsBufferSize),
+	}
+	go m.dispatchNotifs()
+	if pingInterval != 0 {
+		go m.pingLoop()
+	}
+	return m
+}
+
+// Dialer wraps the dialer, so that the connections established by it are monitored.
+func (m *Monitor) Dialer(d pnet.Dialer) pnet.Dialer {
+	return &dialer{Dialer: d, m: m}
+}
+
+// Listener wraps the listener, so that the connections accepted by it are monitored.
+func (m *Monitor) Listener(l pnet.Listener) pnet.Listener {
+	return &listener{Listener: l, m: m}
+}
+
+// Status returns the connectivity status of the peer. If the peer was never dialed and never connected,
+// a status with only the peer address set is returned.
+func (m *Monitor) Status(peer pwire.Address) Status {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if p, ok := m.peers[pwallet.Key(peer)]; ok {
+		return p.status
+	}
+	return Status{Peer: peer}
+}
+
+// Statuses returns the connectivity status of all the peers that were dialed or connected.
+func (m *Monitor) Statuses() []Status {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	statuses := make([]Status, 0, len(m.peers))
+	for _, p := range m.peers {
+		statuses = append(statuses, p.status)
+	}
+	return statuses
+}
+
+// SetNotifier sets the function that is called on each change in the connectivity of a peer. Setting
+// it to nil stops the notifications.
+//
+// The notifier is called from a single go-routine in the order of the changes. Changes are dropped,
+// if the notifier is slower than the changes occur for an extended period.
+func (m *Monitor) SetNotifier(notifier Notifier) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.notifier = notifier
+}
+
+// peer returns the state of the peer, adding it if does not exist. It should be called with the mutex held.
+func (m *Monitor) peer(addr pwire.Address) *peerState {
+	key := pwallet.Key(addr)
+	p, ok := m.peers[key]
+	if !ok {
+		p = &peerState{status: Status{Peer: addr}, conns: make(map[*conn]struct{})}
+		m.peers[key] = p
+	}
+	return p
+}
+
+// dialFailed records the error as the last error of the peer.
+func (m *Monitor) dialFailed(addr pwire.Address, err error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	p := m.peer(addr)
+	p.status.LastError = err
+	m.notifyLocked(p.status)
+}
+
+// connOpened adds the connection to the open connections of the peer.
+func (m *Monitor) connOpened(c *conn, addr pwire.Address) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	p := m.peer(addr)
+	p.conns[c] = struct{}{}
+	if !p.status.Connected {
+		p.status.Connected = true
+		m.notifyLocked(p.status)
+	}
+}
+
+// connClosed removes the connection from the open connections of the peer. The error, if not nil, is
+// recorded as the last error of the peer.
+func (m *Monitor) connClosed(c *conn, addr pwire.Address, err error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	p := m.peer(addr)
+	if _, ok := p.conns[c]; !ok {
+		return
+	}
+	delete(p.conns, c)
+	if err != nil {
+		p.status.LastError = err
+	}
+	if len(p.conns) == 0 {
+		p.status.Connected = false
+		m.notifyLocked(p.status)
+	}
+}
+
+// msgReceived records the time at which a message was received from the peer.
+func (m *Monitor) msgReceived(addr pwire.Address) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.peer(addr).status.LastSeen = time.Now()
+}
+
+// rttMeasured records the round trip time for the peer.
+func (m *Monitor) rttMeasured(addr pwire.Address, rtt time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.peer(addr).status.RTT = rtt
+}
+
+// pingTimedOut records ErrPingTimedOut as the last error of the peer.
+func (m *Monitor) pingTimedOut(addr pwire.Address) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.peer(addr).status.LastError = ErrPingTimedOut
+}
+
+// notifyLocked queues the status for sending to the notifier, if one is set. It should be called with
+// the mutex held.
+func (m *Monitor) notifyLocked(status Status) {
+	if m.notifier == nil || m.IsClosed() {
+		return
+	}
+	select {
+	case m.notifs <- status:
+	default:
+		m.WithField("peer", status.Peer).Warn("Dropping connectivity notification: buffer full")
+	}
+}
+
+// dispatchNotifs sends the queued status changes to the notifier, until the monitor is closed.
+func (m *Monitor) dispatchNotifs() {
+	for {
+		select {
+		case <-m.Closed():
+			return
+		case status := <-m.notifs:
+			m.mutex.Lock()
+			notifier := m.notifier
+			m.mutex.Unlock()
+			if notifier != nil {
+				notifier(status)
+			}
+		}
+	}
+}
+
+// pingLoop pings each of the connected peers at the ping interval, until the monitor is closed.
+func (m *Monitor) pingLoop() {
+	ticker := time.NewTicker(m.pingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.Closed():
+			return
+		case <-ticker.C:
+			m.pingAll()
+		}
+	}
+}
+
+// pingAll sends a ping on one connection of each connected peer. If the ping sent on the connection
+// in the previous round was not answered, ping timeout is recorded for the peer.
+func (m *Monitor) pingAll() {
+	m.mutex.Lock()
+	conns := make([]*conn, 0, len(m.peers))
+	for _, p := range m.peers {
+		for c := range p.conns {
+			conns = append(conns, c)
+			break
+		}
+	}
+	m.mutex.Unlock()
+
+	for _, c := range conns {
+		if c.pingPending() {
+			m.pingTimedOut(c.peerAddr())
+		}
+		go c.ping(m.ownAddr)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/comm/monitor"
+	"github.com/hyperledger-labs/perun-node/comm/tcp"
+	"github.com/hyperledger-labs/perun-node/comm/tcp/tcptest"
+)
+
+const pingInterval = 10 * time.Millisecond
+
+func Test_Monitor_DialError(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	ownAddr, peerAddr := ethereumtest.NewRandomAddress(rng), ethereumtest.NewRandomAddress(rng)
+	m := monitor.NewMonitor(ownAddr, 0)
+	t.Cleanup(func() { m.Close() }) // nolint: errcheck
+	notifs := subscribe(m)
+
+	assert.Equal(t, monitor.Status{Peer: peerAddr}, m.Status(peerAddr))
+	assert.Empty(t, m.Statuses())
+
+	dialer := m.Dialer(tcp.NewTCPBackend(tcptest.DialerTimeout).NewDialer())
+	_, err := dialer.Dial(context.Background(), peerAddr)
+	require.Error(t, err)
+
+	status := m.Status(peerAddr)
+	assert.False(t, status.Connected)
+	assert.Equal(t, err, status.LastError)
+	assert.Len(t, m.Statuses(), 1)
+	notif := nextNotif(t, notifs)
+	assert.True(t, notif.Peer.Equals(peerAddr))
+	assert.Equal(t, err, notif.LastError)
+}
+
+func Test_Monitor_Connectivity(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	dialerAddr, listenerAddr := ethereumtest.NewRandomAddress(rng), ethereumtest.NewRandomAddress(rng)
+	dialerMonitor := monitor.NewMonitor(dialerAddr, pingInterval)
+	t.Cleanup(func() { dialerMonitor.Close() }) // nolint: errcheck
+	listenerMonitor := monitor.NewMonitor(listenerAddr, 0)
+	t.Cleanup(func() { listenerMonitor.Close() }) // nolint: errcheck
+	notifs := subscribe(listenerMonitor)
+
+	dialerConn, listenerConn := connect(t, dialerMonitor, listenerMonitor, listenerAddr)
+
+	t.Run("connected_on_first_message", func(t *testing.T) {
+		assert.False(t, dialerMonitor.Status(listenerAddr).Connected)
+
+		env := &pwire.Envelope{Sender: dialerAddr, Recipient: listenerAddr, Msg: pwire.NewAuthResponseMsg(nil)}
+		require.NoError(t, dialerConn.Send(env))
+		_, err := listenerConn.Recv()
+		require.NoError(t, err)
+
+		status := listenerMonitor.Status(dialerAddr)
+		assert.True(t, status.Connected)
+		assert.False(t, status.LastSeen.IsZero())
+		assert.NoError(t, status.LastError)
+		notif := nextNotif(t, notifs)
+		assert.True(t, notif.Peer.Equals(dialerAddr))
+		assert.True(t, notif.Connected)
+	})
+
+	t.Run("ping", func(t *testing.T) {
+		env := &pwire.Envelope{Sender: listenerAddr, Recipient: dialerAddr, Msg: pwire.NewAuthResponseMsg(nil)}
+		require.NoError(t, listenerConn.Send(env))
+		received := make(chan *pwire.Envelope, 1)
+		go recvAll(dialerConn, received)
+		go recvAll(listenerConn, received)
+
+		require.Eventually(t, func() bool {
+			return dialerMonitor.Status(listenerAddr).RTT != 0
+		}, time.Second, pingInterval)
+		assert.True(t, dialerMonitor.Status(listenerAddr).Connected)
+		assert.Len(t, received, 1, "ping messages should not be returned")
+	})
+
+	t.Run("disconnected_on_close", func(t *testing.T) {
+		require.NoError(t, dialerConn.Close())
+
+		require.Eventually(t, func() bool {
+			return !listenerMonitor.Status(dialerAddr).Connected
+		}, time.Second, pingInterval)
+		assert.False(t, dialerMonitor.Status(listenerAddr).Connected)
+		notif := nextNotif(t, notifs)
+		assert.True(t, notif.Peer.Equals(dialerAddr))
+		assert.False(t, notif.Connected)
+	})
+}
+
+// connect establishes a connection between the dialer and the listener, each monitored by the respective monitor.
+func connect(t *testing.T, dialerMonitor, listenerMonitor *monitor.Monitor, listenerAddr pwire.Address) (
+	dialerConn, listenerConn pnet.Conn) {
+	port, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	commAddr := fmt.Sprintf("127.0.0.1:%d", port)
+
+	backend := tcp.NewTCPBackend(tcptest.DialerTimeout)
+	l, err := backend.NewListener(commAddr)
+	require.NoError(t, err)
+	listener := listenerMonitor.Listener(l)
+	t.Cleanup(func() { listener.Close() }) // nolint: errcheck
+
+	d := backend.NewDialer()
+	d.Register(listenerAddr, commAddr)
+	dialer := dialerMonitor.Dialer(d)
+
+	accepted := make(chan pnet.Conn, 1)
+	go func() {
+		conn, err := listener.Accept()
+		assert.NoError(t, err)
+		accepted <- conn
+	}()
+	dialerConn, err = dialer.Dial(context.Background(), listenerAddr)
+	require.NoError(t, err)
+	t.Cleanup(func() { dialerConn.Close() }) // nolint: errcheck
+
+	select {
+	case listenerConn = <-accepted:
+		require.NotNil(t, listenerConn)
+		t.Cleanup(func() { listenerConn.Close() }) // nolint: errcheck
+	case <-time.After(time.Second):
+		t.Fatal("connection not accepted by the listener")
+	}
+	return dialerConn, listenerConn
+}
+
+func recvAll(conn pnet.Conn, received chan<- *pwire.Envelope) {
+	for {
+		env, err := conn.Recv()
+		if err != nil {
+			return
+		}
+		received <- env
+	}
+}
+
+func subscribe(m *monitor.Monitor) <-chan monitor.Status {
+	notifs := make(chan monitor.Status, 10)
+	m.SetNotifier(func(status monitor.Status) { notifs <- status })
+	return notifs
+}
+
+func nextNotif(t *testing.T, notifs <-chan monitor.Status) monitor.Status {
+	t.Helper()
+	select {
+	case notif := <-notifs:
+		return notif
+	case <-time.After(time.Second):
+		t.Fatal("no notification received")
+	}
+	return monitor.Status{}
+}
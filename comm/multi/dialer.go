@@ -0,0 +1,195 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	pkgsync "perun.network/go-perun/pkg/sync"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+type (
+	// Dialer dials the peers at each of their registered comm endpoints in order, until a connection is
+	// established. Each endpoint is dialed using the comm backend corresponding to its comm type.
+	//
+	// It implements perun.Dialer and perun.EndpointsRegisterer interfaces.
+	Dialer struct {
+		log.Logger
+
+		backends        map[string]perun.CommBackend // Comm backends, indexed by comm type.
+		defaultCommType string                       // Comm type used for addresses registered using Register.
+
+		mutex sync.RWMutex
+		peers map[pwallet.AddrKey]peerEndpoints
+
+		pkgsync.Closer
+	}
+
+	// peerEndpoints represents the endpoints registered for a peer and the endpoint at which the peer
+	// was last reached.
+	peerEndpoints struct {
+		endpoints   []perun.CommEndpoint
+		lastWorking *perun.CommEndpoint
+	}
+)
+
+// NewDialer returns a dialer that uses the given comm backends for dialing the endpoints of
+// corresponding comm types. Addresses registered using Register are assigned the default comm type.
+func NewDialer(backends map[string]perun.CommBackend, defaultCommType string) *Dialer {
+	return &Dialer{
+		Logger:          log.NewLoggerWithField("component", "dialer"),
+		backends:        backends,
+		defaultCommType: defaultCommType,
+		peers:           make(map[pwallet.AddrKey]peerEndpoints),
+	}
+}
+
+// Register registers the comm address as the only endpoint for the off-chain address. The default comm type
+// of the dialer is used for the endpoint.
+func (d *Dialer) Register(offChainAddr pwire.Address, commAddr string) {
+	d.RegisterEndpoints(offChainAddr, []perun.CommEndpoint{{CommAddr: commAddr, CommType: d.defaultCommType}})
+}
+
+// RegisterEndpoints registers the list of endpoints for the off-chain address, replacing any endpoints
+// registered before. If the endpoint at which the peer was last reached is in the new list, it will still be
+// tried first.
+func (d *Dialer) RegisterEndpoints(offChainAddr pwire.Address, endpoints []perun.CommEndpoint) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	key := pwallet.Key(offChainAddr)
+	entry := peerEndpoints{endpoints: append([]perun.CommEndpoint(nil), endpoints...)}
+	if lastWorking := d.peers[key].lastWorking; lastWorking != nil && containsEndpoint(endpoints, *lastWorking) {
+		entry.lastWorking = lastWorking
+	}
+	d.peers[key] = entry
+}
+
+// Dial dials the peer at each of its registered endpoints, until a connection is established. The endpoint
+// at which the peer was last reached is tried first.
+//
+// It returns an error if no endpoints are registered for the peer, or if it could not be reached at any
+// of them. Closing the dialer aborts any ongoing dial.
+func (d *Dialer) Dial(ctx context.Context, offChainAddr pwire.Address) (pnet.Conn, error) {
+	if d.IsClosed() {
+		return nil, errors.New("dialer closed")
+	}
+	endpoints, ok := d.dialOrder(offChainAddr)
+	if !ok {
+		return nil, errors.New("peer not found")
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+		select {
+		case <-d.Closed():
+		case <-done:
+		}
+	}()
+
+	dialErrs := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		conn, err := d.dialEndpoint(ctx, offChainAddr, endpoint)
+		if err == nil {
+			d.WithField("peer", offChainAddr).Infof("Dialed peer at endpoint %s (%s)",
+				endpoint.CommAddr, endpoint.CommType)
+			d.setLastWorking(offChainAddr, endpoint)
+			return conn, nil
+		}
+		d.WithField("peer", offChainAddr).Debugf("Dialing peer at endpoint %s (%s): %v",
+			endpoint.CommAddr, endpoint.CommType, err)
+		dialErrs = append(dialErrs, fmt.Sprintf("%s (%s): %v", endpoint.CommAddr, endpoint.CommType, err))
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, errors.Errorf("dialing peer at endpoints: %s", strings.Join(dialErrs, "; "))
+}
+
+// dialEndpoint dials the peer at the endpoint, using a new dialer of the comm backend for the comm type of
+// the endpoint. A new dialer is used for each attempt, so that concurrent attempts do not interfere.
+func (d *Dialer) dialEndpoint(ctx context.Context, offChainAddr pwire.Address, endpoint perun.CommEndpoint) (
+	pnet.Conn, error) {
+	backend, ok := d.backends[endpoint.CommType]
+	if !ok {
+		return nil, errors.New("unsupported comm type")
+	}
+	dialer := backend.NewDialer()
+	defer dialer.Close() // nolint: errcheck  // Only aborts the ongoing dial, which has already returned.
+
+	dialer.Register(offChainAddr, endpoint.CommAddr)
+	return dialer.Dial(ctx, offChainAddr)
+}
+
+// dialOrder returns the endpoints of the peer in the order in which they should be dialed: the endpoint at
+// which the peer was last reached followed by the other endpoints in the registered order.
+func (d *Dialer) dialOrder(offChainAddr pwire.Address) ([]perun.CommEndpoint, bool) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	entry, ok := d.peers[pwallet.Key(offChainAddr)]
+	if !ok || len(entry.endpoints) == 0 {
+		return nil, false
+	}
+	if entry.lastWorking == nil {
+		return append([]perun.CommEndpoint(nil), entry.endpoints...), true
+	}
+	endpoints := make([]perun.CommEndpoint, 0, len(entry.endpoints))
+	endpoints = append(endpoints, *entry.lastWorking)
+	for _, endpoint := range entry.endpoints {
+		if endpoint != *entry.lastWorking {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints, true
+}
+
+// setLastWorking remembers the endpoint as the one at which the peer was last reached, if the endpoint is
+// still registered for the peer.
+func (d *Dialer) setLastWorking(offChainAddr pwire.Address, endpoint perun.CommEndpoint) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	key := pwallet.Key(offChainAddr)
+	entry, ok := d.peers[key]
+	if ok && containsEndpoint(entry.endpoints, endpoint) {
+		entry.lastWorking = &endpoint
+		d.peers[key] = entry
+	}
+}
+
+func containsEndpoint(endpoints []perun.CommEndpoint, endpoint perun.CommEndpoint) bool {
+	for i := range endpoints {
+		if endpoints[i] == endpoint {
+			return true
+		}
+	}
+	return false
+}
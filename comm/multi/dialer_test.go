@@ -0,0 +1,159 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/comm/multi"
+	"github.com/hyperledger-labs/perun-node/comm/tcp"
+)
+
+func Test_Dialer_Interface(t *testing.T) {
+	assert.Implements(t, (*perun.Dialer)(nil), new(multi.Dialer))
+	assert.Implements(t, (*perun.EndpointsRegisterer)(nil), new(multi.Dialer))
+}
+
+func Test_Dialer_Dial(t *testing.T) {
+	rng := rand.New(rand.NewSource(1729))
+	tcpBackend := tcp.NewTCPBackend(time.Second)
+	backends := map[string]perun.CommBackend{"tcp": tcpBackend}
+
+	t.Run("happy_fallback_and_remember", func(t *testing.T) {
+		d := multi.NewDialer(backends, "tcp")
+		peer := ethereumtest.NewRandomAddress(rng)
+		addr1, addr2 := freeAddr(t), freeAddr(t)
+		d.RegisterEndpoints(peer, []perun.CommEndpoint{
+			{CommAddr: addr1, CommType: "tcp"},
+			{CommAddr: addr2, CommType: "unsupported"},
+			{CommAddr: addr2, CommType: "tcp"},
+		})
+
+		// Peer is not listening at first endpoint, so it should be reached at the last one.
+		listener2 := newListener(t, tcpBackend, addr2)
+		conn, err := d.Dial(context.Background(), peer)
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+		assertAccepted(t, listener2)
+
+		// When peer is listening at both the endpoints, last working endpoint should be dialed first.
+		listener1 := newListener(t, tcpBackend, addr1)
+		conn, err = d.Dial(context.Background(), peer)
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+		assertAccepted(t, listener2)
+
+		// When last working endpoint is removed, endpoints should be dialed in the registered order.
+		d.RegisterEndpoints(peer, []perun.CommEndpoint{{CommAddr: addr1, CommType: "tcp"}})
+		conn, err = d.Dial(context.Background(), peer)
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+		assertAccepted(t, listener1)
+	})
+
+	t.Run("happy_register", func(t *testing.T) {
+		d := multi.NewDialer(backends, "tcp")
+		peer := ethereumtest.NewRandomAddress(rng)
+		addr := freeAddr(t)
+		listener := newListener(t, tcpBackend, addr)
+		d.Register(peer, addr)
+
+		conn, err := d.Dial(context.Background(), peer)
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+		assertAccepted(t, listener)
+	})
+
+	t.Run("peer_not_registered", func(t *testing.T) {
+		d := multi.NewDialer(backends, "tcp")
+		_, err := d.Dial(context.Background(), ethereumtest.NewRandomAddress(rng))
+		assert.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("all_endpoints_unreachable", func(t *testing.T) {
+		d := multi.NewDialer(backends, "tcp")
+		peer := ethereumtest.NewRandomAddress(rng)
+		d.RegisterEndpoints(peer, []perun.CommEndpoint{
+			{CommAddr: freeAddr(t), CommType: "tcp"},
+			{CommAddr: freeAddr(t), CommType: "unsupported"},
+		})
+		_, err := d.Dial(context.Background(), peer)
+		assert.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("dialer_closed", func(t *testing.T) {
+		d := multi.NewDialer(backends, "tcp")
+		peer := ethereumtest.NewRandomAddress(rng)
+		addr := freeAddr(t)
+		newListener(t, tcpBackend, addr)
+		d.Register(peer, addr)
+		require.NoError(t, d.Close())
+
+		_, err := d.Dial(context.Background(), peer)
+		assert.Error(t, err)
+		assert.Error(t, d.Close())
+	})
+}
+
+func freeAddr(t *testing.T) string {
+	port, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	return fmt.Sprintf("127.0.0.1:%d", port)
+}
+
+func newListener(t *testing.T, backend perun.CommBackend, addr string) pnet.Listener {
+	listener, err := backend.NewListener(addr)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := listener.Close(); err != nil {
+			t.Log("Error closing listener at address - " + addr)
+		}
+	})
+	return listener
+}
+
+// assertAccepted asserts that a connection is accepted by the listener.
+func assertAccepted(t *testing.T, listener pnet.Listener) {
+	t.Helper()
+	accepted := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			err = conn.Close()
+		}
+		accepted <- err
+	}()
+	select {
+	case err := <-accepted:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("connection not accepted by the listener")
+	}
+}
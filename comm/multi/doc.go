@@ -0,0 +1,22 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package multi implements a dialer for the off-chain communication that can dial the peers at several
+// comm endpoints, each of which can use a different comm backend.
+//
+// The endpoints registered for a peer are tried in the order, until a connection is established. The
+// endpoint at which the peer was last reached is remembered and is tried first on the next dial.
+package multi
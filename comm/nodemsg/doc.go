@@ -0,0 +1,27 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nodemsg implements the messages exchanged between the perun nodes, that are not a part of the perun
+// wire protocol and hence are not handled by the channel client. For example, the metadata sent along with a
+// channel update.
+//
+// The messages are sent over the same wire bus as the messages of the channel client. The dialer and the
+// listener used by the wire bus are wrapped by a router, that intercepts the node messages received on each
+// connection, dispatches them to the handler registered for their kind and does not pass them to the bus.
+//
+// The message type is an external type in the wire protocol. Nodes that do not know about this type cannot
+// decode it. So, node messages should be sent only when they are required.
+package nodemsg
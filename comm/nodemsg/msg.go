@@ -0,0 +1,60 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodemsg
+
+import (
+	"io"
+
+	perunio "perun.network/go-perun/pkg/io"
+	pwire "perun.network/go-perun/wire"
+)
+
+// MsgType is the type of node messages in the wire protocol. It is chosen well above the types of the perun
+// wire protocol (pwire.LastType), so that it does not collide with the types that are added in the future.
+const MsgType pwire.Type = 128
+
+func init() {
+	pwire.RegisterExternalDecoder(MsgType, decodeMsg, "NodeMsg")
+}
+
+// Msg is a message exchanged between the perun nodes. Kind identifies the handler for the message and data is
+// the payload, which is interpreted by the handler.
+type Msg struct {
+	Kind string
+	Data string
+}
+
+// NewMsg returns a node message of the given kind with the given payload.
+func NewMsg(kind string, data []byte) *Msg {
+	return &Msg{Kind: kind, Data: string(data)}
+}
+
+// Type returns the type of node messages in the wire protocol.
+func (m *Msg) Type() pwire.Type {
+	return MsgType
+}
+
+// Encode encodes the message. Kind and data are each encoded as a string and the size of each should not
+// exceed 65535 bytes.
+func (m *Msg) Encode(w io.Writer) error {
+	return perunio.Encode(w, m.Kind, m.Data)
+}
+
+func decodeMsg(r io.Reader) (pwire.Msg, error) {
+	var m Msg
+	return &m, perunio.Decode(r, &m.Kind, &m.Data)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodemsg
+
+import (
+	"context"
+	"sync"
+
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+type (
+	// Handler is the function that processes the payload of node messages of a kind. It is invoked
+	// synchronously on the receiving connection, so that the messages sent before a message of the channel
+	// client are handled before the latter is passed to the bus. Hence, it should not block.
+	Handler func(sender pwire.Address, data []byte)
+
+	// Router intercepts the node messages received on the connections of the dialer and listener wrapped by it
+	// and dispatches them to the handlers registered for their kinds.
+	Router struct {
+		log.Logger
+
+		mutex    sync.RWMutex
+		handlers map[string]Handler
+	}
+
+	// dialer wraps a dialer, so that the node messages received on the connections established by it are routed.
+	dialer struct {
+		pnet.Dialer
+		r *Router
+	}
+
+	// listener wraps a listener, so that the node messages received on the connections accepted by it are
+	// routed.
+	listener struct {
+		pnet.Listener
+		r *Router
+	}
+
+	// conn wraps a connection and routes the node messages received on it.
+	//
+	// The node messages are routed with the address of the peer of the connection as sender, rather than the
+	// sender in the envelope. For accepted connections, this is the sender of the first message, which is the
+	// authentication message verified by the bus.
+	conn struct {
+		pnet.Conn
+		r    *Router
+		peer pwire.Address // Accessed only by Recv, which is not called concurrently.
+	}
+)
+
+// NewRouter returns a router without any handlers.
+func NewRouter() *Router {
+	return &Router{
+		Logger:   log.NewLoggerWithField("component", "nodemsg-router"),
+		handlers: make(map[string]Handler),
+	}
+}
+
+// Handle registers the handler for the node messages of the given kind. Registering a handler for a kind that
+// already has one, replaces it. Messages of kinds without a handler are dropped.
+func (r *Router) Handle(kind string, h Handler) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.handlers[kind] = h
+}
+
+// Dialer wraps the dialer, so that the node messages received on the connections established by it are routed.
+func (r *Router) Dialer(d pnet.Dialer) pnet.Dialer {
+	return &dialer{Dialer: d, r: r}
+}
+
+// Listener wraps the listener, so that the node messages received on the connections accepted by it are routed.
+func (r *Router) Listener(l pnet.Listener) pnet.Listener {
+	return &listener{Listener: l, r: r}
+}
+
+func (r *Router) route(sender pwire.Address, msg *Msg) {
+	r.mutex.RLock()
+	h, ok := r.handlers[msg.Kind]
+	r.mutex.RUnlock()
+
+	if !ok {
+		r.Warnf("Dropping node message of unknown kind %q from %v", msg.Kind, sender)
+		return
+	}
+	h(sender, []byte(msg.Data))
+}
+
+// Dial dials the peer using the wrapped dialer and returns a connection whose node messages are routed.
+func (d *dialer) Dial(ctx context.Context, addr pwire.Address) (pnet.Conn, error) {
+	c, err := d.Dialer.Dial(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &conn{Conn: c, r: d.r, peer: addr}, nil
+}
+
+// Accept accepts a connection using the wrapped listener and returns a connection whose node messages are
+// routed.
+func (l *listener) Accept() (pnet.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &conn{Conn: c, r: l.r}, nil
+}
+
+// Recv receives the next envelope on the connection. Node messages are routed to their handlers and are not
+// returned to the caller.
+func (c *conn) Recv() (*pwire.Envelope, error) {
+	for {
+		e, err := c.Conn.Recv()
+		if err != nil {
+			return nil, err
+		}
+		msg, ok := e.Msg.(*Msg)
+		if c.peer == nil {
+			c.peer = e.Sender
+			ok = false // First message is passed on, so that it fails the authentication if it is a node message.
+		}
+		if !ok {
+			return e, nil
+		}
+		c.r.route(c.peer, msg)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodemsg_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/comm/nodemsg"
+	"github.com/hyperledger-labs/perun-node/comm/tcp"
+	"github.com/hyperledger-labs/perun-node/comm/tcp/tcptest"
+)
+
+const kind = "test-kind"
+
+type routedMsg struct {
+	sender pwire.Address
+	data   []byte
+}
+
+func Test_Msg_EncodeDecode(t *testing.T) {
+	msg := nodemsg.NewMsg(kind, []byte("some data"))
+	env := &pwire.Envelope{Msg: msg}
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	env.Sender, env.Recipient = ethereumtest.NewRandomAddress(rng), ethereumtest.NewRandomAddress(rng)
+
+	var buf bytes.Buffer
+	require.NoError(t, env.Encode(&buf))
+	var decoded pwire.Envelope
+	require.NoError(t, decoded.Decode(&buf))
+	assert.Equal(t, msg, decoded.Msg)
+}
+
+func Test_Router(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	dialerAddr, listenerAddr := ethereumtest.NewRandomAddress(rng), ethereumtest.NewRandomAddress(rng)
+	dialerRouter, listenerRouter := nodemsg.NewRouter(), nodemsg.NewRouter()
+	dialerMsgs, listenerMsgs := handle(dialerRouter), handle(listenerRouter)
+
+	dialerConn, listenerConn := connect(t, dialerRouter, listenerRouter, listenerAddr)
+
+	t.Run("first_message_not_routed", func(t *testing.T) {
+		env := &pwire.Envelope{Sender: dialerAddr, Recipient: listenerAddr, Msg: nodemsg.NewMsg(kind, nil)}
+		require.NoError(t, dialerConn.Send(env))
+		got, err := listenerConn.Recv()
+		require.NoError(t, err)
+		assert.IsType(t, &nodemsg.Msg{}, got.Msg)
+		assert.Empty(t, listenerMsgs)
+	})
+
+	t.Run("routed_from_accepted_conn", func(t *testing.T) {
+		data := []byte("data-1")
+		require.NoError(t, dialerConn.Send(newEnv(dialerAddr, listenerAddr, nodemsg.NewMsg(kind, data))))
+		require.NoError(t, dialerConn.Send(newEnv(dialerAddr, listenerAddr, pwire.NewPingMsg())))
+
+		got, err := listenerConn.Recv()
+		require.NoError(t, err)
+		assert.IsType(t, &pwire.PingMsg{}, got.Msg)
+		routed := nextMsg(t, listenerMsgs)
+		assert.True(t, routed.sender.Equals(dialerAddr))
+		assert.Equal(t, data, routed.data)
+	})
+
+	t.Run("routed_from_dialed_conn", func(t *testing.T) {
+		data := []byte("data-2")
+		require.NoError(t, listenerConn.Send(newEnv(listenerAddr, dialerAddr, nodemsg.NewMsg(kind, data))))
+		require.NoError(t, listenerConn.Send(newEnv(listenerAddr, dialerAddr, pwire.NewPingMsg())))
+
+		got, err := dialerConn.Recv()
+		require.NoError(t, err)
+		assert.IsType(t, &pwire.PingMsg{}, got.Msg)
+		routed := nextMsg(t, dialerMsgs)
+		assert.True(t, routed.sender.Equals(listenerAddr))
+		assert.Equal(t, data, routed.data)
+	})
+
+	t.Run("unknown_kind_dropped", func(t *testing.T) {
+		require.NoError(t, dialerConn.Send(newEnv(dialerAddr, listenerAddr, nodemsg.NewMsg("unknown", nil))))
+		require.NoError(t, dialerConn.Send(newEnv(dialerAddr, listenerAddr, pwire.NewPingMsg())))
+
+		got, err := listenerConn.Recv()
+		require.NoError(t, err)
+		assert.IsType(t, &pwire.PingMsg{}, got.Msg)
+		assert.Empty(t, listenerMsgs)
+	})
+}
+
+func handle(r *nodemsg.Router) chan routedMsg {
+	msgs := make(chan routedMsg, 10)
+	r.Handle(kind, func(sender pwire.Address, data []byte) {
+		msgs <- routedMsg{sender: sender, data: data}
+	})
+	return msgs
+}
+
+func nextMsg(t *testing.T, msgs chan routedMsg) routedMsg {
+	t.Helper()
+	select {
+	case msg := <-msgs:
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for routed message")
+	}
+	return routedMsg{}
+}
+
+func newEnv(sender, recipient pwire.Address, msg pwire.Msg) *pwire.Envelope {
+	return &pwire.Envelope{Sender: sender, Recipient: recipient, Msg: msg}
+}
+
+func connect(t *testing.T, dialerRouter, listenerRouter *nodemsg.Router, listenerAddr pwire.Address) (
+	dialerConn, listenerConn pnet.Conn) {
+	port, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	commAddr := fmt.Sprintf("127.0.0.1:%d", port)
+
+	backend := tcp.NewTCPBackend(tcptest.DialerTimeout)
+	l, err := backend.NewListener(commAddr)
+	require.NoError(t, err)
+	listener := listenerRouter.Listener(l)
+	t.Cleanup(func() { listener.Close() }) // nolint: errcheck
+
+	d := backend.NewDialer()
+	d.Register(listenerAddr, commAddr)
+	dialer := dialerRouter.Dialer(d)
+
+	accepted := make(chan pnet.Conn, 1)
+	go func() {
+		conn, err := listener.Accept()
+		assert.NoError(t, err)
+		accepted <- conn
+	}()
+	dialerConn, err = dialer.Dial(context.Background(), listenerAddr)
+	require.NoError(t, err)
+	t.Cleanup(func() { dialerConn.Close() }) // nolint: errcheck
+
+	select {
+	case listenerConn = <-accepted:
+		require.NotNil(t, listenerConn)
+		t.Cleanup(func() { listenerConn.Close() }) // nolint: errcheck
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for accepted connection")
+	}
+	return dialerConn, listenerConn
+}
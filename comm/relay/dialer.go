@@ -0,0 +1,96 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	pkgsync "perun.network/go-perun/pkg/sync"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+)
+
+// Dialer is a lookup-table based dialer that can dial known peers via the relays at which they are connected.
+// New peer addresses can be added via Register().
+//
+// It implements perun.Dialer interface.
+type Dialer struct {
+	backend *Backend
+
+	mutex sync.RWMutex
+	peers map[pwallet.AddrKey]string // Addresses of the relays, at which the peers are connected.
+
+	pkgsync.Closer
+}
+
+func newDialer(backend *Backend) *Dialer {
+	return &Dialer{
+		backend: backend,
+		peers:   make(map[pwallet.AddrKey]string),
+	}
+}
+
+// Register registers the address of the relay, at which the peer with the off-chain address is connected.
+func (d *Dialer) Register(offChainAddr pwire.Address, relayAddr string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.peers[pwallet.Key(offChainAddr)] = relayAddr
+}
+
+func (d *Dialer) get(key pwallet.AddrKey) (string, bool) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	relayAddr, ok := d.peers[key]
+	return relayAddr, ok
+}
+
+// Dial requests the relay, at which the peer is connected, to forward a connection to it. If end-to-end
+// encryption is configured, the session is established before returning. It implements pnet.Dialer.Dial().
+func (d *Dialer) Dial(ctx context.Context, addr pwire.Address) (pnet.Conn, error) {
+	done := make(chan struct{})
+	defer close(done)
+
+	relayAddr, ok := d.get(pwallet.Key(addr))
+	if !ok {
+		return nil, errors.New("peer not found")
+	}
+
+	// Combine the provided context with the Dialer's Closer, as done in go-perun's simple dialer.
+	wrappedCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+
+		select {
+		case <-d.Closed():
+		case <-done:
+		}
+	}()
+
+	conn, err := d.backend.connect(wrappedCtx, relayAddr, helloMsg{Mode: ModeDial, Peer: addr.String()})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to dial peer via relay")
+	}
+	if d.backend.cfg.E2E == nil {
+		return pnet.NewIoConn(conn), nil
+	}
+	return d.backend.cfg.E2E.Client(wrappedCtx, conn, addr)
+}
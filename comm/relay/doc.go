@@ -0,0 +1,39 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package relay implements a relay server and the off-chain communication backend to initialize adapters
+// for communicating via the relay, so that peers without a reachable comm address (for example, behind NAT)
+// can still connect to each other.
+//
+// The peers keep an outbound control connection to the relay, authenticated with their off-chain key. The comm
+// address in their peer ID is the address of the relay. To connect to a peer, the dialer opens a connection to
+// the relay requesting the off-chain address of the peer. The relay notifies the peer over its control
+// connection, the peer opens a new connection to the relay to accept it and the relay then forwards the wire
+// messages between the two connections.
+//
+// The relay forwards the bytes as such, so the peers can use end-to-end encryption (see Config) over the
+// forwarded connections and the relay will not see the plaintext messages.
+//
+// All the connections to the relay begin with a handshake, where each message is a JSON object prefixed by
+// its length as a 4 byte big endian integer:
+//  1. Relay sends a challenge with a random nonce.
+//  2. Peer sends a hello with its off-chain address, mode (listen, dial or accept), the mode specific
+//     parameters and its signature on the nonce and the mode.
+//  3. Relay sends a result, with an error message if the request failed.
+//
+// On control connections, the relay then sends an incoming message for each connection request for the peer.
+// On dial and accept connections, the relay starts forwarding the bytes after the result is sent.
+package relay
@@ -0,0 +1,180 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pkgsync "perun.network/go-perun/pkg/sync"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+// listener keeps a control connection to the relay and accepts the connections forwarded by the relay.
+//
+// It implements pnet.Listener interface.
+type listener struct {
+	log.Logger
+
+	backend   *Backend
+	relayAddr string
+	conns     chan pnet.Conn
+
+	mutex sync.Mutex
+	ctrl  net.Conn // Current control connection.
+
+	pkgsync.Closer
+}
+
+func newListener(backend *Backend, relayAddr string) (*listener, error) {
+	ctrl, err := backend.connect(context.Background(), relayAddr, helloMsg{Mode: ModeListen})
+	if err != nil {
+		return nil, errors.WithMessage(err, "initializing listener")
+	}
+	l := &listener{
+		Logger:    log.NewLoggerWithField("component", "relay-listener"),
+		backend:   backend,
+		relayAddr: relayAddr,
+		conns:     make(chan pnet.Conn),
+		ctrl:      ctrl,
+	}
+	l.OnCloseAlways(func() {
+		l.mutex.Lock()
+		defer l.mutex.Unlock()
+		l.ctrl.Close() // nolint: errcheck, gosec  // listener is closed.
+	})
+	go l.run(ctrl)
+	return l, nil
+}
+
+// run serves the control connection and reconnects to the relay when it is lost, until the listener is closed.
+func (l *listener) run(ctrl net.Conn) {
+	for {
+		l.serveControl(ctrl)
+		if l.IsClosed() {
+			return
+		}
+		l.Errorf("Control connection to relay %s lost, reconnecting", l.relayAddr)
+		if ctrl = l.reconnect(); ctrl == nil || !l.setCtrl(ctrl) {
+			return
+		}
+		l.Infof("Reconnected to relay %s", l.relayAddr)
+	}
+}
+
+// reconnect periodically tries to connect to the relay until it succeeds. It returns nil if the listener
+// is closed in the meantime.
+func (l *listener) reconnect() net.Conn {
+	for {
+		select {
+		case <-l.Closed():
+			return nil
+		case <-time.After(l.backend.cfg.ReconnectInterval):
+		}
+		ctrl, err := l.backend.connect(context.Background(), l.relayAddr, helloMsg{Mode: ModeListen})
+		if err == nil {
+			return ctrl
+		}
+		l.Errorf("Reconnecting to relay %s: %v", l.relayAddr, err)
+	}
+}
+
+// setCtrl sets the current control connection. If the listener was closed, it closes the connection
+// and returns false.
+func (l *listener) setCtrl(ctrl net.Conn) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.IsClosed() {
+		ctrl.Close() // nolint: errcheck, gosec  // listener is closed.
+		return false
+	}
+	l.ctrl = ctrl
+	return true
+}
+
+// serveControl reads the incoming messages on the control connection and accepts each of them,
+// until the connection is closed.
+func (l *listener) serveControl(ctrl net.Conn) {
+	for {
+		var incoming incomingMsg
+		if err := readFrame(ctrl, &incoming); err != nil {
+			ctrl.Close() // nolint: errcheck, gosec  // connection is discarded.
+			return
+		}
+		go l.accept(incoming)
+	}
+}
+
+// accept connects to the relay to accept the incoming connection and passes it to Accept.
+func (l *listener) accept(incoming incomingMsg) {
+	hello := helloMsg{Mode: ModeAccept, SessionID: incoming.SessionID}
+	conn, err := l.backend.connect(context.Background(), l.relayAddr, hello)
+	if err != nil {
+		l.Errorf("Accepting connection from %s: %v", incoming.From, err)
+		return
+	}
+
+	var wireConn pnet.Conn = pnet.NewIoConn(conn)
+	if l.backend.cfg.E2E != nil {
+		ctx, cancel := l.handshakeContext()
+		defer cancel()
+		if wireConn, err = l.backend.cfg.E2E.Server(ctx, conn); err != nil {
+			l.Errorf("Accepting connection from %s: %v", incoming.From, err)
+			return
+		}
+	}
+	select {
+	case l.conns <- wireConn:
+	case <-l.Closed():
+		wireConn.Close() // nolint: errcheck, gosec  // listener is closed.
+	}
+}
+
+// handshakeContext returns a context for the end-to-end encryption handshake, that is done when the dialer
+// timeout expires or when the listener is closed.
+func (l *listener) handshakeContext() (context.Context, context.CancelFunc) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if l.backend.cfg.DialerTimeout != 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), l.backend.cfg.DialerTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+	go func() {
+		select {
+		case <-l.Closed():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// Accept waits for a connection forwarded by the relay and returns it. It implements pnet.Listener.Accept().
+func (l *listener) Accept() (pnet.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.Closed():
+		return nil, errors.New("accept failed: listener closed")
+	}
+}
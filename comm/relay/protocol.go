@@ -0,0 +1,140 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// Modes of the connections to the relay.
+const (
+	// ModeListen is used for the control connection, over which the relay notifies the incoming connections.
+	ModeListen = "listen"
+	// ModeDial is used for the connection to a peer.
+	ModeDial = "dial"
+	// ModeAccept is used for accepting an incoming connection notified over the control connection.
+	ModeAccept = "accept"
+)
+
+const (
+	// authSigDomain is prefixed to the data signed by the peers when authenticating to the relay,
+	// so that the signature cannot be used in any other context.
+	authSigDomain = "perun-node relay auth"
+
+	// nonceSize is the size of the nonce in the challenge sent by the relay.
+	nonceSize = 32
+
+	// maxFrameSize is the maximum size of a handshake message.
+	maxFrameSize = 1 << 16
+)
+
+type (
+	// challengeMsg is sent by the relay at the beginning of each connection.
+	challengeMsg struct {
+		Nonce []byte `json:"nonce"`
+	}
+
+	// helloMsg is sent by the peer in response to the challenge.
+	helloMsg struct {
+		Mode      string `json:"mode"`
+		Addr      string `json:"addr"`                // Off-chain address of the peer.
+		Peer      string `json:"peer,omitempty"`      // Off-chain address of the peer to connect to, for dial.
+		SessionID string `json:"sessionID,omitempty"` // ID of the incoming connection, for accept.
+		Signature []byte `json:"signature"`
+	}
+
+	// resultMsg is sent by the relay in response to the hello.
+	resultMsg struct {
+		Error string `json:"error,omitempty"`
+	}
+
+	// incomingMsg is sent by the relay over the control connection, when a peer requests a connection.
+	incomingMsg struct {
+		SessionID string `json:"sessionID"`
+		From      string `json:"from"` // Off-chain address of the dialing peer.
+	}
+)
+
+// authSigData returns the data signed by the peer when authenticating to the relay.
+func authSigData(nonce []byte, mode string) []byte {
+	data := append([]byte(authSigDomain), nonce...)
+	return append(data, []byte(mode)...)
+}
+
+// signHello signs the hello message for the nonce using the account.
+func signHello(hello *helloMsg, nonce []byte, acc pwallet.Account) error {
+	hello.Addr = acc.Address().String()
+	sig, err := acc.SignData(authSigData(nonce, hello.Mode))
+	if err != nil {
+		return errors.Wrap(err, "signing hello")
+	}
+	hello.Signature = sig
+	return nil
+}
+
+// verifyHello verifies the signature in the hello message for the nonce and returns the parsed
+// off-chain address of the peer.
+func verifyHello(hello helloMsg, nonce []byte, wb perun.WalletBackend) (pwallet.Address, error) {
+	addr, err := wb.ParseAddr(hello.Addr)
+	if err != nil {
+		return nil, errors.WithMessage(err, "parsing off-chain address")
+	}
+	isValid, err := wb.VerifySig(authSigData(nonce, hello.Mode), hello.Signature, addr)
+	if err != nil {
+		return nil, errors.WithMessage(err, "verifying signature")
+	}
+	if !isValid {
+		return nil, errors.New("invalid signature")
+	}
+	return addr, nil
+}
+
+// writeFrame writes the message encoded as JSON, prefixed by its length.
+func writeFrame(w io.Writer, msg interface{}) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "encoding message")
+	}
+	frame := make([]byte, 4, 4+len(data))
+	binary.BigEndian.PutUint32(frame, uint32(len(data)))
+	_, err = w.Write(append(frame, data...))
+	return errors.Wrap(err, "writing message")
+}
+
+// readFrame reads a message written by writeFrame and decodes it into msg.
+func readFrame(r io.Reader, msg interface{}) error {
+	lenBytes := make([]byte, 4)
+	if _, err := io.ReadFull(r, lenBytes); err != nil {
+		return errors.Wrap(err, "reading message length")
+	}
+	size := binary.BigEndian.Uint32(lenBytes)
+	if size > maxFrameSize {
+		return errors.Errorf("message size %d exceeds the limit %d", size, maxFrameSize)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return errors.Wrap(err, "reading message")
+	}
+	return errors.Wrap(json.Unmarshal(data, msg), "decoding message")
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// defaultReconnectInterval is the interval for reconnecting to the relay when the control connection
+// is lost, if not configured.
+const defaultReconnectInterval = 5 * time.Second
+
+type (
+	// E2EEncrypter establishes an end-to-end encrypted session over the connections forwarded by the relay.
+	// comm/tls.Backend implements it.
+	E2EEncrypter interface {
+		Client(ctx context.Context, rawConn net.Conn, peer pwire.Address) (pnet.Conn, error)
+		Server(ctx context.Context, rawConn net.Conn) (pnet.Conn, error)
+	}
+
+	// Config defines the parameters required to configure the relay comm backend.
+	Config struct {
+		// Off-chain account of the user, used for authenticating to the relay.
+		Acc pwallet.Account
+
+		// If set, the connections forwarded by the relay are end-to-end encrypted, so that the relay does not
+		// see the plaintext messages. All the peers must use end-to-end encryption as well.
+		E2E E2EEncrypter
+
+		// Timeout to be used when dialing for new outgoing connections, including the handshake with the relay.
+		DialerTimeout time.Duration
+
+		// Interval for reconnecting to the relay when the control connection is lost. If zero,
+		// a default value of 5s is used.
+		ReconnectInterval time.Duration
+	}
+
+	// Backend is an off-chain communication backend that implements `CommBackend` for
+	// communicating via a relay. It stores configuration required for initializing the adapters.
+	//
+	// The comm addresses are the addresses (host:port) of the relays, at which the peers are connected.
+	Backend struct {
+		cfg    Config
+		dialer net.Dialer
+	}
+)
+
+// NewRelayBackend returns a backend that can initialize off-chain communication adapters for
+// communicating via relays.
+func NewRelayBackend(cfg Config) (*Backend, error) {
+	if cfg.Acc == nil {
+		return nil, errors.New("off-chain account is required for authenticating to relay")
+	}
+	if cfg.ReconnectInterval == 0 {
+		cfg.ReconnectInterval = defaultReconnectInterval
+	}
+	return &Backend{cfg: cfg, dialer: net.Dialer{Timeout: cfg.DialerTimeout}}, nil
+}
+
+// NewListener connects to the relay at the specified address and returns a listener that accepts the
+// connections forwarded by the relay. The connection to the relay is re-established, if it is lost.
+func (b *Backend) NewListener(relayAddr string) (pnet.Listener, error) {
+	return newListener(b, relayAddr)
+}
+
+// NewDialer returns a dialer that can dial the peers via the relays at which they are connected.
+//
+// It uses the dial timeout configured during backend initialization.
+// If the duration was set to zero, this program will not use any timeout.
+// However default timeouts based on the operating system will still apply.
+func (b *Backend) NewDialer() perun.Dialer {
+	return newDialer(b)
+}
+
+// connect opens a connection to the relay and completes the handshake for the given hello message. Closing the
+// context aborts it.
+func (b *Backend) connect(ctx context.Context, relayAddr string, hello helloMsg) (net.Conn, error) {
+	if b.cfg.DialerTimeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, b.cfg.DialerTimeout)
+		defer cancel()
+	}
+	conn, err := b.dialer.DialContext(ctx, "tcp", relayAddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "connecting to relay")
+	}
+
+	finish := abortOnDone(ctx, conn)
+	err = handshake(conn, hello, b.cfg.Acc)
+	if abortErr := finish(); abortErr != nil {
+		err = abortErr
+	}
+	if err != nil {
+		conn.Close() // nolint: errcheck, gosec  // connection is discarded.
+		return nil, errors.WithMessage(err, "handshake with relay")
+	}
+	return conn, nil
+}
+
+// handshake responds to the challenge from the relay with the signed hello message and reads the result.
+func handshake(conn net.Conn, hello helloMsg, acc pwallet.Account) error {
+	var challenge challengeMsg
+	if err := readFrame(conn, &challenge); err != nil {
+		return err
+	}
+	if err := signHello(&hello, challenge.Nonce, acc); err != nil {
+		return err
+	}
+	if err := writeFrame(conn, hello); err != nil {
+		return err
+	}
+	var result resultMsg
+	if err := readFrame(conn, &result); err != nil {
+		return err
+	}
+	if result.Error != "" {
+		return errors.New(result.Error)
+	}
+	return nil
+}
+
+// abortOnDone closes the connection if the context is done before the returned finish function is called.
+// The finish function returns an error if the connection was closed.
+func abortOnDone(ctx context.Context, conn net.Conn) (finish func() error) {
+	var mutex sync.Mutex
+	finished, aborted := false, false
+	stop := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			mutex.Lock()
+			if !finished {
+				aborted = true
+				conn.Close() // nolint: errcheck, gosec  // aborts the ongoing handshake.
+			}
+			mutex.Unlock()
+		case <-stop:
+		}
+	}()
+	return func() error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		finished = true
+		close(stop)
+		if aborted {
+			return ctx.Err()
+		}
+		return nil
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/comm/relay"
+	"github.com/hyperledger-labs/perun-node/comm/tcp/tcptest"
+	"github.com/hyperledger-labs/perun-node/comm/tls"
+)
+
+func Test_CommBackend_Interface(t *testing.T) {
+	assert.Implements(t, (*perun.CommBackend)(nil), new(relay.Backend))
+	assert.Implements(t, (*perun.Dialer)(nil), new(relay.Dialer))
+	assert.Implements(t, (*relay.E2EEncrypter)(nil), new(tls.Backend))
+}
+
+func Test_NewRelayBackend(t *testing.T) {
+	_, err := relay.NewRelayBackend(relay.Config{})
+	assert.Error(t, err)
+}
+
+func Test_Relay(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 3)
+	relayAddr := freeAddr(t)
+	startRelay(t, ws.WalletBackend, relayAddr)
+
+	t.Run("happy", func(t *testing.T) {
+		listenerBackend := newBackend(t, relay.Config{Acc: ws.Accs[0]})
+		dialerBackend := newBackend(t, relay.Config{Acc: ws.Accs[1]})
+		assertExchange(t, listenerBackend, dialerBackend, relayAddr, ws.Accs[0], ws.Accs[1])
+	})
+
+	t.Run("happy_e2e", func(t *testing.T) {
+		listenerE2E, err := tls.NewTLSBackend(tls.Config{IdentityAcc: ws.Accs[0]}, ws.WalletBackend)
+		require.NoError(t, err)
+		dialerE2E, err := tls.NewTLSBackend(tls.Config{IdentityAcc: ws.Accs[1]}, ws.WalletBackend)
+		require.NoError(t, err)
+		listenerBackend := newBackend(t, relay.Config{Acc: ws.Accs[0], E2E: listenerE2E})
+		dialerBackend := newBackend(t, relay.Config{Acc: ws.Accs[1], E2E: dialerE2E})
+		assertExchange(t, listenerBackend, dialerBackend, relayAddr, ws.Accs[0], ws.Accs[1])
+	})
+
+	t.Run("peer_not_connected", func(t *testing.T) {
+		dialer := newBackend(t, relay.Config{Acc: ws.Accs[1]}).NewDialer()
+		dialer.Register(ws.Accs[2].Address(), relayAddr)
+		_, err := dialer.Dial(context.Background(), ws.Accs[2].Address())
+		assert.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("peer_not_registered", func(t *testing.T) {
+		dialer := newBackend(t, relay.Config{Acc: ws.Accs[1]}).NewDialer()
+		_, err := dialer.Dial(context.Background(), ws.Accs[2].Address())
+		assert.Error(t, err)
+	})
+
+	t.Run("relay_not_reachable", func(t *testing.T) {
+		_, err := newBackend(t, relay.Config{Acc: ws.Accs[0]}).NewListener(freeAddr(t))
+		assert.Error(t, err)
+	})
+
+	t.Run("listener_closed", func(t *testing.T) {
+		listener, err := newBackend(t, relay.Config{Acc: ws.Accs[0]}).NewListener(relayAddr)
+		require.NoError(t, err)
+		require.NoError(t, listener.Close())
+		_, err = listener.Accept()
+		assert.Error(t, err)
+		assert.Error(t, listener.Close())
+	})
+}
+
+func Test_Relay_Reconnect(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 2)
+	relayAddr := freeAddr(t)
+	server := startRelay(t, ws.WalletBackend, relayAddr)
+
+	cfg := relay.Config{Acc: ws.Accs[0], ReconnectInterval: 10 * time.Millisecond}
+	listener, err := newBackend(t, cfg).NewListener(relayAddr)
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() }) // nolint: errcheck
+
+	// Restart the relay, the listener should reconnect to it.
+	require.NoError(t, server.Close())
+	startRelay(t, ws.WalletBackend, relayAddr)
+
+	dialer := newBackend(t, relay.Config{Acc: ws.Accs[1]}).NewDialer()
+	dialer.Register(ws.Accs[0].Address(), relayAddr)
+	require.Eventually(t, func() bool {
+		conn, err := dialer.Dial(context.Background(), ws.Accs[0].Address())
+		if err != nil {
+			return false
+		}
+		conn.Close() // nolint: errcheck, gosec
+		return true
+	}, 2*time.Second, 50*time.Millisecond)
+}
+
+func startRelay(t *testing.T, wb perun.WalletBackend, addr string) *relay.Server {
+	l, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+	server := relay.NewServer(wb, time.Second, time.Second)
+	go server.Serve(l)                   // nolint: errcheck
+	t.Cleanup(func() { server.Close() }) // nolint: errcheck
+	return server
+}
+
+func newBackend(t *testing.T, cfg relay.Config) *relay.Backend {
+	cfg.DialerTimeout = tcptest.DialerTimeout
+	backend, err := relay.NewRelayBackend(cfg)
+	require.NoError(t, err)
+	return backend
+}
+
+// assertExchange asserts that envelopes can be exchanged in both directions between the listener and the
+// dialer, via the relay.
+func assertExchange(t *testing.T, listenerBackend, dialerBackend *relay.Backend, relayAddr string,
+	listenerAcc, dialerAcc pwallet.Account) {
+	t.Helper()
+	listener, err := listenerBackend.NewListener(relayAddr)
+	require.NoError(t, err)
+	defer listener.Close() // nolint: errcheck
+
+	accepted := make(chan pnet.Conn, 1)
+	go func() {
+		conn, err := listener.Accept()
+		assert.NoError(t, err)
+		accepted <- conn
+	}()
+	dialer := dialerBackend.NewDialer()
+	dialer.Register(listenerAcc.Address(), relayAddr)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	dialerConn, err := dialer.Dial(ctx, listenerAcc.Address())
+	require.NoError(t, err)
+	defer dialerConn.Close() // nolint: errcheck
+
+	var listenerConn pnet.Conn
+	select {
+	case listenerConn = <-accepted:
+		require.NotNil(t, listenerConn)
+		defer listenerConn.Close() // nolint: errcheck
+	case <-time.After(time.Second):
+		t.Fatal("connection not accepted by the listener")
+	}
+
+	listenerAddr, dialerAddr := listenerAcc.Address(), dialerAcc.Address()
+	ping := &pwire.Envelope{Sender: dialerAddr, Recipient: listenerAddr, Msg: pwire.NewPingMsg()}
+	require.NoError(t, dialerConn.Send(ping))
+	received, err := listenerConn.Recv()
+	require.NoError(t, err)
+	assert.True(t, received.Sender.Equals(dialerAddr))
+
+	pong := &pwire.Envelope{Sender: listenerAddr, Recipient: dialerAddr, Msg: pwire.NewPongMsg()}
+	require.NoError(t, listenerConn.Send(pong))
+	received, err = dialerConn.Recv()
+	require.NoError(t, err)
+	assert.True(t, received.Sender.Equals(listenerAddr))
+}
+
+func freeAddr(t *testing.T) string {
+	port, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	return fmt.Sprintf("127.0.0.1:%d", port)
+}
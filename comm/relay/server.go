@@ -0,0 +1,318 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pkgsync "perun.network/go-perun/pkg/sync"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+type (
+	// Server is a relay that forwards the connections between the peers connected to it.
+	Server struct {
+		log.Logger
+
+		wb               perun.WalletBackend
+		handshakeTimeout time.Duration // Time allowed for the handshake on each connection.
+		acceptTimeout    time.Duration // Time allowed for the peer to accept an incoming connection.
+
+		mutex     sync.Mutex
+		listeners map[pwallet.AddrKey]*controlConn // Control connections, indexed by the peer's address.
+		pending   map[string]pendingConn           // Connections waiting to be accepted, indexed by session ID.
+
+		pkgsync.Closer
+	}
+
+	// controlConn is the control connection of a listening peer.
+	controlConn struct {
+		net.Conn
+		writeMutex sync.Mutex
+	}
+
+	// pendingConn is a connection from a dialing peer, waiting to be accepted.
+	pendingConn struct {
+		peer     pwallet.AddrKey // Address of the peer that should accept the connection.
+		accepted chan net.Conn
+	}
+)
+
+// NewServer returns a relay server that uses the wallet backend for authenticating the peers.
+//
+// Handshake timeout is the time allowed for the handshake on each connection and accept timeout is the time
+// allowed for a peer to accept an incoming connection after being notified.
+func NewServer(wb perun.WalletBackend, handshakeTimeout, acceptTimeout time.Duration) *Server {
+	s := &Server{
+		Logger:           log.NewLoggerWithField("component", "relay"),
+		wb:               wb,
+		handshakeTimeout: handshakeTimeout,
+		acceptTimeout:    acceptTimeout,
+		listeners:        make(map[pwallet.AddrKey]*controlConn),
+		pending:          make(map[string]pendingConn),
+	}
+	s.OnCloseAlways(func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		for key, ctrl := range s.listeners {
+			ctrl.Close() // nolint: errcheck, gosec  // relay is closed.
+			delete(s.listeners, key)
+		}
+	})
+	return s
+}
+
+// Serve accepts the connections on the listener and serves them, until the server or the listener is closed.
+// The listener is closed when the server is closed.
+func (s *Server) Serve(l net.Listener) error {
+	if !s.OnCloseAlways(func() { l.Close() }) { // nolint: errcheck, gosec  // server is closed.
+		return errors.New("server closed")
+	}
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if s.IsClosed() {
+				return nil
+			}
+			return errors.Wrap(err, "accepting connection")
+		}
+		go s.handleConn(conn)
+	}
+}
+
+// handleConn performs the handshake on the connection and serves it as per the requested mode.
+func (s *Server) handleConn(conn net.Conn) {
+	if err := conn.SetDeadline(time.Now().Add(s.handshakeTimeout)); err != nil {
+		conn.Close() // nolint: errcheck, gosec  // connection is discarded.
+		return
+	}
+	hello, addr, err := s.authenticate(conn)
+	if err != nil {
+		s.Debugf("Authenticating connection from %s: %v", conn.RemoteAddr(), err)
+		conn.Close() // nolint: errcheck, gosec  // connection is discarded.
+		return
+	}
+
+	switch hello.Mode {
+	case ModeListen:
+		err = s.handleListen(conn, addr)
+	case ModeDial:
+		err = s.handleDial(conn, addr, hello.Peer)
+	case ModeAccept:
+		err = s.handleAccept(conn, addr, hello.SessionID)
+	default:
+		err = errors.Errorf("unknown mode %s", hello.Mode)
+	}
+	if err != nil {
+		s.Debugf("Serving %s connection from %s: %v", hello.Mode, hello.Addr, err)
+		writeFrame(conn, resultMsg{Error: err.Error()}) // nolint: errcheck, gosec  // connection is discarded.
+		conn.Close()                                    // nolint: errcheck, gosec  // connection is discarded.
+	}
+}
+
+// authenticate sends the challenge, reads the hello and verifies it.
+func (s *Server) authenticate(conn net.Conn) (helloMsg, pwallet.Address, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return helloMsg{}, nil, errors.Wrap(err, "generating nonce")
+	}
+	if err := writeFrame(conn, challengeMsg{Nonce: nonce}); err != nil {
+		return helloMsg{}, nil, err
+	}
+	var hello helloMsg
+	if err := readFrame(conn, &hello); err != nil {
+		return helloMsg{}, nil, err
+	}
+	addr, err := verifyHello(hello, nonce, s.wb)
+	return hello, addr, err
+}
+
+// handleListen registers the control connection of the peer, replacing the existing one (if any), and keeps
+// it registered until it is closed.
+func (s *Server) handleListen(conn net.Conn, addr pwallet.Address) error {
+	ctrl := &controlConn{Conn: conn}
+	key := pwallet.Key(addr)
+
+	// Hold the write mutex until the result is sent, so that no incoming message is sent before it.
+	ctrl.writeMutex.Lock()
+	s.mutex.Lock()
+	if s.IsClosed() {
+		s.mutex.Unlock()
+		ctrl.writeMutex.Unlock()
+		return errors.New("relay closed")
+	}
+	if existing, ok := s.listeners[key]; ok {
+		existing.Close() // nolint: errcheck, gosec  // replaced by the new connection.
+	}
+	s.listeners[key] = ctrl
+	s.mutex.Unlock()
+
+	err := writeFrame(conn, resultMsg{})
+	ctrl.writeMutex.Unlock()
+	if err != nil {
+		s.unregister(key, ctrl)
+		return err
+	}
+	conn.SetDeadline(time.Time{}) // nolint: errcheck, gosec  // connection will be closed on errors.
+	s.Infof("Peer %s connected", addr)
+
+	// Peers do not send anything on the control connection, so the read returns only when it is closed.
+	io.Copy(ioutil.Discard, conn) // nolint: errcheck, gosec  // connection is closed in any case.
+	s.unregister(key, ctrl)
+	s.Infof("Peer %s disconnected", addr)
+	return nil
+}
+
+func (s *Server) unregister(key pwallet.AddrKey, ctrl *controlConn) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.listeners[key] == ctrl {
+		delete(s.listeners, key)
+	}
+	ctrl.Close() // nolint: errcheck, gosec  // connection is discarded.
+}
+
+// handleDial notifies the requested peer and waits for it to accept the connection. It then forwards the bytes
+// between the two connections.
+func (s *Server) handleDial(conn net.Conn, addr pwallet.Address, peer string) error {
+	peerAddr, err := s.wb.ParseAddr(peer)
+	if err != nil {
+		return errors.WithMessage(err, "parsing off-chain address of peer")
+	}
+	peerKey := pwallet.Key(peerAddr)
+	sessionID, err := newSessionID()
+	if err != nil {
+		return err
+	}
+	accepted := make(chan net.Conn, 1)
+
+	s.mutex.Lock()
+	ctrl, ok := s.listeners[peerKey]
+	if ok {
+		s.pending[sessionID] = pendingConn{peer: peerKey, accepted: accepted}
+	}
+	s.mutex.Unlock()
+	if !ok {
+		return errors.Errorf("peer %s not connected to relay", peer)
+	}
+	defer func() {
+		s.mutex.Lock()
+		delete(s.pending, sessionID)
+		s.mutex.Unlock()
+		// Close the connection, if it was accepted after the wait was over.
+		select {
+		case peerConn := <-accepted:
+			peerConn.Close() // nolint: errcheck, gosec  // connection is discarded.
+		default:
+		}
+	}()
+
+	if err = ctrl.write(incomingMsg{SessionID: sessionID, From: addr.String()}); err != nil {
+		return errors.WithMessage(err, "notifying peer")
+	}
+	var peerConn net.Conn
+	select {
+	case peerConn = <-accepted:
+	case <-time.After(s.acceptTimeout):
+		return errors.Errorf("peer %s did not accept the connection", peer)
+	case <-s.Closed():
+		return errors.New("relay closed")
+	}
+
+	if err = writeFrame(conn, resultMsg{}); err != nil {
+		peerConn.Close() // nolint: errcheck, gosec  // connection is discarded.
+		return err
+	}
+	s.Debugf("Forwarding connection from %s to %s", addr, peer)
+	s.forward(conn, peerConn)
+	return nil
+}
+
+// handleAccept passes the connection to the dialing peer waiting for it.
+func (s *Server) handleAccept(conn net.Conn, addr pwallet.Address, sessionID string) error {
+	isPending := func() bool {
+		pending, ok := s.pending[sessionID]
+		return ok && pending.peer == pwallet.Key(addr)
+	}
+	s.mutex.Lock()
+	ok := isPending()
+	s.mutex.Unlock()
+	if !ok {
+		return errors.New("unknown session")
+	}
+
+	// Result is sent before passing the connection, as the bytes are forwarded on it after that.
+	if err := writeFrame(conn, resultMsg{}); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if !isPending() {
+		conn.Close() // nolint: errcheck, gosec  // dialing peer is not waiting anymore.
+		return nil
+	}
+	s.pending[sessionID].accepted <- conn // Channel is buffered and only one connection is sent on it.
+	delete(s.pending, sessionID)
+	return nil
+}
+
+// forward copies the bytes between the two connections in both directions, until either of them is closed.
+// Both the connections are then closed.
+func (s *Server) forward(conn1, conn2 net.Conn) {
+	for _, conn := range []net.Conn{conn1, conn2} {
+		conn.SetDeadline(time.Time{}) // nolint: errcheck, gosec  // connection will be closed on errors.
+	}
+	done := make(chan struct{}, 2)
+	copyConn := func(dst, src net.Conn) {
+		io.Copy(dst, src) // nolint: errcheck, gosec  // connections are closed in any case.
+		done <- struct{}{}
+	}
+	go copyConn(conn1, conn2)
+	go copyConn(conn2, conn1)
+
+	select {
+	case <-done:
+	case <-s.Closed():
+	}
+	conn1.Close() // nolint: errcheck, gosec  // forwarding is done.
+	conn2.Close() // nolint: errcheck, gosec  // forwarding is done.
+}
+
+func (c *controlConn) write(msg interface{}) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	return writeFrame(c.Conn, msg)
+}
+
+func newSessionID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", errors.Wrap(err, "generating session id")
+	}
+	return hex.EncodeToString(id), nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	gotls "crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// identityCertValidity is the validity of the certificates generated for deriving identity from the off-chain
+// key. The certificates are generated afresh each time the backend is initialized.
+const identityCertValidity = 365 * 24 * time.Hour
+
+// identitySigDomain is prefixed to the data signed by the off-chain account in an identity certificate,
+// so that the signature cannot be used in any other context.
+const identitySigDomain = "perun-node tls identity"
+
+// identityExtOID is the object identifier of the certificate extension that holds the signature of the
+// off-chain account on the public key of the certificate. It is used only between perun nodes.
+var identityExtOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+
+// CertFingerprint returns the SHA-256 fingerprint of the certificate as a lower case hex string,
+// in the format used for pinning certificates.
+func CertFingerprint(cert *x509.Certificate) string {
+	fingerprint := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(fingerprint[:])
+}
+
+// normalizeFingerprint removes the colons in the fingerprint, converts it to lower case and validates it.
+func normalizeFingerprint(fingerprint string) (string, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(fingerprint, ":", ""))
+	if decoded, err := hex.DecodeString(normalized); err != nil || len(decoded) != sha256.Size {
+		return "", errors.Errorf("fingerprint %s should be a hex encoded SHA-256 hash", fingerprint)
+	}
+	return normalized, nil
+}
+
+// newIdentityCert generates a self-signed certificate with a new key, whose public key is signed by the
+// off-chain account.
+func newIdentityCert(acc pwallet.Account) (*gotls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "generating key")
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding public key")
+	}
+	sig, err := acc.SignData(identitySigData(publicKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "signing public key with off-chain account")
+	}
+	sigExt, err := asn1.Marshal(sig)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding signature")
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "generating serial number")
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:    serialNumber,
+		Subject:         pkix.Name{CommonName: acc.Address().String()},
+		NotBefore:       time.Now().Add(-time.Hour),
+		NotAfter:        time.Now().Add(identityCertValidity),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		ExtraExtensions: []pkix.Extension{{Id: identityExtOID, Value: sigExt}},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating certificate")
+	}
+	return &gotls.Certificate{Certificate: [][]byte{certDER}, PrivateKey: key}, nil
+}
+
+// verifyIdentityCert verifies if the public key of the certificate is signed by the off-chain address.
+func verifyIdentityCert(cert *x509.Certificate, offChainAddr pwallet.Address, wb perun.WalletBackend) error {
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(identityExtOID) {
+			continue
+		}
+		var sig []byte
+		if _, err := asn1.Unmarshal(ext.Value, &sig); err != nil {
+			return errors.Wrap(ErrPeerIdentityInvalid, err.Error())
+		}
+		isValid, err := wb.VerifySig(identitySigData(cert.RawSubjectPublicKeyInfo), sig, offChainAddr)
+		if err != nil {
+			return errors.Wrap(ErrPeerIdentityInvalid, err.Error())
+		}
+		if !isValid {
+			return ErrPeerIdentityInvalid
+		}
+		return nil
+	}
+	return errors.Wrap(ErrPeerIdentityInvalid, "identity extension not found")
+}
+
+// identitySigData returns the data signed by the off-chain account in an identity certificate.
+func identitySigData(publicKey []byte) []byte {
+	hash := sha256.Sum256(publicKey)
+	return append([]byte(identitySigDomain), hash[:]...)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls
+
+import (
+	gotls "crypto/tls"
+	"net"
+
+	"github.com/pkg/errors"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+)
+
+type (
+	// listener listens for incoming tls connections.
+	listener struct {
+		net.Listener
+		backend *Backend
+	}
+
+	// conn is a tls connection to a peer. It ensures that all the envelopes received on the connection are sent
+	// by the peer whose certificate was verified.
+	//
+	// For connections accepted by the listener, the off-chain address of the peer is known only after the first
+	// envelope is received. So the certificate of the peer is verified at that point.
+	conn struct {
+		pnet.Conn
+		tlsConn    *gotls.Conn
+		peer       pwire.Address // nil until the first envelope is received, for accepted connections.
+		verifyPeer func(pwire.Address, [][]byte) error
+	}
+)
+
+// Accept waits for an incoming connection and returns it. The tls handshake is completed when the first
+// envelope is received on it. It implements pnet.Listener.Accept().
+func (l *listener) Accept() (pnet.Conn, error) {
+	rawConn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, errors.Wrap(err, "accept failed")
+	}
+	// Handshake is not done here, so that a slow peer does not block accepting other connections.
+	return newConn(gotls.Server(rawConn, l.backend.serverConfig()), nil, l.backend.verifyPeer), nil
+}
+
+func newConn(tlsConn *gotls.Conn, peer pwire.Address, verifyPeer func(pwire.Address, [][]byte) error) *conn {
+	return &conn{
+		Conn:       pnet.NewIoConn(tlsConn),
+		tlsConn:    tlsConn,
+		peer:       peer,
+		verifyPeer: verifyPeer,
+	}
+}
+
+// Recv receives an envelope from the peer. If the peer cannot be verified or the envelope is sent by
+// a different peer, the connection is closed and an error is returned.
+func (c *conn) Recv() (*pwire.Envelope, error) {
+	e, err := c.Conn.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if c.peer == nil {
+		if err = c.verifyPeer(e.Sender, rawPeerCerts(c.tlsConn)); err != nil {
+			c.Conn.Close() // nolint: errcheck, gosec  // connection is discarded.
+			return nil, errors.WithMessage(err, "verifying peer")
+		}
+		c.peer = e.Sender
+	}
+	if !e.Sender.Equals(c.peer) {
+		c.Conn.Close() // nolint: errcheck, gosec  // connection is discarded.
+		return nil, errors.New("envelope not sent by the verified peer")
+	}
+	return e, nil
+}
+
+func rawPeerCerts(tlsConn *gotls.Conn) [][]byte {
+	peerCerts := tlsConn.ConnectionState().PeerCertificates
+	rawCerts := make([][]byte, len(peerCerts))
+	for i := range peerCerts {
+		rawCerts[i] = peerCerts[i].Raw
+	}
+	return rawCerts
+}
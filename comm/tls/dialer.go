@@ -0,0 +1,119 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls
+
+import (
+	"context"
+	gotls "crypto/tls"
+	"net"
+	"sync"
+
+	"github.com/pkg/errors"
+	pkgsync "perun.network/go-perun/pkg/sync"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+)
+
+// Dialer is a lookup-table based dialer that can dial known peers using tls protocol.
+// New peer addresses can be added via Register().
+//
+// It implements perun.Dialer interface.
+type Dialer struct {
+	backend *Backend
+	dialer  net.Dialer
+
+	mutex sync.RWMutex
+	peers map[pwallet.AddrKey]string // Known peer addresses.
+
+	pkgsync.Closer
+}
+
+func newDialer(backend *Backend) *Dialer {
+	return &Dialer{
+		backend: backend,
+		dialer:  net.Dialer{Timeout: backend.dialerTimeout},
+		peers:   make(map[pwallet.AddrKey]string),
+	}
+}
+
+// Register registers the comm address for the off-chain address of the peer.
+func (d *Dialer) Register(offChainAddr pwire.Address, commAddr string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.peers[pwallet.Key(offChainAddr)] = commAddr
+}
+
+func (d *Dialer) get(key pwallet.AddrKey) (string, bool) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	host, ok := d.peers[key]
+	return host, ok
+}
+
+// Dial dials the peer at its registered comm address and completes the tls handshake, during which the
+// certificate of the peer is verified. It implements pnet.Dialer.Dial().
+func (d *Dialer) Dial(ctx context.Context, addr pwire.Address) (pnet.Conn, error) {
+	done := make(chan struct{})
+	defer close(done)
+
+	host, ok := d.get(pwallet.Key(addr))
+	if !ok {
+		return nil, errors.New("peer not found")
+	}
+
+	// Combine the provided context with the Dialer's Closer, as done in go-perun's simple dialer.
+	wrappedCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+
+		select {
+		case <-d.Closed():
+		case <-done:
+		}
+	}()
+
+	rawConn, err := d.dialer.DialContext(wrappedCtx, "tcp", host)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial peer")
+	}
+	return d.backend.client(wrappedCtx, rawConn, addr, host)
+}
+
+// handshake runs the tls handshake on the connection. If the context is done before the handshake completes,
+// the underlying connection is closed to abort it. On error, the connection is closed.
+func handshake(ctx context.Context, tlsConn *gotls.Conn, rawConn net.Conn) error {
+	errs := make(chan error, 1)
+	go func() {
+		errs <- tlsConn.Handshake()
+	}()
+
+	var err error
+	select {
+	case err = <-errs:
+	case <-ctx.Done():
+		rawConn.Close() // nolint: errcheck, gosec  // aborts the handshake.
+		<-errs
+		err = ctx.Err()
+	}
+	if err != nil {
+		rawConn.Close() // nolint: errcheck, gosec  // connection is discarded.
+	}
+	return err
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tls implements the off-chain communication backend to initialize adapters for
+// tls communication protocol, where the connections are encrypted using transport layer security over tcp.
+//
+// By default, the certificates of the peers are verified using the configured CA certificates (or the system
+// roots). Alternatively, the certificate of a peer can be pinned by its fingerprint or the identity of the peers
+// can be derived from their off-chain keys. See Config for details.
+package tls
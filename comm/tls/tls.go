@@ -0,0 +1,263 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls
+
+import (
+	"context"
+	gotls "crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// Errors returned by the tls comm backend.
+var (
+	ErrCertNotConfigured   = errors.New("certificate not configured, cannot listen for connections")
+	ErrPeerCertMissing     = errors.New("peer did not present a certificate")
+	ErrPeerCertNotPinned   = errors.New("peer certificate does not match the pinned fingerprint")
+	ErrPeerCertExpired     = errors.New("peer certificate is expired or not yet valid")
+	ErrPeerIdentityInvalid = errors.New("peer certificate is not signed by the off-chain address of the peer")
+)
+
+type (
+	// Config defines the parameters required to configure the tls comm backend.
+	Config struct {
+		// Paths to the PEM encoded certificate and the private key used for the connections. These are required
+		// for listening, unless the IdentityAcc is set.
+		CertFile string
+		KeyFile  string
+
+		// Path to the PEM encoded CA certificates used for verifying the certificates of the peers.
+		// If empty, system roots are used.
+		CACertFile string
+
+		// SHA-256 fingerprints of the certificates of the peers, indexed by their off-chain addresses.
+		// Certificate of a pinned peer is accepted only if it has the same fingerprint and is not verified
+		// using the CA certificates. Fingerprints are hex encoded and may be separated by colons.
+		PinnedCerts map[string]string
+
+		// If set, a certificate is generated for the user with its public key signed by this off-chain account
+		// and the identity of the peers is derived from their off-chain keys: the certificate of each peer
+		// must be signed in the same manner by its off-chain address. CertFile and KeyFile should not be set.
+		// All the peers must use this option as well, because the certificates are not verified using
+		// the CA certificates.
+		IdentityAcc pwallet.Account
+
+		// Timeout to be used when dialing for new outgoing connections.
+		DialerTimeout time.Duration
+	}
+
+	// Backend is an off-chain communication backend that implements `CommBackend` for
+	// for tls protocol. It stores configuration required for initializing the adapters.
+	Backend struct {
+		walletBackend perun.WalletBackend
+
+		cert           *gotls.Certificate // nil if not configured.
+		rootCAs        *x509.CertPool     // nil if system roots are to be used.
+		pinnedCerts    map[pwallet.AddrKey]string
+		verifyIdentity bool
+
+		// timeout to be used when dialing for new outgoing connections.
+		dialerTimeout time.Duration
+	}
+)
+
+// NewTLSBackend returns a backend that can initialize off-chain communication adapters for tls protocol.
+//
+// Wallet backend is used for parsing the off-chain addresses of pinned certificates and verifying the
+// identity of the peers.
+func NewTLSBackend(cfg Config, wb perun.WalletBackend) (*Backend, error) {
+	b := &Backend{
+		walletBackend:  wb,
+		pinnedCerts:    make(map[pwallet.AddrKey]string, len(cfg.PinnedCerts)),
+		verifyIdentity: cfg.IdentityAcc != nil,
+		dialerTimeout:  cfg.DialerTimeout,
+	}
+
+	var err error
+	switch {
+	case cfg.IdentityAcc != nil:
+		if cfg.CertFile != "" || cfg.KeyFile != "" {
+			return nil, errors.New("cert and key files should not be set when identity is derived from off-chain key")
+		}
+		if b.cert, err = newIdentityCert(cfg.IdentityAcc); err != nil {
+			return nil, errors.WithMessage(err, "generating identity certificate")
+		}
+	case cfg.CertFile != "" || cfg.KeyFile != "":
+		cert, err := gotls.LoadX509KeyPair(filepath.Clean(cfg.CertFile), filepath.Clean(cfg.KeyFile))
+		if err != nil {
+			return nil, errors.Wrap(err, "loading certificate and key")
+		}
+		b.cert = &cert
+	}
+
+	if cfg.CACertFile != "" {
+		caCerts, err := ioutil.ReadFile(filepath.Clean(cfg.CACertFile))
+		if err != nil {
+			return nil, errors.Wrap(err, "reading CA certificates")
+		}
+		b.rootCAs = x509.NewCertPool()
+		if !b.rootCAs.AppendCertsFromPEM(caCerts) {
+			return nil, errors.New("no valid CA certificates in " + cfg.CACertFile)
+		}
+	}
+
+	for offChainAddr, fingerprint := range cfg.PinnedCerts {
+		addr, err := wb.ParseAddr(offChainAddr)
+		if err != nil {
+			return nil, errors.WithMessage(err, "parsing off-chain address of pinned certificate")
+		}
+		normalized, err := normalizeFingerprint(fingerprint)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "pinned certificate of %s", offChainAddr)
+		}
+		b.pinnedCerts[pwallet.Key(addr)] = normalized
+	}
+	return b, nil
+}
+
+// NewListener returns a listener that can listen for incoming connections at
+// the specified address using tls protocol.
+func (b *Backend) NewListener(addr string) (pnet.Listener, error) {
+	if b.cert == nil {
+		return nil, ErrCertNotConfigured
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "initializing listener")
+	}
+	return &listener{Listener: l, backend: b}, nil
+}
+
+// NewDialer returns a dialer that can dial outgoing connections using
+// tls protocol.
+//
+// It uses the dial timeout configured during backend initialization.
+// If the duration was set to zero, this program will not use any timeout.
+// However default timeouts based on the operating system will still apply.
+func (b *Backend) NewDialer() perun.Dialer {
+	return newDialer(b)
+}
+
+// Client establishes a tls session as the client over an existing connection to the peer with the given
+// off-chain address and returns it as a wire connection. The tls handshake is completed before returning and,
+// on error, the connection is closed.
+//
+// It is intended for end-to-end encryption over connections that are forwarded by intermediaries such as
+// relays. As the host name of the peer is not known, its certificate should be pinned or its identity should
+// be derived from the off-chain key.
+func (b *Backend) Client(ctx context.Context, rawConn net.Conn, peer pwire.Address) (pnet.Conn, error) {
+	return b.client(ctx, rawConn, peer, "")
+}
+
+// Server establishes a tls session as the server over an existing connection and returns it as a wire
+// connection. The tls handshake is completed before returning, but the peer is verified only when the first
+// envelope is received, as its off-chain address is not known until then. On error, the connection is closed.
+//
+// It is intended for end-to-end encryption over connections that are forwarded by intermediaries such as
+// relays. The certificate should have been configured.
+func (b *Backend) Server(ctx context.Context, rawConn net.Conn) (pnet.Conn, error) {
+	if b.cert == nil {
+		rawConn.Close() // nolint: errcheck, gosec  // connection is discarded.
+		return nil, ErrCertNotConfigured
+	}
+	tlsConn := gotls.Server(rawConn, b.serverConfig())
+	if err := handshake(ctx, tlsConn, rawConn); err != nil {
+		return nil, errors.Wrap(err, "tls handshake with peer")
+	}
+	return newConn(tlsConn, nil, b.verifyPeer), nil
+}
+
+func (b *Backend) client(ctx context.Context, rawConn net.Conn, peer pwire.Address, host string) (pnet.Conn, error) {
+	tlsConn := gotls.Client(rawConn, b.clientConfig(peer, host))
+	if err := handshake(ctx, tlsConn, rawConn); err != nil {
+		return nil, errors.Wrap(err, "tls handshake with peer")
+	}
+	return newConn(tlsConn, peer, b.verifyPeer), nil
+}
+
+// serverConfig returns the tls config for the connections accepted by the listener. Client certificates are
+// requested, but are verified only after the off-chain address of the peer is known. See verifyPeer.
+func (b *Backend) serverConfig() *gotls.Config {
+	return &gotls.Config{
+		MinVersion:   gotls.VersionTLS12,
+		Certificates: []gotls.Certificate{*b.cert},
+		ClientAuth:   gotls.RequestClientCert,
+	}
+}
+
+// clientConfig returns the tls config for dialing the peer with the given off-chain address at the host.
+func (b *Backend) clientConfig(peer pwire.Address, host string) *gotls.Config {
+	cfg := &gotls.Config{
+		MinVersion: gotls.VersionTLS12,
+		RootCAs:    b.rootCAs,
+	}
+	if b.cert != nil {
+		cfg.Certificates = []gotls.Certificate{*b.cert}
+	}
+
+	if _, isPinned := b.pinnedCerts[pwallet.Key(peer)]; isPinned || b.verifyIdentity {
+		// Certificate is verified for the off-chain address of the peer, instead of using the CA certificates.
+		cfg.InsecureSkipVerify = true // nolint: gosec
+		cfg.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return b.verifyPeer(peer, rawCerts)
+		}
+		return cfg
+	}
+	cfg.ServerName = host
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		cfg.ServerName = hostname
+	}
+	return cfg
+}
+
+// verifyPeer verifies the certificate presented by the peer with the given off-chain address, if it is pinned
+// or if the identity of the peers are derived from their off-chain keys. Otherwise, it does nothing as the
+// certificates are verified using the CA certificates during the handshake when dialing and the clients are
+// not required to present a certificate when listening.
+func (b *Backend) verifyPeer(peer pwire.Address, rawCerts [][]byte) error {
+	fingerprint, isPinned := b.pinnedCerts[pwallet.Key(peer)]
+	if !isPinned && !b.verifyIdentity {
+		return nil
+	}
+	if len(rawCerts) == 0 {
+		return ErrPeerCertMissing
+	}
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return errors.Wrap(err, "parsing peer certificate")
+	}
+	if now := time.Now(); now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return ErrPeerCertExpired
+	}
+	if isPinned {
+		if CertFingerprint(cert) != fingerprint {
+			return ErrPeerCertNotPinned
+		}
+		return nil
+	}
+	return verifyIdentityCert(cert, peer, b.walletBackend)
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/comm/tcp/tcptest"
+	"github.com/hyperledger-labs/perun-node/comm/tls"
+	"github.com/hyperledger-labs/perun-node/comm/tls/tlstest"
+)
+
+func Test_CommBackend_Interface(t *testing.T) {
+	assert.Implements(t, (*perun.CommBackend)(nil), new(tls.Backend))
+	assert.Implements(t, (*perun.Dialer)(nil), new(tls.Dialer))
+}
+
+func Test_NewTLSBackend(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 1)
+	certFile, keyFile, cert := tlstest.NewCertFilesT(t)
+
+	t.Run("happy", func(t *testing.T) {
+		pinnedCerts := map[string]string{ws.Accs[0].Address().String(): colonSeparated(tls.CertFingerprint(cert))}
+		cfg := tls.Config{CertFile: certFile, KeyFile: keyFile, CACertFile: certFile, PinnedCerts: pinnedCerts}
+		_, err := tls.NewTLSBackend(cfg, ws.WalletBackend)
+		require.NoError(t, err)
+	})
+
+	t.Run("no_cert_listen", func(t *testing.T) {
+		backend, err := tls.NewTLSBackend(tls.Config{}, ws.WalletBackend)
+		require.NoError(t, err)
+		_, err = backend.NewListener(freeAddr(t))
+		assert.True(t, errors.Is(err, tls.ErrCertNotConfigured), err)
+	})
+
+	tests := []struct {
+		name string
+		cfg  tls.Config
+	}{
+		{"missing_key_file", tls.Config{CertFile: certFile}},
+		{"invalid_ca_cert_file", tls.Config{CACertFile: keyFile}},
+		{"identity_with_cert_files", tls.Config{CertFile: certFile, KeyFile: keyFile, IdentityAcc: ws.Accs[0]}},
+		{"invalid_pinned_addr", tls.Config{PinnedCerts: map[string]string{"invalid-addr": tls.CertFingerprint(cert)}}},
+		{"invalid_fingerprint", tls.Config{PinnedCerts: map[string]string{ws.Accs[0].Address().String(): "0x1234"}}},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tls.NewTLSBackend(tc.cfg, ws.WalletBackend)
+			assert.Error(t, err)
+			t.Log(err)
+		})
+	}
+}
+
+func Test_Backend_CA(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 2)
+	certFile, keyFile, _ := tlstest.NewCertFilesT(t)
+	listenerBackend := newBackend(t, ws.WalletBackend, tls.Config{CertFile: certFile, KeyFile: keyFile})
+
+	t.Run("happy", func(t *testing.T) {
+		dialerBackend := newBackend(t, ws.WalletBackend, tls.Config{CACertFile: certFile})
+		dialErr, recvErr := exchange(t, listenerBackend, dialerBackend, ws.Accs[0].Address(), ws.Accs[1].Address())
+		assert.NoError(t, dialErr)
+		assert.NoError(t, recvErr)
+	})
+
+	t.Run("unknown_authority", func(t *testing.T) {
+		dialerBackend := newBackend(t, ws.WalletBackend, tls.Config{})
+		dialErr, _ := exchange(t, listenerBackend, dialerBackend, ws.Accs[0].Address(), ws.Accs[1].Address())
+		assert.Error(t, dialErr)
+		t.Log(dialErr)
+	})
+}
+
+func Test_Backend_PinnedCerts(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 2)
+	listenerAddr, dialerAddr := ws.Accs[0].Address(), ws.Accs[1].Address()
+	listenerCertFile, listenerKeyFile, listenerCert := tlstest.NewCertFilesT(t)
+	dialerCertFile, dialerKeyFile, dialerCert := tlstest.NewCertFilesT(t)
+	dialerCfg := tls.Config{CertFile: dialerCertFile, KeyFile: dialerKeyFile}
+	listenerCfg := tls.Config{CertFile: listenerCertFile, KeyFile: listenerKeyFile}
+
+	t.Run("happy_pinned_by_dialer", func(t *testing.T) {
+		cfg := dialerCfg
+		cfg.PinnedCerts = map[string]string{listenerAddr.String(): colonSeparated(tls.CertFingerprint(listenerCert))}
+		dialErr, recvErr := exchange(t, newBackend(t, ws.WalletBackend, listenerCfg),
+			newBackend(t, ws.WalletBackend, cfg), listenerAddr, dialerAddr)
+		assert.NoError(t, dialErr)
+		assert.NoError(t, recvErr)
+	})
+
+	t.Run("happy_pinned_by_listener", func(t *testing.T) {
+		cfg := listenerCfg
+		cfg.PinnedCerts = map[string]string{dialerAddr.String(): tls.CertFingerprint(dialerCert)}
+		dialerCfg := dialerCfg
+		dialerCfg.CACertFile = listenerCertFile
+		dialErr, recvErr := exchange(t, newBackend(t, ws.WalletBackend, cfg),
+			newBackend(t, ws.WalletBackend, dialerCfg), listenerAddr, dialerAddr)
+		assert.NoError(t, dialErr)
+		assert.NoError(t, recvErr)
+	})
+
+	t.Run("not_pinned_cert_dialer", func(t *testing.T) {
+		cfg := dialerCfg
+		cfg.PinnedCerts = map[string]string{listenerAddr.String(): tls.CertFingerprint(dialerCert)}
+		dialErr, _ := exchange(t, newBackend(t, ws.WalletBackend, listenerCfg),
+			newBackend(t, ws.WalletBackend, cfg), listenerAddr, dialerAddr)
+		assert.True(t, errors.Is(dialErr, tls.ErrPeerCertNotPinned), dialErr)
+	})
+
+	t.Run("not_pinned_cert_listener", func(t *testing.T) {
+		cfg := listenerCfg
+		cfg.PinnedCerts = map[string]string{dialerAddr.String(): tls.CertFingerprint(listenerCert)}
+		dialerCfg := dialerCfg
+		dialerCfg.CACertFile = listenerCertFile
+		_, recvErr := exchange(t, newBackend(t, ws.WalletBackend, cfg),
+			newBackend(t, ws.WalletBackend, dialerCfg), listenerAddr, dialerAddr)
+		assert.True(t, errors.Is(recvErr, tls.ErrPeerCertNotPinned), recvErr)
+	})
+
+	t.Run("missing_cert_listener", func(t *testing.T) {
+		cfg := listenerCfg
+		cfg.PinnedCerts = map[string]string{dialerAddr.String(): tls.CertFingerprint(dialerCert)}
+		_, recvErr := exchange(t, newBackend(t, ws.WalletBackend, cfg),
+			newBackend(t, ws.WalletBackend, tls.Config{CACertFile: listenerCertFile}), listenerAddr, dialerAddr)
+		assert.True(t, errors.Is(recvErr, tls.ErrPeerCertMissing), recvErr)
+	})
+}
+
+func Test_Backend_OffChainIdentity(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 3)
+	listenerBackend := newBackend(t, ws.WalletBackend, tls.Config{IdentityAcc: ws.Accs[0]})
+	dialerBackend := newBackend(t, ws.WalletBackend, tls.Config{IdentityAcc: ws.Accs[1]})
+
+	t.Run("happy", func(t *testing.T) {
+		dialErr, recvErr := exchange(t, listenerBackend, dialerBackend, ws.Accs[0].Address(), ws.Accs[1].Address())
+		assert.NoError(t, dialErr)
+		assert.NoError(t, recvErr)
+	})
+
+	t.Run("listener_identity_mismatch", func(t *testing.T) {
+		dialErr, _ := exchange(t, listenerBackend, dialerBackend, ws.Accs[2].Address(), ws.Accs[1].Address())
+		assert.True(t, errors.Is(dialErr, tls.ErrPeerIdentityInvalid), dialErr)
+	})
+
+	t.Run("dialer_identity_mismatch", func(t *testing.T) {
+		dialErr, recvErr := exchange(t, listenerBackend, dialerBackend, ws.Accs[0].Address(), ws.Accs[2].Address())
+		require.NoError(t, dialErr)
+		assert.True(t, errors.Is(recvErr, tls.ErrPeerIdentityInvalid), recvErr)
+	})
+
+	t.Run("dialer_without_identity", func(t *testing.T) {
+		certFile, keyFile, _ := tlstest.NewCertFilesT(t)
+		backend := newBackend(t, ws.WalletBackend, tls.Config{CertFile: certFile, KeyFile: keyFile})
+		_, recvErr := exchange(t, listenerBackend, backend, ws.Accs[0].Address(), ws.Accs[1].Address())
+		assert.Error(t, recvErr)
+	})
+}
+
+func newBackend(t *testing.T, wb perun.WalletBackend, cfg tls.Config) *tls.Backend {
+	cfg.DialerTimeout = tcptest.DialerTimeout
+	backend, err := tls.NewTLSBackend(cfg, wb)
+	require.NoError(t, err)
+	return backend
+}
+
+// exchange starts a listener using the listener backend, dials it using the dialer backend and sends an
+// envelope from the sender to the recipient. It returns the errors in dialing and in receiving the envelope
+// at the listener.
+func exchange(t *testing.T, listenerBackend, dialerBackend *tls.Backend, recipient, sender pwallet.Address) (
+	dialErr, recvErr error) {
+	t.Helper()
+	addr := freeAddr(t)
+	listener, err := listenerBackend.NewListener(addr)
+	require.NoError(t, err)
+	defer listener.Close() // nolint: errcheck
+
+	received := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			received <- err
+			return
+		}
+		defer conn.Close() // nolint: errcheck
+		e, err := conn.Recv()
+		if err == nil && !e.Sender.Equals(sender) {
+			err = errors.New("envelope not from sender")
+		}
+		received <- err
+	}()
+
+	dialer := dialerBackend.NewDialer()
+	defer dialer.Close() // nolint: errcheck
+	dialer.Register(recipient, addr)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	conn, dialErr := dialer.Dial(ctx, recipient)
+	if dialErr == nil {
+		defer conn.Close() // nolint: errcheck
+		require.NoError(t, conn.Send(&pwire.Envelope{Sender: sender, Recipient: recipient, Msg: pwire.NewPingMsg()}))
+	}
+
+	select {
+	case recvErr = <-received:
+	case <-time.After(time.Second):
+		t.Fatal("envelope not received by the listener")
+	}
+	return dialErr, recvErr
+}
+
+func freeAddr(t *testing.T) string {
+	port, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	return fmt.Sprintf("127.0.0.1:%d", port)
+}
+
+// colonSeparated returns the fingerprint in the upper case and colon separated format, as printed by openssl.
+func colonSeparated(fingerprint string) string {
+	pairs := make([]string, 0, len(fingerprint)/2)
+	for i := 0; i+1 < len(fingerprint); i += 2 {
+		pairs = append(pairs, strings.ToUpper(fingerprint[i:i+2]))
+	}
+	return strings.Join(pairs, ":")
+}
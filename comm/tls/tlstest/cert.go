@@ -0,0 +1,105 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlstest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// NewCertFilesT is the test friendly version of NewCertFiles.
+// It uses the passed testing.T to handle the errors and registers the cleanup functions on it.
+func NewCertFilesT(t *testing.T) (certFile, keyFile string, cert *x509.Certificate) {
+	certFile, keyFile, cert, err := NewCertFiles()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := os.RemoveAll(filepath.Dir(certFile)); err != nil {
+			t.Log("Error in test cleanup: removing directory - " + filepath.Dir(certFile))
+		}
+	})
+	return certFile, keyFile, cert
+}
+
+// NewCertFiles generates a self-signed certificate valid for 127.0.0.1 and localhost and writes it, along
+// with its private key, as PEM encoded files in a new temp directory. It returns the paths of the files and
+// the certificate.
+//
+// As the certificate is self-signed, the certificate file can also be used as the CA certificate file.
+func NewCertFiles() (certFile, keyFile string, cert *x509.Certificate, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", nil, errors.Wrap(err, "generating key")
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return "", "", nil, errors.Wrap(err, "generating serial number")
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: "perun-node test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		DNSNames:              []string{"localhost"},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", "", nil, errors.Wrap(err, "creating certificate")
+	}
+	if cert, err = x509.ParseCertificate(certDER); err != nil {
+		return "", "", nil, errors.Wrap(err, "parsing certificate")
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", "", nil, errors.Wrap(err, "encoding key")
+	}
+
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		return "", "", nil, errors.Wrap(err, "creating temp dir")
+	}
+	certFile, keyFile = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	if err = ioutil.WriteFile(certFile, certPEM, 0o600); err != nil {
+		os.RemoveAll(dir) // nolint: errcheck
+		return "", "", nil, errors.Wrap(err, "writing certificate file")
+	}
+	if err = ioutil.WriteFile(keyFile, keyPEM, 0o600); err != nil {
+		os.RemoveAll(dir) // nolint: errcheck
+		return "", "", nil, errors.Wrap(err, "writing key file")
+	}
+	return certFile, keyFile, cert, nil
+}
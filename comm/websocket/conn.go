@@ -0,0 +1,93 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"perun.network/go-perun/pkg/sync/atomic"
+	pwire "perun.network/go-perun/wire"
+)
+
+// closeTimeout is the time allowed for sending the close message, when closing the connection.
+const closeTimeout = time.Second
+
+// MaxMsgSize is the maximum size in bytes of a message that will be received from a peer. It is large enough
+// for the envelopes exchanged by the node, including channel proposals and updates with app data. Larger
+// messages are rejected and the connection is closed, so that a peer cannot make the node allocate unbounded
+// memory.
+const MaxMsgSize = 4 << 20 // 4 MiB.
+
+// conn is a websocket connection to a peer. Each envelope is sent as a binary websocket message.
+//
+// It implements pnet.Conn interface.
+type conn struct {
+	closed atomic.Bool
+	wsConn *websocket.Conn
+}
+
+func newConn(wsConn *websocket.Conn) *conn {
+	wsConn.SetReadLimit(MaxMsgSize)
+	return &conn{wsConn: wsConn}
+}
+
+// Send sends the envelope to the peer as a binary message. If an error occurs, the connection is closed.
+func (c *conn) Send(e *pwire.Envelope) error {
+	var buf bytes.Buffer
+	if err := e.Encode(&buf); err != nil {
+		c.Close() // nolint: errcheck, gosec  // error in sending is returned.
+		return errors.WithMessage(err, "encoding envelope")
+	}
+	if err := c.wsConn.WriteMessage(websocket.BinaryMessage, buf.Bytes()); err != nil {
+		c.Close() // nolint: errcheck, gosec  // error in sending is returned.
+		return errors.Wrap(err, "sending message")
+	}
+	return nil
+}
+
+// Recv receives an envelope from the peer. If an error occurs, the connection is closed.
+func (c *conn) Recv() (*pwire.Envelope, error) {
+	msgType, data, err := c.wsConn.ReadMessage()
+	if err == nil && msgType != websocket.BinaryMessage {
+		err = errors.Errorf("expected binary message, got message of type %d", msgType)
+	}
+	if err != nil {
+		c.Close() // nolint: errcheck, gosec  // error in receiving is returned.
+		return nil, errors.Wrap(err, "receiving message")
+	}
+
+	var e pwire.Envelope
+	if err = e.Decode(bytes.NewReader(data)); err != nil {
+		c.Close() // nolint: errcheck, gosec  // error in receiving is returned.
+		return nil, errors.WithMessage(err, "decoding envelope")
+	}
+	return &e, nil
+}
+
+// Close sends a close message to the peer and closes the connection. It aborts any ongoing Send and Recv calls.
+// Repeated calls to Close result in an error.
+func (c *conn) Close() error {
+	if !c.closed.TrySet() {
+		return errors.New("already closed")
+	}
+	closeMsg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	c.wsConn.WriteControl(websocket.CloseMessage, closeMsg, time.Now().Add(closeTimeout)) // nolint: errcheck, gosec
+	return c.wsConn.Close()
+}
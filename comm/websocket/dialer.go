@@ -0,0 +1,106 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket
+
+import (
+	"context"
+	gotls "crypto/tls"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	pkgsync "perun.network/go-perun/pkg/sync"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+)
+
+// Dialer is a lookup-table based dialer that can dial known peers at their websocket URLs.
+// New peer addresses can be added via Register().
+//
+// It implements perun.Dialer interface.
+type Dialer struct {
+	dialer *websocket.Dialer
+
+	mutex sync.RWMutex
+	peers map[pwallet.AddrKey]string // Known peer URLs.
+
+	pkgsync.Closer
+}
+
+func newDialer(timeout time.Duration, tlsConfig *gotls.Config) *Dialer {
+	return &Dialer{
+		dialer: &websocket.Dialer{
+			Proxy:            websocket.DefaultDialer.Proxy,
+			HandshakeTimeout: timeout,
+			TLSClientConfig:  tlsConfig,
+		},
+		peers: make(map[pwallet.AddrKey]string),
+	}
+}
+
+// Register registers the websocket URL for the off-chain address of the peer.
+func (d *Dialer) Register(offChainAddr pwire.Address, commAddr string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.peers[pwallet.Key(offChainAddr)] = commAddr
+}
+
+func (d *Dialer) get(key pwallet.AddrKey) (string, bool) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	u, ok := d.peers[key]
+	return u, ok
+}
+
+// Dial dials the peer at its registered websocket URL. It implements pnet.Dialer.Dial().
+func (d *Dialer) Dial(ctx context.Context, addr pwire.Address) (pnet.Conn, error) {
+	done := make(chan struct{})
+	defer close(done)
+
+	rawURL, ok := d.get(pwallet.Key(addr))
+	if !ok {
+		return nil, errors.New("peer not found")
+	}
+	u, err := parseURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Combine the provided context with the Dialer's Closer, as done in go-perun's simple dialer.
+	wrappedCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+
+		select {
+		case <-d.Closed():
+		case <-done:
+		}
+	}()
+
+	wsConn, resp, err := d.dialer.DialContext(wrappedCtx, u.String(), nil)
+	if resp != nil && resp.Body != nil {
+		resp.Body.Close() // nolint: errcheck, gosec  // body is not used.
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial peer")
+	}
+	return newConn(wsConn), nil
+}
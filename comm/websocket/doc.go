@@ -0,0 +1,25 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package websocket implements the off-chain communication backend to initialize adapters for
+// websocket communication protocol.
+//
+// The comm addresses are websocket URLs (for example, ws://127.0.0.1:5751/perun or wss://example.com/perun).
+// The listener serves an HTTP endpoint at the path in the URL that upgrades the requests to websocket
+// connections and the dialer connects to the URL. Each wire message is sent as a binary websocket message.
+//
+// As the connections use HTTP, they can pass through networks that allow only HTTP(S) traffic.
+package websocket
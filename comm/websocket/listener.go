@@ -0,0 +1,107 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket
+
+import (
+	gotls "crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	pkgsync "perun.network/go-perun/pkg/sync"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+// readHeaderTimeout is the time allowed for reading the headers of the upgrade requests.
+const readHeaderTimeout = 10 * time.Second
+
+// listener serves an HTTP endpoint that upgrades the requests to websocket connections.
+//
+// It implements pnet.Listener interface.
+type listener struct {
+	log.Logger
+
+	server   *http.Server
+	upgrader websocket.Upgrader
+	conns    chan *websocket.Conn
+
+	pkgsync.Closer
+}
+
+func newListener(u *url.URL, tlsConfig *gotls.Config) (*listener, error) {
+	netListener, err := net.Listen("tcp", u.Host)
+	if err != nil {
+		return nil, errors.Wrap(err, "listening at "+u.Host)
+	}
+	if tlsConfig != nil {
+		netListener = gotls.NewListener(netListener, tlsConfig)
+	}
+
+	l := &listener{
+		Logger: log.NewLoggerWithField("component", "websocket-listener"),
+		conns:  make(chan *websocket.Conn),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(u.Path, l.handleUpgrade)
+	l.server = &http.Server{Handler: mux, ReadHeaderTimeout: readHeaderTimeout}
+
+	go func() {
+		if err := l.server.Serve(netListener); err != nil && err != http.ErrServerClosed {
+			l.Errorf("Serving websocket endpoint: %v", err)
+		}
+	}()
+	return l, nil
+}
+
+// handleUpgrade upgrades the request to a websocket connection and passes it to Accept.
+func (l *listener) handleUpgrade(w http.ResponseWriter, r *http.Request) {
+	wsConn, err := l.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrader has already responded with an HTTP error.
+		l.Debugf("Upgrading request from %s: %v", r.RemoteAddr, err)
+		return
+	}
+	select {
+	case l.conns <- wsConn:
+	case <-l.Closed():
+		wsConn.Close() // nolint: errcheck, gosec  // listener is closed, connection is discarded.
+	}
+}
+
+// Accept waits for an incoming websocket connection and returns it. It implements pnet.Listener.Accept().
+func (l *listener) Accept() (pnet.Conn, error) {
+	select {
+	case wsConn := <-l.conns:
+		return newConn(wsConn), nil
+	case <-l.Closed():
+		return nil, errors.New("accept failed: listener closed")
+	}
+}
+
+// Close stops serving the websocket endpoint and aborts any ongoing Accept call. Accepted connections
+// are not closed. It implements pnet.Listener.Close().
+func (l *listener) Close() error {
+	if err := l.Closer.Close(); err != nil {
+		return err
+	}
+	return errors.Wrap(l.server.Close(), "stopping server")
+}
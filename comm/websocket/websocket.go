@@ -0,0 +1,132 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket
+
+import (
+	gotls "crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// Schemes of the websocket URLs.
+const (
+	SchemeWS  = "ws"
+	SchemeWSS = "wss"
+)
+
+type (
+	// Config defines the parameters required to configure the websocket comm backend.
+	Config struct {
+		// Paths to the PEM encoded certificate and private key. These are required for listening at
+		// wss:// URLs.
+		CertFile string
+		KeyFile  string
+
+		// Path to the PEM encoded CA certificates used for verifying the servers when dialing wss:// URLs.
+		// If empty, system roots are used.
+		CACertFile string
+
+		// Timeout to be used when dialing for new outgoing connections, including the websocket handshake.
+		DialerTimeout time.Duration
+	}
+
+	// Backend is an off-chain communication backend that implements `CommBackend` for
+	// for websocket protocol. It stores configuration required for initializing the adapters.
+	Backend struct {
+		cert          *gotls.Certificate // nil if not configured.
+		rootCAs       *x509.CertPool     // nil if system roots are to be used.
+		dialerTimeout time.Duration
+	}
+)
+
+// NewWebSocketBackend returns a backend that can initialize off-chain communication
+// adapters for websocket protocol.
+func NewWebSocketBackend(cfg Config) (*Backend, error) {
+	b := &Backend{dialerTimeout: cfg.DialerTimeout}
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := gotls.LoadX509KeyPair(filepath.Clean(cfg.CertFile), filepath.Clean(cfg.KeyFile))
+		if err != nil {
+			return nil, errors.Wrap(err, "loading certificate and key")
+		}
+		b.cert = &cert
+	}
+	if cfg.CACertFile != "" {
+		caCerts, err := ioutil.ReadFile(filepath.Clean(cfg.CACertFile))
+		if err != nil {
+			return nil, errors.Wrap(err, "reading CA certificates")
+		}
+		b.rootCAs = x509.NewCertPool()
+		if !b.rootCAs.AppendCertsFromPEM(caCerts) {
+			return nil, errors.New("no valid CA certificates in " + cfg.CACertFile)
+		}
+	}
+	return b, nil
+}
+
+// NewListener returns a listener that serves the websocket endpoint at the specified URL. Scheme of the URL
+// should be ws or wss. For wss, the certificate and key should have been configured.
+func (b *Backend) NewListener(addr string) (pnet.Listener, error) {
+	u, err := parseURL(addr)
+	if err != nil {
+		return nil, err
+	}
+	var tlsConfig *gotls.Config
+	if u.Scheme == SchemeWSS {
+		if b.cert == nil {
+			return nil, errors.New("certificate not configured, cannot listen at wss:// url")
+		}
+		tlsConfig = &gotls.Config{MinVersion: gotls.VersionTLS12, Certificates: []gotls.Certificate{*b.cert}}
+	}
+	listener, err := newListener(u, tlsConfig)
+	return listener, errors.WithMessage(err, "initializing listener")
+}
+
+// NewDialer returns a dialer that can dial outgoing connections to websocket URLs.
+//
+// It uses the dial timeout configured during backend initialization.
+// If the duration was set to zero, this program will not use any timeout.
+// However default timeouts based on the operating system will still apply.
+func (b *Backend) NewDialer() perun.Dialer {
+	tlsConfig := &gotls.Config{MinVersion: gotls.VersionTLS12, RootCAs: b.rootCAs}
+	return newDialer(b.dialerTimeout, tlsConfig)
+}
+
+// parseURL parses the websocket URL and validates its scheme. If the path is empty, it is set to "/".
+func parseURL(addr string) (*url.URL, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing websocket url")
+	}
+	if u.Scheme != SchemeWS && u.Scheme != SchemeWSS {
+		return nil, errors.Errorf("websocket url %s should begin with %s:// or %s://", addr, SchemeWS, SchemeWSS)
+	}
+	if u.Host == "" {
+		return nil, errors.Errorf("websocket url %s should have a host", addr)
+	}
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	return u, nil
+}
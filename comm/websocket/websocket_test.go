@@ -0,0 +1,263 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package websocket_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	gorillaws "github.com/gorilla/websocket"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pwire "perun.network/go-perun/wire"
+	pnet "perun.network/go-perun/wire/net"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/comm/tcp/tcptest"
+	"github.com/hyperledger-labs/perun-node/comm/tls/tlstest"
+	"github.com/hyperledger-labs/perun-node/comm/websocket"
+)
+
+func Test_CommBackend_Interface(t *testing.T) {
+	assert.Implements(t, (*perun.CommBackend)(nil), new(websocket.Backend))
+	assert.Implements(t, (*perun.Dialer)(nil), new(websocket.Dialer))
+}
+
+func Test_Backend(t *testing.T) {
+	backend, err := websocket.NewWebSocketBackend(websocket.Config{DialerTimeout: tcptest.DialerTimeout})
+	require.NoError(t, err)
+	require.NotNil(t, backend)
+
+	listenerAddr := freeURL(t, websocket.SchemeWS)
+	listener, err := backend.NewListener(listenerAddr)
+	t.Cleanup(func() {
+		if err = listener.Close(); err != nil {
+			t.Log("Error closing listener at address - " + listenerAddr)
+		}
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, listener)
+
+	dialer := backend.NewDialer()
+	assert.NotNil(t, dialer)
+}
+
+func Test_NewWebSocketBackend(t *testing.T) {
+	certFile, keyFile, _ := tlstest.NewCertFilesT(t)
+
+	t.Run("happy", func(t *testing.T) {
+		cfg := websocket.Config{CertFile: certFile, KeyFile: keyFile, CACertFile: certFile}
+		_, err := websocket.NewWebSocketBackend(cfg)
+		assert.NoError(t, err)
+	})
+	t.Run("missing_key_file", func(t *testing.T) {
+		_, err := websocket.NewWebSocketBackend(websocket.Config{CertFile: certFile})
+		assert.Error(t, err)
+	})
+	t.Run("invalid_ca_cert_file", func(t *testing.T) {
+		_, err := websocket.NewWebSocketBackend(websocket.Config{CACertFile: keyFile})
+		assert.Error(t, err)
+	})
+}
+
+func Test_Backend_NewListener_Errors(t *testing.T) {
+	backend, err := websocket.NewWebSocketBackend(websocket.Config{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		addr string
+	}{
+		{"no_scheme", "127.0.0.1:5751"},
+		{"invalid_scheme", "http://127.0.0.1:5751/perun"},
+		{"no_host", "ws:///perun"},
+		{"wss_without_cert", freeURL(t, websocket.SchemeWSS)},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := backend.NewListener(tc.addr)
+			assert.Error(t, err)
+			t.Log(err)
+		})
+	}
+}
+
+func Test_Backend_Exchange(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	certFile, keyFile, _ := tlstest.NewCertFilesT(t)
+	listenerBackend, err := websocket.NewWebSocketBackend(websocket.Config{CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, err)
+
+	t.Run("happy_ws", func(t *testing.T) {
+		dialerBackend, err := websocket.NewWebSocketBackend(websocket.Config{DialerTimeout: tcptest.DialerTimeout})
+		require.NoError(t, err)
+		assertExchange(t, listenerBackend, dialerBackend, freeURL(t, websocket.SchemeWS), rng)
+	})
+
+	t.Run("happy_wss", func(t *testing.T) {
+		cfg := websocket.Config{CACertFile: certFile, DialerTimeout: tcptest.DialerTimeout}
+		dialerBackend, err := websocket.NewWebSocketBackend(cfg)
+		require.NoError(t, err)
+		assertExchange(t, listenerBackend, dialerBackend, freeURL(t, websocket.SchemeWSS), rng)
+	})
+
+	t.Run("wss_unknown_authority", func(t *testing.T) {
+		dialerBackend, err := websocket.NewWebSocketBackend(websocket.Config{DialerTimeout: tcptest.DialerTimeout})
+		require.NoError(t, err)
+		addr := freeURL(t, websocket.SchemeWSS)
+		newListener(t, listenerBackend, addr)
+		_, err = dial(dialerBackend, ethereumtest.NewRandomAddress(rng), addr)
+		assert.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("wrong_path", func(t *testing.T) {
+		dialerBackend, err := websocket.NewWebSocketBackend(websocket.Config{DialerTimeout: tcptest.DialerTimeout})
+		require.NoError(t, err)
+		addr := freeURL(t, websocket.SchemeWS)
+		newListener(t, listenerBackend, addr)
+		_, err = dial(dialerBackend, ethereumtest.NewRandomAddress(rng), addr+"-other")
+		assert.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("peer_not_registered", func(t *testing.T) {
+		dialer := listenerBackend.NewDialer()
+		_, err := dialer.Dial(context.Background(), ethereumtest.NewRandomAddress(rng))
+		assert.Error(t, err)
+	})
+
+	t.Run("dialer_closed", func(t *testing.T) {
+		addr := freeURL(t, websocket.SchemeWS)
+		newListener(t, listenerBackend, addr)
+		dialer := listenerBackend.NewDialer()
+		peer := ethereumtest.NewRandomAddress(rng)
+		dialer.Register(peer, addr)
+		require.NoError(t, dialer.Close())
+
+		_, err := dialer.Dial(context.Background(), peer)
+		assert.Error(t, err)
+	})
+
+	t.Run("msg_too_large", func(t *testing.T) {
+		addr := freeURL(t, websocket.SchemeWS)
+		listener := newListener(t, listenerBackend, addr)
+		accepted := make(chan pnet.Conn, 1)
+		go func() {
+			conn, err := listener.Accept()
+			assert.NoError(t, err)
+			accepted <- conn
+		}()
+		wsConn, _, err := gorillaws.DefaultDialer.Dial(addr, nil)
+		require.NoError(t, err)
+		defer wsConn.Close() // nolint: errcheck
+
+		var listenerConn pnet.Conn
+		select {
+		case listenerConn = <-accepted:
+			require.NotNil(t, listenerConn)
+		case <-time.After(time.Second):
+			t.Fatal("connection not accepted by the listener")
+		}
+		// Write in a separate go-routine, as it blocks until the message is read.
+		go wsConn.WriteMessage(gorillaws.BinaryMessage, make([]byte, websocket.MaxMsgSize+1)) // nolint: errcheck
+		_, err = listenerConn.Recv()
+		assert.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("listener_closed", func(t *testing.T) {
+		listener, err := listenerBackend.NewListener(freeURL(t, websocket.SchemeWS))
+		require.NoError(t, err)
+		require.NoError(t, listener.Close())
+		_, err = listener.Accept()
+		assert.Error(t, err)
+		assert.Error(t, listener.Close())
+	})
+}
+
+// assertExchange asserts that envelopes can be exchanged in both directions between a listener at the given
+// url and a dialer.
+func assertExchange(t *testing.T, listenerBackend, dialerBackend *websocket.Backend, addr string, rng *rand.Rand) {
+	t.Helper()
+	listener := newListener(t, listenerBackend, addr)
+	listenerAddr, dialerAddr := ethereumtest.NewRandomAddress(rng), ethereumtest.NewRandomAddress(rng)
+
+	accepted := make(chan pnet.Conn, 1)
+	go func() {
+		conn, err := listener.Accept()
+		assert.NoError(t, err)
+		accepted <- conn
+	}()
+	dialerConn, err := dial(dialerBackend, listenerAddr, addr)
+	require.NoError(t, err)
+	defer dialerConn.Close() // nolint: errcheck
+
+	var listenerConn pnet.Conn
+	select {
+	case listenerConn = <-accepted:
+		require.NotNil(t, listenerConn)
+		defer listenerConn.Close() // nolint: errcheck
+	case <-time.After(time.Second):
+		t.Fatal("connection not accepted by the listener")
+	}
+
+	sent := &pwire.Envelope{Sender: dialerAddr, Recipient: listenerAddr, Msg: pwire.NewPingMsg()}
+	require.NoError(t, dialerConn.Send(sent))
+	received, err := listenerConn.Recv()
+	require.NoError(t, err)
+	assert.True(t, received.Sender.Equals(dialerAddr))
+	assert.Equal(t, sent.Msg.Type(), received.Msg.Type())
+
+	sent = &pwire.Envelope{Sender: listenerAddr, Recipient: dialerAddr, Msg: pwire.NewPongMsg()}
+	require.NoError(t, listenerConn.Send(sent))
+	received, err = dialerConn.Recv()
+	require.NoError(t, err)
+	assert.True(t, received.Sender.Equals(listenerAddr))
+	assert.Equal(t, sent.Msg.Type(), received.Msg.Type())
+}
+
+func dial(backend *websocket.Backend, peer pwire.Address, addr string) (pnet.Conn, error) {
+	dialer := backend.NewDialer()
+	dialer.Register(peer, addr)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	return dialer.Dial(ctx, peer)
+}
+
+func newListener(t *testing.T, backend *websocket.Backend, addr string) pnet.Listener {
+	listener, err := backend.NewListener(addr)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := listener.Close(); err != nil {
+			t.Log("Error closing listener at address - " + addr)
+		}
+	})
+	return listener
+}
+
+func freeURL(t *testing.T, scheme string) string {
+	port, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	return fmt.Sprintf("%s://127.0.0.1:%d/perun", scheme, port)
+}
@@ -284,6 +284,35 @@ func NewAPIErrInvalidContracts(contractErrInfos ...ContractErrInfo) APIError {
 	)
 }
 
+// SpendingLimitType is used to enumerate valid limit types in the
+// SpendingLimitExceeded error.
+//
+// The enumeration of valid constants should be defined in the package using
+// the error constructors.
+type SpendingLimitType string
+
+// NewAPIErrSpendingLimitExceeded returns an ErrSpendingLimitExceeded API Error
+// with the given limit type, peer alias, currency, limit, usage in the time
+// window of the limit and the requested amount.
+func NewAPIErrSpendingLimitExceeded(limitType SpendingLimitType, peerAlias, currency, limit, usage,
+	amount string) APIError {
+	message := fmt.Sprintf("%s limit of %s %s for %s exceeded: used %s, requested %s",
+		limitType, limit, currency, peerAlias, usage, amount)
+	return NewAPIErr(
+		ClientError,
+		ErrSpendingLimitExceeded,
+		errors.New(message),
+		ErrInfoSpendingLimitExceeded{
+			LimitType: string(limitType),
+			PeerAlias: peerAlias,
+			Currency:  currency,
+			Limit:     limit,
+			Usage:     usage,
+			Amount:    amount,
+		},
+	)
+}
+
 // NewAPIErrTxTimedOut returns an ErrTxTimedOut API Error with the given
 // error message.
 func NewAPIErrTxTimedOut(err error, txType, txID, txTimeout string) APIError {
@@ -230,6 +230,18 @@ func Test_NewErrInvalidContracts(t *testing.T) {
 	peruntest.AssertErrInfoInvalidContracts(t, apiErr.AddInfo(), contractErrInfos)
 }
 
+func Test_NewErrSpendingLimitExceeded(t *testing.T) {
+	limitType := perun.SpendingLimitType("any-type")
+	peerAlias, currency, limit, usage, amount := "any-peer", "any-currency", "10", "8", "3"
+	wantMsg := fmt.Sprintf("%s limit of %s %s for %s exceeded: used %s, requested %s",
+		limitType, limit, currency, peerAlias, usage, amount)
+
+	apiErr := perun.NewAPIErrSpendingLimitExceeded(limitType, peerAlias, currency, limit, usage, amount)
+	peruntest.AssertAPIError(t, apiErr, perun.ClientError, perun.ErrSpendingLimitExceeded, wantMsg)
+	peruntest.AssertErrInfoSpendingLimitExceeded(t, apiErr.AddInfo(), limitType, peerAlias, currency, limit, usage,
+		amount)
+}
+
 func Test_NewErrTxTimedOut(t *testing.T) {
 	txType := "any-type"
 	txID := "any-id"
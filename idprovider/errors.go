@@ -30,4 +30,9 @@ const (
 	ErrPeerAliasAlreadyUsed    Error = "peer alias is already used for another peer id"
 	ErrPeerIDAlreadyRegistered Error = "peer id already regsitered"
 	ErrParsingOffChainAddress  Error = "parsing off-chain address"
+	ErrPeerIDSignatureMissing  Error = "peer id signature missing"
+	ErrPeerIDSignatureInvalid  Error = "invalid peer id signature"
+	ErrOffChainAddrAlreadyUsed Error = "off-chain address is already used for another peer alias"
+	ErrDuplicateRecord         Error = "alias or off-chain address is repeated in the imported records"
+	ErrUnsupportedFormat       Error = "unsupported format for importing or exporting peer ids"
 )
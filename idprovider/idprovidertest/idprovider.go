@@ -26,6 +26,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/idprovider/local"
 )
 
 // NewIDProviderT is the test friendly version of NewIDProvider.
@@ -37,6 +38,12 @@ func NewIDProviderT(t *testing.T, peerIDs ...perun.PeerID) string {
 		if err = os.Remove(idProviderFile); err != nil {
 			t.Log("Error in test cleanup: removing file - " + idProviderFile)
 		}
+		// Remove the backup and lock files, if created by the ID provider when updating the file.
+		for _, suffix := range []string{local.BackupFileSuffix, local.LockFileSuffix} {
+			if err = os.Remove(idProviderFile + suffix); err != nil && !os.IsNotExist(err) {
+				t.Log("Error in test cleanup: removing file - " + idProviderFile + suffix)
+			}
+		}
 	})
 	return idProviderFile
 }
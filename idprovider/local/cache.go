@@ -17,6 +17,7 @@
 package local
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -31,27 +32,46 @@ import (
 type idProviderCache struct {
 	mutex          sync.RWMutex
 	walletBackend  perun.WalletBackend
+	requireSigned  bool                    // If true, peer IDs without a valid signature are rejected.
 	peerIDsByAlias map[string]perun.PeerID // Stores a list of peer IDs indexed by Alias.
 	aliasByAddr    map[string]string       // Stores a list of alias, indexed by off-chain address string.
 }
 
 // newIDProviderCache returns a ID Provider cache created from the given map. It indexes the Peer IDs by both alias and
-// off-chain address. The off-chain address strings are decoded using the passed backend.
-func newIDProviderCache(peerIDsByAlias map[string]perun.PeerID, backend perun.WalletBackend) (*idProviderCache, error) {
-	var err error
-	aliasByAddr := make(map[string]string)
+// off-chain address. The off-chain address strings are decoded and the signatures are verified using the passed
+// backend.
+func newIDProviderCache(peerIDsByAlias map[string]perun.PeerID, backend perun.WalletBackend, requireSigned bool) (
+	*idProviderCache, error) {
+	c := &idProviderCache{
+		peerIDsByAlias: peerIDsByAlias,
+		aliasByAddr:    make(map[string]string),
+		walletBackend:  backend,
+		requireSigned:  requireSigned,
+	}
 	for alias, peer := range peerIDsByAlias {
-		if peer.OffChainAddr, err = backend.ParseAddr(peer.OffChainAddrString); err != nil {
-			return nil, err
+		var err error
+		if peer, err = c.validate(peer); err != nil {
+			return nil, errors.WithMessage(err, alias)
 		}
 		peerIDsByAlias[alias] = peer
-		aliasByAddr[peer.OffChainAddrString] = peer.Alias
+		c.aliasByAddr[peer.OffChainAddrString] = peer.Alias
 	}
-	return &idProviderCache{
-		peerIDsByAlias: peerIDsByAlias,
-		aliasByAddr:    aliasByAddr,
-		walletBackend:  backend,
-	}, nil
+	return c, nil
+}
+
+// validate parses the off-chain address of the peer ID and verifies its signature, if it is signed or if
+// signatures are required. It returns the peer ID with the parsed off-chain address.
+func (c *idProviderCache) validate(p perun.PeerID) (perun.PeerID, error) {
+	var err error
+	if p.OffChainAddr, err = c.walletBackend.ParseAddr(p.OffChainAddrString); err != nil {
+		return perun.PeerID{}, errors.Wrap(idprovider.ErrParsingOffChainAddress, err.Error())
+	}
+	if p.Signature != "" || c.requireSigned {
+		if err = idprovider.VerifyPeerID(p, c.walletBackend); err != nil {
+			return perun.PeerID{}, err
+		}
+	}
+	return p, nil
 }
 
 // ReadByAlias returns the peer ID corresponding to given alias from the cache.
@@ -82,8 +102,21 @@ func (c *idProviderCache) ReadByOffChainAddr(offChainAddr pwire.Address) (_ peru
 	return c.readByAlias(alias)
 }
 
+// ReadAll returns all the peer IDs in the cache, sorted by alias.
+func (c *idProviderCache) ReadAll() []perun.PeerID {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	peerIDs := make([]perun.PeerID, 0, len(c.peerIDsByAlias))
+	for _, p := range c.peerIDsByAlias {
+		peerIDs = append(peerIDs, p)
+	}
+	sort.Slice(peerIDs, func(i, j int) bool { return peerIDs[i].Alias < peerIDs[j].Alias })
+	return peerIDs
+}
+
 // Write adds the peer ID to ID Provider cache. Returns an error if the alias is already used by same or different
-// peer ID or if the off-chain address of the peer ID cannot be parsed using the wallet backend of this ID Provider.
+// peer ID, if the off-chain address of the peer ID cannot be parsed using the wallet backend of this ID Provider or
+// if the signature is invalid (or missing, when signatures are required).
 func (c *idProviderCache) Write(alias string, p perun.PeerID) error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
@@ -96,9 +129,8 @@ func (c *idProviderCache) Write(alias string, p perun.PeerID) error {
 	}
 
 	var err error
-	p.OffChainAddr, err = c.walletBackend.ParseAddr(p.OffChainAddrString)
-	if err != nil {
-		return errors.Wrap(idprovider.ErrParsingOffChainAddress, err.Error())
+	if p, err = c.validate(p); err != nil {
+		return err
 	}
 	c.peerIDsByAlias[alias] = p
 	c.aliasByAddr[p.OffChainAddrString] = alias
@@ -27,4 +27,9 @@
 // Latest state of cache can be updated to the file by explicitly calling
 // UpdateStorage method. Normally this should be called before shutting down
 // the node.
+//
+// Changes made to the file by other programs can be merged into the cache by
+// calling Reload method, or automatically by watching the file using Watch
+// method. Changes to entries that were also modified in the cache, but not yet
+// updated to the file, are not applied and are reported as conflicts.
 package local
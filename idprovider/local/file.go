@@ -0,0 +1,113 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// Suffixes added to the path of the ID provider file, to get the paths of the backup and lock files.
+const (
+	BackupFileSuffix = ".bak"
+	LockFileSuffix   = ".lock"
+)
+
+// writeFileAtomic writes the data to the file, such that the file contains either its previous content or the
+// new data, even if the program crashes or the disk is full. A copy of the previous content is kept in
+// the backup file.
+//
+// It writes the data to a temporary file in the same directory, syncs it to the disk and renames it to the file.
+// If the file already exists, it should be writable and its permissions are retained.
+func writeFileAtomic(filePath string, data []byte) error {
+	perm := os.FileMode(0o600)
+	prevData, err := ioutil.ReadFile(filepath.Clean(filePath))
+	switch {
+	case err == nil:
+		fileInfo, err := checkWritable(filePath)
+		if err != nil {
+			return err
+		}
+		perm = fileInfo.Mode().Perm()
+		if err = writeFileSynced(filePath+BackupFileSuffix, prevData, perm); err != nil {
+			return errors.WithMessage(err, "writing backup file")
+		}
+	case !os.IsNotExist(err):
+		return errors.Wrap(err, "reading file for backup")
+	}
+	return writeFileSynced(filePath, data, perm)
+}
+
+// checkWritable returns an error if the file cannot be opened for writing, so that read-only files are not
+// overwritten by renaming another file to it.
+func checkWritable(filePath string) (os.FileInfo, error) {
+	f, err := os.OpenFile(filepath.Clean(filePath), os.O_WRONLY, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "opening file for writing")
+	}
+	defer f.Close() // nolint: errcheck, gosec  // nothing was written to the file.
+	return f.Stat()
+}
+
+// writeFileSynced writes the data to a temporary file in the same directory as the file, syncs it to the disk
+// and renames it to the file. The directory is then synced, so that the rename is durable.
+func writeFileSynced(filePath string, data []byte, perm os.FileMode) (err error) {
+	dir, base := filepath.Split(filepath.Clean(filePath))
+	tempFile, err := ioutil.TempFile(dir, base+".tmp-*")
+	if err != nil {
+		return errors.Wrap(err, "creating temp file")
+	}
+	defer func() {
+		if err != nil {
+			tempFile.Close()           // nolint: errcheck, gosec  // error is already being returned.
+			os.Remove(tempFile.Name()) // nolint: errcheck, gosec  // error is already being returned.
+		}
+	}()
+
+	if _, err = tempFile.Write(data); err != nil {
+		return errors.Wrap(err, "writing temp file")
+	}
+	if err = tempFile.Chmod(perm); err != nil {
+		return errors.Wrap(err, "setting permissions of temp file")
+	}
+	if err = tempFile.Sync(); err != nil {
+		return errors.Wrap(err, "syncing temp file")
+	}
+	if err = tempFile.Close(); err != nil {
+		return errors.Wrap(err, "closing temp file")
+	}
+	if err = os.Rename(tempFile.Name(), filePath); err != nil {
+		return errors.Wrap(err, "renaming temp file")
+	}
+	return syncDir(dir)
+}
+
+// syncDir syncs the directory to the disk, so that the changes to its entries are durable.
+func syncDir(dir string) error {
+	if dir == "" {
+		dir = "."
+	}
+	d, err := os.Open(filepath.Clean(dir))
+	if err != nil {
+		return errors.Wrap(err, "opening directory")
+	}
+	defer d.Close() // nolint: errcheck, gosec  // safe to defer d.Close() for directories opened in read mode.
+	return errors.Wrap(syncDirHandle(d), "syncing directory")
+}
@@ -17,10 +17,11 @@
 package local
 
 import (
-	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
@@ -35,62 +36,158 @@ import (
 // The changes in cache can be updated to the ID provider file by explicitly calling UpdateStorage method.
 //
 // It also stores an instance of wallet backend that will be used or decoding address strings.
+//
+// The cache can be reloaded with the changes made to the ID provider file by calling the Reload method,
+// or automatically by watching the file using the Watch method.
 type IDProvider struct {
 	*idProviderCache
 
 	localFilePath string
+
+	// fileMutex protects the fields below. It must be acquired before the cache mutex.
+	fileMutex   sync.Mutex
+	loaded      map[string]perun.PeerID // Peer IDs in the ID provider file, as of the last load or write.
+	fileModTime time.Time
+	fileSize    int64
+
+	// handlerMutex protects the reload handler. It must not be acquired while holding the other mutexes.
+	handlerMutex  sync.RWMutex
+	reloadHandler ReloadHandler // Set only while the file is being watched.
 }
 
 // NewIDprovider returns an instance of ID provider to access the peer IDs in the given ID provider file.
 //
 // All the peer IDs are cached in memory during initialization and Read, Write, Delete operations
 // affect only the cache. The changes are updated to the ID provider file only when UpdateStorage method
-// is explicitly called. There is no mechanism to reload the cache if the ID provider file is updated.
+// is explicitly called. Changes made to the ID provider file after initialization are loaded into the cache
+// only when Reload method is called or when the file is being watched using Watch method.
 //
-// Backend is used for decoding the address strings during initialization.
-func NewIDprovider(filePath string, backend perun.WalletBackend) (*IDProvider, error) {
-	f, err := os.Open(filepath.Clean(filePath))
+// Backend is used for decoding the address strings and verifying the signatures. If requireSigned is true,
+// peer IDs without a valid signature are rejected, both when loading them from the file and when writing them
+// to the cache.
+func NewIDprovider(filePath string, backend perun.WalletBackend, requireSigned bool) (*IDProvider, error) {
+	cache, fileInfo, err := readIDProviderFile(filePath)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close() // nolint: errcheck, gosec  // safe to defer f.Close() for files opened in read mode.
+	loaded := copyPeerIDs(cache)
 
-	cache := make(map[string]perun.PeerID)
-	decoder := yaml.NewDecoder(f)
-	if err = decoder.Decode(&cache); err != nil && err != io.EOF {
-		return nil, err
-	}
-
-	idProviderCache, err := newIDProviderCache(cache, backend)
+	idProviderCache, err := newIDProviderCache(cache, backend, requireSigned)
 	if err != nil {
 		return nil, err
 	}
 	return &IDProvider{
 		idProviderCache: idProviderCache,
 		localFilePath:   filePath,
+		loaded:          loaded,
+		fileModTime:     fileInfo.ModTime(),
+		fileSize:        fileInfo.Size(),
 	}, nil
 }
 
+// readIDProviderFile reads the peer IDs from the ID provider file. It also returns the file info
+// of the file that was read.
+func readIDProviderFile(filePath string) (map[string]perun.PeerID, os.FileInfo, error) {
+	f, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close() // nolint: errcheck, gosec  // safe to defer f.Close() for files opened in read mode.
+
+	fileInfo, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	peerIDs := make(map[string]perun.PeerID)
+	decoder := yaml.NewDecoder(f)
+	if err = decoder.Decode(&peerIDs); err != nil && err != io.EOF {
+		return nil, nil, err
+	}
+	return peerIDs, fileInfo, nil
+}
+
+// copyPeerIDs returns a shallow copy of the map of peer IDs.
+func copyPeerIDs(peerIDs map[string]perun.PeerID) map[string]perun.PeerID {
+	peerIDsCopy := make(map[string]perun.PeerID, len(peerIDs))
+	for alias, peerID := range peerIDs {
+		peerIDsCopy[alias] = peerID
+	}
+	return peerIDsCopy
+}
+
 // UpdateStorage writes the latest state of ID provider cache to the file on the disk.
+//
+// The file is locked during the update, so that concurrent updates by other programs using this package do not
+// interfere. If the file was changed by other programs since it was last loaded or written, those changes are
+// first merged into the cache as done in Reload, so that they are not lost.
+//
+// The file is replaced atomically and the previous version is retained in a backup file, with the same path
+// as the ID provider file and the BackupFileSuffix. So, the file is not left corrupted if the program crashes
+// or an error occurs while writing.
+//
+// The entries in the file are then considered as loaded, so any changes made to them in the cache before
+// the file was written will not be reported as conflicts on the next reload.
+//
+// If the file is being watched and external changes were merged, the handler passed to Watch is called with
+// the report of the merge, as these changes will not be detected by the watcher anymore.
 func (c *IDProvider) UpdateStorage() error {
-	c.mutex.RLock()
-	defer c.mutex.RUnlock()
+	report, err := c.updateStorage()
+	if err == nil && !report.IsEmpty() {
+		c.handlerMutex.RLock()
+		if c.reloadHandler != nil {
+			c.reloadHandler(report, nil)
+		}
+		c.handlerMutex.RUnlock()
+	}
+	return err
+}
 
-	f, err := os.Create(c.localFilePath)
+// updateStorage writes the cache to the file as described in UpdateStorage and returns the report of merging
+// the external changes, if any.
+func (c *IDProvider) updateStorage() (report ReloadReport, err error) {
+	c.fileMutex.Lock()
+	defer c.fileMutex.Unlock()
+
+	unlock, err := lockFile(c.localFilePath)
 	if err != nil {
-		return errors.Wrap(err, "opening ID provider file for writing")
+		return ReloadReport{}, errors.WithMessage(err, "locking ID provider file")
 	}
 	defer func() {
-		if fCloseErr := f.Close(); fCloseErr != nil {
-			err = fmt.Errorf("%w; and error closing file - %s", err, fCloseErr.Error())
+		if unlockErr := unlock(); unlockErr != nil && err == nil {
+			err = unlockErr
 		}
 	}()
 
-	encoder := yaml.NewEncoder(f)
-	if err = encoder.Encode(c.peerIDsByAlias); err != nil {
-		return errors.Wrap(err, "encoding data as yaml")
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.fileChangedLocked() {
+		fromFile, _, err := readIDProviderFile(c.localFilePath)
+		if err != nil && !os.IsNotExist(err) {
+			return ReloadReport{}, errors.WithMessage(err, "reading ID provider file for merging external changes")
+		}
+		report = c.merge(fromFile)
+	}
+
+	data, err := yaml.Marshal(c.peerIDsByAlias)
+	if err != nil {
+		return ReloadReport{}, errors.Wrap(err, "encoding data as yaml")
+	}
+	if err = writeFileAtomic(c.localFilePath, data); err != nil {
+		return ReloadReport{}, errors.WithMessage(err, "writing ID provider file")
+	}
+	c.markStorageUpdated()
+	return report, nil
+}
+
+// markStorageUpdated marks the peer IDs in the cache as loaded from the ID provider file and records the
+// file info of the file, so that the write is not detected as an external change when watching the file.
+//
+// It should be called with both the file mutex and the cache mutex held.
+func (c *IDProvider) markStorageUpdated() {
+	c.loaded = copyPeerIDs(c.peerIDsByAlias)
+	if fileInfo, err := os.Stat(c.localFilePath); err == nil {
+		c.fileModTime = fileInfo.ModTime()
+		c.fileSize = fileInfo.Size()
 	}
-	err = errors.Wrap(encoder.Close(), "closing encoder")
-	// receive the error in "err" before returning to ensure file close error is captured.
-	return err
 }
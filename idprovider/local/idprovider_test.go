@@ -20,14 +20,18 @@ import (
 	"bytes"
 	"errors"
 	"io/ioutil"
+	"math/rand"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	pwallet "perun.network/go-perun/wallet"
 
 	"github.com/hyperledger-labs/perun-node"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
 	"github.com/hyperledger-labs/perun-node/idprovider"
 	"github.com/hyperledger-labs/perun-node/idprovider/idprovidertest"
 	"github.com/hyperledger-labs/perun-node/idprovider/local"
@@ -84,7 +88,7 @@ func Test_NewIDProviderFromYaml_ReadByAlias(t *testing.T) {
 	t.Run("happy", func(t *testing.T) {
 		idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
 
-		gotPeerIDs, err := local.NewIDprovider(idProviderFile, walletBackend)
+		gotPeerIDs, err := local.NewIDprovider(idProviderFile, walletBackend, false)
 
 		assert.NoError(t, err)
 		gotPeer1, isPresent := gotPeerIDs.ReadByAlias(peer1.Alias)
@@ -97,9 +101,25 @@ func Test_NewIDProviderFromYaml_ReadByAlias(t *testing.T) {
 		assert.False(t, isPresent)
 	})
 
+	t.Run("happy_alt_comm_endpoints", func(t *testing.T) {
+		peer1WithAltEndpoints := peer1
+		peer1WithAltEndpoints.AltCommEndpoints = []perun.CommEndpoint{
+			{CommAddr: "192.168.1.10:5751", CommType: "tcpip"},
+			{CommAddr: "10.0.0.10:5751", CommType: "tcpip"},
+		}
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1WithAltEndpoints)
+
+		gotPeerIDs, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+		require.NoError(t, err)
+		gotPeer1, isPresent := gotPeerIDs.ReadByAlias(peer1.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer1WithAltEndpoints, gotPeer1)
+		assert.Equal(t, peer1WithAltEndpoints.AltCommEndpoints, gotPeer1.CommEndpoints()[1:])
+	})
+
 	t.Run("corrupted_yaml", func(t *testing.T) {
 		idProviderFile := newCorruptedYAMLFile(t)
-		_, err := local.NewIDprovider(idProviderFile, walletBackend)
+		_, err := local.NewIDprovider(idProviderFile, walletBackend, false)
 		assert.Error(t, err)
 		t.Log(err)
 	})
@@ -109,13 +129,13 @@ func Test_NewIDProviderFromYaml_ReadByAlias(t *testing.T) {
 		peer1Copy.OffChainAddrString = "invalid address"
 		idProviderFile := idprovidertest.NewIDProviderT(t, peer1Copy, peer2)
 
-		_, err := local.NewIDprovider(idProviderFile, walletBackend)
+		_, err := local.NewIDprovider(idProviderFile, walletBackend, false)
 		assert.Error(t, err)
 		t.Log(err)
 	})
 
 	t.Run("missing_file", func(t *testing.T) {
-		_, err := local.NewIDprovider("./random-file.yaml", walletBackend)
+		_, err := local.NewIDprovider("./random-file.yaml", walletBackend, false)
 		assert.Error(t, err)
 		t.Log(err)
 	})
@@ -150,7 +170,7 @@ Bob:
 // nolint:dupl  // False positive. ReadByAlias is diff from ReadByOffChainAddr.
 func Test_YAML_ReadByAlias(t *testing.T) {
 	idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
-	c, err := local.NewIDprovider(idProviderFile, walletBackend)
+	c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
 	assert.NoError(t, err)
 
 	t.Run("happy", func(t *testing.T) {
@@ -168,7 +188,7 @@ func Test_YAML_ReadByAlias(t *testing.T) {
 // nolint:dupl  // False positive. ReadByOffChainAddr is diff from ReadByAlias.
 func Test_YAML_ReadByOffChainAddr(t *testing.T) {
 	idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
-	c, err := local.NewIDprovider(idProviderFile, walletBackend)
+	c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
 	assert.NoError(t, err)
 
 	t.Run("happy", func(t *testing.T) {
@@ -183,9 +203,18 @@ func Test_YAML_ReadByOffChainAddr(t *testing.T) {
 	})
 }
 
+func Test_YAML_ReadAll(t *testing.T) {
+	idProviderFile := idprovidertest.NewIDProviderT(t, peer2, peer1)
+	c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+	require.NoError(t, err)
+
+	assert.Implements(t, (*perun.IDLister)(nil), c)
+	assert.Equal(t, []perun.PeerID{peer1, peer2}, c.ReadAll())
+}
+
 func Test_YAML_Write_Read(t *testing.T) {
 	idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
-	c, err := local.NewIDprovider(idProviderFile, walletBackend)
+	c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
 	assert.NoError(t, err)
 
 	t.Run("happy", func(t *testing.T) {
@@ -207,7 +236,7 @@ func Test_YAML_Write_Read(t *testing.T) {
 
 	t.Run("invalid_offchain_addr", func(t *testing.T) {
 		idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
-		c, err := local.NewIDprovider(idProviderFile, walletBackend)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
 		assert.NoError(t, err)
 
 		peer3Copy := peer3
@@ -220,7 +249,7 @@ func Test_YAML_Write_Read(t *testing.T) {
 
 func Test_YAML_Delete_Read(t *testing.T) {
 	idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
-	c, err := local.NewIDprovider(idProviderFile, walletBackend)
+	c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
 	assert.NoError(t, err)
 
 	t.Run("happy", func(t *testing.T) {
@@ -241,7 +270,7 @@ func Test_YAML_UpdateStorage(t *testing.T) {
 		// Setup: NewYAML with zero entries
 		emptyFile := idprovidertest.NewIDProviderT(t)
 		fileWithTwoPeerIDs := idprovidertest.NewIDProviderT(t, peer1, peer2)
-		c, err := local.NewIDprovider(emptyFile, walletBackend)
+		c, err := local.NewIDprovider(emptyFile, walletBackend, false)
 		assert.NoError(t, err)
 
 		// Setup: Add entries to cache.
@@ -256,7 +285,7 @@ func Test_YAML_UpdateStorage(t *testing.T) {
 	t.Run("happy_non_empty_file", func(t *testing.T) {
 		fileWithTwoPeerIDs := idprovidertest.NewIDProviderT(t, peer1, peer2)
 		fileWithThreePeerIDs := idprovidertest.NewIDProviderT(t, peer1, peer2, peer3)
-		c, err := local.NewIDprovider(fileWithTwoPeerIDs, walletBackend)
+		c, err := local.NewIDprovider(fileWithTwoPeerIDs, walletBackend, false)
 		assert.NoError(t, err)
 		assert.NoError(t, c.Write(peer3.Alias, peer3))
 
@@ -268,7 +297,7 @@ func Test_YAML_UpdateStorage(t *testing.T) {
 	t.Run("file_permission_error", func(t *testing.T) {
 		// Setup: Create a copy of idProvider file with test data and add entry
 		idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
-		c, err := local.NewIDprovider(idProviderFile, walletBackend)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
 		assert.NoError(t, err)
 		assert.NoError(t, c.Write(peer3.Alias, peer3))
 
@@ -283,6 +312,144 @@ func Test_YAML_UpdateStorage(t *testing.T) {
 	})
 }
 
+func Test_YAML_UpdateStorage_Atomic(t *testing.T) {
+	t.Run("happy_backup_and_no_temp_files", func(t *testing.T) {
+		fileWithOnePeerID := idprovidertest.NewIDProviderT(t, peer1)
+		fileWithTwoPeerIDs := idprovidertest.NewIDProviderT(t, peer1, peer2)
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1)
+		require.NoError(t, os.Chmod(idProviderFile, 0o640))
+		c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+		require.NoError(t, err)
+
+		require.NoError(t, c.Write(peer2.Alias, peer2))
+		require.NoError(t, c.UpdateStorage())
+		assert.True(t, compareFileContent(t, idProviderFile, fileWithTwoPeerIDs))
+		assert.True(t, compareFileContent(t, idProviderFile+local.BackupFileSuffix, fileWithOnePeerID))
+
+		fileInfo, err := os.Stat(idProviderFile)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o640), fileInfo.Mode().Perm(), "file permissions should be retained")
+		tempFiles, err := filepath.Glob(idProviderFile + ".tmp-*")
+		require.NoError(t, err)
+		assert.Empty(t, tempFiles)
+
+		// On next update, the backup should be rotated to the file with two peer IDs.
+		require.NoError(t, c.Write(peer3.Alias, peer3))
+		require.NoError(t, c.UpdateStorage())
+		assert.True(t, compareFileContent(t, idProviderFile+local.BackupFileSuffix, fileWithTwoPeerIDs))
+	})
+
+	t.Run("happy_merge_changes_by_other_instance", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1)
+		c1, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+		require.NoError(t, err)
+		c2, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+		require.NoError(t, err)
+
+		require.NoError(t, c1.Write(peer2.Alias, peer2))
+		require.NoError(t, c1.UpdateStorage())
+		require.NoError(t, c2.Write(peer3.Alias, peer3))
+		require.NoError(t, c2.UpdateStorage())
+
+		fileWithThreePeerIDs := idprovidertest.NewIDProviderT(t, peer1, peer2, peer3)
+		assert.True(t, compareFileContent(t, idProviderFile, fileWithThreePeerIDs))
+		_, isPresent := c2.ReadByAlias(peer2.Alias)
+		assert.True(t, isPresent, "changes by other instance should be merged into the cache")
+	})
+
+	t.Run("happy_concurrent_updates", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t)
+		peers := []perun.PeerID{peer1, peer2, peer3}
+		errs := make(chan error, len(peers))
+		for i := range peers {
+			c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+			require.NoError(t, err)
+			require.NoError(t, c.Write(peers[i].Alias, peers[i]))
+			go func() { errs <- c.UpdateStorage() }()
+		}
+		for range peers {
+			assert.NoError(t, <-errs)
+		}
+
+		c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+		require.NoError(t, err, "file should not be corrupted by concurrent updates")
+		for i := range peers {
+			_, isPresent := c.ReadByAlias(peers[i].Alias)
+			assert.True(t, isPresent, "update by each instance should be retained")
+		}
+	})
+}
+
+func Test_IDProvider_RequireSigned(t *testing.T) {
+	ws := ethereumtest.NewWalletSetupT(t, rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs)), 2)
+	signedPeer1, signedPeer2 := newSignedPeerID(t, ws.Accs[0], "Alice"), newSignedPeerID(t, ws.Accs[1], "Bob")
+	tamperedPeer2 := signedPeer2
+	tamperedPeer2.CommAddr = "127.0.0.1:6666"
+
+	t.Run("happy_load", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, signedPeer1, signedPeer2)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend, true)
+		require.NoError(t, err)
+		gotPeer1, isPresent := c.ReadByAlias(signedPeer1.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, signedPeer1, gotPeer1)
+	})
+
+	t.Run("load_unsigned", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, signedPeer1, peer2)
+		_, err := local.NewIDprovider(idProviderFile, walletBackend, true)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDSignatureMissing), err)
+
+		_, err = local.NewIDprovider(idProviderFile, walletBackend, false)
+		assert.NoError(t, err, "unsigned peer IDs should be accepted when signatures are not required")
+	})
+
+	t.Run("load_invalid_signature", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, signedPeer1, tamperedPeer2)
+		_, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDSignatureInvalid), err)
+	})
+
+	t.Run("write", func(t *testing.T) {
+		c, err := local.NewIDprovider(idprovidertest.NewIDProviderT(t), walletBackend, true)
+		require.NoError(t, err)
+
+		err = c.Write(peer1.Alias, peer1)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDSignatureMissing), err)
+		err = c.Write(tamperedPeer2.Alias, tamperedPeer2)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDSignatureInvalid), err)
+		assert.NoError(t, c.Write(signedPeer2.Alias, signedPeer2))
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, signedPeer1)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend, true)
+		require.NoError(t, err)
+
+		writeIDProviderFile(t, idProviderFile, signedPeer1, tamperedPeer2)
+		report, err := c.Reload()
+		require.NoError(t, err)
+		assert.Empty(t, report.Changes)
+		assert.True(t, errors.Is(report.InvalidEntries[tamperedPeer2.Alias], idprovider.ErrPeerIDSignatureInvalid))
+	})
+}
+
+// newSignedPeerID returns a peer ID for the account, signed using the account.
+func newSignedPeerID(t *testing.T, acc pwallet.Account, alias string) perun.PeerID {
+	t.Helper()
+	peerID := perun.PeerID{
+		Alias:              alias,
+		OffChainAddr:       acc.Address(),
+		OffChainAddrString: acc.Address().String(),
+		CommType:           "tcp",
+		CommAddr:           "127.0.0.1:5751",
+	}
+	var err error
+	peerID.Signature, err = idprovider.SignPeerID(peerID, acc)
+	require.NoError(t, err)
+	return peerID
+}
+
 func compareFileContent(t *testing.T, file1, file2 string) bool {
 	f1, err := ioutil.ReadFile(file1)
 	require.NoError(t, err)
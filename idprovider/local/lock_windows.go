@@ -0,0 +1,54 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build windows
+
+package local
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/windows"
+)
+
+// lockFile acquires an exclusive lock on the lock file corresponding to the given file, blocking until
+// the lock is available. The lock is respected by all the programs using this package.
+//
+// A separate lock file is used, because the file itself is replaced on each write.
+func lockFile(filePath string) (unlock func() error, err error) {
+	f, err := os.OpenFile(filepath.Clean(filePath+LockFileSuffix), os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, errors.Wrap(err, "opening lock file")
+	}
+	// Lock the first byte, which is sufficient as all programs lock the same range.
+	overlapped := &windows.Overlapped{}
+	if err = windows.LockFileEx(windows.Handle(f.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK, 0, 1, 0,
+		overlapped); err != nil {
+		f.Close() // nolint: errcheck, gosec  // error is already being returned.
+		return nil, errors.Wrap(err, "acquiring lock")
+	}
+	return func() error {
+		// Closing the file releases the lock.
+		return errors.Wrap(f.Close(), "releasing lock")
+	}, nil
+}
+
+// syncDirHandle is a no-op, as directories cannot be synced on windows.
+func syncDirHandle(_ *os.File) error {
+	return nil
+}
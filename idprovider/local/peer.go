@@ -16,10 +16,13 @@
 
 package local
 
-import "github.com/hyperledger-labs/perun-node"
+import (
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/idprovider"
+)
 
 // PeerIDEqual returns true if all fields in the Peer ID except OffChainAddr are equal.
+// It is the same as idprovider.PeerIDEqual.
 func PeerIDEqual(p1, p2 perun.PeerID) bool {
-	return p1.Alias == p2.Alias && p1.OffChainAddrString == p2.OffChainAddrString &&
-		p1.CommType == p2.CommType && p1.CommAddr == p2.CommAddr
+	return idprovider.PeerIDEqual(p1, p2)
 }
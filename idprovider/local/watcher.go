@@ -0,0 +1,258 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// ChangeType represents the type of change made to a peer ID entry in the ID provider file.
+type ChangeType string
+
+// Enumeration of change types.
+const (
+	PeerIDAdded   ChangeType = "added"
+	PeerIDUpdated ChangeType = "updated"
+	PeerIDRemoved ChangeType = "removed"
+)
+
+type (
+	// PeerIDChange represents a change to a peer ID entry in the ID provider file, that was applied
+	// to the cache on reload.
+	//
+	// Old is the zero value when the entry was added and New is the zero value when it was removed.
+	PeerIDChange struct {
+		Type     ChangeType
+		Alias    string
+		Old, New perun.PeerID
+	}
+
+	// PeerIDConflict represents a change to a peer ID entry in the ID provider file, that was not applied
+	// to the cache on reload, because the entry was also changed in the cache and that change has not yet
+	// been written to the file using UpdateStorage.
+	//
+	// Cached is the entry that is retained in the cache and File is the entry in the ID provider file.
+	// Either of them is the zero value if there is no entry for the alias in the cache or in the file.
+	PeerIDConflict struct {
+		Alias        string
+		Cached, File perun.PeerID
+	}
+
+	// ReloadReport describes the result of reloading the cache from the ID provider file.
+	ReloadReport struct {
+		Changes   []PeerIDChange
+		Conflicts []PeerIDConflict
+		// Entries in the file that were not loaded as they are invalid, indexed by alias.
+		InvalidEntries map[string]error
+	}
+
+	// ReloadHandler is called with the report of each reload that was triggered by a change to the ID
+	// provider file when it is being watched. If the reload failed, err will be non-nil.
+	ReloadHandler func(report ReloadReport, err error)
+)
+
+// IsEmpty returns true if the reload did not change the cache, nor reported any conflicts or
+// invalid entries.
+func (r ReloadReport) IsEmpty() bool {
+	return len(r.Changes) == 0 && len(r.Conflicts) == 0 && len(r.InvalidEntries) == 0
+}
+
+// Reload reads the ID provider file and merges the changes made to it since it was last loaded or written
+// into the cache.
+//
+// For each entry that was changed in the file, the change is applied to the cache if the entry was not
+// changed in the cache too. If it was changed in the cache to the same value, there is nothing to apply.
+// Otherwise, the cached entry is retained and the change is reported as a conflict. The cached entry will
+// overwrite the one in the file on the next call to UpdateStorage.
+//
+// The off-chain addresses and the signatures of the new entries are validated using the wallet backend and
+// the invalid entries are not loaded.
+func (c *IDProvider) Reload() (ReloadReport, error) {
+	c.fileMutex.Lock()
+	defer c.fileMutex.Unlock()
+
+	fromFile, fileInfo, err := readIDProviderFile(c.localFilePath)
+	if err != nil {
+		return ReloadReport{}, errors.WithMessage(err, "reading ID provider file")
+	}
+	c.fileModTime = fileInfo.ModTime()
+	c.fileSize = fileInfo.Size()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.merge(fromFile), nil
+}
+
+// merge merges the changes in the peer IDs read from the ID provider file, relative to the ones that were
+// last loaded or written, into the cache and returns the report. See Reload for details.
+// It should be called with both the file mutex and the cache mutex held.
+func (c *IDProvider) merge(fromFile map[string]perun.PeerID) ReloadReport {
+	var err error
+	report := ReloadReport{InvalidEntries: make(map[string]error)}
+	for _, alias := range changedAliases(c.loaded, fromFile) {
+		loaded, inLoaded := c.loaded[alias]
+		file, inFile := fromFile[alias]
+		cached, inCache := c.peerIDsByAlias[alias]
+
+		if inFile {
+			if file, err = c.validate(file); err != nil {
+				report.InvalidEntries[alias] = err
+				continue
+			}
+		}
+
+		switch {
+		case peerIDEqualOrAbsent(cached, inCache, file, inFile):
+			// Change in the file is the same as the one in cache, there is nothing to apply.
+		case peerIDEqualOrAbsent(cached, inCache, loaded, inLoaded):
+			report.Changes = append(report.Changes, c.apply(alias, cached, inCache, file, inFile))
+		default:
+			report.Conflicts = append(report.Conflicts, PeerIDConflict{Alias: alias, Cached: cached, File: file})
+		}
+
+		if inFile {
+			c.loaded[alias] = file
+		} else {
+			delete(c.loaded, alias)
+		}
+	}
+	return report
+}
+
+// apply applies the change in the file to the cache and returns the change.
+// It should be called with the cache mutex held.
+func (c *IDProvider) apply(alias string, cached perun.PeerID, inCache bool, file perun.PeerID, inFile bool) (
+	change PeerIDChange) {
+	change = PeerIDChange{Alias: alias, Old: cached, New: file}
+	if inCache {
+		delete(c.aliasByAddr, cached.OffChainAddrString)
+		delete(c.peerIDsByAlias, alias)
+	}
+	if inFile {
+		c.peerIDsByAlias[alias] = file
+		c.aliasByAddr[file.OffChainAddrString] = alias
+	}
+
+	switch {
+	case !inCache:
+		change.Type = PeerIDAdded
+	case !inFile:
+		change.Type = PeerIDRemoved
+	default:
+		change.Type = PeerIDUpdated
+	}
+	return change
+}
+
+// Watch starts watching the ID provider file for changes, by checking its modification time and size
+// at the given interval. When a change is detected, the cache is reloaded and the handler is called with
+// the report, unless the reload did not change anything.
+//
+// Writes to the file made using UpdateStorage are not detected as changes. Instead, the handler is called by
+// UpdateStorage with the report of the external changes it merged before writing.
+//
+// It returns a function that stops watching the file. Handler will not be called once it returns.
+func (c *IDProvider) Watch(interval time.Duration, handler ReloadHandler) (stop func()) {
+	c.handlerMutex.Lock()
+	c.reloadHandler = handler
+	c.handlerMutex.Unlock()
+
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if !c.fileChanged() {
+					continue
+				}
+				report, err := c.Reload()
+				if err != nil || !report.IsEmpty() {
+					handler(report, err)
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ticker.Stop()
+			close(done)
+			wg.Wait()
+			c.handlerMutex.Lock()
+			c.reloadHandler = nil
+			c.handlerMutex.Unlock()
+		})
+	}
+}
+
+// fileChanged returns true if the modification time or the size of the ID provider file is different
+// from when it was last loaded or written. It also returns true, if the file could not be accessed,
+// so that the error is reported on reload.
+func (c *IDProvider) fileChanged() bool {
+	c.fileMutex.Lock()
+	defer c.fileMutex.Unlock()
+	return c.fileChangedLocked()
+}
+
+// fileChangedLocked is the same as fileChanged, but should be called with the file mutex held.
+func (c *IDProvider) fileChangedLocked() bool {
+	fileInfo, err := os.Stat(c.localFilePath)
+	if err != nil {
+		return true
+	}
+	return !fileInfo.ModTime().Equal(c.fileModTime) || fileInfo.Size() != c.fileSize
+}
+
+// changedAliases returns the sorted list of aliases for which the entries in both the maps are different.
+func changedAliases(old, latest map[string]perun.PeerID) []string {
+	aliases := make([]string, 0)
+	for alias, oldPeerID := range old {
+		if latestPeerID, ok := latest[alias]; !ok || !PeerIDEqual(oldPeerID, latestPeerID) {
+			aliases = append(aliases, alias)
+		}
+	}
+	for alias := range latest {
+		if _, ok := old[alias]; !ok {
+			aliases = append(aliases, alias)
+		}
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
+// peerIDEqualOrAbsent returns true if both the peer IDs are present and equal or if both are absent.
+func peerIDEqualOrAbsent(p1 perun.PeerID, isPresent1 bool, p2 perun.PeerID, isPresent2 bool) bool {
+	if isPresent1 != isPresent2 {
+		return false
+	}
+	return !isPresent1 || PeerIDEqual(p1, p2)
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/idprovider"
+	"github.com/hyperledger-labs/perun-node/idprovider/idprovidertest"
+	"github.com/hyperledger-labs/perun-node/idprovider/local"
+)
+
+func Test_IDProvider_Reload(t *testing.T) {
+	peer1Updated := peer1
+	peer1Updated.CommAddr = "127.0.0.1:6751"
+	peer3Updated := peer3
+	peer3Updated.CommAddr = "127.0.0.1:6753"
+
+	t.Run("happy_added_updated_removed", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+		require.NoError(t, err)
+
+		writeIDProviderFile(t, idProviderFile, peer1Updated, peer3)
+		report, err := c.Reload()
+		require.NoError(t, err)
+
+		assert.Empty(t, report.Conflicts)
+		assert.Empty(t, report.InvalidEntries)
+		require.Len(t, report.Changes, 3)
+		assert.Equal(t, local.PeerIDUpdated, report.Changes[0].Type)
+		assert.Equal(t, peer1, report.Changes[0].Old)
+		assert.Equal(t, peer1Updated, report.Changes[0].New)
+		assert.Equal(t, local.PeerIDRemoved, report.Changes[1].Type)
+		assert.Equal(t, peer2.Alias, report.Changes[1].Alias)
+		assert.Equal(t, local.PeerIDAdded, report.Changes[2].Type)
+		assert.Equal(t, peer3, report.Changes[2].New)
+
+		gotPeer1, isPresent := c.ReadByAlias(peer1.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer1Updated, gotPeer1)
+		_, isPresent = c.ReadByOffChainAddr(peer2.OffChainAddr)
+		assert.False(t, isPresent)
+		gotPeer3, isPresent := c.ReadByOffChainAddr(peer3.OffChainAddr)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer3, gotPeer3)
+
+		report, err = c.Reload()
+		require.NoError(t, err)
+		assert.True(t, report.IsEmpty(), "reloading an unchanged file should not report anything")
+	})
+
+	t.Run("conflicts_with_cached_writes", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1, peer2)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+		require.NoError(t, err)
+		require.NoError(t, c.Write(peer3.Alias, peer3))
+		require.NoError(t, c.Delete(peer1.Alias))
+
+		writeIDProviderFile(t, idProviderFile, peer1Updated, peer2, peer3Updated)
+		report, err := c.Reload()
+		require.NoError(t, err)
+
+		assert.Empty(t, report.Changes)
+		require.Len(t, report.Conflicts, 2)
+		assert.Equal(t, local.PeerIDConflict{Alias: peer1.Alias, File: peer1Updated}, report.Conflicts[0])
+		assert.Equal(t, local.PeerIDConflict{Alias: peer3.Alias, Cached: peer3, File: peer3Updated},
+			report.Conflicts[1])
+
+		gotPeer3, _ := c.ReadByAlias(peer3.Alias)
+		assert.Equal(t, peer3, gotPeer3, "cached write should be retained")
+		_, isPresent := c.ReadByAlias(peer1.Alias)
+		assert.False(t, isPresent, "cached delete should be retained")
+
+		report, err = c.Reload()
+		require.NoError(t, err)
+		assert.True(t, report.IsEmpty(), "conflicts should be reported only once")
+	})
+
+	t.Run("same_change_in_cache_and_file", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+		require.NoError(t, err)
+		require.NoError(t, c.Write(peer3.Alias, peer3))
+
+		writeIDProviderFile(t, idProviderFile, peer1, peer3)
+		report, err := c.Reload()
+		require.NoError(t, err)
+		assert.True(t, report.IsEmpty())
+	})
+
+	t.Run("invalid_entry", func(t *testing.T) {
+		idProviderFile := idprovidertest.NewIDProviderT(t, peer1)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+		require.NoError(t, err)
+
+		peer3Invalid := peer3
+		peer3Invalid.OffChainAddrString = "invalid address"
+		writeIDProviderFile(t, idProviderFile, peer1, peer3Invalid)
+		report, err := c.Reload()
+		require.NoError(t, err)
+
+		assert.Empty(t, report.Changes)
+		require.Contains(t, report.InvalidEntries, peer3.Alias)
+		assert.True(t, errors.Is(report.InvalidEntries[peer3.Alias], idprovider.ErrParsingOffChainAddress))
+		_, isPresent := c.ReadByAlias(peer3.Alias)
+		assert.False(t, isPresent)
+	})
+
+	t.Run("missing_file", func(t *testing.T) {
+		idProviderFile, err := idprovidertest.NewIDProvider(peer1)
+		require.NoError(t, err)
+		c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+		require.NoError(t, err)
+		require.NoError(t, os.Remove(idProviderFile))
+
+		_, err = c.Reload()
+		assert.Error(t, err)
+		t.Log(err)
+	})
+}
+
+func Test_IDProvider_Watch(t *testing.T) {
+	idProviderFile := idprovidertest.NewIDProviderT(t, peer1)
+	c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+	require.NoError(t, err)
+
+	reports := make(chan local.ReloadReport, 10)
+	stop := c.Watch(10*time.Millisecond, func(report local.ReloadReport, err error) {
+		assert.NoError(t, err)
+		reports <- report
+	})
+	defer stop()
+
+	t.Run("external_change", func(t *testing.T) {
+		writeIDProviderFile(t, idProviderFile, peer1, peer2)
+		select {
+		case report := <-reports:
+			require.Len(t, report.Changes, 1)
+			assert.Equal(t, local.PeerIDAdded, report.Changes[0].Type)
+			assert.Equal(t, peer2, report.Changes[0].New)
+		case <-time.After(time.Second):
+			t.Fatal("no reload report received")
+		}
+	})
+
+	t.Run("update_storage", func(t *testing.T) {
+		require.NoError(t, c.Write(peer3.Alias, peer3))
+		require.NoError(t, c.UpdateStorage())
+		select {
+		case report := <-reports:
+			t.Fatalf("unexpected reload report for own write: %+v", report)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		stop()
+		writeIDProviderFile(t, idProviderFile, peer1)
+		select {
+		case report := <-reports:
+			t.Fatalf("unexpected reload report after stop: %+v", report)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
+
+func Test_IDProvider_Watch_UpdateStorageMerge(t *testing.T) {
+	idProviderFile := idprovidertest.NewIDProviderT(t, peer1)
+	c, err := local.NewIDprovider(idProviderFile, walletBackend, false)
+	require.NoError(t, err)
+
+	reports := make(chan local.ReloadReport, 10)
+	// Long interval, so that the external change is merged only by UpdateStorage.
+	stop := c.Watch(time.Hour, func(report local.ReloadReport, err error) {
+		assert.NoError(t, err)
+		reports <- report
+	})
+	defer stop()
+
+	t.Run("external_change_merged", func(t *testing.T) {
+		writeIDProviderFile(t, idProviderFile, peer1, peer2)
+		require.NoError(t, c.UpdateStorage())
+		select {
+		case report := <-reports:
+			require.Len(t, report.Changes, 1)
+			assert.Equal(t, local.PeerIDAdded, report.Changes[0].Type)
+			assert.Equal(t, peer2, report.Changes[0].New)
+		default:
+			t.Fatal("no reload report received")
+		}
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		stop()
+		writeIDProviderFile(t, idProviderFile, peer1, peer2, peer3)
+		require.NoError(t, c.UpdateStorage())
+		select {
+		case report := <-reports:
+			t.Fatalf("unexpected reload report after stop: %+v", report)
+		default:
+		}
+	})
+}
+
+// writeIDProviderFile overwrites the ID provider file with the given peer IDs.
+func writeIDProviderFile(t *testing.T, idProviderFile string, peerIDs ...perun.PeerID) {
+	t.Helper()
+	peerIDsByAlias := make(map[string]perun.PeerID, len(peerIDs))
+	for _, peerID := range peerIDs {
+		peerIDsByAlias[peerID.Alias] = peerID
+	}
+	data, err := yaml.Marshal(peerIDsByAlias)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(idProviderFile, data, 0o600))
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idprovider
+
+import (
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// PeerIDEqual returns true if all fields in the Peer ID except OffChainAddr are equal.
+func PeerIDEqual(p1, p2 perun.PeerID) bool {
+	if len(p1.AltCommEndpoints) != len(p2.AltCommEndpoints) {
+		return false
+	}
+	for i := range p1.AltCommEndpoints {
+		if p1.AltCommEndpoints[i] != p2.AltCommEndpoints[i] {
+			return false
+		}
+	}
+	return p1.Alias == p2.Alias && p1.OffChainAddrString == p2.OffChainAddrString &&
+		p1.CommType == p2.CommType && p1.CommAddr == p2.CommAddr && p1.Signature == p2.Signature
+}
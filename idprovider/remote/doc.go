@@ -0,0 +1,35 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package remote contains an implementation of ID provider, where the peer
+// IDs are stored in a directory service that is accessed over HTTP.
+//
+// Peer IDs are resolved from the directory service by alias or by off-chain
+// address and the results are cached locally for a configurable duration.
+// Write and Delete operations are sent to the directory service immediately,
+// so UpdateStorage has nothing to do.
+//
+// The package also includes a reference implementation of the directory
+// service (see Server), which can be used for running a directory locally.
+//
+// The directory service provides the following endpoints. Peer IDs are
+// encoded as JSON objects (see PeerIDJSON) and errors as ErrorJSON objects.
+//
+//	GET    /peers/alias/{alias}             Returns the peer ID with given alias.
+//	GET    /peers/offchainaddr/{address}    Returns the peer ID with given off-chain address.
+//	POST   /peers                           Adds the peer ID in the request body.
+//	DELETE /peers/alias/{alias}             Deletes the peer ID with given alias.
+package remote
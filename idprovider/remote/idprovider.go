@@ -0,0 +1,319 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/idprovider"
+	"github.com/hyperledger-labs/perun-node/idprovider/local"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+// Config represents the parameters for accessing the directory service.
+type Config struct {
+	URL      string            // Base URL of the directory service.
+	Headers  map[string]string // Headers (such as those for authentication) added to each request.
+	CacheTTL time.Duration     // Duration for which resolved peer IDs are cached. Zero disables caching.
+	Timeout  time.Duration     // Timeout for each request to the directory service. Zero uses DefaultTimeout.
+
+	// If true, peer IDs without a valid signature are rejected, both when resolving them from and when
+	// writing them to the directory service.
+	RequireSigned bool
+}
+
+// DefaultTimeout is the timeout for each request to the directory service, if none is configured.
+const DefaultTimeout = 10 * time.Second
+
+// IDProvider represents an ID provider that provides access to peer IDs stored in a directory service.
+//
+// Peer IDs resolved from the directory service are cached locally for the configured TTL. When the directory
+// service cannot be reached, the last resolved value is returned even if it has expired.
+//
+// Signatures of the peer IDs are verified if they are signed or if signatures are required.
+//
+// The entry for perun.OwnAlias is stored only in the local cache and is never sent to the directory service,
+// as the alias is specific to the user of this ID provider.
+type IDProvider struct {
+	log.Logger
+
+	cfg           Config
+	httpClient    *http.Client
+	walletBackend perun.WalletBackend
+
+	mutex        sync.Mutex
+	own          *perun.PeerID
+	cacheByAlias map[string]cacheEntry
+	aliasByAddr  map[string]string // Stores aliases of the cached entries, indexed by off-chain address string.
+}
+
+type cacheEntry struct {
+	peerID perun.PeerID
+	expiry time.Time
+}
+
+// NewIDProvider returns an instance of ID provider to access the peer IDs in the directory service at the
+// given URL. Backend is used for decoding the off-chain address strings.
+func NewIDProvider(cfg Config, backend perun.WalletBackend) (*IDProvider, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.New("url scheme should be http or https")
+	}
+	if cfg.Timeout < 0 {
+		return nil, errors.New("timeout should not be negative")
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	cfg.URL = strings.TrimSuffix(cfg.URL, "/")
+	return &IDProvider{
+		Logger:        log.NewLoggerWithField("idprovider", cfg.URL),
+		cfg:           cfg,
+		httpClient:    &http.Client{Timeout: cfg.Timeout},
+		walletBackend: backend,
+		cacheByAlias:  make(map[string]cacheEntry),
+		aliasByAddr:   make(map[string]string),
+	}, nil
+}
+
+// ReadByAlias returns the peer ID corresponding to given alias.
+func (c *IDProvider) ReadByAlias(alias string) (_ perun.PeerID, isPresent bool) {
+	c.mutex.Lock()
+	if alias == perun.OwnAlias && c.own != nil {
+		c.mutex.Unlock()
+		return *c.own, true
+	}
+	entry, isCached := c.cacheByAlias[alias]
+	c.mutex.Unlock()
+	if isCached && time.Now().Before(entry.expiry) {
+		return entry.peerID, true
+	}
+
+	matches := func(p perun.PeerID) bool { return p.Alias == alias }
+	return c.resolve(PathPeerByAlias+url.PathEscape(alias), matches, entry, isCached)
+}
+
+// ReadByOffChainAddr returns the peer ID corresponding to given off-chain address.
+func (c *IDProvider) ReadByOffChainAddr(offChainAddr pwire.Address) (_ perun.PeerID, isPresent bool) {
+	if offChainAddr == nil {
+		return perun.PeerID{}, false
+	}
+	c.mutex.Lock()
+	if c.own != nil && c.own.OffChainAddr.Equals(offChainAddr) {
+		c.mutex.Unlock()
+		return *c.own, true
+	}
+	entry, isCached := c.cacheByAlias[c.aliasByAddr[offChainAddr.String()]]
+	c.mutex.Unlock()
+	if isCached && time.Now().Before(entry.expiry) {
+		return entry.peerID, true
+	}
+
+	matches := func(p perun.PeerID) bool { return p.OffChainAddr.Equals(offChainAddr) }
+	return c.resolve(PathPeerByOffChainAddr+url.PathEscape(offChainAddr.String()), matches, entry, isCached)
+}
+
+// resolve retrieves the peer ID from the given path in the directory service and caches it. If the directory
+// service cannot be reached, the cached entry is returned, if any.
+//
+// The peer ID is rejected if it does not match the key used for looking it up, as a directory service returning
+// the record of a different peer could otherwise make the node send messages or funds to that peer.
+func (c *IDProvider) resolve(path string, matches func(perun.PeerID) bool, cached cacheEntry, isCached bool) (
+	_ perun.PeerID, isPresent bool) {
+	var peerIDJSON PeerIDJSON
+	err := c.do(http.MethodGet, path, nil, &peerIDJSON)
+	if errors.Is(err, idprovider.ErrPeerIDNotFound) {
+		if isCached {
+			c.uncache(cached.peerID.Alias)
+		}
+		return perun.PeerID{}, false
+	}
+	if err != nil {
+		c.WithError(err).Error("Resolving peer ID from directory service")
+		return cached.peerID, isCached
+	}
+
+	peerID, err := c.validate(fromPeerIDJSON(peerIDJSON))
+	if err != nil {
+		c.WithError(err).Error("Validating peer ID from directory service")
+		return perun.PeerID{}, false
+	}
+	if !matches(peerID) {
+		c.WithField("alias", peerID.Alias).Error("Peer ID from directory service does not match the lookup key")
+		return perun.PeerID{}, false
+	}
+	c.cache(peerID)
+	return peerID, true
+}
+
+// Write adds the peer ID to the directory service. Returns an error if the alias is already used by same or
+// different peer ID, if the off-chain address of the peer ID cannot be parsed using the wallet backend of this
+// ID provider or if the signature is invalid (or missing, when signatures are required).
+func (c *IDProvider) Write(alias string, p perun.PeerID) error {
+	var err error
+	if p, err = c.validate(p); err != nil {
+		return err
+	}
+
+	if alias == perun.OwnAlias {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		if c.own != nil {
+			if local.PeerIDEqual(*c.own, p) {
+				return idprovider.ErrPeerIDAlreadyRegistered
+			}
+			return idprovider.ErrPeerAliasAlreadyUsed
+		}
+		c.own = &p
+		return nil
+	}
+
+	peerIDJSON := toPeerIDJSON(p)
+	peerIDJSON.Alias = alias
+	if err = c.do(http.MethodPost, PathPeers, peerIDJSON, nil); err != nil {
+		return err
+	}
+	c.cache(p)
+	return nil
+}
+
+// Delete deletes the peer from the directory service.
+// Returns an error if peer corresponding to given alias is not found.
+func (c *IDProvider) Delete(alias string) error {
+	if alias == perun.OwnAlias {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		if c.own == nil {
+			return idprovider.ErrPeerIDNotFound
+		}
+		c.own = nil
+		return nil
+	}
+
+	err := c.do(http.MethodDelete, PathPeerByAlias+url.PathEscape(alias), nil, nil)
+	if err == nil || errors.Is(err, idprovider.ErrPeerIDNotFound) {
+		c.uncache(alias)
+	}
+	return err
+}
+
+// UpdateStorage does nothing, as the changes are sent to the directory service when they are made.
+func (c *IDProvider) UpdateStorage() error {
+	return nil
+}
+
+// validate parses the off-chain address of the peer ID and verifies its signature, if it is signed or if
+// signatures are required. It returns the peer ID with the parsed off-chain address.
+func (c *IDProvider) validate(p perun.PeerID) (perun.PeerID, error) {
+	var err error
+	if p.OffChainAddr, err = c.walletBackend.ParseAddr(p.OffChainAddrString); err != nil {
+		return perun.PeerID{}, errors.Wrap(idprovider.ErrParsingOffChainAddress, err.Error())
+	}
+	if p.Signature != "" || c.cfg.RequireSigned {
+		if err = idprovider.VerifyPeerID(p, c.walletBackend); err != nil {
+			return perun.PeerID{}, err
+		}
+	}
+	return p, nil
+}
+
+func (c *IDProvider) cache(p perun.PeerID) {
+	if c.cfg.CacheTTL == 0 {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if old, ok := c.cacheByAlias[p.Alias]; ok {
+		delete(c.aliasByAddr, old.peerID.OffChainAddr.String())
+	}
+	c.cacheByAlias[p.Alias] = cacheEntry{peerID: p, expiry: time.Now().Add(c.cfg.CacheTTL)}
+	c.aliasByAddr[p.OffChainAddr.String()] = p.Alias
+}
+
+func (c *IDProvider) uncache(alias string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if old, ok := c.cacheByAlias[alias]; ok {
+		delete(c.aliasByAddr, old.peerID.OffChainAddr.String())
+		delete(c.cacheByAlias, alias)
+	}
+}
+
+// do sends a request to the directory service with the request body encoded as JSON and decodes the response
+// body into resp, if it is not nil.
+//
+// If the directory service returns an error, that corresponds to an error defined in the idprovider package, the
+// corresponding error is returned.
+func (c *IDProvider) do(method, path string, reqBody, resp interface{}) error {
+	var body bytes.Buffer
+	if reqBody != nil {
+		if err := json.NewEncoder(&body).Encode(reqBody); err != nil {
+			return errors.Wrap(err, "encoding request")
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.cfg.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, c.cfg.URL+path, &body)
+	if err != nil {
+		return errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range c.cfg.Headers {
+		req.Header.Set(name, value)
+	}
+
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "sending request to directory service")
+	}
+	defer httpResp.Body.Close() // nolint: errcheck
+
+	if httpResp.StatusCode/100 != 2 {
+		var errJSON ErrorJSON
+		if err = json.NewDecoder(httpResp.Body).Decode(&errJSON); err != nil {
+			return errors.Errorf("directory service returned %s", httpResp.Status)
+		}
+		for idProviderErr, code := range errCodes {
+			if errJSON.Code != code {
+				continue
+			}
+			if errJSON.Message == idProviderErr.Error() {
+				return idProviderErr
+			}
+			return errors.Wrap(idProviderErr, errJSON.Message)
+		}
+		return errors.Errorf("directory service returned %s: %s", httpResp.Status, errJSON.Message)
+	}
+	if resp == nil {
+		return nil
+	}
+	return errors.Wrap(json.NewDecoder(httpResp.Body).Decode(resp), "decoding response")
+}
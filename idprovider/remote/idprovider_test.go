@@ -0,0 +1,310 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/idprovider"
+	"github.com/hyperledger-labs/perun-node/idprovider/idprovidertest"
+	"github.com/hyperledger-labs/perun-node/idprovider/local"
+	"github.com/hyperledger-labs/perun-node/idprovider/remote"
+)
+
+var (
+	walletBackend = ethereum.NewWalletBackend()
+	authHeaders   = map[string]string{"Authorization": "Bearer test-token"}
+)
+
+func Test_IDProvider_Interface(t *testing.T) {
+	assert.Implements(t, (*perun.IDProvider)(nil), new(remote.IDProvider))
+}
+
+func Test_NewIDProvider(t *testing.T) {
+	t.Run("happy", func(t *testing.T) {
+		_, err := remote.NewIDProvider(remote.Config{URL: "http://127.0.0.1:8090/"}, walletBackend)
+		assert.NoError(t, err)
+	})
+	t.Run("invalid_scheme", func(t *testing.T) {
+		_, err := remote.NewIDProvider(remote.Config{URL: "ftp://127.0.0.1:8090"}, walletBackend)
+		assert.Error(t, err)
+		t.Log(err)
+	})
+	t.Run("negative_timeout", func(t *testing.T) {
+		_, err := remote.NewIDProvider(remote.Config{URL: "http://127.0.0.1:8090", Timeout: -time.Second}, walletBackend)
+		assert.Error(t, err)
+		t.Log(err)
+	})
+}
+
+func Test_IDProvider_ZeroTimeout(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	peer1 := newPeerID(rng, "Alice")
+	serverURL := newDirectoryService(t)
+	c, err := remote.NewIDProvider(remote.Config{URL: serverURL, Headers: authHeaders}, walletBackend)
+	require.NoError(t, err)
+
+	// Requests should use the default timeout instead of expiring immediately.
+	require.NoError(t, c.Write(peer1.Alias, peer1))
+	_, isPresent := c.ReadByAlias(peer1.Alias)
+	assert.True(t, isPresent)
+}
+
+func Test_IDProvider_MismatchedRecord(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	peer1, peer2 := newPeerID(rng, "Alice"), newPeerID(rng, "Bob")
+	// Directory service that returns the record of peer2 for any lookup.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(remote.PeerIDJSON{ // nolint: errcheck
+			Alias:        peer2.Alias,
+			OffChainAddr: peer2.OffChainAddrString,
+			CommType:     peer2.CommType,
+			CommAddr:     peer2.CommAddr,
+		})
+	}))
+	t.Cleanup(server.Close)
+	c := newIDProvider(t, server.URL, time.Hour)
+
+	t.Run("alias_mismatch", func(t *testing.T) {
+		_, isPresent := c.ReadByAlias(peer1.Alias)
+		assert.False(t, isPresent)
+	})
+	t.Run("off_chain_addr_mismatch", func(t *testing.T) {
+		_, isPresent := c.ReadByOffChainAddr(peer1.OffChainAddr)
+		assert.False(t, isPresent)
+	})
+	t.Run("happy_match", func(t *testing.T) {
+		gotPeerID, isPresent := c.ReadByAlias(peer2.Alias)
+		require.True(t, isPresent)
+		assert.Equal(t, peer2, gotPeerID)
+		gotPeerID, isPresent = c.ReadByOffChainAddr(peer2.OffChainAddr)
+		require.True(t, isPresent)
+		assert.Equal(t, peer2, gotPeerID)
+	})
+}
+
+func Test_IDProvider_Write_Read_Delete(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	peer1, peer2 := newPeerID(rng, "Alice"), newPeerID(rng, "Bob")
+	serverURL := newDirectoryService(t)
+	c := newIDProvider(t, serverURL, 0)
+
+	t.Run("happy_write_read", func(t *testing.T) {
+		require.NoError(t, c.Write(peer1.Alias, peer1))
+
+		gotPeerID, isPresent := c.ReadByAlias(peer1.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer1, gotPeerID)
+		gotPeerID, isPresent = c.ReadByOffChainAddr(peer1.OffChainAddr)
+		assert.True(t, isPresent)
+		assert.Equal(t, peer1, gotPeerID)
+	})
+
+	t.Run("read_missing", func(t *testing.T) {
+		_, isPresent := c.ReadByAlias(peer2.Alias)
+		assert.False(t, isPresent)
+		_, isPresent = c.ReadByOffChainAddr(peer2.OffChainAddr)
+		assert.False(t, isPresent)
+		_, isPresent = c.ReadByOffChainAddr(nil)
+		assert.False(t, isPresent)
+	})
+
+	t.Run("write_errors", func(t *testing.T) {
+		err := c.Write(peer1.Alias, peer1)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDAlreadyRegistered), err)
+
+		err = c.Write(peer1.Alias, peer2)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerAliasAlreadyUsed), err)
+
+		peer2Invalid := peer2
+		peer2Invalid.OffChainAddrString = "invalid-addr"
+		err = c.Write(peer2.Alias, peer2Invalid)
+		assert.True(t, errors.Is(err, idprovider.ErrParsingOffChainAddress), err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, c.Delete(peer1.Alias))
+		_, isPresent := c.ReadByAlias(peer1.Alias)
+		assert.False(t, isPresent)
+
+		err := c.Delete(peer1.Alias)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDNotFound), err)
+	})
+
+	t.Run("update_storage", func(t *testing.T) {
+		assert.NoError(t, c.UpdateStorage())
+	})
+}
+
+func Test_IDProvider_OwnAlias(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	own := newPeerID(rng, perun.OwnAlias)
+	serverURL := newDirectoryService(t)
+	c := newIDProvider(t, serverURL, 0)
+
+	require.NoError(t, c.Write(perun.OwnAlias, own))
+	gotPeerID, isPresent := c.ReadByAlias(perun.OwnAlias)
+	assert.True(t, isPresent)
+	assert.Equal(t, own, gotPeerID)
+	gotPeerID, isPresent = c.ReadByOffChainAddr(own.OffChainAddr)
+	assert.True(t, isPresent)
+	assert.Equal(t, own, gotPeerID)
+
+	otherIDProvider := newIDProvider(t, serverURL, 0)
+	_, isPresent = otherIDProvider.ReadByAlias(perun.OwnAlias)
+	assert.False(t, isPresent, "own peer ID should not be sent to directory service")
+}
+
+func Test_IDProvider_Cache(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	peer1 := newPeerID(rng, "Alice")
+	store, err := local.NewIDprovider(idprovidertest.NewIDProviderT(t, peer1), walletBackend, false)
+	require.NoError(t, err)
+	server := httptest.NewServer(remote.NewServer(store, walletBackend, authHeaders))
+	defer server.Close()
+
+	cached := newIDProvider(t, server.URL, time.Hour)
+	notCached := newIDProvider(t, server.URL, 0)
+	_, isPresent := cached.ReadByAlias(peer1.Alias)
+	require.True(t, isPresent)
+	_, isPresent = notCached.ReadByAlias(peer1.Alias)
+	require.True(t, isPresent)
+
+	server.Close()
+	gotPeerID, isPresent := cached.ReadByOffChainAddr(peer1.OffChainAddr)
+	assert.True(t, isPresent)
+	assert.Equal(t, peer1, gotPeerID)
+	_, isPresent = notCached.ReadByAlias(peer1.Alias)
+	assert.False(t, isPresent)
+}
+
+func Test_Server_Unauthorized(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	peer1 := newPeerID(rng, "Alice")
+	serverURL := newDirectoryService(t)
+	c, err := remote.NewIDProvider(remote.Config{URL: serverURL, Timeout: time.Second}, walletBackend)
+	require.NoError(t, err)
+
+	err = c.Write(peer1.Alias, peer1)
+	assert.Error(t, err)
+	t.Log(err)
+}
+
+func Test_IDProvider_RequireSigned(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 1)
+	unsignedPeer := newPeerID(rng, "Bob")
+	signedPeer := newPeerID(rng, "Alice")
+	signedPeer.OffChainAddr = ws.Accs[0].Address()
+	signedPeer.OffChainAddrString = ws.Accs[0].Address().String()
+	var err error
+	signedPeer.Signature, err = idprovider.SignPeerID(signedPeer, ws.Accs[0])
+	require.NoError(t, err)
+
+	serverURL := newDirectoryService(t)
+	c := newIDProvider(t, serverURL, 0)
+	require.NoError(t, c.Write(unsignedPeer.Alias, unsignedPeer))
+	require.NoError(t, c.Write(signedPeer.Alias, signedPeer))
+
+	cfg := remote.Config{URL: serverURL, Headers: authHeaders, Timeout: time.Second, RequireSigned: true}
+	cRequireSigned, err := remote.NewIDProvider(cfg, walletBackend)
+	require.NoError(t, err)
+
+	t.Run("happy_read_signed", func(t *testing.T) {
+		gotPeerID, isPresent := cRequireSigned.ReadByAlias(signedPeer.Alias)
+		assert.True(t, isPresent)
+		assert.Equal(t, signedPeer, gotPeerID)
+	})
+
+	t.Run("read_unsigned", func(t *testing.T) {
+		_, isPresent := cRequireSigned.ReadByAlias(unsignedPeer.Alias)
+		assert.False(t, isPresent)
+	})
+
+	t.Run("write_unsigned", func(t *testing.T) {
+		err := cRequireSigned.Write("Tom", newPeerID(rng, "Tom"))
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDSignatureMissing), err)
+	})
+
+	t.Run("server_rejects_invalid_signature", func(t *testing.T) {
+		tampered := remote.PeerIDJSON{
+			Alias:        "Tampered",
+			OffChainAddr: signedPeer.OffChainAddrString,
+			CommType:     signedPeer.CommType,
+			CommAddr:     "127.0.0.1:6666",
+			Signature:    signedPeer.Signature,
+		}
+		body, err := json.Marshal(tampered)
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodPost, serverURL+remote.PathPeers, bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", authHeaders["Authorization"])
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close() // nolint: errcheck
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		var errJSON remote.ErrorJSON
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&errJSON))
+		assert.Equal(t, remote.ErrCodeInvalidSignature, errJSON.Code)
+	})
+}
+
+func newDirectoryService(t *testing.T) string {
+	store, err := local.NewIDprovider(idprovidertest.NewIDProviderT(t), walletBackend, false)
+	require.NoError(t, err)
+	server := httptest.NewServer(remote.NewServer(store, walletBackend, authHeaders))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func newIDProvider(t *testing.T, url string, cacheTTL time.Duration) *remote.IDProvider {
+	cfg := remote.Config{
+		URL:      url,
+		Headers:  authHeaders,
+		CacheTTL: cacheTTL,
+		Timeout:  time.Second,
+	}
+	c, err := remote.NewIDProvider(cfg, walletBackend)
+	require.NoError(t, err)
+	return c
+}
+
+func newPeerID(rng *rand.Rand, alias string) perun.PeerID {
+	offChainAddr := ethereumtest.NewRandomAddress(rng)
+	return perun.PeerID{
+		Alias:              alias,
+		OffChainAddr:       offChainAddr,
+		OffChainAddrString: offChainAddr.String(),
+		CommType:           "tcp",
+		CommAddr:           "127.0.0.1:5751",
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/idprovider"
+)
+
+// Paths of the endpoints provided by the directory service.
+const (
+	PathPeers              = "/peers"
+	PathPeerByAlias        = PathPeers + "/alias/"
+	PathPeerByOffChainAddr = PathPeers + "/offchainaddr/"
+)
+
+// Enumeration of error codes returned by the directory service.
+const (
+	ErrCodeNotFound          = "not_found"
+	ErrCodeAliasAlreadyUsed  = "alias_already_used"
+	ErrCodeAlreadyRegistered = "already_registered"
+	ErrCodeInvalidAddress    = "invalid_address"
+	ErrCodeSignatureMissing  = "signature_missing"
+	ErrCodeInvalidSignature  = "invalid_signature"
+	ErrCodeInvalidRequest    = "invalid_request"
+	ErrCodeUnauthorized      = "unauthorized"
+	ErrCodeInternal          = "internal"
+)
+
+type (
+	// PeerIDJSON is the representation of a peer ID used by the directory service.
+	PeerIDJSON struct {
+		Alias        string `json:"alias"`
+		OffChainAddr string `json:"offChainAddr"`
+		CommType     string `json:"commType"`
+		CommAddr     string `json:"commAddr"`
+		Signature    string `json:"signature,omitempty"`
+
+		AltCommEndpoints []CommEndpointJSON `json:"altCommEndpoints,omitempty"`
+	}
+
+	// CommEndpointJSON is the representation of a comm endpoint used by the directory service.
+	CommEndpointJSON struct {
+		CommAddr string `json:"commAddr"`
+		CommType string `json:"commType"`
+	}
+
+	// ErrorJSON is the representation of an error returned by the directory service.
+	ErrorJSON struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+)
+
+// toPeerIDJSON returns the representation of the peer ID used by the directory service.
+func toPeerIDJSON(p perun.PeerID) PeerIDJSON {
+	peerIDJSON := PeerIDJSON{
+		Alias:        p.Alias,
+		OffChainAddr: p.OffChainAddrString,
+		CommType:     p.CommType,
+		CommAddr:     p.CommAddr,
+		Signature:    p.Signature,
+	}
+	for _, endpoint := range p.AltCommEndpoints {
+		peerIDJSON.AltCommEndpoints = append(peerIDJSON.AltCommEndpoints,
+			CommEndpointJSON{CommAddr: endpoint.CommAddr, CommType: endpoint.CommType})
+	}
+	return peerIDJSON
+}
+
+// fromPeerIDJSON returns the peer ID for its representation used by the directory service.
+// The off-chain address is not decoded.
+func fromPeerIDJSON(p PeerIDJSON) perun.PeerID {
+	peerID := perun.PeerID{
+		Alias:              p.Alias,
+		OffChainAddrString: p.OffChainAddr,
+		CommType:           p.CommType,
+		CommAddr:           p.CommAddr,
+		Signature:          p.Signature,
+	}
+	for _, endpoint := range p.AltCommEndpoints {
+		peerID.AltCommEndpoints = append(peerID.AltCommEndpoints,
+			perun.CommEndpoint{CommAddr: endpoint.CommAddr, CommType: endpoint.CommType})
+	}
+	return peerID
+}
+
+// errCodes maps the errors returned by the ID provider to the error codes returned by the directory service.
+var errCodes = map[idprovider.Error]string{
+	idprovider.ErrPeerIDNotFound:          ErrCodeNotFound,
+	idprovider.ErrPeerAliasAlreadyUsed:    ErrCodeAliasAlreadyUsed,
+	idprovider.ErrPeerIDAlreadyRegistered: ErrCodeAlreadyRegistered,
+	idprovider.ErrParsingOffChainAddress:  ErrCodeInvalidAddress,
+	idprovider.ErrPeerIDSignatureMissing:  ErrCodeSignatureMissing,
+	idprovider.ErrPeerIDSignatureInvalid:  ErrCodeInvalidSignature,
+}
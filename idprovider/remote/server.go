@@ -0,0 +1,186 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/idprovider"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+// Server is a reference implementation of the directory service. It stores the peer IDs in an ID provider
+// and writes the changes to its storage after each request that modifies it.
+//
+// If required headers are configured, requests that do not include each of these headers with the configured
+// value are rejected.
+type Server struct {
+	log.Logger
+
+	store           perun.IDProvider
+	walletBackend   perun.WalletBackend
+	requiredHeaders map[string]string
+	mux             *http.ServeMux
+
+	mutex sync.Mutex // Serializes the requests that modify the store.
+}
+
+// NewServer returns a directory service that stores the peer IDs in the given ID provider. Backend is used for
+// decoding the off-chain address strings in the requests.
+func NewServer(store perun.IDProvider, backend perun.WalletBackend, requiredHeaders map[string]string) *Server {
+	s := &Server{
+		Logger:          log.NewLoggerWithField("component", "directory-service"),
+		store:           store,
+		walletBackend:   backend,
+		requiredHeaders: requiredHeaders,
+		mux:             http.NewServeMux(),
+	}
+	s.mux.HandleFunc(PathPeers, s.handlePeers)
+	s.mux.HandleFunc(PathPeerByAlias, s.handlePeerByAlias)
+	s.mux.HandleFunc(PathPeerByOffChainAddr, s.handlePeerByOffChainAddr)
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	for name, value := range s.requiredHeaders {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(name)), []byte(value)) != 1 {
+			writeError(w, http.StatusUnauthorized, ErrCodeUnauthorized, "missing or invalid header "+name)
+			return
+		}
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) handlePeers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, ErrCodeInvalidRequest, "method not allowed")
+		return
+	}
+	var peerIDJSON PeerIDJSON
+	if err := json.NewDecoder(r.Body).Decode(&peerIDJSON); err != nil {
+		writeError(w, http.StatusBadRequest, ErrCodeInvalidRequest, "decoding request: "+err.Error())
+		return
+	}
+	if peerIDJSON.Alias == "" {
+		writeError(w, http.StatusBadRequest, ErrCodeInvalidRequest, "alias should not be empty")
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.store.Write(peerIDJSON.Alias, fromPeerIDJSON(peerIDJSON)); err != nil {
+		writeIDProviderError(w, err)
+		return
+	}
+	if err := s.store.UpdateStorage(); err != nil {
+		s.WithError(err).Error("Updating storage")
+		writeError(w, http.StatusInternalServerError, ErrCodeInternal, "updating storage")
+		return
+	}
+	s.WithField("alias", peerIDJSON.Alias).Info("Added peer ID")
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) handlePeerByAlias(w http.ResponseWriter, r *http.Request) {
+	alias := strings.TrimPrefix(r.URL.Path, PathPeerByAlias)
+	switch r.Method {
+	case http.MethodGet:
+		peerID, isPresent := s.store.ReadByAlias(alias)
+		if !isPresent {
+			writeIDProviderError(w, idprovider.ErrPeerIDNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, toPeerIDJSON(peerID))
+
+	case http.MethodDelete:
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		if _, isPresent := s.store.ReadByAlias(alias); !isPresent {
+			writeIDProviderError(w, idprovider.ErrPeerIDNotFound)
+			return
+		}
+		if err := s.store.Delete(alias); err != nil {
+			writeIDProviderError(w, err)
+			return
+		}
+		if err := s.store.UpdateStorage(); err != nil {
+			s.WithError(err).Error("Updating storage")
+			writeError(w, http.StatusInternalServerError, ErrCodeInternal, "updating storage")
+			return
+		}
+		s.WithField("alias", alias).Info("Deleted peer ID")
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, ErrCodeInvalidRequest, "method not allowed")
+	}
+}
+
+func (s *Server) handlePeerByOffChainAddr(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, ErrCodeInvalidRequest, "method not allowed")
+		return
+	}
+	offChainAddr, err := s.walletBackend.ParseAddr(strings.TrimPrefix(r.URL.Path, PathPeerByOffChainAddr))
+	if err != nil {
+		writeIDProviderError(w, errors.Wrap(idprovider.ErrParsingOffChainAddress, err.Error()))
+		return
+	}
+	peerID, isPresent := s.store.ReadByOffChainAddr(offChainAddr)
+	if !isPresent {
+		writeIDProviderError(w, idprovider.ErrPeerIDNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, toPeerIDJSON(peerID))
+}
+
+// writeIDProviderError writes the error returned by the ID provider with the corresponding error code.
+func writeIDProviderError(w http.ResponseWriter, err error) {
+	var idProviderErr idprovider.Error
+	if !errors.As(err, &idProviderErr) {
+		writeError(w, http.StatusInternalServerError, ErrCodeInternal, err.Error())
+		return
+	}
+	switch code := errCodes[idProviderErr]; code {
+	case ErrCodeNotFound:
+		writeError(w, http.StatusNotFound, code, err.Error())
+	case ErrCodeAliasAlreadyUsed, ErrCodeAlreadyRegistered:
+		writeError(w, http.StatusConflict, code, err.Error())
+	case ErrCodeInvalidAddress, ErrCodeSignatureMissing, ErrCodeInvalidSignature:
+		writeError(w, http.StatusBadRequest, code, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, ErrCodeInternal, err.Error())
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, ErrorJSON{Code: code, Message: message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v) // nolint: errcheck, gosec  // nothing can be done if writing the response fails.
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idprovider
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// peerIDSigDomain is prefixed to the data signed in a peer ID, so that the signature cannot be used
+// in any other context.
+const peerIDSigDomain = "perun-node peer id"
+
+// SignPeerID signs the off-chain address and the comm endpoints of the peer ID using the given account,
+// which should correspond to the off-chain address. The signature is returned as a hex string with 0x prefix.
+//
+// Alias is not signed, as it is assigned by the user of the peer ID.
+func SignPeerID(p perun.PeerID, acc pwallet.Account) (string, error) {
+	if p.OffChainAddr == nil || !p.OffChainAddr.Equals(acc.Address()) {
+		return "", errors.New("account does not correspond to off-chain address of peer id")
+	}
+	sig, err := acc.SignData(peerIDSigData(p))
+	if err != nil {
+		return "", errors.Wrap(err, "signing peer id")
+	}
+	return hexutil.Encode(sig), nil
+}
+
+// VerifyPeerID verifies if the signature in the peer ID was made by its off-chain address. The off-chain
+// address of the peer ID should have been parsed.
+//
+// It returns ErrPeerIDSignatureMissing if the peer ID is not signed and ErrPeerIDSignatureInvalid if the
+// signature is invalid.
+func VerifyPeerID(p perun.PeerID, wb perun.WalletBackend) error {
+	if p.Signature == "" {
+		return ErrPeerIDSignatureMissing
+	}
+	sig, err := hexutil.Decode(p.Signature)
+	if err != nil {
+		return errors.Wrap(ErrPeerIDSignatureInvalid, err.Error())
+	}
+	isValid, err := wb.VerifySig(peerIDSigData(p), sig, p.OffChainAddr)
+	if err != nil {
+		return errors.Wrap(ErrPeerIDSignatureInvalid, err.Error())
+	}
+	if !isValid {
+		return ErrPeerIDSignatureInvalid
+	}
+	return nil
+}
+
+// peerIDSigData returns the data that is signed in a peer ID. Each of the fields is prefixed with its length,
+// so that the encoding is unambiguous.
+func peerIDSigData(p perun.PeerID) []byte {
+	fields := [][]byte{[]byte(peerIDSigDomain), p.OffChainAddr.Bytes(), []byte(p.CommAddr), []byte(p.CommType)}
+	for _, endpoint := range p.AltCommEndpoints {
+		fields = append(fields, []byte(endpoint.CommAddr), []byte(endpoint.CommType))
+	}
+	var buf bytes.Buffer
+	lenBytes := make([]byte, 4)
+	for _, field := range fields {
+		binary.BigEndian.PutUint32(lenBytes, uint32(len(field)))
+		buf.Write(lenBytes)
+		buf.Write(field)
+	}
+	return buf.Bytes()
+}
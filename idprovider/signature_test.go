@@ -0,0 +1,97 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idprovider_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/idprovider"
+)
+
+func Test_SignPeerID_VerifyPeerID(t *testing.T) {
+	rng := rand.New(rand.NewSource(1729))
+	ws := ethereumtest.NewWalletSetupT(t, rng, 2)
+	acc := ws.Accs[0]
+	peerID := perun.PeerID{
+		Alias:              "Alice",
+		OffChainAddr:       acc.Address(),
+		OffChainAddrString: acc.Address().String(),
+		CommAddr:           "127.0.0.1:5751",
+		CommType:           "tcp",
+	}
+
+	signed := peerID
+	var err error
+	signed.Signature, err = idprovider.SignPeerID(peerID, acc)
+	require.NoError(t, err)
+
+	t.Run("happy", func(t *testing.T) {
+		assert.NoError(t, idprovider.VerifyPeerID(signed, ws.WalletBackend))
+	})
+
+	t.Run("happy_alias_changed", func(t *testing.T) {
+		renamed := signed
+		renamed.Alias = "Bob"
+		assert.NoError(t, idprovider.VerifyPeerID(renamed, ws.WalletBackend))
+	})
+
+	t.Run("error_sign_with_other_account", func(t *testing.T) {
+		_, err := idprovider.SignPeerID(peerID, ws.Accs[1])
+		assert.Error(t, err)
+	})
+
+	t.Run("error_missing", func(t *testing.T) {
+		err := idprovider.VerifyPeerID(peerID, ws.WalletBackend)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDSignatureMissing))
+	})
+
+	t.Run("error_invalid_encoding", func(t *testing.T) {
+		invalid := signed
+		invalid.Signature = "invalid-signature"
+		err := idprovider.VerifyPeerID(invalid, ws.WalletBackend)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDSignatureInvalid))
+	})
+
+	t.Run("error_comm_addr_tampered", func(t *testing.T) {
+		tampered := signed
+		tampered.CommAddr = "127.0.0.1:6666"
+		err := idprovider.VerifyPeerID(tampered, ws.WalletBackend)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDSignatureInvalid))
+	})
+
+	t.Run("error_alt_comm_endpoint_added", func(t *testing.T) {
+		tampered := signed
+		tampered.AltCommEndpoints = []perun.CommEndpoint{{CommAddr: "127.0.0.1:6666", CommType: "tcp"}}
+		err := idprovider.VerifyPeerID(tampered, ws.WalletBackend)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDSignatureInvalid))
+	})
+
+	t.Run("error_off_chain_addr_tampered", func(t *testing.T) {
+		tampered := signed
+		tampered.OffChainAddr = ws.Accs[1].Address()
+		tampered.OffChainAddrString = ws.Accs[1].Address().String()
+		err := idprovider.VerifyPeerID(tampered, ws.WalletBackend)
+		assert.True(t, errors.Is(err, idprovider.ErrPeerIDSignatureInvalid))
+	})
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idprovider
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// Formats for importing and exporting peer IDs.
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// commEndpointSep separates the comm type and the comm address, when an alternative comm endpoint is encoded
+// in a CSV record as "comm_type://comm_address". Multiple endpoints are separated by spaces.
+const commEndpointSep = "://"
+
+// csvHeader is the header row of the peer IDs encoded in CSV format.
+var csvHeader = []string{"alias", "offchain_address", "comm_address", "comm_type", "signature", "alt_comm_endpoints"}
+
+type (
+	// peerIDRecord is the representation of a peer ID in the JSON format.
+	peerIDRecord struct {
+		Alias            string               `json:"alias"`
+		OffChainAddr     string               `json:"offChainAddress"`
+		CommAddr         string               `json:"commAddress"`
+		CommType         string               `json:"commType"`
+		Signature        string               `json:"signature,omitempty"`
+		AltCommEndpoints []commEndpointRecord `json:"altCommEndpoints,omitempty"`
+	}
+
+	// commEndpointRecord is the representation of a comm endpoint in the JSON format.
+	commEndpointRecord struct {
+		CommAddr string `json:"commAddress"`
+		CommType string `json:"commType"`
+	}
+)
+
+// EncodePeerIDs encodes the peer IDs in the given format (FormatJSON or FormatCSV).
+func EncodePeerIDs(peerIDs []perun.PeerID, format string) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		records := make([]peerIDRecord, len(peerIDs))
+		for i := range peerIDs {
+			records[i] = toPeerIDRecord(peerIDs[i])
+		}
+		data, err := json.MarshalIndent(records, "", "  ")
+		return data, errors.Wrap(err, "encoding as json")
+	case FormatCSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		if err := w.Write(csvHeader); err != nil {
+			return nil, errors.Wrap(err, "encoding as csv")
+		}
+		for i := range peerIDs {
+			if err := w.Write(toCSVRecord(peerIDs[i])); err != nil {
+				return nil, errors.Wrap(err, "encoding as csv")
+			}
+		}
+		w.Flush()
+		return buf.Bytes(), errors.Wrap(w.Error(), "encoding as csv")
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// DecodePeerIDs decodes the peer IDs from the data in the given format (FormatJSON or FormatCSV). Data in the
+// CSV format should begin with the header row. The off-chain addresses are not parsed.
+func DecodePeerIDs(data []byte, format string) ([]perun.PeerID, error) {
+	switch format {
+	case FormatJSON:
+		var records []peerIDRecord
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, errors.Wrap(err, "decoding json")
+		}
+		peerIDs := make([]perun.PeerID, len(records))
+		for i := range records {
+			peerIDs[i] = fromPeerIDRecord(records[i])
+		}
+		return peerIDs, nil
+	case FormatCSV:
+		r := csv.NewReader(bytes.NewReader(data))
+		r.FieldsPerRecord = len(csvHeader)
+		records, err := r.ReadAll()
+		if err != nil {
+			return nil, errors.Wrap(err, "decoding csv")
+		}
+		if len(records) == 0 || strings.Join(records[0], ",") != strings.Join(csvHeader, ",") {
+			return nil, errors.Errorf("decoding csv: header row should be %s", strings.Join(csvHeader, ","))
+		}
+		peerIDs := make([]perun.PeerID, len(records)-1)
+		for i := range peerIDs {
+			if peerIDs[i], err = fromCSVRecord(records[i+1]); err != nil {
+				return nil, errors.WithMessagef(err, "decoding csv: record %d", i)
+			}
+		}
+		return peerIDs, nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+func toPeerIDRecord(p perun.PeerID) peerIDRecord {
+	record := peerIDRecord{
+		Alias:        p.Alias,
+		OffChainAddr: p.OffChainAddrString,
+		CommAddr:     p.CommAddr,
+		CommType:     p.CommType,
+		Signature:    p.Signature,
+	}
+	for _, endpoint := range p.AltCommEndpoints {
+		record.AltCommEndpoints = append(record.AltCommEndpoints,
+			commEndpointRecord{CommAddr: endpoint.CommAddr, CommType: endpoint.CommType})
+	}
+	return record
+}
+
+func fromPeerIDRecord(record peerIDRecord) perun.PeerID {
+	p := perun.PeerID{
+		Alias:              record.Alias,
+		OffChainAddrString: record.OffChainAddr,
+		CommAddr:           record.CommAddr,
+		CommType:           record.CommType,
+		Signature:          record.Signature,
+	}
+	for _, endpoint := range record.AltCommEndpoints {
+		p.AltCommEndpoints = append(p.AltCommEndpoints,
+			perun.CommEndpoint{CommAddr: endpoint.CommAddr, CommType: endpoint.CommType})
+	}
+	return p
+}
+
+func toCSVRecord(p perun.PeerID) []string {
+	endpoints := make([]string, len(p.AltCommEndpoints))
+	for i, endpoint := range p.AltCommEndpoints {
+		endpoints[i] = endpoint.CommType + commEndpointSep + endpoint.CommAddr
+	}
+	return []string{p.Alias, p.OffChainAddrString, p.CommAddr, p.CommType, p.Signature, strings.Join(endpoints, " ")}
+}
+
+func fromCSVRecord(record []string) (perun.PeerID, error) {
+	p := perun.PeerID{
+		Alias:              record[0],
+		OffChainAddrString: record[1],
+		CommAddr:           record[2],
+		CommType:           record[3],
+		Signature:          record[4],
+	}
+	for _, endpoint := range strings.Fields(record[5]) {
+		parts := strings.SplitN(endpoint, commEndpointSep, 2)
+		if len(parts) != 2 {
+			return perun.PeerID{}, errors.Errorf("alt comm endpoint %s should be comm_type%scomm_address",
+				endpoint, commEndpointSep)
+		}
+		p.AltCommEndpoints = append(p.AltCommEndpoints, perun.CommEndpoint{CommType: parts[0], CommAddr: parts[1]})
+	}
+	return p, nil
+}
+
+// importOp represents the operation of adding an imported record to the ID provider, after deleting the
+// existing peer IDs that conflict with it. If the record could not be added, the deleted peer IDs are restored.
+type importOp struct {
+	index      int
+	record     perun.PeerID
+	overwrites []string // Aliases of the existing peer IDs to be deleted.
+}
+
+// ImportPeerIDs imports the peer IDs into the ID provider and returns the report.
+//
+// The off-chain addresses of the records are parsed and the signatures, if present, are verified using the
+// wallet backend. Records that are invalid, repeated within the imported list or are for the own alias are
+// not imported. If a record conflicts with an existing peer ID, because the alias is used for another peer
+// or because the off-chain address is registered with another alias, it is resolved using the merge strategy.
+// Peer ID of the user (perun.OwnAlias) is never overwritten.
+//
+// If dryRun is true, the ID provider is not updated, but the report describes what would have been done.
+func ImportPeerIDs(p perun.IDProvider, wb perun.WalletBackend, records []perun.PeerID,
+	strategy perun.PeerIDMergeStrategy, dryRun bool) (perun.PeerIDImportReport, error) {
+	switch strategy {
+	case perun.MergeSkip, perun.MergeOverwrite, perun.MergeAbort:
+	default:
+		return perun.PeerIDImportReport{}, errors.Errorf("unsupported merge strategy: %s", strategy)
+	}
+
+	report := perun.PeerIDImportReport{}
+	ops := make([]importOp, 0, len(records))
+	seenAliases, seenAddrs := make(map[string]bool), make(map[string]bool)
+	for i, record := range records {
+		var err error
+		if record, err = validateRecord(record, wb); err == nil &&
+			(seenAliases[record.Alias] || seenAddrs[record.OffChainAddr.String()]) {
+			err = ErrDuplicateRecord
+		}
+		if err != nil {
+			report.Invalid = append(report.Invalid, perun.PeerIDImportInvalid{
+				Index: i, Alias: record.Alias, Reason: err.Error(),
+			})
+			continue
+		}
+		seenAliases[record.Alias] = true
+		seenAddrs[record.OffChainAddr.String()] = true
+
+		existing, found := p.ReadByAlias(record.Alias)
+		if found && PeerIDEqual(existing, record) {
+			report.Unchanged = append(report.Unchanged, record.Alias)
+			continue
+		}
+		conflicts := findConflicts(p, record)
+		if len(conflicts) == 0 {
+			report.Added = append(report.Added, record.Alias)
+			ops = append(ops, importOp{index: i, record: record})
+			continue
+		}
+		report.Conflicts = append(report.Conflicts, conflicts...)
+		if strategy == perun.MergeOverwrite && !conflictsWithOwnAlias(conflicts) {
+			report.Overwritten = append(report.Overwritten, record.Alias)
+			ops = append(ops, importOp{index: i, record: record, overwrites: existingAliases(conflicts)})
+		}
+	}
+
+	if dryRun || (strategy == perun.MergeAbort && len(report.Conflicts) != 0) {
+		return report, nil
+	}
+	for _, op := range ops {
+		if err := applyImportOp(p, op); err != nil {
+			report.Added = removeAlias(report.Added, op.record.Alias)
+			report.Overwritten = removeAlias(report.Overwritten, op.record.Alias)
+			report.Invalid = append(report.Invalid, perun.PeerIDImportInvalid{
+				Index: op.index, Alias: op.record.Alias, Reason: err.Error(),
+			})
+		}
+	}
+	report.Applied = true
+	return report, nil
+}
+
+// applyImportOp deletes the existing peer IDs to be overwritten and adds the record. If the record could not be
+// added, the deleted peer IDs are written back, so that a failed import does not lose them.
+func applyImportOp(p perun.IDProvider, op importOp) error {
+	deleted := make([]perun.PeerID, 0, len(op.overwrites))
+	for _, alias := range op.overwrites {
+		existing, found := p.ReadByAlias(alias)
+		if !found {
+			continue // Entry might have been deleted for an earlier record.
+		}
+		if err := p.Delete(alias); err != nil {
+			continue
+		}
+		deleted = append(deleted, existing)
+	}
+	err := p.Write(op.record.Alias, op.record)
+	if err != nil {
+		for _, existing := range deleted {
+			p.Write(existing.Alias, existing) // nolint: errcheck, gosec  // it was valid when it was written.
+		}
+	}
+	return err
+}
+
+// validateRecord validates the imported record and returns it with the parsed off-chain address.
+func validateRecord(record perun.PeerID, wb perun.WalletBackend) (perun.PeerID, error) {
+	if record.Alias == "" || record.Alias == perun.OwnAlias {
+		return record, errors.Errorf("alias should not be empty or %s", perun.OwnAlias)
+	}
+	var err error
+	if record.OffChainAddr, err = wb.ParseAddr(record.OffChainAddrString); err != nil {
+		return record, errors.Wrap(ErrParsingOffChainAddress, err.Error())
+	}
+	if record.Signature != "" {
+		if err = VerifyPeerID(record, wb); err != nil {
+			return record, err
+		}
+	}
+	return record, nil
+}
+
+// findConflicts returns the conflicts between the record and the existing peer IDs in the ID provider.
+func findConflicts(p perun.IDReader, record perun.PeerID) []perun.PeerIDImportConflict {
+	var conflicts []perun.PeerIDImportConflict
+	if existing, found := p.ReadByAlias(record.Alias); found {
+		conflicts = append(conflicts, perun.PeerIDImportConflict{
+			Alias: record.Alias, ExistingAlias: existing.Alias, Reason: ErrPeerAliasAlreadyUsed.Error(),
+		})
+	}
+	if existing, found := p.ReadByOffChainAddr(record.OffChainAddr); found && existing.Alias != record.Alias {
+		conflicts = append(conflicts, perun.PeerIDImportConflict{
+			Alias:         record.Alias,
+			ExistingAlias: existing.Alias,
+			Reason:        fmt.Sprintf("%s: %s", ErrOffChainAddrAlreadyUsed, record.OffChainAddrString),
+		})
+	}
+	return conflicts
+}
+
+func conflictsWithOwnAlias(conflicts []perun.PeerIDImportConflict) bool {
+	for i := range conflicts {
+		if conflicts[i].ExistingAlias == perun.OwnAlias {
+			return true
+		}
+	}
+	return false
+}
+
+func existingAliases(conflicts []perun.PeerIDImportConflict) []string {
+	aliases := make([]string, len(conflicts))
+	for i := range conflicts {
+		aliases[i] = conflicts[i].ExistingAlias
+	}
+	return aliases
+}
+
+func removeAlias(aliases []string, alias string) []string {
+	for i := range aliases {
+		if aliases[i] == alias {
+			return append(aliases[:i], aliases[i+1:]...)
+		}
+	}
+	return aliases
+}
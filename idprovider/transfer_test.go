@@ -0,0 +1,242 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idprovider_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/idprovider"
+	"github.com/hyperledger-labs/perun-node/idprovider/idprovidertest"
+	"github.com/hyperledger-labs/perun-node/idprovider/local"
+)
+
+func Test_EncodePeerIDs_DecodePeerIDs(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	peer1, peer2 := newPeerID(rng, "Alice"), newPeerID(rng, "Bob")
+	peer2.Signature = "0x1234"
+	peer2.AltCommEndpoints = []perun.CommEndpoint{
+		{CommAddr: "10.0.0.1:5751", CommType: "tcp"},
+		{CommAddr: "10.0.0.2:5751", CommType: "tcp"},
+	}
+	peerIDs := []perun.PeerID{peer1, peer2}
+
+	for _, format := range []string{idprovider.FormatJSON, idprovider.FormatCSV} {
+		format := format
+		t.Run("happy_"+format, func(t *testing.T) {
+			data, err := idprovider.EncodePeerIDs(peerIDs, format)
+			require.NoError(t, err)
+			decoded, err := idprovider.DecodePeerIDs(data, format)
+			require.NoError(t, err)
+			require.Len(t, decoded, len(peerIDs))
+			for i := range peerIDs {
+				assert.True(t, idprovider.PeerIDEqual(peerIDs[i], decoded[i]), decoded[i])
+			}
+		})
+	}
+
+	t.Run("unsupported_format", func(t *testing.T) {
+		_, err := idprovider.EncodePeerIDs(peerIDs, "xml")
+		assert.True(t, errors.Is(err, idprovider.ErrUnsupportedFormat), err)
+		_, err = idprovider.DecodePeerIDs([]byte{}, "xml")
+		assert.True(t, errors.Is(err, idprovider.ErrUnsupportedFormat), err)
+	})
+
+	t.Run("csv_missing_header", func(t *testing.T) {
+		_, err := idprovider.DecodePeerIDs([]byte("Alice,0x1,127.0.0.1:5751,tcp,,\n"), idprovider.FormatCSV)
+		assert.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("csv_invalid_alt_comm_endpoint", func(t *testing.T) {
+		data := "alias,offchain_address,comm_address,comm_type,signature,alt_comm_endpoints\n" +
+			"Alice,0x1,127.0.0.1:5751,tcp,,10.0.0.1:5751\n"
+		_, err := idprovider.DecodePeerIDs([]byte(data), idprovider.FormatCSV)
+		assert.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("json_invalid", func(t *testing.T) {
+		_, err := idprovider.DecodePeerIDs([]byte("{invalid"), idprovider.FormatJSON)
+		assert.Error(t, err)
+	})
+}
+
+func Test_ImportPeerIDs(t *testing.T) {
+	wb := ethereum.NewWalletBackend()
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	own, existing := newPeerID(rng, perun.OwnAlias), newPeerID(rng, "Alice")
+
+	newProvider := func(t *testing.T) *local.IDProvider {
+		p, err := local.NewIDprovider(idprovidertest.NewIDProviderT(t, own, existing), wb, false)
+		require.NoError(t, err)
+		return p
+	}
+	newRecord := func(p perun.PeerID) perun.PeerID {
+		p.OffChainAddr = nil
+		return p
+	}
+
+	t.Run("happy_added_unchanged", func(t *testing.T) {
+		p := newProvider(t)
+		bob := newPeerID(rng, "Bob")
+		records := []perun.PeerID{newRecord(existing), newRecord(bob)}
+		report, err := idprovider.ImportPeerIDs(p, wb, records, perun.MergeAbort, false)
+		require.NoError(t, err)
+		assert.True(t, report.Applied)
+		assert.Equal(t, []string{"Bob"}, report.Added)
+		assert.Equal(t, []string{"Alice"}, report.Unchanged)
+		assert.Empty(t, report.Conflicts)
+
+		gotPeerID, found := p.ReadByAlias("Bob")
+		require.True(t, found)
+		assert.True(t, idprovider.PeerIDEqual(bob, gotPeerID))
+	})
+
+	t.Run("invalid_records", func(t *testing.T) {
+		p := newProvider(t)
+		bob := newPeerID(rng, "Bob")
+		invalidAddr := newRecord(newPeerID(rng, "Tom"))
+		invalidAddr.OffChainAddrString = "invalid-addr"
+		invalidSig := newRecord(newPeerID(rng, "Carol"))
+		invalidSig.Signature = "invalid-signature"
+		records := []perun.PeerID{
+			newRecord(bob), newRecord(bob), invalidAddr, invalidSig,
+			newRecord(newPeerID(rng, perun.OwnAlias)), newRecord(newPeerID(rng, "")),
+		}
+
+		report, err := idprovider.ImportPeerIDs(p, wb, records, perun.MergeSkip, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Bob"}, report.Added)
+		require.Len(t, report.Invalid, 5)
+		for i, invalid := range report.Invalid {
+			assert.Equal(t, i+1, invalid.Index)
+		}
+	})
+
+	conflictingAlias := newRecord(newPeerID(rng, existing.Alias))
+	conflictingAddr := newRecord(existing)
+	conflictingAddr.Alias = "Bob"
+	conflictingOwn := newRecord(own)
+	conflictingOwn.Alias = "Tom"
+	records := []perun.PeerID{conflictingAlias, conflictingAddr, conflictingOwn, newRecord(newPeerID(rng, "Carol"))}
+
+	t.Run("dry_run", func(t *testing.T) {
+		p := newProvider(t)
+		report, err := idprovider.ImportPeerIDs(p, wb, records, perun.MergeOverwrite, true)
+		require.NoError(t, err)
+		assert.False(t, report.Applied)
+		assert.Equal(t, []string{"Carol"}, report.Added)
+		assert.Equal(t, []string{existing.Alias, "Bob"}, report.Overwritten)
+		assert.Len(t, report.Conflicts, 3)
+
+		_, found := p.ReadByAlias("Carol")
+		assert.False(t, found)
+	})
+
+	t.Run("merge_skip", func(t *testing.T) {
+		p := newProvider(t)
+		report, err := idprovider.ImportPeerIDs(p, wb, records, perun.MergeSkip, false)
+		require.NoError(t, err)
+		assert.True(t, report.Applied)
+		assert.Equal(t, []string{"Carol"}, report.Added)
+		assert.Empty(t, report.Overwritten)
+		assert.Len(t, report.Conflicts, 3)
+
+		gotPeerID, found := p.ReadByAlias(existing.Alias)
+		require.True(t, found)
+		assert.True(t, idprovider.PeerIDEqual(existing, gotPeerID))
+	})
+
+	t.Run("merge_overwrite", func(t *testing.T) {
+		p := newProvider(t)
+		report, err := idprovider.ImportPeerIDs(p, wb, records, perun.MergeOverwrite, false)
+		require.NoError(t, err)
+		assert.True(t, report.Applied)
+		assert.Equal(t, []string{existing.Alias, "Bob"}, report.Overwritten)
+
+		// Off-chain address of the existing peer is now registered for Bob, so Alice was deleted.
+		_, found := p.ReadByAlias(existing.Alias)
+		assert.False(t, found)
+		gotPeerID, found := p.ReadByOffChainAddr(existing.OffChainAddr)
+		require.True(t, found)
+		assert.Equal(t, "Bob", gotPeerID.Alias)
+		_, found = p.ReadByAlias(perun.OwnAlias)
+		assert.True(t, found, "own peer ID should not be overwritten")
+	})
+
+	t.Run("merge_overwrite_write_fails", func(t *testing.T) {
+		p := &failingWriteProvider{IDProvider: newProvider(t), failAlias: "Bob"}
+		report, err := idprovider.ImportPeerIDs(p, wb, []perun.PeerID{conflictingAddr}, perun.MergeOverwrite, false)
+		require.NoError(t, err)
+		assert.Empty(t, report.Overwritten)
+		require.Len(t, report.Invalid, 1)
+		assert.Equal(t, "Bob", report.Invalid[0].Alias)
+
+		// Existing peer ID deleted for overwriting should be restored.
+		gotPeerID, found := p.ReadByAlias(existing.Alias)
+		require.True(t, found)
+		assert.True(t, idprovider.PeerIDEqual(existing, gotPeerID))
+	})
+
+	t.Run("merge_abort", func(t *testing.T) {
+		p := newProvider(t)
+		report, err := idprovider.ImportPeerIDs(p, wb, records, perun.MergeAbort, false)
+		require.NoError(t, err)
+		assert.False(t, report.Applied)
+		assert.Len(t, report.Conflicts, 3)
+
+		_, found := p.ReadByAlias("Carol")
+		assert.False(t, found)
+	})
+
+	t.Run("unsupported_strategy", func(t *testing.T) {
+		_, err := idprovider.ImportPeerIDs(newProvider(t), wb, records, "invalid", false)
+		assert.Error(t, err)
+	})
+}
+
+// failingWriteProvider is an ID provider, for which writing the peer ID with the given alias fails.
+type failingWriteProvider struct {
+	*local.IDProvider
+	failAlias string
+}
+
+func (p *failingWriteProvider) Write(alias string, peerID perun.PeerID) error {
+	if alias == p.failAlias {
+		return errors.New("write failed")
+	}
+	return p.IDProvider.Write(alias, peerID)
+}
+
+func newPeerID(rng *rand.Rand, alias string) perun.PeerID {
+	offChainAddr := ethereumtest.NewRandomAddress(rng)
+	return perun.PeerID{
+		Alias:              alias,
+		OffChainAddr:       offChainAddr,
+		OffChainAddrString: offChainAddr.String(),
+		CommType:           "tcp",
+		CommAddr:           "127.0.0.1:5751",
+	}
+}
@@ -81,6 +81,29 @@ func (_m *ChAPI) Currency(symbol string) (int, perun.Currency, bool) {
 	return r0, r1, r2
 }
 
+// ExportReceipt provides a mock function with given fields: version
+func (_m *ChAPI) ExportReceipt(version string) (perun.ChReceipt, perun.APIError) {
+	ret := _m.Called(version)
+
+	var r0 perun.ChReceipt
+	if rf, ok := ret.Get(0).(func(string) perun.ChReceipt); ok {
+		r0 = rf(version)
+	} else {
+		r0 = ret.Get(0).(perun.ChReceipt)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(string) perun.APIError); ok {
+		r1 = rf(version)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetChInfo provides a mock function with given fields:
 func (_m *ChAPI) GetChInfo() perun.ChInfo {
 	ret := _m.Called()
@@ -95,6 +118,22 @@ func (_m *ChAPI) GetChInfo() perun.ChInfo {
 	return r0
 }
 
+// GetHistory provides a mock function with given fields:
+func (_m *ChAPI) GetHistory() []perun.ChUpdateRecord {
+	ret := _m.Called()
+
+	var r0 []perun.ChUpdateRecord
+	if rf, ok := ret.Get(0).(func() []perun.ChUpdateRecord); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]perun.ChUpdateRecord)
+		}
+	}
+
+	return r0
+}
+
 // ID provides a mock function with given fields:
 func (_m *ChAPI) ID() string {
 	ret := _m.Called()
@@ -171,6 +210,29 @@ func (_m *ChAPI) SendChUpdate(_a0 context.Context, _a1 perun.StateUpdater) (peru
 	return r0, r1
 }
 
+// SendChUpdateWMeta provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ChAPI) SendChUpdateWMeta(_a0 context.Context, _a1 perun.StateUpdater, _a2 perun.ChUpdateMeta) (perun.ChInfo, perun.APIError) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 perun.ChInfo
+	if rf, ok := ret.Get(0).(func(context.Context, perun.StateUpdater, perun.ChUpdateMeta) perun.ChInfo); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(perun.ChInfo)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(context.Context, perun.StateUpdater, perun.ChUpdateMeta) perun.APIError); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
 // SubChUpdates provides a mock function with given fields: _a0
 func (_m *ChAPI) SubChUpdates(_a0 perun.ChUpdateNotifier) perun.APIError {
 	ret := _m.Called(_a0)
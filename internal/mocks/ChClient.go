@@ -13,6 +13,8 @@ import (
 
 	persistence "perun.network/go-perun/channel/persistence"
 
+	perun "github.com/hyperledger-labs/perun-node"
+
 	session "github.com/hyperledger-labs/perun-node/session"
 
 	time "time"
@@ -93,6 +95,11 @@ func (_m *ChClient) OnNewChannel(handler func(session.PChannel)) {
 	_m.Called(handler)
 }
 
+// OnSignedState provides a mock function with given fields: handler
+func (_m *ChClient) OnSignedState(handler session.SignedStateHandler) {
+	_m.Called(handler)
+}
+
 // ProposeChannel provides a mock function with given fields: _a0, _a1
 func (_m *ChClient) ProposeChannel(_a0 context.Context, _a1 client.ChannelProposal) (session.PChannel, error) {
 	ret := _m.Called(_a0, _a1)
@@ -116,11 +123,32 @@ func (_m *ChClient) ProposeChannel(_a0 context.Context, _a1 client.ChannelPropos
 	return r0, r1
 }
 
+// Publish provides a mock function with given fields: _a0, _a1
+func (_m *ChClient) Publish(_a0 context.Context, _a1 *wire.Envelope) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *wire.Envelope) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(error)
+		}
+	}
+
+	return r0
+}
+
 // Register provides a mock function with given fields: offChainAddr, commAddr
 func (_m *ChClient) Register(offChainAddr wire.Address, commAddr string) {
 	_m.Called(offChainAddr, commAddr)
 }
 
+// RegisterEndpoints provides a mock function with given fields: offChainAddr, endpoints
+func (_m *ChClient) RegisterEndpoints(offChainAddr wire.Address, endpoints []perun.CommEndpoint) {
+	_m.Called(offChainAddr, endpoints)
+}
+
 // Restore provides a mock function with given fields: _a0
 func (_m *ChClient) Restore(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -30,6 +30,29 @@ func (_m *SessionAPI) AddPeerID(_a0 perun.PeerID) perun.APIError {
 	return r0
 }
 
+// CancelScheduledPayment provides a mock function with given fields: scheduledPaymentID
+func (_m *SessionAPI) CancelScheduledPayment(scheduledPaymentID string) (perun.ScheduledPayment, perun.APIError) {
+	ret := _m.Called(scheduledPaymentID)
+
+	var r0 perun.ScheduledPayment
+	if rf, ok := ret.Get(0).(func(string) perun.ScheduledPayment); ok {
+		r0 = rf(scheduledPaymentID)
+	} else {
+		r0 = ret.Get(0).(perun.ScheduledPayment)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(string) perun.APIError); ok {
+		r1 = rf(scheduledPaymentID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields: force
 func (_m *SessionAPI) Close(force bool) ([]perun.ChInfo, perun.APIError) {
 	ret := _m.Called(force)
@@ -55,6 +78,52 @@ func (_m *SessionAPI) Close(force bool) ([]perun.ChInfo, perun.APIError) {
 	return r0, r1
 }
 
+// CreateInvoice provides a mock function with given fields: currency, amount, memo, expirySecs
+func (_m *SessionAPI) CreateInvoice(currency string, amount string, memo string, expirySecs uint64) (perun.Invoice, perun.APIError) {
+	ret := _m.Called(currency, amount, memo, expirySecs)
+
+	var r0 perun.Invoice
+	if rf, ok := ret.Get(0).(func(string, string, string, uint64) perun.Invoice); ok {
+		r0 = rf(currency, amount, memo, expirySecs)
+	} else {
+		r0 = ret.Get(0).(perun.Invoice)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(string, string, string, uint64) perun.APIError); ok {
+		r1 = rf(currency, amount, memo, expirySecs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateScheduledPayment provides a mock function with given fields: _a0
+func (_m *SessionAPI) CreateScheduledPayment(_a0 perun.ScheduledPaymentParams) (perun.ScheduledPayment, perun.APIError) {
+	ret := _m.Called(_a0)
+
+	var r0 perun.ScheduledPayment
+	if rf, ok := ret.Get(0).(func(perun.ScheduledPaymentParams) perun.ScheduledPayment); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(perun.ScheduledPayment)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(perun.ScheduledPaymentParams) perun.APIError); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeployAssetERC20 provides a mock function with given fields: tokenERC20
 func (_m *SessionAPI) DeployAssetERC20(tokenERC20 string) (string, perun.APIError) {
 	ret := _m.Called(tokenERC20)
@@ -78,6 +147,54 @@ func (_m *SessionAPI) DeployAssetERC20(tokenERC20 string) (string, perun.APIErro
 	return r0, r1
 }
 
+// ExportPeerIDs provides a mock function with given fields: format
+func (_m *SessionAPI) ExportPeerIDs(format string) ([]byte, perun.APIError) {
+	ret := _m.Called(format)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = rf(format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(string) perun.APIError); ok {
+		r1 = rf(format)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllowance provides a mock function with given fields: currency
+func (_m *SessionAPI) GetAllowance(currency string) (string, perun.APIError) {
+	ret := _m.Called(currency)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(currency)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(string) perun.APIError); ok {
+		r1 = rf(currency)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetCh provides a mock function with given fields: _a0
 func (_m *SessionAPI) GetCh(_a0 string) (perun.ChAPI, perun.APIError) {
 	ret := _m.Called(_a0)
@@ -119,6 +236,84 @@ func (_m *SessionAPI) GetChsInfo() []perun.ChInfo {
 	return r0
 }
 
+// GetInvoice provides a mock function with given fields: invoiceID
+func (_m *SessionAPI) GetInvoice(invoiceID string) (perun.Invoice, perun.APIError) {
+	ret := _m.Called(invoiceID)
+
+	var r0 perun.Invoice
+	if rf, ok := ret.Get(0).(func(string) perun.Invoice); ok {
+		r0 = rf(invoiceID)
+	} else {
+		r0 = ret.Get(0).(perun.Invoice)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(string) perun.APIError); ok {
+		r1 = rf(invoiceID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetInvoices provides a mock function with given fields:
+func (_m *SessionAPI) GetInvoices() []perun.Invoice {
+	ret := _m.Called()
+
+	var r0 []perun.Invoice
+	if rf, ok := ret.Get(0).(func() []perun.Invoice); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]perun.Invoice)
+		}
+	}
+
+	return r0
+}
+
+// GetOnChainBal provides a mock function with given fields: currency
+func (_m *SessionAPI) GetOnChainBal(currency string) (string, perun.APIError) {
+	ret := _m.Called(currency)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(currency)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(string) perun.APIError); ok {
+		r1 = rf(currency)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetOpenChsWithPeer provides a mock function with given fields: peerAlias
+func (_m *SessionAPI) GetOpenChsWithPeer(peerAlias string) []perun.ChAPI {
+	ret := _m.Called(peerAlias)
+
+	var r0 []perun.ChAPI
+	if rf, ok := ret.Get(0).(func(string) []perun.ChAPI); ok {
+		r0 = rf(peerAlias)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]perun.ChAPI)
+		}
+	}
+
+	return r0
+}
+
 // GetPeerID provides a mock function with given fields: alias
 func (_m *SessionAPI) GetPeerID(alias string) (perun.PeerID, perun.APIError) {
 	ret := _m.Called(alias)
@@ -142,6 +337,86 @@ func (_m *SessionAPI) GetPeerID(alias string) (perun.PeerID, perun.APIError) {
 	return r0, r1
 }
 
+// GetPeerStatus provides a mock function with given fields: aliases
+func (_m *SessionAPI) GetPeerStatus(aliases []string) ([]perun.PeerStatus, perun.APIError) {
+	ret := _m.Called(aliases)
+
+	var r0 []perun.PeerStatus
+	if rf, ok := ret.Get(0).(func([]string) []perun.PeerStatus); ok {
+		r0 = rf(aliases)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]perun.PeerStatus)
+		}
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func([]string) perun.APIError); ok {
+		r1 = rf(aliases)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetScheduledPayments provides a mock function with given fields:
+func (_m *SessionAPI) GetScheduledPayments() []perun.ScheduledPayment {
+	ret := _m.Called()
+
+	var r0 []perun.ScheduledPayment
+	if rf, ok := ret.Get(0).(func() []perun.ScheduledPayment); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]perun.ScheduledPayment)
+		}
+	}
+
+	return r0
+}
+
+// GetSpendingLimits provides a mock function with given fields:
+func (_m *SessionAPI) GetSpendingLimits() []perun.SpendingLimit {
+	ret := _m.Called()
+
+	var r0 []perun.SpendingLimit
+	if rf, ok := ret.Get(0).(func() []perun.SpendingLimit); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]perun.SpendingLimit)
+		}
+	}
+
+	return r0
+}
+
+// GetTxCostReport provides a mock function with given fields:
+func (_m *SessionAPI) GetTxCostReport() (perun.TxCostReport, perun.APIError) {
+	ret := _m.Called()
+
+	var r0 perun.TxCostReport
+	if rf, ok := ret.Get(0).(func() perun.TxCostReport); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(perun.TxCostReport)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func() perun.APIError); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ID provides a mock function with given fields:
 func (_m *SessionAPI) ID() string {
 	ret := _m.Called()
@@ -156,6 +431,29 @@ func (_m *SessionAPI) ID() string {
 	return r0
 }
 
+// ImportPeerIDs provides a mock function with given fields: data, format, strategy, dryRun
+func (_m *SessionAPI) ImportPeerIDs(data []byte, format string, strategy perun.PeerIDMergeStrategy, dryRun bool) (perun.PeerIDImportReport, perun.APIError) {
+	ret := _m.Called(data, format, strategy, dryRun)
+
+	var r0 perun.PeerIDImportReport
+	if rf, ok := ret.Get(0).(func([]byte, string, perun.PeerIDMergeStrategy, bool) perun.PeerIDImportReport); ok {
+		r0 = rf(data, format, strategy, dryRun)
+	} else {
+		r0 = ret.Get(0).(perun.PeerIDImportReport)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func([]byte, string, perun.PeerIDMergeStrategy, bool) perun.APIError); ok {
+		r1 = rf(data, format, strategy, dryRun)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
 // OpenCh provides a mock function with given fields: _a0, _a1, _a2, _a3
 func (_m *SessionAPI) OpenCh(_a0 context.Context, _a1 perun.BalInfo, _a2 perun.App, _a3 uint64) (perun.ChInfo, perun.APIError) {
 	ret := _m.Called(_a0, _a1, _a2, _a3)
@@ -179,6 +477,22 @@ func (_m *SessionAPI) OpenCh(_a0 context.Context, _a1 perun.BalInfo, _a2 perun.A
 	return r0, r1
 }
 
+// PayStreams provides a mock function with given fields: newPayStreams
+func (_m *SessionAPI) PayStreams(newPayStreams func() perun.PayStreams) perun.PayStreams {
+	ret := _m.Called(newPayStreams)
+
+	var r0 perun.PayStreams
+	if rf, ok := ret.Get(0).(func(func() perun.PayStreams) perun.PayStreams); ok {
+		r0 = rf(newPayStreams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(perun.PayStreams)
+		}
+	}
+
+	return r0
+}
+
 // RespondChProposal provides a mock function with given fields: _a0, _a1, _a2
 func (_m *SessionAPI) RespondChProposal(_a0 context.Context, _a1 string, _a2 bool) (perun.ChInfo, perun.APIError) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -202,6 +516,50 @@ func (_m *SessionAPI) RespondChProposal(_a0 context.Context, _a1 string, _a2 boo
 	return r0, r1
 }
 
+// SetAllowance provides a mock function with given fields: currency, amount
+func (_m *SessionAPI) SetAllowance(currency string, amount string) (string, perun.APIError) {
+	ret := _m.Called(currency, amount)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(currency, amount)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(string, string) perun.APIError); ok {
+		r1 = rf(currency, amount)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
+// SetSpendingLimits provides a mock function with given fields: _a0
+func (_m *SessionAPI) SetSpendingLimits(_a0 []perun.SpendingLimit) perun.APIError {
+	ret := _m.Called(_a0)
+
+	var r0 perun.APIError
+	if rf, ok := ret.Get(0).(func([]perun.SpendingLimit) perun.APIError); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(perun.APIError)
+		}
+	}
+
+	return r0
+}
+
+// StartScheduler provides a mock function with given fields: _a0
+func (_m *SessionAPI) StartScheduler(_a0 perun.ScheduledPaymentRunner) {
+	_m.Called(_a0)
+}
+
 // SubChProposals provides a mock function with given fields: _a0
 func (_m *SessionAPI) SubChProposals(_a0 perun.ChProposalNotifier) perun.APIError {
 	ret := _m.Called(_a0)
@@ -218,6 +576,45 @@ func (_m *SessionAPI) SubChProposals(_a0 perun.ChProposalNotifier) perun.APIErro
 	return r0
 }
 
+// SubPeerStatus provides a mock function with given fields: _a0
+func (_m *SessionAPI) SubPeerStatus(_a0 perun.PeerStatusNotifier) perun.APIError {
+	ret := _m.Called(_a0)
+
+	var r0 perun.APIError
+	if rf, ok := ret.Get(0).(func(perun.PeerStatusNotifier) perun.APIError); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(perun.APIError)
+		}
+	}
+
+	return r0
+}
+
+// TransferOnChain provides a mock function with given fields: currency, to, amount
+func (_m *SessionAPI) TransferOnChain(currency string, to string, amount string) (string, perun.APIError) {
+	ret := _m.Called(currency, to, amount)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(currency, to, amount)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 perun.APIError
+	if rf, ok := ret.Get(1).(func(string, string, string) perun.APIError); ok {
+		r1 = rf(currency, to, amount)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(perun.APIError)
+		}
+	}
+
+	return r0, r1
+}
+
 // UnsubChProposals provides a mock function with given fields:
 func (_m *SessionAPI) UnsubChProposals() perun.APIError {
 	ret := _m.Called()
@@ -233,3 +630,19 @@ func (_m *SessionAPI) UnsubChProposals() perun.APIError {
 
 	return r0
 }
+
+// UnsubPeerStatus provides a mock function with given fields:
+func (_m *SessionAPI) UnsubPeerStatus() perun.APIError {
+	ret := _m.Called()
+
+	var r0 perun.APIError
+	if rf, ok := ret.Get(0).(func() perun.APIError); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(perun.APIError)
+		}
+	}
+
+	return r0
+}
@@ -42,6 +42,39 @@ type PeerID struct {
 	CommAddr string `yaml:"comm_address"`
 	// Type of off-chain communication protocol.
 	CommType string `yaml:"comm_type"`
+	// Alternative endpoints for off-chain communication, that will be tried in the given order, if the peer
+	// cannot be reached at the comm address. These are optional.
+	AltCommEndpoints []CommEndpoint `yaml:"alt_comm_endpoints,omitempty"`
+
+	// Signature over the off-chain address and the comm endpoints, made using the off-chain account.
+	// It proves that the owner of the off-chain address published the comm endpoints and is optional.
+	// See idprovider.SignPeerID for details.
+	Signature string `yaml:"signature,omitempty"`
+}
+
+// PeerIDMergeStrategy defines how the conflicts between the imported records and the existing peer IDs are
+// resolved when importing peer IDs.
+type PeerIDMergeStrategy string
+
+// Enumeration of merge strategies.
+const (
+	MergeSkip      PeerIDMergeStrategy = "skip"      // Retain existing peer IDs and skip conflicting records.
+	MergeOverwrite PeerIDMergeStrategy = "overwrite" // Replace the conflicting peer IDs with imported records.
+	MergeAbort     PeerIDMergeStrategy = "abort"     // Do not import anything if there are any conflicts.
+)
+
+// CommEndpoint represents an endpoint at which a peer can be reached in the off-chain network.
+type CommEndpoint struct {
+	CommAddr string `yaml:"comm_address"`
+	CommType string `yaml:"comm_type"`
+}
+
+// CommEndpoints returns the list of endpoints at which the peer can be reached, in the order in which they
+// should be tried: comm address followed by the alternative endpoints.
+func (p PeerID) CommEndpoints() []CommEndpoint {
+	endpoints := make([]CommEndpoint, 0, 1+len(p.AltCommEndpoints))
+	endpoints = append(endpoints, CommEndpoint{CommAddr: p.CommAddr, CommType: p.CommType})
+	return append(endpoints, p.AltCommEndpoints...)
 }
 
 // OwnAlias is the alias for the entry of the user's own PeerID details.
@@ -54,6 +87,12 @@ type IDReader interface {
 	ReadByOffChainAddr(offChainAddr pwire.Address) (p PeerID, contains bool)
 }
 
+// IDLister represents the function to list all the peer IDs in the cache connected to a peer ID provider.
+// It is implemented only by the ID providers that can enumerate all their peer IDs.
+type IDLister interface {
+	ReadAll() []PeerID
+}
+
 // IDProvider represents the functions to read, write peer IDs from and to the local cache connected to a
 // peer ID provider. It also includes a function to sync the changes in the cache with the ID provider backend.
 type IDProvider interface {
@@ -63,6 +102,22 @@ type IDProvider interface {
 	UpdateStorage() error
 }
 
+// PriceSource provides the prices of the currencies in a fiat currency. These are used for showing the value of
+// the balances in fiat and for the spending limits that are set in fiat.
+type PriceSource interface {
+	// Fiat returns the fiat currency (such as "USD") in which the prices are quoted.
+	Fiat() string
+	// Price returns the price of one unit of the currency.
+	Price(currency string) (Price, error)
+}
+
+// Price represents the price of one unit of a currency in fiat, as a decimal string, and the time
+// (in unix timestamp) at which it was last updated.
+type Price struct {
+	Rate      string
+	Timestamp int64
+}
+
 //go:generate mockery --name CommBackend --output ./internal/mocks
 
 // CommBackend defines the set of methods required for initializing components required for off-chain communication.
@@ -91,6 +146,14 @@ type Registerer interface {
 	Register(offChainAddr pwire.Address, commAddr string)
 }
 
+//go:generate mockery --name EndpointsRegisterer --output ./internal/mocks
+
+// EndpointsRegisterer is used to register the list of comm endpoints corresponding to an offChainAddr to the
+// wire.Bus in runtime. The endpoints are tried in the given order when dialing the peer.
+type EndpointsRegisterer interface {
+	RegisterEndpoints(offChainAddr pwire.Address, endpoints []CommEndpoint)
+}
+
 //go:generate mockery --name WireBus --output ./internal/mocks
 
 // WireBus is an extension of the wire.Bus interface in go-perun to include a "Close" method.
@@ -110,7 +173,12 @@ type Credential struct {
 }
 
 // ChainBackend wraps the methods required for deploy contracts, validating
-// them and instantiating funde, adjudicator instances.
+// them, instantiating funder, adjudicator instances and sending on-chain
+// transfers and token approvals.
+//
+// TxCosts returns the costs of transactions sent by the chain backend itself
+// (for deployments, transfers and approvals). Costs of the transactions sent
+// by the funder and adjudicator are recorded via the context passed to them.
 //
 // The timeout for on-chain transaction should be implemented by the
 // corresponding backend. It is up to the implementation to make the value user
@@ -123,11 +191,28 @@ type ChainBackend interface {
 	DeployAssetERC20(adjudicator, tokenERC20, txSender pwallet.Address) (pwallet.Address, error)
 	DeployPerunToken(initAccs []pwallet.Address, initBal *big.Int, txSender pwallet.Address) (pwallet.Address, error)
 
+	TransferETH(to pwallet.Address, amount *big.Int, txSender pwallet.Address) (txID string, _ error)
+	TransferERC20(tokenERC20, to pwallet.Address, amount *big.Int, txSender pwallet.Address) (txID string, _ error)
+	ApproveERC20(tokenERC20, spender pwallet.Address, amount *big.Int, txSender pwallet.Address) (txID string, _ error)
+
 	NewFunder(assetETH, txSender pwallet.Address) Funder
 	NewAdjudicator(adjudicator, txSender pwallet.Address) pchannel.Adjudicator
+
+	TxCosts() []TxCost
 }
 
-// ROChainBackend wraps the methods required for validating contracts.
+// TxCost represents the gas used and the fee paid for an on-chain transaction
+// sent by the node. Fee is in the base unit of the native currency of the
+// blockchain.
+type TxCost struct {
+	TxType  string
+	TxID    string
+	GasUsed uint64
+	Fee     *big.Int
+}
+
+// ROChainBackend wraps the methods required for validating contracts,
+// reading on-chain balances and reading past adjudicator events.
 //
 // The timeout for on-chain transaction should be implemented by the
 // corresponding backend. It is up to the implementation to make the value user
@@ -137,6 +222,13 @@ type ROChainBackend interface {
 	ValidateAssetETH(adjudicator, assetETH pwallet.Address) error
 	ValidateAssetERC20(adjudicator, tokenERC20, assetERC20 pwallet.Address) (symbol string, maxDecimals uint8, _ error)
 	ERC20Info(token pwallet.Address) (symbol string, decimal uint8, _ error)
+
+	BalanceAt(addr pwallet.Address) (*big.Int, error)
+	BalanceAtERC20(tokenERC20, addr pwallet.Address) (*big.Int, error)
+	AllowanceERC20(tokenERC20, owner, spender pwallet.Address) (*big.Int, error)
+
+	BlockNumber() (uint64, error)
+	PastAdjEvents(adjudicator pwallet.Address, chID pchannel.ID, fromBlock uint64) ([]pchannel.AdjudicatorEvent, error)
 }
 
 // Funder wraps the methods required on ETH funder.
@@ -158,6 +250,7 @@ type WalletBackend interface {
 	ParseAddr(string) (pwallet.Address, error)
 	NewWallet(keystore string, password string) (pwallet.Wallet, error)
 	UnlockAccount(pwallet.Wallet, pwallet.Address) (pwallet.Account, error)
+	VerifySig(msg, sig []byte, addr pwallet.Address) (bool, error)
 }
 
 // Currency represents a parser that can convert between string representation of a currency and
@@ -289,19 +382,20 @@ type ErrorCode int
 
 // Error code definitions.
 const (
-	ErrPeerRequestTimedOut  ErrorCode = 101
-	ErrPeerRejected         ErrorCode = 102
-	ErrPeerNotFunded        ErrorCode = 103
-	ErrUserResponseTimedOut ErrorCode = 104
-	ErrResourceNotFound     ErrorCode = 201
-	ErrResourceExists       ErrorCode = 202
-	ErrInvalidArgument      ErrorCode = 203
-	ErrFailedPreCondition   ErrorCode = 204
-	ErrInvalidConfig        ErrorCode = 205
-	ErrInvalidContracts     ErrorCode = 206
-	ErrTxTimedOut           ErrorCode = 301
-	ErrChainNotReachable    ErrorCode = 302
-	ErrUnknownInternal      ErrorCode = 401
+	ErrPeerRequestTimedOut   ErrorCode = 101
+	ErrPeerRejected          ErrorCode = 102
+	ErrPeerNotFunded         ErrorCode = 103
+	ErrUserResponseTimedOut  ErrorCode = 104
+	ErrResourceNotFound      ErrorCode = 201
+	ErrResourceExists        ErrorCode = 202
+	ErrInvalidArgument       ErrorCode = 203
+	ErrFailedPreCondition    ErrorCode = 204
+	ErrInvalidConfig         ErrorCode = 205
+	ErrInvalidContracts      ErrorCode = 206
+	ErrSpendingLimitExceeded ErrorCode = 207
+	ErrTxTimedOut            ErrorCode = 301
+	ErrChainNotReachable     ErrorCode = 302
+	ErrUnknownInternal       ErrorCode = 401
 )
 
 type (
@@ -384,6 +478,17 @@ type (
 		ContractErrInfos []ContractErrInfo
 	}
 
+	// ErrInfoSpendingLimitExceeded represents the fields in the additional
+	// info for ErrSpendingLimitExceeded.
+	ErrInfoSpendingLimitExceeded struct {
+		LimitType string
+		PeerAlias string
+		Currency  string
+		Limit     string
+		Usage     string // Amount already spent in the time window of the limit.
+		Amount    string // Amount that was requested to be spent.
+	}
+
 	// ErrInfoTxTimedOut represents the fields in the additional info
 	// for ErrTxTimedOut.
 	ErrInfoTxTimedOut struct {
@@ -433,9 +538,57 @@ type SessionAPI interface {
 
 	DeployAssetERC20(tokenERC20 string) (asset string, _ APIError)
 
+	GetOnChainBal(currency string) (bal string, _ APIError)
+	TransferOnChain(currency, to, amount string) (txID string, _ APIError)
+	GetAllowance(currency string) (allowance string, _ APIError)
+	SetAllowance(currency, amount string) (txID string, _ APIError)
+
+	GetTxCostReport() (TxCostReport, APIError)
+
+	ExportPeerIDs(format string) ([]byte, APIError)
+	ImportPeerIDs(data []byte, format string, strategy PeerIDMergeStrategy, dryRun bool) (
+		PeerIDImportReport, APIError)
+
+	GetPeerStatus(aliases []string) ([]PeerStatus, APIError)
+	SubPeerStatus(PeerStatusNotifier) APIError
+	UnsubPeerStatus() APIError
+
+	CreateInvoice(currency, amount, memo string, expirySecs uint64) (Invoice, APIError)
+	GetInvoice(invoiceID string) (Invoice, APIError)
+	GetInvoices() []Invoice
+
+	CreateScheduledPayment(ScheduledPaymentParams) (ScheduledPayment, APIError)
+	GetScheduledPayments() []ScheduledPayment
+	CancelScheduledPayment(scheduledPaymentID string) (ScheduledPayment, APIError)
+
+	SetSpendingLimits([]SpendingLimit) APIError
+	GetSpendingLimits() []SpendingLimit
+
+	// This function is used internally by the payment app to set the runner for the scheduled payments.
+	// Should not be exposed via user API.
+	StartScheduler(ScheduledPaymentRunner)
+
 	// This function is used internally to get a ChAPI instance.
 	// Should not be exposed via user API.
 	GetCh(string) (ChAPI, APIError)
+
+	// This function is used internally by the payment app to select the channels for paying a peer.
+	// Should not be exposed via user API.
+	GetOpenChsWithPeer(peerAlias string) []ChAPI
+
+	// This function is used internally by the payment app to get the payment streams of the session, which
+	// are created using the given function on first use.
+	// Should not be exposed via user API.
+	PayStreams(newPayStreams func() PayStreams) PayStreams
+}
+
+// PayStreams represents the payment streams started in a session. These are owned by the session, so that
+// they are stopped when the session or their channel is closed.
+type PayStreams interface {
+	// StopCh stops the streams on the channel, that are running or paused.
+	StopCh(chID string)
+	// StopAll stops all the streams, that are running or paused.
+	StopAll()
 }
 
 type (
@@ -468,11 +621,17 @@ type ChAPI interface {
 	// Methods to transact on, close the channel and read its state.
 	// These APIs use a mutex lock.
 	SendChUpdate(context.Context, StateUpdater) (ChInfo, APIError)
+	SendChUpdateWMeta(context.Context, StateUpdater, ChUpdateMeta) (ChInfo, APIError)
 	SubChUpdates(ChUpdateNotifier) APIError
 	UnsubChUpdates() APIError
 	RespondChUpdate(context.Context, string, bool) (ChInfo, APIError)
 	GetChInfo() ChInfo
 	Close(context.Context) (ChInfo, APIError)
+
+	// Methods for reading the history of the channel.
+	// These APIs don't use mutex lock.
+	GetHistory() []ChUpdateRecord
+	ExportReceipt(version string) (ChReceipt, APIError)
 }
 
 // Enumeration of values for ChUpdateType:
@@ -485,6 +644,57 @@ const (
 	ChUpdateTypeClosed
 )
 
+// Keys in the channel update metadata that are used by the node. See ChUpdateMeta.
+const (
+	// ReservedMetaKeyPrefix is the prefix of the keys in the channel update metadata reserved for the node.
+	ReservedMetaKeyPrefix = "perun."
+
+	// InvoiceIDMetaKey is the key whose value is the ID of the invoice paid by the update.
+	InvoiceIDMetaKey = "perun.invoiceID"
+	// MemoMetaKey is the key whose value is a free-form description of the update.
+	MemoMetaKey = "perun.memo"
+	// ReferenceMetaKey is the key whose value is a reference, such as an order number, for reconciling the
+	// update with the records outside the node.
+	ReferenceMetaKey = "perun.reference"
+	// ExpiryMetaKey is the key whose value is the time (in unix timestamp) until which the proposer waits for a
+	// response to the update. If it is earlier than the expiry set by the node, it is used as the expiry of the
+	// update notification.
+	ExpiryMetaKey = "perun.expiry"
+	// SwapMetaKey is the key whose value is the quote of the swap made by the update, as a JSON encoded object.
+	SwapMetaKey = "perun.swap"
+)
+
+// InvoiceStatus is the settlement status of an invoice.
+type InvoiceStatus string
+
+// Enumeration of invoice statuses.
+const (
+	InvoiceOpen          InvoiceStatus = "open"           // No payments received yet.
+	InvoicePartiallyPaid InvoiceStatus = "partially-paid" // Payments received are less than the amount.
+	InvoicePaid          InvoiceStatus = "paid"           // Payments received are equal to or more than the amount.
+	InvoiceExpired       InvoiceStatus = "expired"        // Expired before it was fully paid.
+)
+
+// ScheduledPaymentStatus is the status of a scheduled payment.
+type ScheduledPaymentStatus string
+
+// Enumeration of scheduled payment statuses.
+const (
+	ScheduledPaymentActive    ScheduledPaymentStatus = "active"    // Payments will be made as per the schedule.
+	ScheduledPaymentEnded     ScheduledPaymentStatus = "ended"     // No more runs before the end time.
+	ScheduledPaymentCancelled ScheduledPaymentStatus = "cancelled" // Cancelled by the user.
+)
+
+// ScheduledPaymentRunStatus is the result of a run of a scheduled payment.
+type ScheduledPaymentRunStatus string
+
+// Enumeration of scheduled payment run statuses.
+const (
+	ScheduledPaymentRunSucceeded ScheduledPaymentRunStatus = "succeeded"
+	ScheduledPaymentRunFailed    ScheduledPaymentRunStatus = "failed"
+	ScheduledPaymentRunMissed    ScheduledPaymentRunStatus = "missed" // Node was not running when it was due.
+)
+
 type (
 	// ChUpdateType is the type of channel update. It can have three values: "open", "final" and "closed".
 	ChUpdateType uint8
@@ -519,6 +729,16 @@ type (
 		// while a channel is closed by the watcher.
 		// When this is non empty, expiry will also be zero and no response is expected
 		Error APIError
+
+		// Info describes the adjudicator events, that occurred while the node
+		// was offline and were handled when the channel was restored.
+		// It is set only for closing updates and for the notification (with
+		// Error set) sent when these events could not be read. It is empty
+		// otherwise.
+		Info string
+
+		// Meta is the metadata sent by the peer along with the update. It is nil, if none was sent.
+		Meta ChUpdateMeta
 	}
 
 	// App represents the app definition and the corresponding app data for a channel.
@@ -538,6 +758,10 @@ type (
 		// during each update. When registering the state on-chain, if different participants register states with
 		// different versions, channel will be settled according to the state with highest version number.
 		Version string
+		// Cumulative fee (in ETH) paid for all the on-chain transactions sent by this node for the channel.
+		TxFee string
+		// Value of the balances in fiat. It is nil, if no price source is configured for the session.
+		FiatBalInfo *FiatBalInfo
 	}
 
 	// BalInfo represents the Balance information of the channel participants.
@@ -559,6 +783,187 @@ type (
 		Bals       [][]string // Amounts held by each participant in this channel for the each currency.
 	}
 
+	// FiatBalInfo represents the value of the balances in a BalInfo in fiat, along with the prices used for
+	// computing it. Rates, Timestamps and the outer length of Bals correspond to the Currencies in the BalInfo
+	// and the inner length of Bals to its Parts.
+	//
+	// If the price of a currency is not available, its rate and values are empty strings and its timestamp is
+	// zero.
+	FiatBalInfo struct {
+		Fiat       string
+		Rates      []string   // Price of one unit of each currency in fiat.
+		Timestamps []int64    // Time (in unix timestamp) at which each price was last updated.
+		Bals       [][]string // Value of the amounts held by each participant, rounded to two decimal places.
+	}
+
+	// TxCostReport represents the costs of on-chain transactions sent by the
+	// node in a session. Fees are in ETH.
+	TxCostReport struct {
+		TxCosts  []TxCostInfo
+		TotalFee string
+	}
+
+	// TxCostInfo represents the cost of an on-chain transaction that will be
+	// sent to the user. ChID is empty for transactions that are not related to
+	// a channel, such as asset deployments and on-chain transfers.
+	TxCostInfo struct {
+		ChID    string
+		TxType  string
+		TxID    string
+		GasUsed uint64
+		Fee     string
+	}
+
+	// PeerIDImportReport represents the result of importing a list of peer IDs into the ID provider.
+	//
+	// Added, Overwritten and Unchanged are the aliases of the records that were (or, in case of a dry run
+	// or an aborted import, would have been) added, used to overwrite the conflicting peer IDs and
+	// that are already present. Applied is false if the import was a dry run or it was aborted.
+	PeerIDImportReport struct {
+		Added       []string
+		Overwritten []string
+		Unchanged   []string
+		Conflicts   []PeerIDImportConflict
+		Invalid     []PeerIDImportInvalid
+		Applied     bool
+	}
+
+	// PeerIDImportConflict represents an imported record that conflicts with an existing peer ID, either
+	// because the alias is used for another peer or because the off-chain address is registered with
+	// another alias.
+	PeerIDImportConflict struct {
+		Alias         string // Alias of the imported record.
+		ExistingAlias string // Alias of the existing peer ID.
+		Reason        string
+	}
+
+	// PeerIDImportInvalid represents an imported record that is invalid. Index is the position of the
+	// record (starting from 0) in the imported data.
+	PeerIDImportInvalid struct {
+		Index  int
+		Alias  string
+		Reason string
+	}
+
+	// PeerStatus represents the connectivity status of a peer. Alias is empty if the peer is not
+	// in the ID provider.
+	PeerStatus struct {
+		Alias        string
+		OffChainAddr string
+		Connected    bool          // True, if there is at least one open connection to the peer.
+		LastSeen     int64         // Time (in unix timestamp) at which a message was last received. Zero, if never.
+		LastError    string        // Last error when dialing or communicating with the peer. Empty, if none.
+		RTT          time.Duration // Round trip time measured using pings. Zero, if not known.
+	}
+
+	// PeerStatusNotifier is the notifier function that is used for sending peer connectivity notifications.
+	PeerStatusNotifier func(PeerStatus)
+
+	// ChUpdateMeta is the metadata sent to the peer along with a channel update. It is not a part of the
+	// channel state, hence it is neither signed by the participants nor enforced on the blockchain.
+	// Keys with the ReservedMetaKeyPrefix are reserved for use by the node.
+	ChUpdateMeta map[string]string
+
+	// Invoice represents a request for payment created by the payee. It is paid by channel updates tagged
+	// with its ID (see InvoiceIDMetaKey) that transfer the amount in its currency to the payee.
+	//
+	// Payments accepted after the expiry are recorded, but do not change the status of an expired invoice.
+	Invoice struct {
+		ID         string
+		Currency   string
+		Amount     string
+		Memo       string
+		CreatedAt  int64 // Time (in unix timestamp) at which the invoice was created.
+		Expiry     int64 // Time (in unix timestamp) at which the invoice expires. Zero, if it does not expire.
+		Status     InvoiceStatus
+		AmountPaid string
+		Payments   []InvoicePayment
+	}
+
+	// ChUpdateRecord represents an update accepted on the channel, along with the metadata sent with it.
+	ChUpdateRecord struct {
+		Version    string // Version of the channel state after the update.
+		BalInfo    BalInfo
+		ProposedBy string // Alias of the participant that proposed the update; OwnAlias, if it was the user.
+		Meta       ChUpdateMeta
+		AcceptedAt int64 // Time (in unix timestamp) at which the update was accepted.
+	}
+
+	// ChReceipt is a portable proof that the participants agreed on the state of the channel at the version. It
+	// can be verified offline, without a connection to the blockchain.
+	//
+	// Params and State are the channel parameters and the state, in the binary encoding of go-perun, as hex
+	// strings. Sigs are the signatures on the state, as hex strings, in the order of Participants, which are the
+	// addresses used by the participants for signing the states. BalInfo is derived from the state when the
+	// receipt is exported and is only for information, it is not covered by the signatures.
+	ChReceipt struct {
+		ChID         string
+		Version      string
+		BalInfo      BalInfo
+		Participants []string
+		Params       string
+		State        string
+		Sigs         []string
+	}
+
+	// InvoicePayment represents a channel update that paid an invoice, fully or partially.
+	InvoicePayment struct {
+		ChID       string
+		Version    string // Version of the channel state after the update.
+		PayerAlias string
+		Amount     string
+		PaidAt     int64 // Time (in unix timestamp) at which the update was accepted.
+	}
+
+	// ScheduledPaymentParams are the parameters of a recurring payment to the peer. The payment is made either
+	// on the channel with the ChID or, if PeerAlias is set instead, on any open channel with the peer.
+	ScheduledPaymentParams struct {
+		ChID      string
+		PeerAlias string
+		Currency  string
+		Amount    string
+		Schedule  string // Cron-like schedule with five fields: minute hour day-of-month month day-of-week, in UTC.
+		EndTime   int64  // Time (in unix timestamp) after which no payments are made. Zero, if none.
+	}
+
+	// ScheduledPayment represents a recurring payment along with the results of its recent runs.
+	ScheduledPayment struct {
+		ID        string
+		Params    ScheduledPaymentParams
+		Status    ScheduledPaymentStatus
+		CreatedAt int64                 // Time (in unix timestamp) at which it was created.
+		NextRun   int64                 // Time (in unix timestamp) of the next run. Zero, if not active.
+		Runs      []ScheduledPaymentRun // Recent runs, in the order in which they were due.
+	}
+
+	// ScheduledPaymentRun represents a run of a scheduled payment.
+	ScheduledPaymentRun struct {
+		DueAt   int64 // Time (in unix timestamp) at which the run was due.
+		RanAt   int64 // Time (in unix timestamp) at which the run completed. Zero, if it was missed.
+		Status  ScheduledPaymentRunStatus
+		ChID    string // Channel on which the payment was made.
+		Version string // Version of the channel state after the payment.
+		Error   string // Reason for the failure, if the run failed.
+	}
+
+	// ScheduledPaymentRunner is the function that makes a scheduled payment on the channel.
+	ScheduledPaymentRunner func(ctx context.Context, ch ChAPI, currency, amount string) APIError
+
+	// SpendingLimit represents the limits on the amount of the currency that can be paid to or funded in
+	// channels with a peer. If the PeerAlias is empty, the limit applies to each of the peers without a peer
+	// specific limit for the currency. Empty values mean no limit.
+	SpendingLimit struct {
+		Currency     string
+		PeerAlias    string
+		MaxPayment   string // Maximum amount paid in a single channel update.
+		MaxPerHour   string // Maximum total amount paid in any rolling window of one hour.
+		MaxPerDay    string // Maximum total amount paid in any rolling window of one day.
+		MaxChFunding string // Maximum amount funded by the user when opening a channel.
+		// If set, the above amounts are in this fiat currency and are converted to the currency at its current
+		// price, when enforcing the limits. It should be the fiat of the price source configured for the session.
+		Fiat string
+	}
+
 	// StateUpdater function is the function that will be used for applying state updates.
 	StateUpdater func(*pchannel.State) error
 )
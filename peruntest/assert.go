@@ -146,6 +146,22 @@ func AssertErrInfoInvalidContracts(t *testing.T, info interface{}, contractErrIn
 	// assert.Len(t, len(contractErrInfos), len(addInfo.ContractErrInfos))
 }
 
+// AssertErrInfoSpendingLimitExceeded tests if additional info field is of
+// correct type and has expected values.
+func AssertErrInfoSpendingLimitExceeded(t *testing.T, info interface{}, limitType perun.SpendingLimitType,
+	peerAlias, currency, limit, usage, amount string) {
+	t.Helper()
+
+	addInfo, ok := info.(perun.ErrInfoSpendingLimitExceeded)
+	require.True(t, ok)
+	assert.Equal(t, string(limitType), addInfo.LimitType)
+	assert.Equal(t, peerAlias, addInfo.PeerAlias)
+	assert.Equal(t, currency, addInfo.Currency)
+	assert.Equal(t, limit, addInfo.Limit)
+	assert.Equal(t, usage, addInfo.Usage)
+	assert.Equal(t, amount, addInfo.Amount)
+}
+
 // AssertErrInfoTxTimedOut tests if additional info field is of
 // correct type and has expected values.
 func AssertErrInfoTxTimedOut(t *testing.T, info interface{}, txType, txID, txTimeout string) {
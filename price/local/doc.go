@@ -0,0 +1,32 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package local contains an implementation of price source, where the prices of the currencies are stored in a
+// YAML file on the disk.
+//
+// The file is read again when it is modified, so that the prices can be updated by other programs (such as a
+// cron job that fetches them from an exchange) without restarting the node. The fiat currency in which the
+// prices are quoted cannot be changed after initialization.
+//
+// Format of the file:
+//
+//	fiat: USD
+//	prices:
+//	  ETH: "2000.50"
+//	  PRN: "0.25"
+//
+// The time at which the prices were last updated is the modification time of the file.
+package local
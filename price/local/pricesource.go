@@ -0,0 +1,136 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// ErrPriceNotFound is returned when there is no price for the currency in the file.
+var ErrPriceNotFound = errors.New("price not found")
+
+type (
+	// PriceSource provides the prices stored in a YAML file on the file system.
+	PriceSource struct {
+		filePath string
+		fiat     string
+
+		mutex   sync.Mutex
+		prices  map[string]string
+		modTime time.Time
+	}
+
+	// priceFile is the format of the price source file.
+	priceFile struct {
+		Fiat   string            `yaml:"fiat"`
+		Prices map[string]string `yaml:"prices"` // Price of one unit of each currency, indexed by its symbol.
+	}
+)
+
+// NewPriceSource returns an instance of price source to access the prices in the given file.
+func NewPriceSource(filePath string) (*PriceSource, error) {
+	contents, modTime, err := readPriceFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceSource{
+		filePath: filePath,
+		fiat:     contents.Fiat,
+		prices:   contents.Prices,
+		modTime:  modTime,
+	}, nil
+}
+
+// Fiat returns the fiat currency in which the prices are quoted.
+func (s *PriceSource) Fiat() string {
+	return s.fiat
+}
+
+// Price returns the price of one unit of the currency. The file is read again, if it was modified since it was
+// last read. If it cannot be read, an error is returned until it is fixed.
+func (s *PriceSource) Price(currency string) (perun.Price, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.reloadIfModified(); err != nil {
+		return perun.Price{}, err
+	}
+	rate, ok := s.prices[currency]
+	if !ok {
+		return perun.Price{}, errors.WithMessage(ErrPriceNotFound, currency)
+	}
+	return perun.Price{Rate: rate, Timestamp: s.modTime.Unix()}, nil
+}
+
+// reloadIfModified reads the file again, if its modification time has changed. It should be called with the
+// mutex locked.
+func (s *PriceSource) reloadIfModified() error {
+	fileInfo, err := os.Stat(filepath.Clean(s.filePath))
+	if err != nil {
+		return errors.Wrap(err, "reading price source file")
+	}
+	if fileInfo.ModTime().Equal(s.modTime) {
+		return nil
+	}
+	contents, modTime, err := readPriceFile(s.filePath)
+	if err != nil {
+		return err
+	}
+	if contents.Fiat != s.fiat {
+		return errors.Errorf("fiat in price source file changed from %s to %s", s.fiat, contents.Fiat)
+	}
+	s.prices, s.modTime = contents.Prices, modTime
+	return nil
+}
+
+// readPriceFile reads and validates the contents of the price source file and returns it along with its
+// modification time.
+func readPriceFile(filePath string) (priceFile, time.Time, error) {
+	fileInfo, err := os.Stat(filepath.Clean(filePath))
+	if err != nil {
+		return priceFile{}, time.Time{}, errors.Wrap(err, "reading price source file")
+	}
+	data, err := ioutil.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return priceFile{}, time.Time{}, errors.Wrap(err, "reading price source file")
+	}
+	var contents priceFile
+	if err = yaml.Unmarshal(data, &contents); err != nil {
+		return priceFile{}, time.Time{}, errors.Wrap(err, "decoding price source file")
+	}
+	if contents.Fiat == "" {
+		return priceFile{}, time.Time{}, errors.New("fiat should be set in price source file")
+	}
+	for currency, rate := range contents.Prices {
+		parsed, err := decimal.NewFromString(rate)
+		if err != nil || !parsed.IsPositive() {
+			return priceFile{}, time.Time{}, errors.Errorf("price of %s should be a positive decimal: %s",
+				currency, rate)
+		}
+	}
+	return contents, fileInfo.ModTime(), nil
+}
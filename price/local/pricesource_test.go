@@ -0,0 +1,111 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node/price/local"
+)
+
+func Test_PriceSource(t *testing.T) {
+	updatedAt := time.Unix(1600000000, 0)
+
+	t.Run("happy", func(t *testing.T) {
+		priceFile := writePriceFile(t, "", "fiat: USD\nprices:\n  ETH: \"2000.5\"\n", updatedAt)
+		s, err := local.NewPriceSource(priceFile)
+		require.NoError(t, err)
+		assert.Equal(t, "USD", s.Fiat())
+
+		price, err := s.Price("ETH")
+		require.NoError(t, err)
+		assert.Equal(t, "2000.5", price.Rate)
+		assert.Equal(t, updatedAt.Unix(), price.Timestamp)
+
+		_, err = s.Price("PRN")
+		assert.True(t, errors.Is(err, local.ErrPriceNotFound))
+	})
+
+	t.Run("happy_reload", func(t *testing.T) {
+		priceFile := writePriceFile(t, "", "fiat: USD\nprices:\n  ETH: \"2000.5\"\n", updatedAt)
+		s, err := local.NewPriceSource(priceFile)
+		require.NoError(t, err)
+
+		writePriceFile(t, priceFile, "fiat: USD\nprices:\n  ETH: \"1900\"\n  PRN: \"0.25\"\n",
+			updatedAt.Add(time.Minute))
+		price, err := s.Price("ETH")
+		require.NoError(t, err)
+		assert.Equal(t, "1900", price.Rate)
+		assert.Equal(t, updatedAt.Add(time.Minute).Unix(), price.Timestamp)
+		price, err = s.Price("PRN")
+		require.NoError(t, err)
+		assert.Equal(t, "0.25", price.Rate)
+	})
+
+	t.Run("error_reload_fiatChanged", func(t *testing.T) {
+		priceFile := writePriceFile(t, "", "fiat: USD\nprices:\n  ETH: \"2000.5\"\n", updatedAt)
+		s, err := local.NewPriceSource(priceFile)
+		require.NoError(t, err)
+
+		writePriceFile(t, priceFile, "fiat: EUR\nprices:\n  ETH: \"1800\"\n", updatedAt.Add(time.Minute))
+		_, err = s.Price("ETH")
+		assert.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("error_missingFile", func(t *testing.T) {
+		_, err := local.NewPriceSource(filepath.Join(t.TempDir(), "missing.yaml"))
+		assert.Error(t, err)
+		t.Log(err)
+	})
+
+	t.Run("error_invalidContents", func(t *testing.T) {
+		for name, contents := range map[string]string{
+			"noFiat":        "prices:\n  ETH: \"2000\"\n",
+			"invalidPrice":  "fiat: USD\nprices:\n  ETH: abc\n",
+			"negativePrice": "fiat: USD\nprices:\n  ETH: \"-1\"\n",
+			"invalidYAML":   "fiat: [USD\n",
+		} {
+			contents := contents
+			t.Run(name, func(t *testing.T) {
+				_, err := local.NewPriceSource(writePriceFile(t, "", contents, updatedAt))
+				assert.Error(t, err)
+				t.Log(err)
+			})
+		}
+	})
+}
+
+// writePriceFile writes the contents to the price file (or to a new file in a temp directory, if the path is
+// empty) and sets its modification time. It returns the path of the file.
+func writePriceFile(t *testing.T, priceFile, contents string, modTime time.Time) string {
+	t.Helper()
+	if priceFile == "" {
+		priceFile = filepath.Join(t.TempDir(), "prices.yaml")
+	}
+	require.NoError(t, ioutil.WriteFile(priceFile, []byte(contents), 0o600))
+	require.NoError(t, os.Chtimes(priceFile, modTime, modTime))
+	return priceFile
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain"
 	"github.com/hyperledger-labs/perun-node/log"
 )
 
@@ -56,6 +57,25 @@ type (
 		chUpdateNotifCache []perun.ChUpdateNotif
 		chUpdateResponders map[string]chUpdateResponderEntry
 
+		// Exchanges the metadata of channel updates with the peer. Updates with metadata cannot be sent, if nil.
+		chUpdateMetas *chUpdateMetaExchanger
+		// Called with the notification and the states of each incoming update after it is accepted. It is
+		// called with the channel locked and should not call any methods on the channel that lock it.
+		onChUpdateAccepted func(ch *Channel, notif perun.ChUpdateNotif, currState, proposedState *pchannel.State)
+		// Records the updates accepted on the channel. Updates are not recorded, if nil.
+		history *chHistoryStore
+		// Holds the fully signed states of the channel, for exporting receipts. No receipts are available, if nil.
+		receipts *receiptStore
+		// Enforces the spending limits on the updates sent and accepted on the channel. Limits are not enforced,
+		// if nil.
+		spending *spendingLimiter
+		// Provides the prices for the value of the balances in fiat. Values are not included in the info, if nil.
+		prices perun.PriceSource
+		// Called with the channel ID after the channel is closed. It is called with the channel locked.
+		onClose func(chID string)
+
+		txCosts *blockchain.TxCostRecorder // Costs of on-chain txs sent by this node for the channel.
+
 		watcherWg *sync.WaitGroup
 		psync.Mutex
 	}
@@ -70,6 +90,7 @@ type (
 		timeoutCfg       timeoutConfig
 		challengeDurSecs uint64
 		chainURL         string
+		txFeeCurrency    perun.Currency // Currency for printing the fees of on-chain txs.
 	}
 
 	// PChannel represents the methods on the state channel controller defined
@@ -94,9 +115,11 @@ type (
 	chStatus uint8
 
 	chUpdateResponderEntry struct {
-		notif       perun.ChUpdateNotif
-		responder   ChUpdateResponder
-		notifExpiry int64
+		notif         perun.ChUpdateNotif
+		responder     ChUpdateResponder
+		notifExpiry   int64
+		currState     *pchannel.State
+		proposedState *pchannel.State
 	}
 
 	// ChUpdateResponder represents the methods on channel update responder that will be used the perun node.
@@ -111,9 +134,10 @@ type (
 //go:generate mockery --name ChUpdateResponder --output ../internal/mocks
 
 // newCh initializes  a channel instance using the passed pchannel (controller)
-// and other channel parameters.
+// and other channel parameters. Costs of the on-chain txs sent for the channel
+// will be recorded in txCosts.
 func newCh(pch PChannel, chainURL string, currencies []perun.Currency, parts []string, timeoutCfg timeoutConfig,
-	challengeDurSecs uint64) *Channel {
+	challengeDurSecs uint64, txFeeCurrency perun.Currency, txCosts *blockchain.TxCostRecorder) *Channel {
 	ch := &Channel{
 		params: params{
 			id:               fmt.Sprintf("%x", pch.ID()),
@@ -123,11 +147,13 @@ func newCh(pch PChannel, chainURL string, currencies []perun.Currency, parts []s
 			currencies:       currencies,
 			symbols:          make(map[string]int, len(currencies)),
 			parts:            parts,
+			txFeeCurrency:    txFeeCurrency,
 		},
 		pch:                pch,
 		status:             open,
 		wasCloseInitiated:  false,
 		chUpdateResponders: make(map[string]chUpdateResponderEntry),
+		txCosts:            txCosts,
 		watcherWg:          &sync.WaitGroup{},
 	}
 	for i := range currencies {
@@ -152,6 +178,13 @@ func (ch *Channel) HandleAdjudicatorEvent(e pchannel.AdjudicatorEvent) {
 	ch.Lock()
 	defer ch.Unlock()
 
+	// Channel could have been closed when handling the adjudicator events
+	// missed while the node was offline.
+	if ch.status == closed {
+		ch.Infof("Ignoring adjudicator event as channel is already closed")
+		return
+	}
+
 	switch e.(type) {
 
 	case *pchannel.RegisteredEvent:
@@ -170,7 +203,7 @@ func (ch *Channel) HandleAdjudicatorEvent(e pchannel.AdjudicatorEvent) {
 			}
 
 			apiErr := ch.settle()
-			ch.closeAndNotify(apiErr)
+			ch.closeAndNotify(apiErr, "")
 			return
 		}
 
@@ -186,7 +219,7 @@ func (ch *Channel) HandleAdjudicatorEvent(e pchannel.AdjudicatorEvent) {
 		// implementation in go-perun/backend/ethereum/channel package.
 		if ch.pch.State().IsFinal {
 			apiErr := ch.settle()
-			ch.closeAndNotify(apiErr)
+			ch.closeAndNotify(apiErr, "")
 			return
 		}
 
@@ -195,9 +228,88 @@ func (ch *Channel) HandleAdjudicatorEvent(e pchannel.AdjudicatorEvent) {
 	}
 }
 
+// handleMissedAdjEvents handles the adjudicator events for this channel that
+// were emitted while the node was offline. It is invoked when the channel is
+// restored from persistence.
+//
+// Only the latest event is relevant. If an older state was registered and the
+// dispute is still live, it is refuted by registering the latest state. In
+// all cases, the channel is then settled after the challenge duration and a
+// channel close notification describing the events is sent.
+func (ch *Channel) handleMissedAdjEvents(events []pchannel.AdjudicatorEvent) {
+	if len(events) == 0 {
+		return
+	}
+	ch.Lock()
+	defer ch.Unlock()
+
+	if ch.status == closed {
+		return
+	}
+
+	info, timeout := ch.refuteIfRequired(events[len(events)-1])
+	ch.Infof("Handling adjudicator events missed while offline: %s", info)
+
+	if err := timeout.Wait(context.Background()); err != nil {
+		ch.Errorf("Wait for timeout returned error:%v. Trying to settle anyways", err)
+	}
+	apiErr := ch.settle()
+	ch.closeAndNotify(apiErr, info)
+}
+
+// notifyPastAdjEventsErr notifies the user that the adjudicator events emitted
+// for this restored channel while the node was offline could not be read. The
+// user should then check the status of the channel on the blockchain, as a
+// dispute could be going on. The notification is cached if there is no
+// subscription for channel updates.
+func (ch *Channel) notifyPastAdjEventsErr(err perun.APIError) {
+	ch.Lock()
+	defer ch.Unlock()
+
+	currChInfo := ch.getChInfo()
+	ch.sendChUpdateNotif(perun.ChUpdateNotif{
+		UpdateID:   fmt.Sprintf("%s_%s_%s", currChInfo.ChID, currChInfo.Version, "pastAdjEvents"),
+		CurrChInfo: currChInfo,
+		Type:       perun.ChUpdateTypeOpen,
+		Expiry:     0,
+		Error:      err,
+		Info: "adjudicator events emitted while the node was offline could not be read," +
+			" check the status of the channel on the blockchain",
+	})
+}
+
+// refuteIfRequired refutes the given adjudicator event by registering the
+// latest state, if an older state was registered and the dispute is still
+// live. It returns a description of the event and the timeout after which the
+// channel can be settled.
+func (ch *Channel) refuteIfRequired(e pchannel.AdjudicatorEvent) (info string, timeout pchannel.Timeout) {
+	if _, ok := e.(*pchannel.ConcludedEvent); ok {
+		return fmt.Sprintf("channel was concluded on-chain with version %d", e.Version()), &pchannel.ElapsedTimeout{}
+	}
+
+	ownVersion := ch.pch.State().Version
+	switch {
+	case e.Version() >= ownVersion:
+		return fmt.Sprintf("channel was registered on-chain with version %d", e.Version()), e.Timeout()
+	case e.Timeout().IsElapsed(context.Background()):
+		return fmt.Sprintf("peer registered older version %d on-chain and challenge duration passed before it"+
+			" could be refuted with version %d", e.Version(), ownVersion), e.Timeout()
+	}
+
+	ctx, cancel := context.WithTimeout(ch.txCostsContext(context.Background()), ch.timeoutCfg.onChainTx)
+	defer cancel()
+	if err := ch.pch.Register(ctx); err != nil {
+		return fmt.Sprintf("peer registered older version %d on-chain, refuting it with version %d failed: %v",
+			e.Version(), ownVersion, err), e.Timeout()
+	}
+	challengeDur := time.Duration(ch.challengeDurSecs) * time.Second
+	return fmt.Sprintf("peer registered older version %d on-chain, refuted it with version %d",
+		e.Version(), ownVersion), &pchannel.TimeTimeout{Time: time.Now().Add(challengeDur)}
+}
+
 // settle concludes the channel on-chain and ensures the funds are withdrawn.
 func (ch *Channel) settle() perun.APIError {
-	ctx, cancel := context.WithTimeout(context.Background(), ch.timeoutCfg.settle(ch.challengeDurSecs))
+	ctx, cancel := context.WithTimeout(ch.txCostsContext(context.Background()), ch.timeoutCfg.settle(ch.challengeDurSecs))
 	defer cancel()
 	// Settle in go-perun does not implement secondary logic. So both users
 	// will have sent on-chain transactions, one of which will not reverted.
@@ -211,6 +323,12 @@ func (ch *Channel) settle() perun.APIError {
 	return nil
 }
 
+// txCostsContext returns a copy of the parent context, that records the
+// costs of on-chain txs sent using it for this channel.
+func (ch *Channel) txCostsContext(parent context.Context) context.Context {
+	return blockchain.WithTxCostRecorder(parent, ch.txCosts)
+}
+
 // handleChSettleError inspects the passed error, constructs an
 // appropriate APIError and returns it.
 func (ch *Channel) handleChSettleError(err error) perun.APIError {
@@ -225,7 +343,7 @@ func (ch *Channel) handleChSettleError(err error) perun.APIError {
 // notification if an active subscription for channel update already exists.
 // The notification is dropped otherwise. Because the user will not able to
 // subscribe to update notifications for a channel after it is closed.
-func (ch *Channel) closeAndNotify(err perun.APIError) {
+func (ch *Channel) closeAndNotify(err perun.APIError, info string) {
 	ch.close()
 	ch.Info("Channel closed")
 
@@ -233,13 +351,13 @@ func (ch *Channel) closeAndNotify(err perun.APIError) {
 		ch.Debug("Channel close notification dropped as there is no active subscription")
 		return
 	}
-	notif := makeChCloseNotif(ch.getChInfo(), err)
+	notif := makeChCloseNotif(ch.getChInfo(), err, info)
 	ch.chUpdateNotifier(notif)
 	ch.unsubChUpdates()
 	ch.Debug("Channel close notification sent")
 }
 
-func makeChCloseNotif(currChInfo perun.ChInfo, err perun.APIError) perun.ChUpdateNotif {
+func makeChCloseNotif(currChInfo perun.ChInfo, err perun.APIError, info string) perun.ChUpdateNotif {
 	return perun.ChUpdateNotif{
 		UpdateID:       fmt.Sprintf("%s_%s_%s", currChInfo.ChID, currChInfo.Version, "closed"),
 		CurrChInfo:     currChInfo,
@@ -247,6 +365,7 @@ func makeChCloseNotif(currChInfo perun.ChInfo, err perun.APIError) perun.ChUpdat
 		Type:           perun.ChUpdateTypeClosed,
 		Expiry:         0,
 		Error:          err,
+		Info:           info,
 	}
 }
 
@@ -303,18 +422,40 @@ func (ch *Channel) ChallengeDurSecs() uint64 {
 //
 // If there is an error, it will be one of the following codes:
 // - ErrInvalidArgument with Name:"Amount" when any of the amounts is invalid.
+// - ErrSpendingLimitExceeded when the amount paid to the peer exceeds any of the spending limits.
 // - ErrPeerRequestTimedOut when peer request times out.
 // - ErrPeerRejected when peer rejects the request.
 // - ErrUnknownInternal.
 func (ch *Channel) SendChUpdate(pctx context.Context, updater perun.StateUpdater) (perun.ChInfo, perun.APIError) {
 	ch.WithField("method", "SendChUpdate").Infof("\nReceived request with params %+v", updater)
+	return ch.sendChUpdate(pctx, "SendChUpdate", updater, nil)
+}
+
+// SendChUpdateWMeta is same as SendChUpdate, except that the metadata is sent to the peer along with the
+// update. The peer receives it in the Meta field of the channel update notification.
+//
+// The metadata is sent in a separate message that is not known to the nodes that do not support it. Hence, it
+// is sent only when it is not empty.
+//
+// If the metadata has an expiry (see perun.ExpiryMetaKey), the response from the peer is awaited only until
+// then. The peer's node uses it as the expiry of the update notification.
+//
+// If there is an error, it will be one of the errors returned by SendChUpdate.
+func (ch *Channel) SendChUpdateWMeta(pctx context.Context, updater perun.StateUpdater, meta perun.ChUpdateMeta) (
+	perun.ChInfo, perun.APIError) {
+	ch.WithField("method", "SendChUpdateWMeta").Infof("\nReceived request with params %+v, %+v", updater, meta)
+	return ch.sendChUpdate(pctx, "SendChUpdateWMeta", updater, meta)
+}
+
+func (ch *Channel) sendChUpdate(pctx context.Context, method string, updater perun.StateUpdater,
+	meta perun.ChUpdateMeta) (perun.ChInfo, perun.APIError) {
 	ch.Lock()
 	defer ch.Unlock()
 
 	var apiErr perun.APIError
 	defer func() {
 		if apiErr != nil {
-			ch.WithFields(perun.APIErrAsMap("SendChUpdate", apiErr)).Error(apiErr.Message())
+			ch.WithFields(perun.APIErrAsMap(method, apiErr)).Error(apiErr.Message())
 		}
 	}()
 
@@ -323,17 +464,101 @@ func (ch *Channel) SendChUpdate(pctx context.Context, updater perun.StateUpdater
 		return ch.getChInfo(), apiErr
 	}
 
+	releaseSpending, apiErr := ch.reserveSpending(updater)
+	if apiErr != nil {
+		return ch.getChInfo(), apiErr
+	}
+
 	ctx, cancel := context.WithTimeout(pctx, ch.timeoutCfg.chUpdate())
 	defer cancel()
+	if expiry, ok := metaExpiry(meta); ok {
+		var cancelAtExpiry context.CancelFunc
+		ctx, cancelAtExpiry = context.WithDeadline(ctx, time.Unix(expiry, 0))
+		defer cancelAtExpiry()
+	}
+	var metaErr perun.APIError
+	if len(meta) != 0 {
+		if ch.chUpdateMetas == nil {
+			releaseSpending()
+			apiErr = perun.NewAPIErrUnknownInternal(errors.New("sending channel update metadata is not supported"))
+			return ch.getChInfo(), apiErr
+		}
+		updater = ch.withChUpdateMeta(ctx, updater, meta, &metaErr)
+	}
 	err := ch.pch.UpdateBy(ctx, updater)
+	if metaErr != nil {
+		releaseSpending()
+		apiErr = metaErr
+		return ch.getChInfo(), apiErr
+	}
 	if err != nil {
+		releaseSpending()
 		apiErr = ch.handleSendChUpdateError(errors.WithMessage(err, "sending channel update"))
 		return perun.ChInfo{}, apiErr
 	}
-	ch.WithField("method", "SendChUpdate").Info("State updated successfully")
+	ch.WithField("method", method).Info("State updated successfully")
+	ch.recordChUpdate(true, meta)
 	return ch.getChInfo(), nil
 }
 
+// withChUpdateMeta returns an updater that applies the given updater and then sends the metadata to the peer,
+// bound to the resulting state. So, the metadata is sent only once the proposed state is known and the peer
+// drops it, if the update it receives is not for this state. If sending fails, the error is stored in metaErr
+// and the update is aborted.
+func (ch *Channel) withChUpdateMeta(ctx context.Context, updater perun.StateUpdater, meta perun.ChUpdateMeta,
+	metaErr *perun.APIError) perun.StateUpdater {
+	return func(state *pchannel.State) error {
+		if err := updater(state); err != nil {
+			return err
+		}
+		if *metaErr = ch.sendChUpdateMeta(ctx, state, meta); *metaErr != nil {
+			return *metaErr
+		}
+		return nil
+	}
+}
+
+// sendChUpdateMeta sends the metadata for the update to the given state to the peer. The state is as returned
+// by the updater, before its version is incremented by go-perun.
+func (ch *Channel) sendChUpdateMeta(ctx context.Context, state *pchannel.State, meta perun.ChUpdateMeta) (
+	apiErr perun.APIError) {
+	proposed := state.Clone()
+	proposed.Version++
+	stateHash, err := chUpdateStateHash(proposed)
+	if err != nil {
+		return perun.NewAPIErrUnknownInternal(err)
+	}
+	updateID := fmt.Sprintf("%s_%d", ch.ID(), proposed.Version)
+	peer := ch.pch.Peers()[ch.pch.Idx()^1] // Logic works only for a two party channel.
+	if err := ch.chUpdateMetas.send(ctx, peer, updateID, stateHash, meta); err != nil {
+		return perun.NewAPIErrUnknownInternal(err)
+	}
+	return nil
+}
+
+// recordChUpdate adds the latest state of the channel, that was just accepted, to its history. Errors are only
+// logged, as the update has already been accepted by both the participants.
+func (ch *Channel) recordChUpdate(isOwnUpdate bool, meta perun.ChUpdateMeta) {
+	if ch.history == nil {
+		return
+	}
+	proposedBy := perun.OwnAlias
+	if !isOwnUpdate {
+		proposedBy = ch.parts[ch.pch.Idx()^1] // Logic works only for a two party channel.
+	}
+	chInfo := ch.getChInfo()
+	record := perun.ChUpdateRecord{
+		Version:    chInfo.Version,
+		BalInfo:    chInfo.BalInfo,
+		ProposedBy: proposedBy,
+		Meta:       meta,
+		AcceptedAt: time.Now().UTC().Unix(),
+	}
+	if err := ch.history.add(ch.ID(), record); err != nil {
+		ch.Errorf("Recording update with version %s in channel history: %v", chInfo.Version, err)
+	}
+}
+
 // handleSendChUpdateError inspects the passed error, constructs an
 // appropriate APIError and returns it.
 func (ch *Channel) handleSendChUpdateError(err error) perun.APIError {
@@ -361,10 +586,19 @@ func (ch *Channel) HandleUpdate(
 	expiry := time.Now().UTC().Add(ch.timeoutCfg.response).Unix()
 	currChInfo := ch.makeChInfo(currState)
 	notif := ch.makeChUpdateNotif(currChInfo, chUpdate.State, expiry)
+	if ch.chUpdateMetas != nil {
+		notif.Meta = ch.chUpdateMetas.take(notif.UpdateID, ch.pch.Peers()[ch.pch.Idx()^1], chUpdate.State)
+	}
+	if proposerExpiry, ok := metaExpiry(notif.Meta); ok && proposerExpiry < expiry {
+		expiry = proposerExpiry
+		notif.Expiry = expiry
+	}
 	entry := chUpdateResponderEntry{
-		notif:       notif,
-		responder:   responder,
-		notifExpiry: expiry,
+		notif:         notif,
+		responder:     responder,
+		notifExpiry:   expiry,
+		currState:     currState,
+		proposedState: chUpdate.State,
 	}
 
 	// Need not store entries for notification with expiry = 0, as these update requests have
@@ -486,6 +720,8 @@ func (ch *Channel) unsubChUpdates() {
 // If there is an error, it will be one of the following codes:
 // - ErrResourceNotFound with ResourceType: "update" when update ID is not known.
 // - ErrUserResponseTimedOut when user responded after time out expired.
+// - ErrSpendingLimitExceeded when the update is accepted and the amount paid to the peer in it exceeds any of
+// the spending limits. The update is rejected in this case.
 // - ErrUnknownInternal.
 func (ch *Channel) RespondChUpdate(pctx context.Context, updateID string, accept bool) (
 	perun.ChInfo, perun.APIError) {
@@ -523,6 +759,10 @@ func (ch *Channel) RespondChUpdate(pctx context.Context, updateID string, accept
 		apiErr = ch.acceptChUpdate(pctx, entry)
 		if apiErr == nil {
 			ch.WithField("method", "RespondChUpdate").Info("Channel update accepted successfully")
+			ch.recordChUpdate(false, entry.notif.Meta)
+			if ch.onChUpdateAccepted != nil {
+				ch.onChUpdateAccepted(ch, entry.notif, entry.currState, entry.proposedState)
+			}
 		}
 		if apiErr == nil && entry.notif.Type == perun.ChUpdateTypeFinal {
 			ch.Info("Responded to update successfully, registering the state as it was final update.")
@@ -547,10 +787,18 @@ func (ch *Channel) RespondChUpdate(pctx context.Context, updateID string, accept
 }
 
 func (ch *Channel) acceptChUpdate(pctx context.Context, entry chUpdateResponderEntry) perun.APIError {
+	releaseSpending, apiErr := ch.reserveSpendingForStates(entry.currState, entry.proposedState)
+	if apiErr != nil {
+		// nolint: errcheck              // It is sufficient to return the spending limit error.
+		ch.rejectChUpdate(pctx, entry, "spending limit exceeded")
+		return apiErr
+	}
+
 	ctx, cancel := context.WithTimeout(pctx, ch.timeoutCfg.respChUpdate())
 	defer cancel()
 	err := entry.responder.Accept(ctx)
 	if err != nil {
+		releaseSpending()
 		ch.Error("Accepting channel update", err)
 		return perun.NewAPIErrUnknownInternal(errors.Wrap(err, "accepting update"))
 	}
@@ -586,14 +834,26 @@ func (ch *Channel) makeChInfo(state *pchannel.State) perun.ChInfo {
 	if state == nil {
 		return perun.ChInfo{}
 	}
+	balInfo := makeBalInfoFromState(ch.parts, ch.currencies, state)
 	return perun.ChInfo{
-		ChID:    ch.id,
-		BalInfo: makeBalInfoFromState(ch.parts, ch.currencies, state),
-		App:     makeApp(state.App, state.Data),
-		Version: fmt.Sprintf("%d", state.Version),
+		ChID:        ch.id,
+		BalInfo:     balInfo,
+		App:         makeApp(state.App, state.Data),
+		Version:     fmt.Sprintf("%d", state.Version),
+		TxFee:       ch.txFeeCurrency.Print(ch.txCosts.TotalFee()),
+		FiatBalInfo: makeFiatBalInfo(ch.prices, balInfo),
 	}
 }
 
+// GetHistory returns the records of the updates accepted on the channel, in the order of acceptance. These
+// include the updates accepted before the node was restarted.
+func (ch *Channel) GetHistory() []perun.ChUpdateRecord {
+	if ch.history == nil {
+		return []perun.ChUpdateRecord{}
+	}
+	return ch.history.get(ch.ID())
+}
+
 // makeApp returns perun.makeApp formed from the given app def and app data.
 func makeApp(def pchannel.App, data pchannel.Data) perun.App {
 	return perun.App{
@@ -692,12 +952,13 @@ func (ch *Channel) finalize(pctx context.Context) {
 		return
 	}
 
+	ch.recordChUpdate(true, nil)
 	ch.Info("Channel finalized. Proceeding with collaborative close")
 }
 
 // register registers the latest state of the channel on-chain.
 func (ch *Channel) register(pctx context.Context) perun.APIError {
-	ctx, cancel := context.WithTimeout(pctx, ch.timeoutCfg.register(ch.challengeDurSecs))
+	ctx, cancel := context.WithTimeout(ch.txCostsContext(pctx), ch.timeoutCfg.register(ch.challengeDurSecs))
 	defer cancel()
 	err := ch.pch.Register(ctx)
 	if err != nil {
@@ -725,4 +986,7 @@ func (ch *Channel) close() {
 	}
 	ch.watcherWg.Wait()
 	ch.status = closed
+	if ch.onClose != nil {
+		ch.onClose(ch.ID())
+	}
 }
@@ -28,6 +28,7 @@ import (
 	pethchannel "perun.network/go-perun/backend/ethereum/channel"
 	pchannel "perun.network/go-perun/channel"
 	pclient "perun.network/go-perun/client"
+	pwire "perun.network/go-perun/wire"
 
 	"github.com/hyperledger-labs/perun-node"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
@@ -125,6 +126,17 @@ func Test_SendChUpdate(t *testing.T) {
 		assert.NotZero(t, gotChInfo)
 	})
 
+	t.Run("meta_not_supported", func(t *testing.T) {
+		pch, _ := newMockPCh()
+		ch := session.NewChForTest(
+			pch, currency.ETHSymbol, validOpeningBalInfo.Parts, responseTimeout, challengeDurSecs, true)
+
+		pch.On("State").Return(makeState(t, validOpeningBalInfo, false))
+		meta := perun.ChUpdateMeta{"key": "value"}
+		_, err := ch.SendChUpdateWMeta(context.Background(), noopUpdater, meta)
+		peruntest.AssertAPIError(t, err, perun.InternalError, perun.ErrUnknownInternal)
+	})
+
 	t.Run("channel_closed", func(t *testing.T) {
 		pch, _ := newMockPCh()
 		pch.On("State").Return(makeState(t, validOpeningBalInfo, false))
@@ -342,6 +354,77 @@ func Test_HandleUpdate_Sub(t *testing.T) {
 	})
 }
 
+func Test_HandleUpdate_ProposerExpiry(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+
+	// receive handles an incoming update sent along with the metadata and returns its notification. If
+	// metaForOtherState is true, the metadata is sent for a state different from the one in the update.
+	receive := func(t *testing.T, meta perun.ChUpdateMeta, metaForOtherState bool) perun.ChUpdateNotif {
+		pch, _ := newMockPCh()
+		pch.On("State").Return(makeState(t, openingBalInfo, false))
+		sess := newSessionWCh(t, peerIDs, openingBalInfo, pch)
+		ownPeerID, err := sess.GetPeerID(perun.OwnAlias)
+		require.NoError(t, err)
+		pch.On("Idx").Return(pchannel.Index(0))
+		pch.On("Peers").Return([]pwire.Address{ownPeerID.OffChainAddr, peerIDs[0].OffChainAddr})
+		chAPI, err := sess.GetCh(sess.GetChsInfo()[0].ChID)
+		require.NoError(t, err)
+		ch := chAPI.(*session.Channel)
+
+		updatedState := makeState(t, openingBalInfo, false)
+		updatedState.Version = 1
+		metaState := updatedState
+		if metaForOtherState {
+			otherBalInfo := openingBalInfo
+			otherBalInfo.Bals = [][]string{{"2", "1"}}
+			metaState = makeState(t, otherBalInfo, false)
+			metaState.Version = 1
+		}
+		sess.ReceiveChUpdateMeta(peerIDs[0].OffChainAddr, fmt.Sprintf("%s_%d", ch.ID(), 1), metaState, meta)
+
+		notifs := make(chan perun.ChUpdateNotif, 1)
+		require.NoError(t, ch.SubChUpdates(func(notif perun.ChUpdateNotif) { notifs <- notif }))
+		ch.HandleUpdate(makeState(t, openingBalInfo, false), pclient.ChannelUpdate{State: updatedState},
+			&mocks.ChUpdateResponder{})
+		select {
+		case notif := <-notifs:
+			return notif
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for notification")
+		}
+		return perun.ChUpdateNotif{}
+	}
+
+	t.Run("earlier_than_node_expiry", func(t *testing.T) {
+		proposerExpiry := time.Now().Unix() + 1
+		notif := receive(t, perun.ChUpdateMeta{perun.ExpiryMetaKey: fmt.Sprintf("%d", proposerExpiry)}, false)
+		assert.Equal(t, proposerExpiry, notif.Expiry)
+	})
+
+	t.Run("later_than_node_expiry", func(t *testing.T) {
+		proposerExpiry := time.Now().Unix() + 3600
+		notif := receive(t, perun.ChUpdateMeta{perun.ExpiryMetaKey: fmt.Sprintf("%d", proposerExpiry)}, false)
+		assert.Less(t, notif.Expiry, proposerExpiry)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		notif := receive(t, perun.ChUpdateMeta{perun.ExpiryMetaKey: "invalid"}, false)
+		assert.Greater(t, notif.Expiry, time.Now().Unix())
+	})
+
+	t.Run("meta_for_other_state_dropped", func(t *testing.T) {
+		proposerExpiry := time.Now().Unix() + 1
+		notif := receive(t, perun.ChUpdateMeta{perun.ExpiryMetaKey: fmt.Sprintf("%d", proposerExpiry)}, true)
+		assert.Nil(t, notif.Meta)
+		assert.Greater(t, notif.Expiry, proposerExpiry)
+	})
+}
+
 func Test_HandleUpdate_Respond(t *testing.T) {
 	peers := newPeerIDs(t, uint(2))
 	validOpeningBalInfo := perun.BalInfo{
@@ -890,3 +973,152 @@ func Test_Close(t *testing.T) {
 		assert.Nil(t, err.AddInfo())
 	})
 }
+
+func Test_NotifyPastAdjEventsErr(t *testing.T) {
+	peers := newPeerIDs(t, uint(1))
+	validOpeningBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peers[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	pch, _ := newMockPCh()
+	pch.On("State").Return(makeState(t, validOpeningBalInfo, false))
+	ch := session.NewChForTest(pch, currency.ETHSymbol, validOpeningBalInfo.Parts, responseTimeout, 0, true)
+	chainURL := "ws://127.0.0.1:8545"
+	apiErr := perun.NewAPIErrChainNotReachable(fmt.Errorf("dial error"), chainURL)
+
+	// Notification should be cached, as there is no subscription yet.
+	ch.NotifyPastAdjEventsErr(apiErr)
+
+	notifs := make(chan perun.ChUpdateNotif, 1)
+	require.NoError(t, ch.SubChUpdates(func(notif perun.ChUpdateNotif) {
+		notifs <- notif
+	}))
+	select {
+	case notif := <-notifs:
+		assert.Equal(t, perun.ChUpdateTypeOpen, notif.Type)
+		assert.Zero(t, notif.Expiry)
+		assert.Equal(t, apiErr, notif.Error)
+		assert.Contains(t, notif.Info, "could not be read")
+	case <-time.After(time.Second):
+		t.Fatal("no notification received")
+	}
+}
+
+func Test_HandleMissedAdjEvents(t *testing.T) {
+	peers := newPeerIDs(t, uint(2))
+	validOpeningBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peers[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	var ownVersion uint64 = 5
+	var noChallengeDurSecs uint64
+
+	newRestoredCh := func(t *testing.T, isOpen bool) (*session.Channel, *mocks.PChannel, *[]perun.ChUpdateNotif) {
+		pch, watcherSignal := newMockPCh()
+		ch := session.NewChForTest(
+			pch, currency.ETHSymbol, validOpeningBalInfo.Parts, responseTimeout, noChallengeDurSecs, isOpen)
+
+		state := makeState(t, validOpeningBalInfo, false)
+		state.Version = ownVersion
+		pch.On("State").Return(state)
+		pch.On("Close").Return(nil).Run(func(args mock.Arguments) {
+			watcherSignal <- time.Now() // Signal the watcher to return when pch is closed.
+		})
+
+		notifs := make([]perun.ChUpdateNotif, 0, 1)
+		if isOpen {
+			require.NoError(t, ch.SubChUpdates(func(notif perun.ChUpdateNotif) {
+				notifs = append(notifs, notif)
+			}))
+		}
+		return ch, pch, &notifs
+	}
+
+	assertCloseNotif := func(t *testing.T, notifs []perun.ChUpdateNotif, wantInfo string) {
+		t.Helper()
+		require.Len(t, notifs, 1)
+		assert.Equal(t, perun.ChUpdateTypeClosed, notifs[0].Type)
+		assert.Nil(t, notifs[0].Error)
+		assert.Contains(t, notifs[0].Info, wantInfo)
+	}
+
+	t.Run("no_events", func(t *testing.T) {
+		ch, pch, notifs := newRestoredCh(t, true)
+
+		ch.HandleMissedAdjEvents(nil)
+		pch.AssertNotCalled(t, "Settle", mock.Anything, mock.Anything)
+		assert.Len(t, *notifs, 0)
+	})
+
+	t.Run("concluded", func(t *testing.T) {
+		ch, pch, notifs := newRestoredCh(t, true)
+		pch.On("Settle", mock.Anything, mock.Anything).Return(nil)
+
+		concludedEvent := &pchannel.ConcludedEvent{
+			AdjudicatorEventBase: *pchannel.NewAdjudicatorEventBase(pch.ID(), &pchannel.ElapsedTimeout{}, ownVersion),
+		}
+		ch.HandleMissedAdjEvents([]pchannel.AdjudicatorEvent{concludedEvent})
+		pch.AssertNotCalled(t, "Register", mock.Anything)
+		assertCloseNotif(t, *notifs, "concluded on-chain")
+	})
+
+	t.Run("registered_latestVersion", func(t *testing.T) {
+		ch, pch, notifs := newRestoredCh(t, true)
+		pch.On("Settle", mock.Anything, mock.Anything).Return(nil)
+
+		registeredEvent := pchannel.NewRegisteredEvent(pch.ID(), &pchannel.ElapsedTimeout{}, ownVersion)
+		ch.HandleMissedAdjEvents([]pchannel.AdjudicatorEvent{registeredEvent})
+		pch.AssertNotCalled(t, "Register", mock.Anything)
+		assertCloseNotif(t, *notifs, "registered on-chain")
+	})
+
+	t.Run("registered_olderVersion_timeoutElapsed", func(t *testing.T) {
+		ch, pch, notifs := newRestoredCh(t, true)
+		pch.On("Settle", mock.Anything, mock.Anything).Return(nil)
+
+		registeredEvent := pchannel.NewRegisteredEvent(pch.ID(), &pchannel.ElapsedTimeout{}, ownVersion-2)
+		ch.HandleMissedAdjEvents([]pchannel.AdjudicatorEvent{registeredEvent})
+		pch.AssertNotCalled(t, "Register", mock.Anything)
+		assertCloseNotif(t, *notifs, "challenge duration passed")
+	})
+
+	t.Run("registered_olderVersion_refuted", func(t *testing.T) {
+		ch, pch, notifs := newRestoredCh(t, true)
+		pch.On("Register", mock.Anything).Return(nil)
+		pch.On("Settle", mock.Anything, mock.Anything).Return(nil)
+
+		olderEvent := pchannel.NewRegisteredEvent(pch.ID(), &pchannel.ElapsedTimeout{}, ownVersion-2)
+		latestEvent := pchannel.NewRegisteredEvent(pch.ID(), liveTimeout{}, ownVersion-1)
+		ch.HandleMissedAdjEvents([]pchannel.AdjudicatorEvent{olderEvent, latestEvent})
+		pch.AssertCalled(t, "Register", mock.Anything)
+		assertCloseNotif(t, *notifs, fmt.Sprintf("refuted it with version %d", ownVersion))
+	})
+
+	t.Run("registered_olderVersion_refute_AnError", func(t *testing.T) {
+		ch, pch, notifs := newRestoredCh(t, true)
+		pch.On("Register", mock.Anything).Return(assert.AnError)
+		pch.On("Settle", mock.Anything, mock.Anything).Return(nil)
+
+		registeredEvent := pchannel.NewRegisteredEvent(pch.ID(), liveTimeout{}, ownVersion-1)
+		ch.HandleMissedAdjEvents([]pchannel.AdjudicatorEvent{registeredEvent})
+		assertCloseNotif(t, *notifs, "failed")
+	})
+
+	t.Run("channel_closed", func(t *testing.T) {
+		ch, pch, _ := newRestoredCh(t, false)
+
+		registeredEvent := pchannel.NewRegisteredEvent(pch.ID(), &pchannel.ElapsedTimeout{}, ownVersion)
+		ch.HandleMissedAdjEvents([]pchannel.AdjudicatorEvent{registeredEvent})
+		pch.AssertNotCalled(t, "Settle", mock.Anything, mock.Anything)
+	})
+}
+
+// liveTimeout is a timeout that has not elapsed yet, but waiting on it
+// returns immediately.
+type liveTimeout struct{}
+
+func (liveTimeout) IsElapsed(context.Context) bool { return false }
+func (liveTimeout) Wait(context.Context) error     { return nil }
+func (liveTimeout) String() string                 { return "<Live timeout>" }
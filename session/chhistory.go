@@ -0,0 +1,104 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+// chHistoryFile is the name of the file in the database directory, in which the history of the channels is
+// persisted. Each line in the file is a JSON encoded chHistoryEntry.
+const chHistoryFile = "chhistory.jsonl"
+
+type (
+	// chHistoryStore holds the records of the updates accepted on the channels in the session and persists them
+	// by appending to a file. It has its own mutex, as updates are recorded with the channel locked.
+	chHistoryStore struct {
+		log.Logger
+
+		filePath string // History is not persisted, if empty.
+
+		mutex   sync.Mutex
+		records map[string][]perun.ChUpdateRecord // Indexed by channel ID.
+	}
+
+	// chHistoryEntry is the format in which a record is persisted.
+	chHistoryEntry struct {
+		ChID   string               `json:"chID"`
+		Record perun.ChUpdateRecord `json:"record"`
+	}
+)
+
+// loadChHistoryStore loads the history persisted in the file. If the file does not exist, the store is empty.
+// If the file path is empty, the history is not persisted.
+func loadChHistoryStore(filePath string) (*chHistoryStore, error) {
+	s := &chHistoryStore{
+		Logger:   log.NewLoggerWithField("component", "ch-history"),
+		filePath: filePath,
+		records:  make(map[string][]perun.ChUpdateRecord),
+	}
+	if filePath == "" {
+		return s, nil
+	}
+	err := loadJSONLines(filePath, s.Logger, func(line []byte) error {
+		var entry chHistoryEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return errors.Wrap(err, "decoding entry")
+		}
+		s.records[entry.ChID] = append(s.records[entry.ChID], entry.Record)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "loading channel history file")
+	}
+	return s, nil
+}
+
+// add adds the record to the history of the channel and persists it.
+func (s *chHistoryStore) add(chID string, record perun.ChUpdateRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.filePath != "" {
+		if err := s.persist(chHistoryEntry{ChID: chID, Record: record}); err != nil {
+			return err
+		}
+	}
+	s.records[chID] = append(s.records[chID], record)
+	return nil
+}
+
+// get returns the records of the updates accepted on the channel, in the order of acceptance.
+func (s *chHistoryStore) get(chID string) []perun.ChUpdateRecord {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	records := make([]perun.ChUpdateRecord, len(s.records[chID]))
+	copy(records, s.records[chID])
+	return records
+}
+
+// persist appends the entry to the file.
+func (s *chHistoryStore) persist(entry chHistoryEntry) error {
+	return errors.WithMessage(appendJSONLine(s.filePath, entry), "appending to channel history file")
+}
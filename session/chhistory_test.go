@@ -0,0 +1,126 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	pclient "perun.network/go-perun/client"
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/session"
+	"github.com/hyperledger-labs/perun-node/session/sessiontest"
+)
+
+func Test_Channel_GetHistory(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	app := perun.App{Def: pchannel.NoApp(), Data: pchannel.NoData()}
+	noopUpdater := func(s *pchannel.State) error { return nil }
+
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	cfg := sessiontest.NewConfigT(t, rng, peerIDs...)
+	rng = rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+
+	pch, _ := newMockPCh()
+	state := makeState(t, openingBalInfo, false)
+	state.Version = 1
+	pch.On("State").Return(state)
+	pch.On("Idx").Return(pchannel.Index(0))
+	pch.On("UpdateBy", mock.Anything, mock.Anything).Return(nil)
+
+	// openCh opens the channel (using the same mock channel) in a new session that uses the config.
+	openCh := func(t *testing.T) (*session.Session, perun.ChAPI) {
+		chClient := &mocks.ChClient{}
+		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil)
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
+		chClient.On("Publish", mock.Anything, mock.Anything).Return(nil)
+		sess, err := session.NewSessionForTest(cfg, true, chClient, chainSetup)
+		require.NoError(t, err)
+		ownPeerID, err := sess.GetPeerID(perun.OwnAlias)
+		require.NoError(t, err)
+		pch.On("Peers").Return([]pwire.Address{ownPeerID.OffChainAddr, peerIDs[0].OffChainAddr})
+		chInfo, err := sess.OpenCh(context.Background(), openingBalInfo, app, 10)
+		require.NoError(t, err)
+		ch, err := sess.GetCh(chInfo.ChID)
+		require.NoError(t, err)
+		return sess, ch
+	}
+
+	sess, ch := openCh(t)
+	sentMeta := perun.ChUpdateMeta{perun.MemoMetaKey: "order 42", "orderID": "42"}
+	receivedMeta := perun.ChUpdateMeta{perun.ReferenceMetaKey: "ref 1"}
+	var wantRecords []perun.ChUpdateRecord
+
+	t.Run("happy_empty", func(t *testing.T) {
+		assert.Empty(t, ch.GetHistory())
+	})
+
+	t.Run("happy_sent", func(t *testing.T) {
+		_, err := ch.SendChUpdateWMeta(context.Background(), noopUpdater, sentMeta)
+		require.NoError(t, err)
+
+		records := ch.GetHistory()
+		require.Len(t, records, 1)
+		assert.Equal(t, "1", records[0].Version)
+		assert.Equal(t, openingBalInfo, records[0].BalInfo)
+		assert.Equal(t, perun.OwnAlias, records[0].ProposedBy)
+		assert.Equal(t, sentMeta, records[0].Meta)
+		assert.NotZero(t, records[0].AcceptedAt)
+		wantRecords = records
+	})
+
+	t.Run("happy_received", func(t *testing.T) {
+		updatedState := makeState(t, openingBalInfo, false)
+		updatedState.Version = 2
+		updateID := fmt.Sprintf("%s_%d", ch.ID(), 2)
+		responder := &mocks.ChUpdateResponder{}
+		responder.On("Accept", mock.Anything).Return(nil)
+
+		sess.ReceiveChUpdateMeta(peerIDs[0].OffChainAddr, updateID, updatedState, receivedMeta)
+		ch.(*session.Channel).HandleUpdate(state, pclient.ChannelUpdate{State: updatedState}, responder)
+		_, err := ch.RespondChUpdate(context.Background(), updateID, true)
+		require.NoError(t, err)
+
+		records := ch.GetHistory()
+		require.Len(t, records, 2)
+		assert.Equal(t, peerIDs[0].Alias, records[1].ProposedBy)
+		assert.Equal(t, receivedMeta, records[1].Meta)
+		wantRecords = records
+	})
+
+	t.Run("happy_persisted", func(t *testing.T) {
+		_, restoredCh := openCh(t)
+		assert.Equal(t, wantRecords, restoredCh.GetHistory())
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+// chStartBlocksFile is the name of the file in the database directory, in which the blocks at which the channels
+// were opened are persisted. Each line in the file is a JSON encoded chStartBlockEntry.
+const chStartBlocksFile = "chstartblocks.jsonl"
+
+type (
+	// chStartBlockStore holds the number of the latest block on the blockchain at the time each channel was
+	// opened. Adjudicator events for a channel cannot be emitted before this block, so these are read only from
+	// this block onwards when the channel is restored.
+	chStartBlockStore struct {
+		log.Logger
+
+		filePath string // Start blocks are not persisted, if empty.
+
+		mutex  sync.Mutex
+		blocks map[string]uint64 // Indexed by channel ID.
+	}
+
+	// chStartBlockEntry is the format in which a start block is persisted.
+	chStartBlockEntry struct {
+		ChID  string `json:"chID"`
+		Block uint64 `json:"block"`
+	}
+)
+
+// loadChStartBlockStore loads the start blocks persisted in the file. If the file does not exist, the store is
+// empty. If the file path is empty, the start blocks are not persisted.
+func loadChStartBlockStore(filePath string) (*chStartBlockStore, error) {
+	s := &chStartBlockStore{
+		Logger:   log.NewLoggerWithField("component", "ch-start-blocks"),
+		filePath: filePath,
+		blocks:   make(map[string]uint64),
+	}
+	if filePath == "" {
+		return s, nil
+	}
+	err := loadJSONLines(filePath, s.Logger, func(line []byte) error {
+		var entry chStartBlockEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return errors.Wrap(err, "decoding entry")
+		}
+		s.blocks[entry.ChID] = entry.Block
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "loading channel start blocks file")
+	}
+	return s, nil
+}
+
+// add sets the start block of the channel and persists it.
+func (s *chStartBlockStore) add(chID string, block uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.filePath != "" {
+		if err := appendJSONLine(s.filePath, chStartBlockEntry{ChID: chID, Block: block}); err != nil {
+			return errors.WithMessage(err, "appending to channel start blocks file")
+		}
+	}
+	s.blocks[chID] = block
+	return nil
+}
+
+// get returns the start block of the channel. If it is not known, it returns false.
+func (s *chStartBlockStore) get(chID string) (uint64, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	block, ok := s.blocks[chID]
+	return block, ok
+}
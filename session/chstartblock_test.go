@@ -0,0 +1,77 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/session"
+	"github.com/hyperledger-labs/perun-node/session/sessiontest"
+)
+
+func Test_Session_OpenCh_StartBlock(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	app := perun.App{Def: pchannel.NoApp(), Data: pchannel.NoData()}
+
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	cfg := sessiontest.NewConfigT(t, rng, peerIDs...)
+	rng = rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+
+	pch, _ := newMockPCh()
+	pch.On("State").Return(makeState(t, openingBalInfo, false))
+	chClient := &mocks.ChClient{}
+	chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil)
+	chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
+	sess, err := session.NewSessionForTest(cfg, true, chClient, chainSetup)
+	require.NoError(t, err)
+	ownPeerID, err := sess.GetPeerID(perun.OwnAlias)
+	require.NoError(t, err)
+	pch.On("Peers").Return([]pwire.Address{ownPeerID.OffChainAddr, peerIDs[0].OffChainAddr})
+
+	wantBlock, err := chainSetup.ChainBackend.BlockNumber()
+	require.NoError(t, err)
+	chInfo, err := sess.OpenCh(context.Background(), openingBalInfo, app, 10)
+	require.NoError(t, err)
+
+	// Start block should be persisted, so that it is available after a restart.
+	gotBlock, ok, err := session.ChStartBlock(cfg.DatabaseDir, chInfo.ChID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, wantBlock, gotBlock)
+
+	_, ok, err = session.ChStartBlock(cfg.DatabaseDir, "unknown-channel")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pchannel "perun.network/go-perun/channel"
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/comm/nodemsg"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+const (
+	// nodeMsgKindChUpdateMeta is the kind of node messages used for sending the channel update metadata.
+	nodeMsgKindChUpdateMeta = "chUpdateMeta"
+
+	// chUpdateMetaMaxAge is the duration for which the received metadata is retained, if the corresponding
+	// update is not received. This can happen, when the peer fails to send the update after sending the metadata.
+	chUpdateMetaMaxAge = 10 * time.Minute
+)
+
+type (
+	// chUpdateMetaExchanger sends the metadata for the channel updates sent by this node and retains the metadata
+	// received from the peers, until the corresponding updates are received.
+	//
+	// The metadata is sent as a node message over the wire bus, just before the update. Since the messages to
+	// a peer are sent in order over the same connection and the node messages are handled synchronously on
+	// receipt, the metadata for an update is always retained before the update is received.
+	//
+	// The metadata is bound to the hash of the proposed state. So, if the update could not be sent after
+	// sending the metadata, it is not attached to a later update with the same ID.
+	chUpdateMetaExchanger struct {
+		log.Logger
+
+		ownAddr pwire.Address
+		publish func(context.Context, *pwire.Envelope) error
+
+		mutex    sync.Mutex
+		received map[string]receivedChUpdateMeta // Indexed by update ID.
+	}
+
+	receivedChUpdateMeta struct {
+		sender     pwire.Address
+		stateHash  string
+		meta       perun.ChUpdateMeta
+		receivedAt time.Time
+	}
+
+	// chUpdateMetaMsg is the payload of the node message used for sending the channel update metadata.
+	chUpdateMetaMsg struct {
+		UpdateID  string             `json:"updateID"`
+		StateHash string             `json:"stateHash"`
+		Meta      perun.ChUpdateMeta `json:"meta"`
+	}
+)
+
+// newChUpdateMetaExchanger returns a channel update metadata exchanger that uses the publish function for
+// sending the metadata and registers itself for handling the received metadata with the router.
+func newChUpdateMetaExchanger(ownAddr pwire.Address, publish func(context.Context, *pwire.Envelope) error,
+	router *nodemsg.Router) *chUpdateMetaExchanger {
+	e := &chUpdateMetaExchanger{
+		Logger:   log.NewLoggerWithField("component", "ch-update-meta"),
+		ownAddr:  ownAddr,
+		publish:  publish,
+		received: make(map[string]receivedChUpdateMeta),
+	}
+	if router != nil {
+		router.Handle(nodeMsgKindChUpdateMeta, e.handle)
+	}
+	return e
+}
+
+// send sends the metadata for the update with the given ID and proposed state hash to the peer.
+func (e *chUpdateMetaExchanger) send(ctx context.Context, peer pwire.Address, updateID, stateHash string,
+	meta perun.ChUpdateMeta) error {
+	data, err := json.Marshal(chUpdateMetaMsg{UpdateID: updateID, StateHash: stateHash, Meta: meta})
+	if err != nil {
+		return errors.Wrap(err, "encoding channel update metadata")
+	}
+	env := &pwire.Envelope{
+		Sender:    e.ownAddr,
+		Recipient: peer,
+		Msg:       nodemsg.NewMsg(nodeMsgKindChUpdateMeta, data),
+	}
+	return errors.WithMessage(e.publish(ctx, env), "sending channel update metadata")
+}
+
+// handle retains the metadata received from the peer. It also drops the metadata that was retained for longer
+// than the max age.
+func (e *chUpdateMetaExchanger) handle(sender pwire.Address, data []byte) {
+	var msg chUpdateMetaMsg
+	if err := json.Unmarshal(data, &msg); err != nil {
+		e.Errorf("Dropping invalid channel update metadata from %v: %v", sender, err)
+		return
+	}
+
+	now := time.Now()
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	for updateID, entry := range e.received {
+		if now.Sub(entry.receivedAt) > chUpdateMetaMaxAge {
+			delete(e.received, updateID)
+		}
+	}
+	e.received[msg.UpdateID] = receivedChUpdateMeta{
+		sender:     sender,
+		stateHash:  msg.StateHash,
+		meta:       msg.Meta,
+		receivedAt: now,
+	}
+}
+
+// take returns and drops the metadata received for the update with the given ID. It returns nil, if no
+// metadata was received, if it was not sent by the given peer or if it was sent for a different proposed state.
+func (e *chUpdateMetaExchanger) take(updateID string, peer pwire.Address, proposed *pchannel.State) (
+	meta perun.ChUpdateMeta) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	entry, ok := e.received[updateID]
+	if !ok {
+		return nil
+	}
+	delete(e.received, updateID)
+	if !entry.sender.Equals(peer) {
+		e.Errorf("Dropping metadata for update %s, as it was not sent by the peer in the channel", updateID)
+		return nil
+	}
+	stateHash, err := chUpdateStateHash(proposed)
+	if err != nil {
+		e.Errorf("Dropping metadata for update %s, as the proposed state could not be hashed: %v", updateID, err)
+		return nil
+	}
+	if entry.stateHash != stateHash {
+		e.Errorf("Dropping metadata for update %s, as it was sent for a different proposed state", updateID)
+		return nil
+	}
+	return entry.meta
+}
+
+// chUpdateStateHash returns the hex encoded sha256 hash of the encoded state.
+func chUpdateStateHash(state *pchannel.State) (string, error) {
+	var buf bytes.Buffer
+	if err := state.Encode(&buf); err != nil {
+		return "", errors.Wrap(err, "encoding state")
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(buf.Bytes())), nil
+}
+
+// metaExpiry returns the expiry set by the proposer in the metadata. Ok is false, if it is not set or invalid.
+func metaExpiry(meta perun.ChUpdateMeta) (expiry int64, ok bool) {
+	expiry, err := strconv.ParseInt(meta[perun.ExpiryMetaKey], 10, 64)
+	return expiry, err == nil && expiry > 0
+}
@@ -32,6 +32,9 @@ import (
 	pnet "perun.network/go-perun/wire/net"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/comm/monitor"
+	"github.com/hyperledger-labs/perun-node/comm/multi"
+	"github.com/hyperledger-labs/perun-node/comm/nodemsg"
 )
 
 type (
@@ -47,6 +50,7 @@ type (
 	// This interface is defined for isolating the client type from session.
 	ChClient interface {
 		perun.Registerer
+		perun.EndpointsRegisterer
 		ProposeChannel(context.Context, pclient.ChannelProposal) (PChannel, error)
 		Handle(pclient.ProposalHandler, pclient.UpdateHandler)
 		Channel(pchannel.ID) (PChannel, error)
@@ -56,10 +60,15 @@ type (
 		OnNewChannel(handler func(PChannel))
 		Restore(context.Context) error
 		RestoreChs(databaseDir string, timeout time.Duration, handler func(PChannel)) error
+		OnSignedState(handler SignedStateHandler)
+		Publish(context.Context, *pwire.Envelope) error
 
 		Log() plog.Logger
 	}
 
+	// SignedStateHandler is called with each fully signed state of a channel, when it is persisted.
+	SignedStateHandler func(params *pchannel.Params, tx pchannel.Transaction)
+
 	// Closer is used to call close on database.
 	Closer interface {
 		Close() error
@@ -78,6 +87,8 @@ type (
 
 		dbConn Closer // Database connection for closing it during client.Close.
 
+		signedStateHandler SignedStateHandler // Set before restoring the channels, hence not guarded by a mutex.
+
 		wg *sync.WaitGroup
 	}
 
@@ -97,6 +108,13 @@ type (
 		Log() plog.Logger
 	}
 
+	// signedStatePersister is a wrapper around the persister used by the channel client, that passes each fully
+	// signed state of a channel to the handler, after it is persisted.
+	signedStatePersister struct {
+		ppersistence.PersistRestorer
+		handler SignedStateHandler
+	}
+
 	// pclientWrapped is a wrapper around pclient.Client that returns a channel of interface type
 	// instead of struct type. This enables easier mocking of the returned value in tests.
 	pclientWrapped struct {
@@ -129,19 +147,22 @@ func (c *pclientWrapped) OnNewChannel(handler func(PChannel)) {
 
 // newEthereumPaymentClient initializes a two party, ethereum payment channel client for the given user.
 // It establishes a connection to the blockchain and verifies the integrity of contracts at the given address.
-// It uses the comm backend to initialize adapters for off-chain communication network.
+// It uses the comm backends to initialize adapters for off-chain communication network: peers are dialed using
+// the backend for the comm type of each of their endpoints and the listener is initialized using the backend for
+// the given comm type. The connections established by the dialer and the listener are monitored using the
+// connectivity monitor and the node messages received on them are dispatched using the router.
 func newEthereumPaymentClient(
 	funder pchannel.Funder, adjudicator pchannel.Adjudicator,
-	comm perun.CommBackend, commAddr string,
-	offChainCred perun.Credential) (
+	commBackends map[string]perun.CommBackend, commType, commAddr string,
+	offChainCred perun.Credential, connMonitor *monitor.Monitor, router *nodemsg.Router) (
 	ChClient, perun.APIError) {
 	offChainAcc, err := offChainCred.Wallet.Unlock(offChainCred.Addr)
 	if err != nil {
 		return nil, perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "off-chain account"))
 	}
 
-	dialer := comm.NewDialer()
-	msgBus := pnet.NewBus(offChainAcc, dialer)
+	dialer := multi.NewDialer(commBackends, commType)
+	msgBus := pnet.NewBus(offChainAcc, router.Dialer(connMonitor.Dialer(dialer)))
 
 	pcClient, err := pclient.New(offChainAcc.Address(), msgBus, funder, adjudicator, offChainCred.Wallet)
 	if err != nil {
@@ -155,11 +176,11 @@ func newEthereumPaymentClient(
 		wg:             &sync.WaitGroup{},
 	}
 
-	listener, err := comm.NewListener(commAddr)
+	listener, err := commBackends[commType].NewListener(commAddr)
 	if err != nil {
 		return nil, perun.NewAPIErrInvalidConfig(err, "commAddr", commAddr)
 	}
-	c.runAsGoRoutine(func() { msgBus.Listen(listener) })
+	c.runAsGoRoutine(func() { msgBus.Listen(router.Listener(connMonitor.Listener(listener))) })
 
 	return c, nil
 }
@@ -206,6 +227,24 @@ func (c *client) Register(offChainAddr pwire.Address, commAddr string) {
 	c.msgBusRegistry.Register(offChainAddr, commAddr)
 }
 
+// RegisterEndpoints registers the list of comm endpoints for the given off-chain address in the client.
+// If the registry does not support multiple endpoints, only the first endpoint is registered.
+func (c *client) RegisterEndpoints(offChainAddr pwire.Address, endpoints []perun.CommEndpoint) {
+	if r, ok := c.msgBusRegistry.(perun.EndpointsRegisterer); ok {
+		r.RegisterEndpoints(offChainAddr, endpoints)
+		return
+	}
+	if len(endpoints) != 0 {
+		c.msgBusRegistry.Register(offChainAddr, endpoints[0].CommAddr)
+	}
+}
+
+// Publish sends the envelope to its recipient over the message bus. It is used for sending the messages that
+// are not a part of the perun wire protocol.
+func (c *client) Publish(ctx context.Context, e *pwire.Envelope) error {
+	return c.msgBus.Publish(ctx, e)
+}
+
 // Handle registers the channel proposal handler and channel update handler for the client.
 // It also starts the handle function as a go-routine.
 func (c *client) Handle(ph pclient.ProposalHandler, ch pclient.UpdateHandler) {
@@ -222,7 +261,10 @@ func (c *client) RestoreChs(databaseDir string, timeout time.Duration, handler f
 	}
 	c.dbConn = db
 
-	pr := pkeyvalue.NewPersistRestorer(db)
+	var pr ppersistence.PersistRestorer = pkeyvalue.NewPersistRestorer(db)
+	if c.signedStateHandler != nil {
+		pr = &signedStatePersister{PersistRestorer: pr, handler: c.signedStateHandler}
+	}
 	c.EnablePersistence(pr)
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
@@ -234,6 +276,40 @@ func (c *client) RestoreChs(databaseDir string, timeout time.Duration, handler f
 	return err
 }
 
+// OnSignedState registers the handler that is called with each fully signed state of a channel, when it is
+// persisted. It should be called before RestoreChs.
+func (c *client) OnSignedState(handler SignedStateHandler) {
+	c.signedStateHandler = handler
+}
+
+// Enabled persists the channel state and passes the fully signed state to the handler. The initial state is
+// also passed from here, as it is enabled once all the participants have signed it.
+//
+// States are not passed from ChannelCreated, because the channel does not have an initial state yet, when it is
+// called.
+func (p *signedStatePersister) Enabled(ctx context.Context, source pchannel.Source) error {
+	if err := p.PersistRestorer.Enabled(ctx, source); err != nil {
+		return err
+	}
+	if tx := source.CurrentTX(); isFullySigned(tx) {
+		p.handler(source.Params(), tx)
+	}
+	return nil
+}
+
+// isFullySigned returns true, if the transaction has a state and the signatures of all the participants on it.
+func isFullySigned(tx pchannel.Transaction) bool {
+	if tx.State == nil || len(tx.Sigs) == 0 {
+		return false
+	}
+	for i := range tx.Sigs {
+		if tx.Sigs[i] == nil {
+			return false
+		}
+	}
+	return true
+}
+
 // Close closes the client and waits until the listener and handler go routines return. It then closes the
 // database connection used for persistence.
 //
@@ -17,11 +17,59 @@
 package session
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	ppersistence "perun.network/go-perun/channel/persistence"
+	pwallet "perun.network/go-perun/wallet"
 )
 
+// fakeSource is a channel source that returns the params and the current transaction.
+type fakeSource struct {
+	pchannel.Source
+	params *pchannel.Params
+	tx     pchannel.Transaction
+}
+
+func (s fakeSource) Params() *pchannel.Params        { return s.params }
+func (s fakeSource) CurrentTX() pchannel.Transaction { return s.tx }
+
 func Test_ChannelClient_Interface(t *testing.T) {
 	assert.Implements(t, (*ChClient)(nil), new(client))
 }
+
+func Test_SignedStatePersister(t *testing.T) {
+	params := &pchannel.Params{ChallengeDuration: 10}
+	var gotParams []*pchannel.Params
+	var gotVersions []uint64
+	p := &signedStatePersister{
+		PersistRestorer: ppersistence.NonPersistRestorer,
+		handler: func(params *pchannel.Params, tx pchannel.Transaction) {
+			gotParams = append(gotParams, params)
+			gotVersions = append(gotVersions, tx.Version)
+		},
+	}
+
+	// Channel does not have an initial state, when it is created.
+	source := fakeSource{params: params, tx: pchannel.Transaction{}}
+	require.NoError(t, p.ChannelCreated(context.Background(), source, nil, nil))
+	source.tx = pchannel.Transaction{State: &pchannel.State{Version: 0}, Sigs: []pwallet.Sig{{1}, nil}}
+	require.NoError(t, p.Staged(context.Background(), source))
+	source.tx = pchannel.Transaction{State: &pchannel.State{Version: 0}, Sigs: []pwallet.Sig{{1}, {2}}}
+	require.NoError(t, p.Enabled(context.Background(), source))
+	source.tx = pchannel.Transaction{State: &pchannel.State{Version: 1}, Sigs: []pwallet.Sig{{1}, {2}}}
+	require.NoError(t, p.Staged(context.Background(), source))
+	require.NoError(t, p.Enabled(context.Background(), source))
+
+	// States without the signatures of all the participants are not passed.
+	source.tx = pchannel.Transaction{State: &pchannel.State{Version: 2}, Sigs: []pwallet.Sig{{1}, nil}}
+	require.NoError(t, p.Enabled(context.Background(), source))
+	source.tx = pchannel.Transaction{}
+	require.NoError(t, p.Enabled(context.Background(), source))
+
+	assert.Equal(t, []*pchannel.Params{params, params}, gotParams)
+	assert.Equal(t, []uint64{0, 1}, gotVersions)
+}
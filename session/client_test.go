@@ -22,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/hyperledger-labs/perun-node"
 	"github.com/hyperledger-labs/perun-node/internal/mocks"
 	"github.com/hyperledger-labs/perun-node/session"
 )
@@ -63,3 +64,32 @@ func Test_Client_Register(t *testing.T) {
 		Client.Register(nil, "")
 	})
 }
+
+func Test_Client_RegisterEndpoints(t *testing.T) {
+	endpoints := []perun.CommEndpoint{
+		{CommAddr: "127.0.0.1:5751", CommType: "tcp"},
+		{CommAddr: "10.0.0.1:5751", CommType: "tcp"},
+	}
+
+	t.Run("happy_endpoints_registerer", func(t *testing.T) {
+		registerer := &endpointsRegisterer{}
+		Client := session.NewClientForTest(nil, nil, registerer, &mocks.Closer{})
+		registerer.EndpointsRegisterer.On("RegisterEndpoints", nil, endpoints).Return()
+		Client.RegisterEndpoints(nil, endpoints)
+		registerer.EndpointsRegisterer.AssertExpectations(t)
+	})
+
+	t.Run("happy_registerer_first_endpoint", func(t *testing.T) {
+		registerer := &mocks.Registerer{}
+		Client := session.NewClientForTest(nil, nil, registerer, &mocks.Closer{})
+		registerer.On("Register", nil, endpoints[0].CommAddr).Return()
+		Client.RegisterEndpoints(nil, endpoints)
+		registerer.AssertExpectations(t)
+	})
+}
+
+// endpointsRegisterer is a registerer that also supports registering multiple endpoints.
+type endpointsRegisterer struct {
+	mocks.Registerer
+	mocks.EndpointsRegisterer
+}
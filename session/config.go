@@ -23,6 +23,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
 )
 
 type (
@@ -30,8 +32,21 @@ type (
 	Config struct {
 		User UserConfig
 
-		IDProviderType   string        // Type of ID provider.
-		IDProviderURL    string        // URL for accessing the ID provider.
+		IDProviderType string // Type of ID provider.
+		IDProviderURL  string // URL for accessing the ID provider.
+
+		// Interval for checking the ID provider file for changes made to it by other programs.
+		// Changes are loaded into the session when detected. Zero disables it.
+		IDProviderWatchInterval time.Duration
+
+		// Headers (such as those for authentication) added to each request sent to the "http" ID provider.
+		IDProviderAuthHeaders map[string]string
+		// Duration for which peer IDs resolved using the "http" ID provider are cached. Zero disables caching.
+		IDProviderCacheTTL time.Duration
+
+		// If true, only the peer IDs signed by their off-chain account are accepted by the ID provider.
+		RequireSignedPeerIDs bool
+
 		ChainURL         string        // URL of the blockchain node.
 		ChainID          int           // See chainconfig.
 		ChainConnTimeout time.Duration // Timeout for connecting to blockchain node.
@@ -42,6 +57,23 @@ type (
 		// Timeout for re-establishing all open channels (if any) that was persisted during the
 		// previous running instance of the node.
 		PeerReconnTimeout time.Duration
+		// Interval for pinging the connected peers, for measuring the round trip time and detecting broken
+		// connections. Zero disables it.
+		PeerPingInterval time.Duration
+
+		// Limits on the outgoing payments and channel funding. These can be changed at runtime using the
+		// SetSpendingLimits API.
+		SpendingLimits []perun.SpendingLimit
+
+		// Block from which the adjudicator events are read for the restored channels, for which the block at
+		// which they were opened is not known (for example, channels opened by an older version of the node).
+		AdjEventsStartBlock uint64
+
+		PriceSourceType string // Type of price source. Fiat values are not available, if empty.
+		PriceSourceURL  string // URL for accessing the price source.
+		// Maximum age of the prices used for converting the spending limits set in fiat. If the price is older,
+		// payments to which these limits apply are not allowed. If zero, one hour is used.
+		MaxPriceAge time.Duration
 
 		// Address of the valid AssetETH and Adjudicator contracts.
 		// These values are set by the node and will not parsed from the user
@@ -63,6 +95,31 @@ type (
 
 		CommAddr string
 		CommType string
+		// Alternative endpoints (for example, on other networks) at which the user can be reached. These are
+		// included in the user's peer ID and the peers try them in order, if the comm address is not reachable.
+		AltCommEndpoints []perun.CommEndpoint
+		// Configuration for the "tls" comm type. Certificate files are also used for the "websocket" comm type,
+		// when listening at or dialing wss:// URLs.
+		TLS TLSConfig
+		// If true, connections made via the relay (comm type "relay") are end-to-end encrypted using the
+		// TLS config, so that the relay cannot read them. It should be enabled by all the peers or none.
+		RelayEncryption bool
+	}
+
+	// TLSConfig defines the parameters required to configure the "tls" comm backend.
+	// See comm/tls.Config for details.
+	TLSConfig struct {
+		// Paths to the PEM encoded certificate and private key. Required if comm type is "tls",
+		// unless OffChainIdentity is true.
+		CertFile string
+		KeyFile  string
+		// Path to the PEM encoded CA certificates for verifying the peers. If empty, system roots are used.
+		CACertFile string
+		// SHA-256 fingerprints of the certificates of the peers, indexed by their off-chain addresses.
+		PinnedCerts map[string]string
+		// If true, the identity of the user and the peers is derived from their off-chain keys, instead of
+		// using the certificate files.
+		OffChainIdentity bool
 	}
 
 	// WalletConfig defines the parameters required to configure a wallet.
@@ -50,6 +50,9 @@ var (
 			},
 			CommAddr: "127.0.0.1:5751",
 			CommType: "tcp",
+			AltCommEndpoints: []perun.CommEndpoint{
+				{CommAddr: "192.168.1.10:5751", CommType: "tcp"},
+			},
 		},
 		IDProviderType: "local",
 		IDProviderURL:  "./test-idprovider.yaml",
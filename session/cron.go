@@ -0,0 +1,153 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// cronSearchLimit is the duration after which the search for the next time matching a cron schedule is
+// stopped. It bounds the search for schedules that never match, such as "0 0 31 2 *".
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// cronDescriptors are the shorthands supported in place of the five fields of a cron schedule.
+var cronDescriptors = map[string]string{
+	"@yearly":  "0 0 1 1 *",
+	"@monthly": "0 0 1 * *",
+	"@weekly":  "0 0 * * 0",
+	"@daily":   "0 0 * * *",
+	"@hourly":  "0 * * * *",
+}
+
+type (
+	// cronSchedule is a schedule in the cron format with five fields: minute (0-59), hour (0-23), day of month
+	// (1-31), month (1-12) and day of week (0-6, with 0 for sunday). Each field can be a "*", a value, a range
+	// ("1-5"), a step ("*/15" or "1-30/5") or a comma separated list of these. Times are matched in UTC.
+	//
+	// As in cron, when both day of month and day of week are restricted, a day matches if either matches.
+	cronSchedule struct {
+		minute, hour, dom, month, dow cronField
+	}
+
+	// cronField is the set of values that match a field of the cron schedule.
+	cronField struct {
+		values []bool
+		isAny  bool // True, if the field is "*".
+	}
+)
+
+// parseCronSchedule parses the schedule in the cron format.
+func parseCronSchedule(spec string) (*cronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	if expanded, ok := cronDescriptors[spec]; ok {
+		spec = expanded
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, errors.Errorf("schedule should have 5 fields, got %d", len(fields))
+	}
+
+	var err error
+	var s cronSchedule
+	bounds := []struct {
+		field    *cronField
+		name     string
+		min, max int
+	}{
+		{&s.minute, "minute", 0, 59},
+		{&s.hour, "hour", 0, 23},
+		{&s.dom, "day of month", 1, 31},
+		{&s.month, "month", 1, 12},
+		{&s.dow, "day of week", 0, 6},
+	}
+	for i, b := range bounds {
+		if *b.field, err = parseCronField(fields[i], b.min, b.max); err != nil {
+			return nil, errors.WithMessage(err, b.name)
+		}
+	}
+	return &s, nil
+}
+
+func parseCronField(field string, min, max int) (cronField, error) {
+	f := cronField{values: make([]bool, max+1), isAny: field == "*"}
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i != -1 {
+			var err error
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return cronField{}, errors.Errorf("invalid step in %q", part)
+			}
+			rangePart = part[:i]
+		}
+
+		start, end := min, max
+		if rangePart != "*" {
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if start, err = strconv.Atoi(bounds[0]); err != nil {
+				return cronField{}, errors.Errorf("invalid value in %q", part)
+			}
+			end = start
+			if len(bounds) == 2 {
+				if end, err = strconv.Atoi(bounds[1]); err != nil {
+					return cronField{}, errors.Errorf("invalid value in %q", part)
+				}
+			}
+		}
+		if start < min || end > max || start > end {
+			return cronField{}, errors.Errorf("%q is out of range %d-%d", part, min, max)
+		}
+		for v := start; v <= end; v += step {
+			f.values[v] = true
+		}
+	}
+	return f, nil
+}
+
+// next returns the earliest time matching the schedule that is after the given time. It returns a zero time,
+// if there is no such time within the search limit.
+func (s *cronSchedule) next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+	for t.Before(limit) {
+		switch {
+		case !s.month.values[t.Month()]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case !s.hour.values[t.Hour()]:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case !s.minute.values[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s *cronSchedule) matchesDay(t time.Time) bool {
+	domMatch, dowMatch := s.dom.values[t.Day()], s.dow.values[t.Weekday()]
+	if s.dom.isAny || s.dow.isAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
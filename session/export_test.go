@@ -17,15 +17,23 @@
 package session
 
 import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
 	"time"
 
 	pchannel "perun.network/go-perun/channel"
+	pwire "perun.network/go-perun/wire"
 
 	"github.com/pkg/errors"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/comm/monitor"
+	currencypkg "github.com/hyperledger-labs/perun-node/currency"
 	"github.com/hyperledger-labs/perun-node/currency/currencytest"
 	"github.com/hyperledger-labs/perun-node/log"
 )
@@ -53,7 +61,7 @@ func NewSessionForTest(cfg Config, isOpen bool, chClient ChClient, chainSetup *e
 		return nil, apiErr
 	}
 
-	idProvider, apiErr := initIDProvider(cfg.IDProviderType, cfg.IDProviderURL, walletBackend, user.PeerID)
+	idProvider, apiErr := initIDProvider(cfg, walletBackend, user.PeerID)
 	if apiErr != nil {
 		return nil, apiErr
 	}
@@ -70,8 +78,41 @@ func NewSessionForTest(cfg Config, isOpen bool, chClient ChClient, chainSetup *e
 	}
 
 	funder := chainSetup.ChainBackend.NewFunder(contracts.AssetETH(), user.OnChain.Addr)
+	invoices, err := loadInvoiceStore(filepath.Join(cfg.DatabaseDir, invoicesFile))
+	if err != nil {
+		return nil, err
+	}
+	chHistory, err := loadChHistoryStore(filepath.Join(cfg.DatabaseDir, chHistoryFile))
+	if err != nil {
+		return nil, err
+	}
+	chStartBlocks, err := loadChStartBlockStore(filepath.Join(cfg.DatabaseDir, chStartBlocksFile))
+	if err != nil {
+		return nil, err
+	}
+	chTxCosts, err := loadTxCostStore(filepath.Join(cfg.DatabaseDir, txCostsFile))
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := loadReceiptStore(filepath.Join(cfg.DatabaseDir, receiptsFile))
+	if err != nil {
+		return nil, err
+	}
+	scheduler, err := loadScheduler(filepath.Join(cfg.DatabaseDir, scheduledPaymentsFile))
+	if err != nil {
+		return nil, err
+	}
+	prices, apiErr := initPriceSource(cfg)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	spending, err := loadSpendingLimiter(filepath.Join(cfg.DatabaseDir, spendsFile), currencytest.Registry(), prices,
+		cfg.MaxPriceAge, cfg.SpendingLimits)
+	if err != nil {
+		return nil, err
+	}
 
-	return &Session{
+	sess := &Session{
 		Logger:               log.NewLoggerWithField("session-id", sessionID),
 		id:                   sessionID,
 		isOpen:               isOpen,
@@ -82,11 +123,25 @@ func NewSessionForTest(cfg Config, isOpen bool, chClient ChClient, chainSetup *e
 		idProvider:           idProvider,
 		chain:                chainSetup.ChainBackend,
 		funder:               funder,
+		txCosts:              blockchain.NewTxCostRecorder(),
 		chs:                  newChRegistry(initialChRegistrySize),
 		contractRegistry:     contracts,
 		currencyRegistry:     currencytest.Registry(),
 		chProposalResponders: make(map[string]chProposalResponderEntry),
-	}, nil
+		connMonitor:          monitor.NewMonitor(user.OffChainAddr, 0),
+		invoices:             invoices,
+		chHistory:            chHistory,
+		chStartBlocks:        chStartBlocks,
+		chTxCosts:            chTxCosts,
+		receipts:             receipts,
+		scheduler:            scheduler,
+		spending:             spending,
+		prices:               prices,
+	}
+	scheduler.findCh = sess.findChForScheduledPayment
+	sess.chUpdateMetas = newChUpdateMetaExchanger(user.OffChainAddr, chClient.Publish, nil)
+	sess.watchIDProvider(cfg.IDProviderWatchInterval)
+	return sess, nil
 }
 
 func NewChForTest(pch PChannel,
@@ -98,7 +153,9 @@ func NewChForTest(pch PChannel,
 		onChainTx: onChainTxTimeout,
 	}
 	currency := []perun.Currency{currencytest.Registry().Currency(currencySymbol)}
-	ch := newCh(pch, chainURL, currency, parts, timeoutCfg, challengeDurSecs)
+	txFeeCurrency := currencytest.Registry().Currency(currencypkg.ETHSymbol)
+	txCosts := blockchain.NewTxCostRecorder()
+	ch := newCh(pch, chainURL, currency, parts, timeoutCfg, challengeDurSecs, txFeeCurrency, txCosts)
 	if isOpen {
 		ch.status = open
 	} else {
@@ -108,9 +165,66 @@ func NewChForTest(pch PChannel,
 	return ch
 }
 
+// ReceiveChUpdateMeta passes the channel update metadata for the proposed state to the session, as if it was
+// received from the sender.
+func (s *Session) ReceiveChUpdateMeta(sender pwire.Address, updateID string, proposed *pchannel.State,
+	meta perun.ChUpdateMeta) {
+	stateHash, _ := chUpdateStateHash(proposed)                                                    // nolint: errcheck
+	data, _ := json.Marshal(chUpdateMetaMsg{UpdateID: updateID, StateHash: stateHash, Meta: meta}) // nolint: errcheck
+	s.chUpdateMetas.handle(sender, data)
+}
+
+func (ch *Channel) HandleMissedAdjEvents(events []pchannel.AdjudicatorEvent) {
+	ch.handleMissedAdjEvents(events)
+}
+
+func (ch *Channel) NotifyPastAdjEventsErr(err perun.APIError) {
+	ch.notifyPastAdjEventsErr(err)
+}
+
+// ChStartBlock returns the persisted start block of the channel.
+func ChStartBlock(databaseDir, chID string) (uint64, bool, error) {
+	store, err := loadChStartBlockStore(filepath.Join(databaseDir, chStartBlocksFile))
+	if err != nil {
+		return 0, false, err
+	}
+	block, ok := store.get(chID)
+	return block, ok, nil
+}
+
+// ChTxCosts returns the persisted tx costs of the channel.
+func ChTxCosts(databaseDir, chID string) ([]perun.TxCost, error) {
+	store, err := loadTxCostStore(filepath.Join(databaseDir, txCostsFile))
+	if err != nil {
+		return nil, err
+	}
+	r := store.recorder(chID)
+	return r.Costs(), nil
+}
+
 func MakeAllocation(openingBalInfo perun.BalInfo,
 	contractRegistry perun.ROContractRegistry, currencyRegistry perun.ROCurrencyRegistry) (
 	*pchannel.Allocation, error) {
 	_, allocation, err := makeAllocation(openingBalInfo, contractRegistry, currencyRegistry)
 	return allocation, err
 }
+
+// RunDueScheduledPayments runs the scheduled payments due at the given time using the runner and waits for the
+// runs to complete. It does not start the scheduler.
+func (s *Session) RunDueScheduledPayments(runner perun.ScheduledPaymentRunner, now time.Time) {
+	s.scheduler.runner = runner
+	s.scheduler.runDue(now)
+	s.scheduler.wg.Wait()
+}
+
+// HandleSignedState passes the fully signed state to the session, as if it was persisted by the channel client.
+func (s *Session) HandleSignedState(params *pchannel.Params, tx pchannel.Transaction) {
+	s.receipts.handleSignedState(params, tx)
+}
+
+// EncodeStateForTest encodes the state in the format used in receipts.
+func EncodeStateForTest(state *pchannel.State) (string, error) {
+	var buf bytes.Buffer
+	err := state.Encode(&buf)
+	return hex.EncodeToString(buf.Bytes()), err
+}
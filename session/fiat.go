@@ -0,0 +1,97 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// fiatDecimals is the number of decimal places to which the values in fiat are rounded.
+const fiatDecimals = 2
+
+// makeFiatBalInfo returns the value of the balances in fiat, using the current prices from the price source.
+// If the price of a currency is not available, its values are left empty. It returns nil, if the price source
+// is nil.
+func makeFiatBalInfo(prices perun.PriceSource, balInfo perun.BalInfo) *perun.FiatBalInfo {
+	if prices == nil {
+		return nil
+	}
+	fiatBalInfo := &perun.FiatBalInfo{
+		Fiat:       prices.Fiat(),
+		Rates:      make([]string, len(balInfo.Currencies)),
+		Timestamps: make([]int64, len(balInfo.Currencies)),
+		Bals:       make([][]string, len(balInfo.Bals)),
+	}
+	for i := range balInfo.Bals {
+		fiatBalInfo.Bals[i] = make([]string, len(balInfo.Bals[i]))
+		price, rate, err := parsedPrice(prices, balInfo.Currencies[i])
+		if err != nil {
+			continue
+		}
+		fiatBalInfo.Rates[i], fiatBalInfo.Timestamps[i] = price.Rate, price.Timestamp
+		for j := range balInfo.Bals[i] {
+			bal, err := decimal.NewFromString(balInfo.Bals[i][j])
+			if err != nil {
+				continue
+			}
+			fiatBalInfo.Bals[i][j] = bal.Mul(rate).StringFixed(fiatDecimals)
+		}
+	}
+	return fiatBalInfo
+}
+
+// fiatToCurrency converts the amount in fiat to the amount in base unit of the currency, using its current price
+// from the price source. If the price was updated before the given time, it is not used and an error is returned.
+func fiatToCurrency(prices perun.PriceSource, curr perun.Currency, fiatAmount decimal.Decimal,
+	notBefore time.Time) (*big.Int, error) {
+	price, rate, err := parsedPrice(prices, curr.Symbol())
+	if err != nil {
+		return nil, err
+	}
+	if price.Timestamp < notBefore.Unix() {
+		return nil, errors.Errorf("price of %s is stale, it was updated at %s", curr.Symbol(),
+			time.Unix(price.Timestamp, 0).UTC().Format(time.RFC3339))
+	}
+	baseUnitsPerUnit, err := curr.Parse("1")
+	if err != nil {
+		return nil, errors.WithMessage(err, "parsing unit of "+curr.Symbol())
+	}
+	// Multiply before dividing, so that the result is not truncated to the division precision.
+	return fiatAmount.Mul(decimal.NewFromBigInt(baseUnitsPerUnit, 0)).Div(rate).BigInt(), nil
+}
+
+// parsedPrice returns the price of the currency from the price source, along with its rate parsed as a decimal.
+func parsedPrice(prices perun.PriceSource, currency string) (perun.Price, decimal.Decimal, error) {
+	price, err := prices.Price(currency)
+	if err != nil {
+		return perun.Price{}, decimal.Decimal{}, errors.WithMessage(err, "getting price of "+currency)
+	}
+	rate, err := decimal.NewFromString(price.Rate)
+	if err == nil && !rate.IsPositive() {
+		err = errors.New("price should be positive")
+	}
+	if err != nil {
+		return perun.Price{}, decimal.Decimal{}, errors.WithMessage(err, "parsing price of "+currency)
+	}
+	return price, rate, nil
+}
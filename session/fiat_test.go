@@ -0,0 +1,195 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"context"
+	"io/ioutil"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/currency/currencytest"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+	"github.com/hyperledger-labs/perun-node/session"
+	"github.com/hyperledger-labs/perun-node/session/sessiontest"
+)
+
+func Test_Session_FiatValues(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	peerAlias := peerIDs[0].Alias
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerAlias},
+		Bals:       [][]string{{"10", "10"}},
+	}
+	app := perun.App{Def: pchannel.NoApp(), Data: pchannel.NoData()}
+	pricesUpdatedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+	// openCh opens a channel, that accepts all updates, in a new session that uses the price source file. It
+	// returns the path of the file along with the session and the channel.
+	openCh := func(t *testing.T, priceFileContents string) (*session.Session, perun.ChAPI, string) {
+		rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+		cfg := sessiontest.NewConfigT(t, rng, peerIDs...)
+		cfg.PriceSourceType = "local"
+		cfg.PriceSourceURL = filepath.Join(t.TempDir(), "prices.yaml")
+		require.NoError(t, ioutil.WriteFile(cfg.PriceSourceURL, []byte(priceFileContents), 0o600))
+		require.NoError(t, os.Chtimes(cfg.PriceSourceURL, pricesUpdatedAt, pricesUpdatedAt))
+		rng = rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+		chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+
+		pch, _ := newMockPCh()
+		pch.On("State").Return(makeState(t, openingBalInfo, false))
+		pch.On("Idx").Return(pchannel.Index(0))
+		pch.On("UpdateBy", mock.Anything, mock.Anything).Return(nil)
+		chClient := &mocks.ChClient{}
+		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil)
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
+		sess, err := session.NewSessionForTest(cfg, true, chClient, chainSetup)
+		require.NoError(t, err)
+		ownPeerID, err := sess.GetPeerID(perun.OwnAlias)
+		require.NoError(t, err)
+		pch.On("Peers").Return([]pwire.Address{ownPeerID.OffChainAddr, peerIDs[0].OffChainAddr})
+
+		chInfo, err := sess.OpenCh(context.Background(), openingBalInfo, app, 10)
+		require.NoError(t, err)
+		ch, err := sess.GetCh(chInfo.ChID)
+		require.NoError(t, err)
+		return sess, ch, cfg.PriceSourceURL
+	}
+	// pay returns an updater that pays the amount (in ETH) to the peer.
+	pay := func(amount string) perun.StateUpdater {
+		return func(state *pchannel.State) error {
+			parsed, err := currencytest.Registry().Currency(currency.ETHSymbol).Parse(amount)
+			if err != nil {
+				return err
+			}
+			state.Balances[0][0] = new(big.Int).Sub(state.Balances[0][0], parsed)
+			state.Balances[0][1] = new(big.Int).Add(state.Balances[0][1], parsed)
+			return nil
+		}
+	}
+
+	t.Run("happy_chInfo", func(t *testing.T) {
+		_, ch, _ := openCh(t, "fiat: USD\nprices:\n  ETH: \"2000.125\"\n")
+		want := &perun.FiatBalInfo{
+			Fiat:       "USD",
+			Rates:      []string{"2000.125"},
+			Timestamps: []int64{pricesUpdatedAt.Unix()},
+			Bals:       [][]string{{"20001.25", "20001.25"}},
+		}
+		assert.Equal(t, want, ch.GetChInfo().FiatBalInfo)
+	})
+
+	t.Run("happy_chInfo_priceNotAvailable", func(t *testing.T) {
+		_, ch, _ := openCh(t, "fiat: USD\nprices: {}\n")
+		want := &perun.FiatBalInfo{
+			Fiat:       "USD",
+			Rates:      []string{""},
+			Timestamps: []int64{0},
+			Bals:       [][]string{{"", ""}},
+		}
+		assert.Equal(t, want, ch.GetChInfo().FiatBalInfo)
+	})
+
+	t.Run("happy_limit_in_fiat", func(t *testing.T) {
+		sess, ch, _ := openCh(t, "fiat: USD\nprices:\n  ETH: \"2000\"\n")
+		require.NoError(t, sess.SetSpendingLimits([]perun.SpendingLimit{
+			{Currency: currency.ETHSymbol, Fiat: "USD", MaxPayment: "3000"},
+		}))
+
+		_, err := ch.SendChUpdate(context.Background(), pay("1.5"))
+		require.NoError(t, err)
+		_, err = ch.SendChUpdate(context.Background(), pay("2"))
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrSpendingLimitExceeded)
+		peruntest.AssertErrInfoSpendingLimitExceeded(t, err.AddInfo(), session.LimitTypeMaxPayment, peerAlias,
+			currency.ETHSymbol, "1.5", "0", "2")
+	})
+
+	t.Run("happy_limit_in_fiat_not_truncated", func(t *testing.T) {
+		sess, ch, _ := openCh(t, "fiat: USD\nprices:\n  ETH: \"3\"\n")
+		require.NoError(t, sess.SetSpendingLimits([]perun.SpendingLimit{
+			{Currency: currency.ETHSymbol, Fiat: "USD", MaxPayment: "1"},
+		}))
+
+		// Limit is 1/3 ETH, which is 333333333333333333 wei when rounded down.
+		_, err := ch.SendChUpdate(context.Background(), pay("0.333333333333333334"))
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrSpendingLimitExceeded)
+		_, err = ch.SendChUpdate(context.Background(), pay("0.333333333333333333"))
+		require.NoError(t, err)
+	})
+
+	t.Run("error_limit_in_fiat_priceStale", func(t *testing.T) {
+		sess, ch, priceFile := openCh(t, "fiat: USD\nprices:\n  ETH: \"2000\"\n")
+		require.NoError(t, sess.SetSpendingLimits([]perun.SpendingLimit{
+			{Currency: currency.ETHSymbol, Fiat: "USD", MaxPayment: "3000"},
+		}))
+		staleAt := time.Now().Add(-2 * time.Hour)
+		require.NoError(t, os.Chtimes(priceFile, staleAt, staleAt))
+
+		_, err := ch.SendChUpdate(context.Background(), pay("1"))
+		peruntest.AssertAPIError(t, err, perun.InternalError, perun.ErrUnknownInternal)
+	})
+
+	t.Run("error_limit_in_fiat_priceNotAvailable", func(t *testing.T) {
+		sess, ch, _ := openCh(t, "fiat: USD\nprices: {}\n")
+		require.NoError(t, sess.SetSpendingLimits([]perun.SpendingLimit{
+			{Currency: currency.ETHSymbol, Fiat: "USD", MaxPerDay: "3000"},
+		}))
+
+		_, err := ch.SendChUpdate(context.Background(), pay("1"))
+		peruntest.AssertAPIError(t, err, perun.InternalError, perun.ErrUnknownInternal)
+	})
+
+	t.Run("error_limit_in_other_fiat", func(t *testing.T) {
+		sess, _, _ := openCh(t, "fiat: USD\nprices:\n  ETH: \"2000\"\n")
+		err := sess.SetSpendingLimits([]perun.SpendingLimit{{Currency: currency.ETHSymbol, Fiat: "EUR", MaxPayment: "1"}})
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameSpendingLimits, "EUR")
+	})
+
+	t.Run("error_limit_in_fiat_noPriceSource", func(t *testing.T) {
+		sess, _, _ := newSessionWMockChClient(t, true, peerIDs...)
+		err := sess.SetSpendingLimits([]perun.SpendingLimit{{Currency: currency.ETHSymbol, Fiat: "USD", MaxPayment: "1"}})
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameSpendingLimits, "USD")
+	})
+
+	t.Run("error_unsupported_price_source", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+		cfg := sessiontest.NewConfigT(t, rng, peerIDs...)
+		cfg.PriceSourceType = "unsupported"
+		rng = rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+		chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+		_, err := session.NewSessionForTest(cfg, true, &mocks.ChClient{}, chainSetup)
+		require.Error(t, err)
+		t.Log(err)
+	})
+}
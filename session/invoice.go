@@ -0,0 +1,337 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pchannel "perun.network/go-perun/channel"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+const (
+	// invoicesFile is the name of the file in the database directory, in which the invoices are persisted.
+	invoicesFile = "invoices.json"
+
+	invoiceIDLen = 16 // Length of the random invoice IDs in bytes.
+)
+
+// invoiceStore holds the invoices created in the session and persists them to a file. It has its own mutex,
+// as payments are recorded when updates are accepted on a channel, with the channel locked.
+type invoiceStore struct {
+	log.Logger
+
+	filePath string // Invoices are not persisted, if empty.
+
+	mutex    sync.Mutex
+	invoices map[string]*perun.Invoice
+	ids      []string // IDs of the invoices in the order of creation.
+}
+
+// loadInvoiceStore loads the invoices persisted in the file. If the file does not exist, the store is empty.
+// If the file path is empty, the invoices are not persisted.
+func loadInvoiceStore(filePath string) (*invoiceStore, error) {
+	s := &invoiceStore{
+		Logger:   log.NewLoggerWithField("component", "invoices"),
+		filePath: filePath,
+		invoices: make(map[string]*perun.Invoice),
+	}
+	if filePath == "" {
+		return s, nil
+	}
+	data, err := ioutil.ReadFile(filepath.Clean(filePath))
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "reading invoices file")
+	}
+	var invoices []perun.Invoice
+	if err = json.Unmarshal(data, &invoices); err != nil {
+		return nil, errors.Wrap(err, "decoding invoices file")
+	}
+	for i := range invoices {
+		s.invoices[invoices[i].ID] = &invoices[i]
+		s.ids = append(s.ids, invoices[i].ID)
+	}
+	return s, nil
+}
+
+// add adds the invoice to the store and persists it.
+func (s *invoiceStore) add(invoice perun.Invoice) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.invoices[invoice.ID] = &invoice
+	s.ids = append(s.ids, invoice.ID)
+	if err := s.persist(); err != nil {
+		delete(s.invoices, invoice.ID)
+		s.ids = s.ids[:len(s.ids)-1]
+		return err
+	}
+	return nil
+}
+
+// get returns a copy of the invoice with the status as of now.
+func (s *invoiceStore) get(invoiceID string) (perun.Invoice, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	invoice, ok := s.invoices[invoiceID]
+	if !ok {
+		return perun.Invoice{}, false
+	}
+	return copyInvoice(invoice, time.Now()), true
+}
+
+// list returns copies of all the invoices with the status as of now, ordered by the time of creation.
+func (s *invoiceStore) list() []perun.Invoice {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	invoices := make([]perun.Invoice, len(s.ids))
+	for i, id := range s.ids {
+		invoices[i] = copyInvoice(s.invoices[id], now)
+	}
+	return invoices
+}
+
+// recordPayment records the amount received in the accepted channel update as a payment for the invoice
+// whose ID is in the update metadata. Updates that are not tagged with a known invoice or that do not transfer
+// any amount in the currency of the invoice to the user are ignored.
+func (s *invoiceStore) recordPayment(ch *Channel, notif perun.ChUpdateNotif, currState, proposedState *pchannel.State,
+	paidAt time.Time) {
+	invoiceID, ok := notif.Meta[perun.InvoiceIDMetaKey]
+	if !ok {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	invoice, ok := s.invoices[invoiceID]
+	if !ok {
+		s.Warnf("Ignoring payment on update %s for unknown invoice %s", notif.UpdateID, invoiceID)
+		return
+	}
+	currIdx, currency, ok := ch.Currency(invoice.Currency)
+	if !ok {
+		s.Warnf("Ignoring payment on update %s for invoice %s: currency not in channel", notif.UpdateID, invoiceID)
+		return
+	}
+	ownIdx := ch.pch.Idx()
+	received := new(big.Int).Sub(proposedState.Allocation.Balances[currIdx][ownIdx],
+		currState.Allocation.Balances[currIdx][ownIdx])
+	if received.Sign() <= 0 {
+		s.Warnf("Ignoring update %s for invoice %s as it does not pay any amount", notif.UpdateID, invoiceID)
+		return
+	}
+
+	// Amounts are validated before they are stored, so parsing them will not fail.
+	amount, _ := currency.Parse(invoice.Amount) // nolint: errcheck
+	paid := new(big.Int).Set(received)
+	for i := range invoice.Payments {
+		paymentAmount, _ := currency.Parse(invoice.Payments[i].Amount) // nolint: errcheck
+		paid.Add(paid, paymentAmount)
+	}
+
+	prevStatus := copyInvoice(invoice, paidAt).Status
+	invoice.Payments = append(invoice.Payments, perun.InvoicePayment{
+		ChID:       ch.ID(),
+		Version:    fmt.Sprintf("%d", proposedState.Version),
+		PayerAlias: ch.parts[ownIdx^1], // Logic works only for a two party channel.
+		Amount:     currency.Print(received),
+		PaidAt:     paidAt.Unix(),
+	})
+	invoice.AmountPaid = currency.Print(paid)
+	switch {
+	case prevStatus == perun.InvoiceExpired || prevStatus == perun.InvoicePaid:
+		invoice.Status = prevStatus
+	case paid.Cmp(amount) >= 0:
+		invoice.Status = perun.InvoicePaid
+	default:
+		invoice.Status = perun.InvoicePartiallyPaid
+	}
+	s.Infof("Recorded payment of %s on update %s for invoice %s, status: %s",
+		currency.Print(received), notif.UpdateID, invoiceID, invoice.Status)
+
+	if err := s.persist(); err != nil {
+		s.Errorf("Persisting payment for invoice %s: %v", invoiceID, err)
+	}
+}
+
+// persist writes all the invoices to the file. It writes to a temporary file and renames it, so that the file
+// is not corrupted if the write fails. This function assumes that caller has already locked the store.
+func (s *invoiceStore) persist() error {
+	if s.filePath == "" {
+		return nil
+	}
+	invoices := make([]perun.Invoice, len(s.ids))
+	for i, id := range s.ids {
+		invoices[i] = *s.invoices[id]
+	}
+	data, err := json.MarshalIndent(invoices, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "encoding invoices")
+	}
+	return errors.WithMessage(writeFileAtomic(s.filePath, data), "writing invoices file")
+}
+
+// copyInvoice returns a copy of the invoice, with the status updated to expired if it expired before the
+// given time without being paid fully.
+func copyInvoice(invoice *perun.Invoice, now time.Time) perun.Invoice {
+	c := *invoice
+	c.Payments = append([]perun.InvoicePayment(nil), invoice.Payments...)
+	isUnpaid := c.Status == perun.InvoiceOpen || c.Status == perun.InvoicePartiallyPaid
+	if isUnpaid && c.Expiry != 0 && now.Unix() >= c.Expiry {
+		c.Status = perun.InvoiceExpired
+	}
+	return c
+}
+
+func newInvoiceID() (string, error) {
+	id := make([]byte, invoiceIDLen)
+	if _, err := rand.Read(id); err != nil {
+		return "", errors.Wrap(err, "generating invoice id")
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// CreateInvoice creates an invoice for receiving the amount in the currency and persists it. The invoice
+// expires after the given duration, if it is non zero. The ID of the returned invoice should be shared with the
+// payer, who should pay it using channel updates tagged with this ID. Payments for the invoice are tracked,
+// when the updates are accepted.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPreCondition when session is closed.
+// - ErrResourceNotFound with Type:"currency", when currency is not registered.
+// - ErrInvalidArgument with Name:"amount", when the amount cannot be parsed or is not positive.
+// - ErrUnknownInternal.
+func (s *Session) CreateInvoice(currency, amount, memo string, expirySecs uint64) (perun.Invoice, perun.APIError) {
+	s.WithField("method", "CreateInvoice").Infof("\nReceived request with params %+v, %+v, %+v, %+v",
+		currency, amount, memo, expirySecs)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("CreateInvoice", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return perun.Invoice{}, apiErr
+	}
+	if !s.currencyRegistry.IsRegistered(currency) {
+		apiErr = perun.NewAPIErrResourceNotFound(ResTypeCurrency, currency)
+		return perun.Invoice{}, apiErr
+	}
+	curr := s.currencyRegistry.Currency(currency)
+	parsedAmount, err := curr.Parse(amount)
+	if err == nil && parsedAmount.Sign() <= 0 {
+		err = errors.New("amount should be positive")
+	}
+	if err != nil {
+		apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameAmount, amount)
+		return perun.Invoice{}, apiErr
+	}
+
+	id, err := newInvoiceID()
+	if err != nil {
+		apiErr = perun.NewAPIErrUnknownInternal(err)
+		return perun.Invoice{}, apiErr
+	}
+	now := time.Now().UTC()
+	invoice := perun.Invoice{
+		ID:         id,
+		Currency:   currency,
+		Amount:     curr.Print(parsedAmount),
+		Memo:       memo,
+		CreatedAt:  now.Unix(),
+		Status:     perun.InvoiceOpen,
+		AmountPaid: curr.Print(big.NewInt(0)),
+	}
+	if expirySecs != 0 {
+		invoice.Expiry = now.Add(time.Duration(expirySecs) * time.Second).Unix()
+	}
+	if err = s.invoices.add(invoice); err != nil {
+		apiErr = perun.NewAPIErrUnknownInternal(err)
+		return perun.Invoice{}, apiErr
+	}
+	return invoice, nil
+}
+
+// GetInvoice returns the invoice with the given ID, along with the payments received for it.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPreCondition when session is closed.
+// - ErrResourceNotFound with Type:"invoice", when the invoice ID is not known.
+func (s *Session) GetInvoice(invoiceID string) (perun.Invoice, perun.APIError) {
+	s.WithField("method", "GetInvoice").Infof("\nReceived request with params %+v", invoiceID)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("GetInvoice", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return perun.Invoice{}, apiErr
+	}
+	invoice, ok := s.invoices.get(invoiceID)
+	if !ok {
+		apiErr = perun.NewAPIErrResourceNotFound(ResTypeInvoice, invoiceID)
+		return perun.Invoice{}, apiErr
+	}
+	return invoice, nil
+}
+
+// GetInvoices returns all the invoices created in the session, ordered by the time of creation.
+func (s *Session) GetInvoices() []perun.Invoice {
+	s.WithField("method", "GetInvoices").Info("Received request")
+	s.Lock()
+	defer s.Unlock()
+
+	return s.invoices.list()
+}
+
+// handleAcceptedChUpdate is called after an incoming update is accepted on any of the channels in the session.
+// It records the payments for invoices. Since it is called with the channel locked, it should not lock the
+// session.
+func (s *Session) handleAcceptedChUpdate(ch *Channel, notif perun.ChUpdateNotif,
+	currState, proposedState *pchannel.State) {
+	s.invoices.recordPayment(ch, notif, currState, proposedState, time.Now().UTC())
+}
@@ -0,0 +1,311 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	pclient "perun.network/go-perun/client"
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/comm/nodemsg"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+	"github.com/hyperledger-labs/perun-node/session"
+	"github.com/hyperledger-labs/perun-node/session/sessiontest"
+)
+
+func Test_Session_CreateInvoice_GetInvoice(t *testing.T) {
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	cfg := sessiontest.NewConfigT(t, rng)
+	chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+	sess, err := session.NewSessionForTest(cfg, true, &mocks.ChClient{}, chainSetup)
+	require.NoError(t, err)
+	closedSess, _, _ := newSessionWMockChClient(t, false)
+
+	var created []perun.Invoice
+	t.Run("happy", func(t *testing.T) {
+		invoice, err := sess.CreateInvoice(currency.ETHSymbol, "1", "for coffee", 60)
+		require.NoError(t, err)
+		assert.NotEmpty(t, invoice.ID)
+		assert.Equal(t, currency.ETHSymbol, invoice.Currency)
+		assert.Equal(t, "1", invoice.Amount)
+		assert.Equal(t, "for coffee", invoice.Memo)
+		assert.Equal(t, invoice.CreatedAt+60, invoice.Expiry)
+		assert.Equal(t, perun.InvoiceOpen, invoice.Status)
+		assert.Equal(t, "0", invoice.AmountPaid)
+		assert.Empty(t, invoice.Payments)
+
+		gotInvoice, err := sess.GetInvoice(invoice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, invoice, gotInvoice)
+		created = append(created, invoice)
+	})
+
+	t.Run("happy_noExpiry", func(t *testing.T) {
+		invoice, err := sess.CreateInvoice(currency.ETHSymbol, "0.5", "", 0)
+		require.NoError(t, err)
+		assert.Zero(t, invoice.Expiry)
+		created = append(created, invoice)
+	})
+
+	t.Run("happy_getInvoices", func(t *testing.T) {
+		assert.Equal(t, created, sess.GetInvoices())
+	})
+
+	t.Run("happy_persisted", func(t *testing.T) {
+		restoredSess, err := session.NewSessionForTest(cfg, true, &mocks.ChClient{}, chainSetup)
+		require.NoError(t, err)
+		assert.Equal(t, created, restoredSess.GetInvoices())
+	})
+
+	t.Run("unknown_currency", func(t *testing.T) {
+		_, err := sess.CreateInvoice("unknown", "1", "", 0)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), session.ResTypeCurrency, "unknown")
+	})
+
+	for _, amount := range []string{"abc", "0", "-1"} {
+		amount := amount
+		t.Run("invalid_amount_"+amount, func(t *testing.T) {
+			_, err := sess.CreateInvoice(currency.ETHSymbol, amount, "", 0)
+			peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+			peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameAmount, amount)
+		})
+	}
+
+	t.Run("unknown_invoice", func(t *testing.T) {
+		_, err := sess.GetInvoice("unknown")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), session.ResTypeInvoice, "unknown")
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		_, err := closedSess.CreateInvoice(currency.ETHSymbol, "1", "", 0)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+		_, err = closedSess.GetInvoice("any")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+	})
+}
+
+func Test_Session_InvoicePayments(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(2))
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+
+	// setup returns a session with a channel, in which the user is at index 0.
+	setup := func(t *testing.T) (*session.Session, *session.Channel) {
+		pch, _ := newMockPCh()
+		pch.On("State").Return(makeState(t, openingBalInfo, false))
+		sess := newSessionWCh(t, peerIDs, openingBalInfo, pch)
+		ownPeerID, err := sess.GetPeerID(perun.OwnAlias)
+		require.NoError(t, err)
+		pch.On("Idx").Return(pchannel.Index(0))
+		pch.On("Peers").Return([]pwire.Address{ownPeerID.OffChainAddr, peerIDs[0].OffChainAddr})
+
+		chAPI, err := sess.GetCh(sess.GetChsInfo()[0].ChID)
+		require.NoError(t, err)
+		return sess, chAPI.(*session.Channel)
+	}
+
+	// pay handles an incoming update, in which the user receives the amount and which is tagged with the invoice,
+	// and accepts it. It returns the notification for the update.
+	pay := func(t *testing.T, sess *session.Session, ch *session.Channel, sender pwire.Address, invoiceID string,
+		version uint64, ownBal string) perun.ChUpdateNotif {
+		currBalInfo, updatedBalInfo := openingBalInfo, openingBalInfo
+		currBalInfo.Bals = [][]string{{"1", "2"}}
+		updatedBalInfo.Bals = [][]string{{ownBal, "2"}}
+		currState := makeState(t, currBalInfo, false)
+		currState.Version = version - 1
+		updatedState := makeState(t, updatedBalInfo, false)
+		updatedState.Version = version
+
+		updateID := fmt.Sprintf("%s_%d", ch.ID(), version)
+		sess.ReceiveChUpdateMeta(sender, updateID, updatedState, perun.ChUpdateMeta{perun.InvoiceIDMetaKey: invoiceID})
+
+		notifs := make(chan perun.ChUpdateNotif, 1)
+		require.NoError(t, ch.SubChUpdates(func(notif perun.ChUpdateNotif) { notifs <- notif }))
+		defer ch.UnsubChUpdates() // nolint: errcheck
+
+		responder := &mocks.ChUpdateResponder{}
+		responder.On("Accept", mock.Anything).Return(nil)
+		ch.HandleUpdate(currState, pclient.ChannelUpdate{State: updatedState}, responder)
+		_, err := ch.RespondChUpdate(context.Background(), updateID, true)
+		require.NoError(t, err)
+
+		select {
+		case notif := <-notifs:
+			return notif
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for notification")
+		}
+		return perun.ChUpdateNotif{}
+	}
+
+	t.Run("happy_partial_full", func(t *testing.T) {
+		sess, ch := setup(t)
+		invoice, err := sess.CreateInvoice(currency.ETHSymbol, "1", "", 0)
+		require.NoError(t, err)
+
+		notif := pay(t, sess, ch, peerIDs[0].OffChainAddr, invoice.ID, 1, "1.4")
+		assert.Equal(t, perun.ChUpdateMeta{perun.InvoiceIDMetaKey: invoice.ID}, notif.Meta)
+		invoice, err = sess.GetInvoice(invoice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, perun.InvoicePartiallyPaid, invoice.Status)
+		assert.Equal(t, "0.4", invoice.AmountPaid)
+		require.Len(t, invoice.Payments, 1)
+		assert.Equal(t, ch.ID(), invoice.Payments[0].ChID)
+		assert.Equal(t, "1", invoice.Payments[0].Version)
+		assert.Equal(t, peerIDs[0].Alias, invoice.Payments[0].PayerAlias)
+		assert.Equal(t, "0.4", invoice.Payments[0].Amount)
+
+		pay(t, sess, ch, peerIDs[0].OffChainAddr, invoice.ID, 2, "1.6")
+		invoice, err = sess.GetInvoice(invoice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, perun.InvoicePaid, invoice.Status)
+		assert.Equal(t, "1", invoice.AmountPaid)
+		assert.Len(t, invoice.Payments, 2)
+	})
+
+	t.Run("happy_expired", func(t *testing.T) {
+		sess, ch := setup(t)
+		invoice, err := sess.CreateInvoice(currency.ETHSymbol, "1", "", 1)
+		require.NoError(t, err)
+		time.Sleep(1100 * time.Millisecond)
+
+		invoice, err = sess.GetInvoice(invoice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, perun.InvoiceExpired, invoice.Status)
+
+		pay(t, sess, ch, peerIDs[0].OffChainAddr, invoice.ID, 1, "2")
+		invoice, err = sess.GetInvoice(invoice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, perun.InvoiceExpired, invoice.Status)
+		assert.Equal(t, "1", invoice.AmountPaid)
+	})
+
+	t.Run("meta_from_other_sender", func(t *testing.T) {
+		sess, ch := setup(t)
+		invoice, err := sess.CreateInvoice(currency.ETHSymbol, "1", "", 0)
+		require.NoError(t, err)
+
+		notif := pay(t, sess, ch, peerIDs[1].OffChainAddr, invoice.ID, 1, "2")
+		assert.Nil(t, notif.Meta)
+		invoice, err = sess.GetInvoice(invoice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, perun.InvoiceOpen, invoice.Status)
+	})
+
+	t.Run("unknown_invoice", func(t *testing.T) {
+		sess, ch := setup(t)
+
+		notif := pay(t, sess, ch, peerIDs[0].OffChainAddr, "unknown", 1, "2")
+		assert.Equal(t, perun.ChUpdateMeta{perun.InvoiceIDMetaKey: "unknown"}, notif.Meta)
+		assert.Empty(t, sess.GetInvoices())
+	})
+}
+
+func Test_Channel_SendChUpdateWMeta(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(2))
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	meta := perun.ChUpdateMeta{perun.InvoiceIDMetaKey: "invoice1"}
+
+	app := perun.App{Def: pchannel.NoApp(), Data: pchannel.NoData()}
+	setup := func(t *testing.T) (*mocks.ChClient, *mocks.PChannel, perun.ChAPI) {
+		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
+		pch, _ := newMockPCh()
+		pch.On("State").Return(makeState(t, openingBalInfo, false))
+		pch.On("Idx").Return(pchannel.Index(0))
+		ownPeerID, err := sess.GetPeerID(perun.OwnAlias)
+		require.NoError(t, err)
+		pch.On("Peers").Return([]pwire.Address{ownPeerID.OffChainAddr, peerIDs[0].OffChainAddr})
+		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil)
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
+		chInfo, err := sess.OpenCh(context.Background(), openingBalInfo, app, 10)
+		require.NoError(t, err)
+		ch, err := sess.GetCh(chInfo.ChID)
+		require.NoError(t, err)
+		return chClient, pch, ch
+	}
+	noopUpdater := func(s *pchannel.State) error { return nil }
+	// runUpdater applies the updater passed to UpdateBy on the current state, as done by go-perun.
+	runUpdater := func(args mock.Arguments) {
+		args.Get(1).(func(*pchannel.State) error)(makeState(t, openingBalInfo, false)) // nolint: errcheck
+	}
+
+	t.Run("happy", func(t *testing.T) {
+		chClient, pch, ch := setup(t)
+		var published *pwire.Envelope
+		chClient.On("Publish", mock.Anything, mock.MatchedBy(func(e *pwire.Envelope) bool {
+			published = e
+			return true
+		})).Return(nil)
+		pch.On("UpdateBy", mock.Anything, mock.Anything).Return(nil).Run(runUpdater)
+
+		_, err := ch.SendChUpdateWMeta(context.Background(), noopUpdater, meta)
+		require.NoError(t, err)
+		require.NotNil(t, published)
+		assert.True(t, published.Recipient.Equals(peerIDs[0].OffChainAddr))
+		msg, ok := published.Msg.(*nodemsg.Msg)
+		require.True(t, ok)
+		var payload struct {
+			UpdateID  string
+			StateHash string
+			Meta      perun.ChUpdateMeta
+		}
+		require.NoError(t, json.Unmarshal([]byte(msg.Data), &payload))
+		assert.Equal(t, ch.ID()+"_1", payload.UpdateID)
+		assert.NotEmpty(t, payload.StateHash)
+		assert.Equal(t, meta, payload.Meta)
+	})
+
+	t.Run("happy_emptyMeta_notSent", func(t *testing.T) {
+		_, pch, ch := setup(t)
+		pch.On("UpdateBy", mock.Anything, mock.Anything).Return(nil)
+
+		_, err := ch.SendChUpdateWMeta(context.Background(), noopUpdater, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("publish_error", func(t *testing.T) {
+		chClient, pch, ch := setup(t)
+		chClient.On("Publish", mock.Anything, mock.Anything).Return(assert.AnError)
+		pch.On("UpdateBy", mock.Anything, mock.Anything).Return(assert.AnError).Run(runUpdater)
+
+		_, err := ch.SendChUpdateWMeta(context.Background(), noopUpdater, meta)
+		peruntest.AssertAPIError(t, err, perun.InternalError, perun.ErrUnknownInternal)
+	})
+}
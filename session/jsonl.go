@@ -0,0 +1,112 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+// appendJSONLine encodes the entry as JSON and appends it to the file as a line. If the file does not exist, it
+// is created.
+func appendJSONLine(filePath string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "encoding entry")
+	}
+	f, err := os.OpenFile(filepath.Clean(filePath), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.Wrap(err, "opening file")
+	}
+	if _, err = f.Write(append(data, '\n')); err != nil {
+		f.Close() // nolint: errcheck, gosec	// write error is more relevant.
+		return errors.Wrap(err, "writing file")
+	}
+	return errors.Wrap(f.Close(), "closing file")
+}
+
+// writeFileAtomic replaces the content of the file with the data. The data is written to a temp file, which is
+// then renamed to the file, so that a crash while writing does not leave the file partially written.
+func writeFileAtomic(filePath string, data []byte) error {
+	tmpFilePath := filePath + ".tmp"
+	if err := ioutil.WriteFile(tmpFilePath, data, 0o600); err != nil {
+		return errors.Wrap(err, "writing temp file")
+	}
+	return errors.Wrap(os.Rename(tmpFilePath, filePath), "renaming temp file")
+}
+
+// loadJSONLines reads the file, in which each line is a JSON encoded entry, and calls decode for each line.
+// If the file does not exist, decode is not called.
+//
+// Entries are appended to these files one at a time. So, if the node crashed while appending, the last line
+// can be incomplete. If the last line cannot be decoded, it is dropped and the file is truncated to remove it,
+// so that the next entry is appended on a new line. An invalid line before the last one is returned as an
+// error, as the file is then corrupted in some other way.
+func loadJSONLines(filePath string, logger log.Logger, decode func(line []byte) error) error {
+	f, err := os.OpenFile(filepath.Clean(filePath), os.O_RDWR, 0o600)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "opening file")
+	}
+	defer f.Close() // nolint: errcheck, gosec	// only the truncation writes to the file and is checked.
+
+	r := bufio.NewReader(f)
+	var validSize int64 // Size of the file up to the end of the last valid line.
+	for lineNum := 1; ; lineNum++ {
+		line, readErr := r.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return errors.Wrap(readErr, "reading file")
+		}
+		if len(line) == 0 {
+			return nil
+		}
+		isLast := readErr == io.EOF
+		if !isLast {
+			_, peekErr := r.Peek(1)
+			isLast = peekErr == io.EOF
+		}
+
+		if trimmed := bytes.TrimSpace(line); len(trimmed) != 0 {
+			if err = decode(trimmed); err != nil {
+				if !isLast {
+					return errors.WithMessagef(err, "decoding line %d", lineNum)
+				}
+				logger.Errorf("Dropping incomplete last line %d of %s: %v", lineNum, filePath, err)
+				return errors.Wrap(f.Truncate(validSize), "truncating incomplete last line")
+			}
+		}
+		validSize += int64(len(line))
+
+		if readErr == io.EOF {
+			// Last line is valid, but the newline was not written. Add it, so that the next entry is appended
+			// on a new line.
+			_, err = f.WriteAt([]byte{'\n'}, validSize)
+			return errors.Wrap(err, "completing last line")
+		}
+	}
+}
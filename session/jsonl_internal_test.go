@@ -0,0 +1,121 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+func Test_LoadJSONLines(t *testing.T) {
+	logger := log.NewLoggerWithField("component", "test")
+	// load writes the content to a file, loads the chain start blocks from it, adds an entry and returns the
+	// blocks in the store along with the final content of the file.
+	load := func(t *testing.T, content string) (map[string]uint64, string, error) {
+		filePath := filepath.Join(t.TempDir(), chStartBlocksFile)
+		require.NoError(t, ioutil.WriteFile(filePath, []byte(content), 0o600))
+		s, err := loadChStartBlockStore(filePath)
+		if err != nil {
+			return nil, "", err
+		}
+		require.NoError(t, s.add("c", 3))
+		data, err := ioutil.ReadFile(filePath)
+		require.NoError(t, err)
+		return s.blocks, string(data), nil
+	}
+
+	t.Run("happy", func(t *testing.T) {
+		blocks, content, err := load(t, "{\"chID\":\"a\",\"block\":1}\n\n{\"chID\":\"b\",\"block\":2}\n")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]uint64{"a": 1, "b": 2, "c": 3}, blocks)
+		assert.Equal(t, "{\"chID\":\"a\",\"block\":1}\n\n{\"chID\":\"b\",\"block\":2}\n{\"chID\":\"c\",\"block\":3}\n",
+			content)
+	})
+	t.Run("happy_incomplete_last_line_dropped", func(t *testing.T) {
+		blocks, content, err := load(t, "{\"chID\":\"a\",\"block\":1}\n{\"chID\":\"b\",\"blo")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]uint64{"a": 1, "c": 3}, blocks)
+		assert.Equal(t, "{\"chID\":\"a\",\"block\":1}\n{\"chID\":\"c\",\"block\":3}\n", content)
+	})
+	t.Run("happy_invalid_last_line_dropped", func(t *testing.T) {
+		blocks, content, err := load(t, "{\"chID\":\"a\",\"block\":1}\ninvalid\n")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]uint64{"a": 1, "c": 3}, blocks)
+		assert.Equal(t, "{\"chID\":\"a\",\"block\":1}\n{\"chID\":\"c\",\"block\":3}\n", content)
+	})
+	t.Run("happy_missing_last_newline_added", func(t *testing.T) {
+		blocks, content, err := load(t, "{\"chID\":\"a\",\"block\":1}")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]uint64{"a": 1, "c": 3}, blocks)
+		assert.Equal(t, "{\"chID\":\"a\",\"block\":1}\n{\"chID\":\"c\",\"block\":3}\n", content)
+	})
+	t.Run("invalid_line_before_last", func(t *testing.T) {
+		_, _, err := load(t, "invalid\n{\"chID\":\"a\",\"block\":1}\n")
+		require.Error(t, err)
+		t.Log(err)
+	})
+	t.Run("missing_file", func(t *testing.T) {
+		called := false
+		err := loadJSONLines(filepath.Join(t.TempDir(), "missing.jsonl"), logger, func([]byte) error {
+			called = true
+			return nil
+		})
+		require.NoError(t, err)
+		assert.False(t, called)
+	})
+}
+
+func Test_AppendJSONLine(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), chStartBlocksFile)
+	require.NoError(t, appendJSONLine(filePath, chStartBlockEntry{ChID: "a", Block: 1}))
+	require.NoError(t, appendJSONLine(filePath, chStartBlockEntry{ChID: "b", Block: 2}))
+
+	data, err := ioutil.ReadFile(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"chID\":\"a\",\"block\":1}\n{\"chID\":\"b\",\"block\":2}\n", string(data))
+
+	t.Run("unencodable_entry", func(t *testing.T) {
+		assert.Error(t, appendJSONLine(filePath, make(chan int)))
+	})
+	t.Run("missing_dir", func(t *testing.T) {
+		assert.Error(t, appendJSONLine(filepath.Join(t.TempDir(), "missing", chStartBlocksFile), 1))
+	})
+}
+
+func Test_WriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "file.json")
+	require.NoError(t, ioutil.WriteFile(filePath, []byte("old"), 0o600))
+	require.NoError(t, writeFileAtomic(filePath, []byte("new")))
+
+	data, err := ioutil.ReadFile(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1, "temp file should be renamed")
+
+	t.Run("missing_dir", func(t *testing.T) {
+		assert.Error(t, writeFileAtomic(filepath.Join(dir, "missing", "file.json"), []byte("new")))
+	})
+}
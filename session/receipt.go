@@ -0,0 +1,308 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	pchannel "perun.network/go-perun/channel"
+	pwallet "perun.network/go-perun/wallet"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+// receiptsFile is the name of the file in the database directory, in which the fully signed states of the
+// channels are persisted. Each line in the file is a JSON encoded signedState.
+const receiptsFile = "receipts.jsonl"
+
+type (
+	// receiptStore holds the fully signed states of the channels in the session, from which the receipts are
+	// exported, and persists them by appending to a file. It has its own mutex, as the states are recorded by
+	// the channel client from its own go-routines.
+	receiptStore struct {
+		log.Logger
+
+		filePath string // Signed states are not persisted, if empty.
+
+		mutex  sync.Mutex
+		states map[string]map[uint64]signedState // Indexed by channel ID and version.
+	}
+
+	// signedState is a fully signed state of a channel, in the format in which it is persisted. Params, State and
+	// Sigs are encoded as in perun.ChReceipt.
+	signedState struct {
+		ChID    string   `json:"chID"`
+		Version uint64   `json:"version"`
+		Params  string   `json:"params"`
+		State   string   `json:"state"`
+		Sigs    []string `json:"sigs"`
+	}
+)
+
+// loadReceiptStore loads the signed states persisted in the file. If the file does not exist, the store is
+// empty. If the file path is empty, the signed states are not persisted.
+func loadReceiptStore(filePath string) (*receiptStore, error) {
+	s := &receiptStore{
+		Logger:   log.NewLoggerWithField("component", "receipts"),
+		filePath: filePath,
+		states:   make(map[string]map[uint64]signedState),
+	}
+	if filePath == "" {
+		return s, nil
+	}
+	err := loadJSONLines(filePath, s.Logger, func(line []byte) error {
+		var entry signedState
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return errors.Wrap(err, "decoding entry")
+		}
+		s.put(entry)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "loading receipts file")
+	}
+	return s, nil
+}
+
+// handleSignedState records the fully signed state of the channel. It is registered with the channel client
+// and errors are only logged, as the state has already been persisted by the channel client.
+func (s *receiptStore) handleSignedState(params *pchannel.Params, tx pchannel.Transaction) {
+	entry, err := encodeSignedState(params, tx)
+	if err != nil {
+		s.Errorf("Encoding signed state: %v", err)
+		return
+	}
+	if err := s.add(entry); err != nil {
+		s.Errorf("Recording signed state of channel %s with version %d: %v", entry.ChID, entry.Version, err)
+	}
+}
+
+// add adds the signed state and persists it. If a state with the same version is already recorded for the
+// channel, it is ignored.
+func (s *receiptStore) add(entry signedState) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, found := s.states[entry.ChID][entry.Version]; found {
+		return nil
+	}
+	if s.filePath != "" {
+		if err := s.persist(entry); err != nil {
+			return err
+		}
+	}
+	s.put(entry)
+	return nil
+}
+
+// put adds the signed state to the map. It should be called with the mutex locked.
+func (s *receiptStore) put(entry signedState) {
+	if s.states[entry.ChID] == nil {
+		s.states[entry.ChID] = make(map[uint64]signedState)
+	}
+	s.states[entry.ChID][entry.Version] = entry
+}
+
+// get returns the signed state of the channel with the version.
+func (s *receiptStore) get(chID string, version uint64) (signedState, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entry, found := s.states[chID][version]
+	return entry, found
+}
+
+// persist appends the entry to the file.
+func (s *receiptStore) persist(entry signedState) error {
+	return errors.WithMessage(appendJSONLine(s.filePath, entry), "appending to receipts file")
+}
+
+// encodeSignedState encodes the channel parameters, the state and the signatures on it.
+func encodeSignedState(params *pchannel.Params, tx pchannel.Transaction) (signedState, error) {
+	if tx.State == nil {
+		return signedState{}, errors.New("transaction has no state")
+	}
+	var paramsBuf, stateBuf bytes.Buffer
+	if err := params.Encode(&paramsBuf); err != nil {
+		return signedState{}, errors.Wrap(err, "encoding params")
+	}
+	if err := tx.State.Encode(&stateBuf); err != nil {
+		return signedState{}, errors.Wrap(err, "encoding state")
+	}
+	sigs := make([]string, len(tx.Sigs))
+	for i := range tx.Sigs {
+		sigs[i] = hex.EncodeToString(tx.Sigs[i])
+	}
+	return signedState{
+		ChID:    fmt.Sprintf("%x", tx.State.ID),
+		Version: tx.State.Version,
+		Params:  hex.EncodeToString(paramsBuf.Bytes()),
+		State:   hex.EncodeToString(stateBuf.Bytes()),
+		Sigs:    sigs,
+	}, nil
+}
+
+// decodeParamsAndState decodes the hex encoded channel parameters and state.
+func decodeParamsAndState(encodedParams, encodedState string) (*pchannel.Params, *pchannel.State, error) {
+	paramsBytes, err := hex.DecodeString(encodedParams)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "decoding params as hex")
+	}
+	stateBytes, err := hex.DecodeString(encodedState)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "decoding state as hex")
+	}
+	params, state := new(pchannel.Params), new(pchannel.State)
+	if err = params.Decode(bytes.NewReader(paramsBytes)); err != nil {
+		return nil, nil, errors.Wrap(err, "decoding params")
+	}
+	if err = state.Decode(bytes.NewReader(stateBytes)); err != nil {
+		return nil, nil, errors.Wrap(err, "decoding state")
+	}
+	return params, state, nil
+}
+
+// ExportReceipt returns a receipt for the state of the channel at the version, that contains the signatures of
+// all the participants on the state. The receipt can be verified offline using VerifyReceipt.
+//
+// Receipts can be exported for all the states that were fully signed, since this feature was available in the
+// node; including the states of the channel before the node was restarted and after the channel was closed.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrInvalidArgument with Name:"version" when the version is not a valid number.
+// - ErrResourceNotFound with ResourceType:"receipt" when no fully signed state is recorded for the version.
+// - ErrUnknownInternal.
+func (ch *Channel) ExportReceipt(version string) (perun.ChReceipt, perun.APIError) {
+	ch.WithField("method", "ExportReceipt").Info("Received request with params:", version)
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			ch.WithFields(perun.APIErrAsMap("ExportReceipt", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	parsedVersion, err := strconv.ParseUint(version, 10, 64)
+	if err != nil {
+		apiErr = perun.NewAPIErrInvalidArgument(errors.Wrap(err, "parsing version"), ArgNameVersion, version)
+		return perun.ChReceipt{}, apiErr
+	}
+	var entry signedState
+	found := false
+	if ch.receipts != nil {
+		entry, found = ch.receipts.get(ch.ID(), parsedVersion)
+	}
+	if !found {
+		apiErr = perun.NewAPIErrResourceNotFound(ResTypeReceipt, version)
+		return perun.ChReceipt{}, apiErr
+	}
+
+	params, state, err := decodeParamsAndState(entry.Params, entry.State)
+	if err != nil {
+		apiErr = perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "decoding signed state"))
+		return perun.ChReceipt{}, apiErr
+	}
+	participants := make([]string, len(params.Parts))
+	for i := range params.Parts {
+		participants[i] = params.Parts[i].String()
+	}
+	return perun.ChReceipt{
+		ChID:         entry.ChID,
+		Version:      version,
+		BalInfo:      makeBalInfoFromState(ch.parts, ch.currencies, state),
+		Participants: participants,
+		Params:       entry.Params,
+		State:        entry.State,
+		Sigs:         entry.Sigs,
+	}, nil
+}
+
+// VerifyReceipt verifies the receipt offline. It checks that the params and the state are of the channel, the
+// version and the participants in the receipt and that each of the participants has signed the state. It does
+// not require a connection to the blockchain.
+//
+// It returns the assets and the balances in the signed state, in the base unit of each currency, in the same
+// layout as the balances in BalInfo. The currencies in BalInfo are not signed and hence cannot be verified. So,
+// the signed assets should be used to identify the currency of each balance.
+func VerifyReceipt(receipt perun.ChReceipt) (assets []string, bals [][]string, _ error) {
+	params, state, err := decodeParamsAndState(receipt.Params, receipt.State)
+	if err != nil {
+		return nil, nil, err
+	}
+	if chID := fmt.Sprintf("%x", params.ID()); chID != receipt.ChID {
+		return nil, nil, errors.Errorf("params are of channel %s, not %s", chID, receipt.ChID)
+	}
+	if chID := fmt.Sprintf("%x", state.ID); chID != receipt.ChID {
+		return nil, nil, errors.Errorf("state is of channel %s, not %s", chID, receipt.ChID)
+	}
+	if version := fmt.Sprintf("%d", state.Version); version != receipt.Version {
+		return nil, nil, errors.Errorf("state has version %s, not %s", version, receipt.Version)
+	}
+	if len(receipt.Participants) != len(params.Parts) {
+		return nil, nil, errors.Errorf("receipt has %d participants, want %d", len(receipt.Participants),
+			len(params.Parts))
+	}
+	for i := range params.Parts {
+		if receipt.Participants[i] != params.Parts[i].String() {
+			return nil, nil, errors.Errorf("participant %d is %s in params, not %s", i, params.Parts[i],
+				receipt.Participants[i])
+		}
+	}
+	if len(receipt.Sigs) != len(params.Parts) {
+		return nil, nil, errors.Errorf("receipt has %d signatures, want %d", len(receipt.Sigs), len(params.Parts))
+	}
+	for i := range params.Parts {
+		if err = verifySig(params, state, params.Parts[i], receipt.Sigs[i]); err != nil {
+			return nil, nil, errors.WithMessagef(err, "participant %d (%s)", i, params.Parts[i])
+		}
+	}
+
+	assets = make([]string, len(state.Assets))
+	for i := range state.Assets {
+		assets[i] = fmt.Sprint(state.Assets[i])
+	}
+	bals = make([][]string, len(state.Balances))
+	for i := range state.Balances {
+		bals[i] = make([]string, len(state.Balances[i]))
+		for j := range state.Balances[i] {
+			bals[i][j] = state.Balances[i][j].String()
+		}
+	}
+	return assets, bals, nil
+}
+
+// verifySig verifies that the hex encoded signature on the state was made by the address.
+func verifySig(params *pchannel.Params, state *pchannel.State, addr pwallet.Address, encodedSig string) error {
+	sig, err := hex.DecodeString(encodedSig)
+	if err != nil {
+		return errors.Wrap(err, "decoding signature as hex")
+	}
+	valid, err := pchannel.Verify(addr, params, state, sig)
+	if err != nil {
+		return errors.Wrap(err, "verifying signature")
+	}
+	if !valid {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	pwallet "perun.network/go-perun/wallet"
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+	"github.com/hyperledger-labs/perun-node/session"
+	"github.com/hyperledger-labs/perun-node/session/sessiontest"
+)
+
+func Test_Channel_ExportReceipt_VerifyReceipt(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	app := perun.App{Def: pchannel.NoApp(), Data: pchannel.NoData()}
+
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	cfg := sessiontest.NewConfigT(t, rng, peerIDs...)
+	rng = rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+
+	// Fully signed state of the channel, with the signers as participants.
+	signers := ethereumtest.NewWalletSetupT(t, rng, 2).Accs
+	params := pchannel.NewParamsUnsafe(10, []pwallet.Address{signers[0].Address(), signers[1].Address()},
+		pchannel.NoApp(), big.NewInt(1), true, false)
+	state := makeState(t, openingBalInfo, false)
+	state.ID = params.ID()
+	state.Version = 1
+	sigs := make([]pwallet.Sig, len(signers))
+	for i := range signers {
+		var err error
+		sigs[i], err = pchannel.Sign(signers[i], params, state)
+		require.NoError(t, err)
+	}
+
+	pch := &mocks.PChannel{}
+	pch.On("ID").Return(params.ID())
+	pch.On("Watch", mock.Anything).WaitUntil(make(chan time.Time)).Return(nil)
+	pch.On("State").Return(state)
+	pch.On("Idx").Return(pchannel.Index(0))
+
+	// openCh opens the channel (using the same mock channel) in a new session that uses the config.
+	openCh := func(t *testing.T) (*session.Session, perun.ChAPI) {
+		chClient := &mocks.ChClient{}
+		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil)
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
+		sess, err := session.NewSessionForTest(cfg, true, chClient, chainSetup)
+		require.NoError(t, err)
+		ownPeerID, err := sess.GetPeerID(perun.OwnAlias)
+		require.NoError(t, err)
+		pch.On("Peers").Return([]pwire.Address{ownPeerID.OffChainAddr, peerIDs[0].OffChainAddr})
+		chInfo, err := sess.OpenCh(context.Background(), openingBalInfo, app, 10)
+		require.NoError(t, err)
+		ch, err := sess.GetCh(chInfo.ChID)
+		require.NoError(t, err)
+		return sess, ch
+	}
+
+	sess, ch := openCh(t)
+	sess.HandleSignedState(params, pchannel.Transaction{State: state, Sigs: sigs})
+	wantBals := [][]string{{"1000000000000000000", "2000000000000000000"}}
+
+	t.Run("happy", func(t *testing.T) {
+		receipt, err := ch.ExportReceipt("1")
+		require.NoError(t, err)
+		assert.Equal(t, ch.ID(), receipt.ChID)
+		assert.Equal(t, "1", receipt.Version)
+		assert.Equal(t, openingBalInfo, receipt.BalInfo)
+		assert.Equal(t, []string{signers[0].Address().String(), signers[1].Address().String()},
+			receipt.Participants)
+
+		gotAssets, gotBals, verifyErr := session.VerifyReceipt(receipt)
+		require.NoError(t, verifyErr)
+		assert.Equal(t, []string{fmt.Sprint(state.Assets[0])}, gotAssets)
+		assert.Equal(t, wantBals, gotBals)
+	})
+
+	t.Run("happy_persisted", func(t *testing.T) {
+		_, restoredCh := openCh(t)
+		receipt, err := restoredCh.ExportReceipt("1")
+		require.NoError(t, err)
+		_, _, verifyErr := session.VerifyReceipt(receipt)
+		require.NoError(t, verifyErr)
+	})
+
+	t.Run("error_verify_tampered", func(t *testing.T) {
+		receipt, err := ch.ExportReceipt("1")
+		require.NoError(t, err)
+
+		swappedSigs := receipt
+		swappedSigs.Sigs = []string{receipt.Sigs[1], receipt.Sigs[0]}
+		_, _, verifyErr := session.VerifyReceipt(swappedSigs)
+		require.Error(t, verifyErr)
+		assert.Contains(t, verifyErr.Error(), "invalid signature")
+
+		missingSig := receipt
+		missingSig.Sigs = receipt.Sigs[:1]
+		_, _, verifyErr = session.VerifyReceipt(missingSig)
+		require.Error(t, verifyErr)
+
+		wrongParticipants := receipt
+		wrongParticipants.Participants = []string{receipt.Participants[1], receipt.Participants[0]}
+		_, _, verifyErr = session.VerifyReceipt(wrongParticipants)
+		require.Error(t, verifyErr)
+
+		wrongVersion := receipt
+		wrongVersion.Version = "2"
+		_, _, verifyErr = session.VerifyReceipt(wrongVersion)
+		require.Error(t, verifyErr)
+
+		tamperedState := receipt
+		otherState := state.Clone()
+		otherState.Balances[0][0] = big.NewInt(5)
+		otherReceiptState, encodeErr := session.EncodeStateForTest(otherState)
+		require.NoError(t, encodeErr)
+		tamperedState.State = otherReceiptState
+		_, _, verifyErr = session.VerifyReceipt(tamperedState)
+		require.Error(t, verifyErr)
+		assert.Contains(t, verifyErr.Error(), "invalid signature")
+	})
+
+	t.Run("error_export_unknown_version", func(t *testing.T) {
+		_, err := ch.ExportReceipt("5")
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), session.ResTypeReceipt, "5")
+	})
+
+	t.Run("error_export_invalid_version", func(t *testing.T) {
+		_, err := ch.ExportReceipt("abc")
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameVersion, "abc")
+	})
+}
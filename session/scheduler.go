@@ -0,0 +1,556 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+const (
+	// scheduledPaymentsFile is the name of the file in the database directory, in which the scheduled payments
+	// are persisted.
+	scheduledPaymentsFile = "scheduledpayments.json"
+
+	scheduledPaymentIDLen = 16 // Length of the random scheduled payment IDs in bytes.
+
+	// maxScheduledPaymentRuns is the number of recent runs retained for each scheduled payment.
+	maxScheduledPaymentRuns = 100
+
+	// scheduledPaymentMaxRetries is the number of times a run is retried, when the peer does not respond in time.
+	scheduledPaymentMaxRetries = 3
+	// scheduledPaymentRetryDelay is the delay between the retries of a run.
+	scheduledPaymentRetryDelay = 30 * time.Second
+)
+
+type (
+	// scheduler holds the scheduled payments of the session, persists them to a file and runs each payment when
+	// it is due. It has its own mutex, as the payments are run in separate go-routines.
+	//
+	// Payments are run only after the runner is set using start. Runs that were due before it is started, for
+	// example, when the node was not running, are recorded as missed.
+	scheduler struct {
+		log.Logger
+
+		filePath string // Scheduled payments are not persisted, if empty.
+
+		// findCh returns the channel on which the scheduled payment is to be made.
+		findCh     func(perun.ScheduledPaymentParams) (perun.ChAPI, perun.APIError)
+		retryDelay time.Duration
+
+		ctx    context.Context // Cancelled when the scheduler is closed.
+		cancel context.CancelFunc
+		wakeup chan struct{}
+		wg     sync.WaitGroup // Go-routines of the loop and of the runs in progress.
+
+		mutex    sync.Mutex
+		payments map[string]*scheduledPayment
+		ids      []string // IDs of the scheduled payments in the order of creation.
+		runner   perun.ScheduledPaymentRunner
+	}
+
+	scheduledPayment struct {
+		perun.ScheduledPayment
+		schedule *cronSchedule
+	}
+)
+
+// loadScheduler loads the scheduled payments persisted in the file. If the file does not exist, there are no
+// scheduled payments. If the file path is empty, the scheduled payments are not persisted.
+//
+// findCh should be set before the scheduler is started.
+func loadScheduler(filePath string) (*scheduler, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &scheduler{
+		Logger:     log.NewLoggerWithField("component", "scheduler"),
+		filePath:   filePath,
+		retryDelay: scheduledPaymentRetryDelay,
+		ctx:        ctx,
+		cancel:     cancel,
+		wakeup:     make(chan struct{}, 1),
+		payments:   make(map[string]*scheduledPayment),
+	}
+	if filePath == "" {
+		return s, nil
+	}
+	data, err := ioutil.ReadFile(filepath.Clean(filePath))
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "reading scheduled payments file")
+	}
+	var payments []perun.ScheduledPayment
+	if err = json.Unmarshal(data, &payments); err != nil {
+		return nil, errors.Wrap(err, "decoding scheduled payments file")
+	}
+	for i := range payments {
+		schedule, err := parseCronSchedule(payments[i].Params.Schedule)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "parsing schedule of scheduled payment %s", payments[i].ID)
+		}
+		s.payments[payments[i].ID] = &scheduledPayment{ScheduledPayment: payments[i], schedule: schedule}
+		s.ids = append(s.ids, payments[i].ID)
+	}
+	return s, nil
+}
+
+// start sets the runner and starts running the payments as they become due. The runs that were due until now
+// are recorded as missed. It does nothing, if the scheduler is already started.
+func (s *scheduler) start(runner perun.ScheduledPaymentRunner, now time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.runner != nil {
+		return
+	}
+	s.runner = runner
+	for _, id := range s.ids {
+		p := s.payments[id]
+		for p.Status == perun.ScheduledPaymentActive && p.NextRun <= now.Unix() {
+			s.Warnf("Missed run of scheduled payment %s that was due at %s", p.ID, time.Unix(p.NextRun, 0).UTC())
+			addScheduledPaymentRun(p, perun.ScheduledPaymentRun{DueAt: p.NextRun, Status: perun.ScheduledPaymentRunMissed})
+			p.advance()
+		}
+	}
+	if err := s.persist(); err != nil {
+		s.Errorf("Persisting scheduled payments: %v", err)
+	}
+	s.wg.Add(1)
+	go s.loop()
+}
+
+// add adds the scheduled payment and persists it. Its next run should be set.
+func (s *scheduler) add(payment perun.ScheduledPayment, schedule *cronSchedule) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.payments[payment.ID] = &scheduledPayment{ScheduledPayment: payment, schedule: schedule}
+	s.ids = append(s.ids, payment.ID)
+	if err := s.persist(); err != nil {
+		delete(s.payments, payment.ID)
+		s.ids = s.ids[:len(s.ids)-1]
+		return err
+	}
+	s.wake()
+	return nil
+}
+
+// cancel cancels the scheduled payment and persists it. A run that is in progress is not aborted.
+func (s *scheduler) cancelPayment(id string) (perun.ScheduledPayment, perun.APIError) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	p, ok := s.payments[id]
+	if !ok {
+		return perun.ScheduledPayment{}, perun.NewAPIErrResourceNotFound(ResTypeScheduledPayment, id)
+	}
+	if p.Status != perun.ScheduledPaymentActive {
+		return p.copy(), perun.NewAPIErrFailedPreCondition(ErrScheduledPaymentNotActive)
+	}
+	p.Status = perun.ScheduledPaymentCancelled
+	p.NextRun = 0
+	if err := s.persist(); err != nil {
+		return perun.ScheduledPayment{}, perun.NewAPIErrUnknownInternal(err)
+	}
+	s.wake()
+	return p.copy(), nil
+}
+
+// list returns copies of all the scheduled payments, ordered by the time of creation.
+func (s *scheduler) list() []perun.ScheduledPayment {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	payments := make([]perun.ScheduledPayment, len(s.ids))
+	for i, id := range s.ids {
+		payments[i] = s.payments[id].copy()
+	}
+	return payments
+}
+
+// close stops running the payments and waits until the runs in progress return. These runs are aborted and
+// their results are not recorded, so that they are reported as missed when the scheduler is started again.
+//
+// The runs use the session and channel locks. So, it should not be called with any of those locked.
+func (s *scheduler) close() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// reopen starts running the payments again after the scheduler was closed, if it was started before. The runs
+// that were due until now are recorded as missed.
+func (s *scheduler) reopen(now time.Time) {
+	s.mutex.Lock()
+	runner := s.runner
+	s.runner = nil
+	// Safe to replace, as the loop and the runs that use these have returned when the scheduler was closed.
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.mutex.Unlock()
+
+	if runner != nil {
+		s.start(runner, now)
+	}
+}
+
+// wake signals the loop to re-compute the time of the next run. It should be called with the mutex locked.
+func (s *scheduler) wake() {
+	select {
+	case s.wakeup <- struct{}{}:
+	default: // Loop is already signalled.
+	}
+}
+
+// loop runs the payments when they are due, until the scheduler is closed.
+func (s *scheduler) loop() {
+	defer s.wg.Done()
+	for {
+		s.mutex.Lock()
+		var nextRun int64
+		for _, p := range s.payments {
+			if p.Status == perun.ScheduledPaymentActive && (nextRun == 0 || p.NextRun < nextRun) {
+				nextRun = p.NextRun
+			}
+		}
+		s.mutex.Unlock()
+
+		var timer *time.Timer
+		var timerC <-chan time.Time
+		if nextRun != 0 {
+			timer = time.NewTimer(time.Until(time.Unix(nextRun, 0)))
+			timerC = timer.C
+		}
+		select {
+		case <-s.ctx.Done():
+			stopTimer(timer)
+			return
+		case <-s.wakeup:
+		case now := <-timerC:
+			s.runDue(now)
+		}
+		stopTimer(timer)
+	}
+}
+
+// stopTimer stops the timer, if it is not nil.
+func stopTimer(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
+
+// runDue starts a run for each of the scheduled payments that are due. If more than one run of a payment is
+// due, only the latest one is made and the others are recorded as missed.
+func (s *scheduler) runDue(now time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, id := range s.ids {
+		p := s.payments[id]
+		if p.Status != perun.ScheduledPaymentActive || p.NextRun > now.Unix() {
+			continue
+		}
+		dueAt := p.NextRun
+		p.advance()
+		for p.Status == perun.ScheduledPaymentActive && p.NextRun <= now.Unix() {
+			addScheduledPaymentRun(p, perun.ScheduledPaymentRun{DueAt: dueAt, Status: perun.ScheduledPaymentRunMissed})
+			dueAt = p.NextRun
+			p.advance()
+		}
+		s.wg.Add(1)
+		go s.run(p.ID, p.Params, dueAt)
+	}
+	if err := s.persist(); err != nil {
+		s.Errorf("Persisting scheduled payments: %v", err)
+	}
+}
+
+// run makes the payment, retrying when the peer does not respond in time, and records the result.
+//
+// If it fails because the scheduler was closed, the result is not recorded. Instead, the next run is set back
+// to this one, so that it is recorded as missed when the scheduler is started again.
+func (s *scheduler) run(id string, params perun.ScheduledPaymentParams, dueAt int64) {
+	defer s.wg.Done()
+
+	ch, apiErr := s.findCh(params)
+	for attempt := 0; apiErr == nil; attempt++ {
+		if apiErr = s.runner(s.ctx, ch, params.Currency, params.Amount); apiErr == nil {
+			break
+		}
+		if apiErr.Code() != perun.ErrPeerRequestTimedOut || attempt == scheduledPaymentMaxRetries {
+			break
+		}
+		s.Infof("Retrying run of scheduled payment %s, as peer did not respond in time", id)
+		select {
+		case <-s.ctx.Done():
+		case <-time.After(s.retryDelay):
+			apiErr = nil
+		}
+	}
+
+	if apiErr != nil && s.ctx.Err() != nil {
+		s.Warnf("Run of scheduled payment %s that was due at %s was aborted, as the scheduler was closed", id,
+			time.Unix(dueAt, 0).UTC())
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		p := s.payments[id]
+		if p.Status != perun.ScheduledPaymentCancelled && (p.NextRun == 0 || dueAt < p.NextRun) {
+			p.Status = perun.ScheduledPaymentActive
+			p.NextRun = dueAt
+		}
+		if err := s.persist(); err != nil {
+			s.Errorf("Persisting scheduled payments: %v", err)
+		}
+		return
+	}
+
+	run := perun.ScheduledPaymentRun{
+		DueAt:  dueAt,
+		RanAt:  time.Now().UTC().Unix(),
+		Status: perun.ScheduledPaymentRunSucceeded,
+	}
+	if ch != nil {
+		run.ChID = ch.ID()
+	}
+	if apiErr != nil {
+		s.Errorf("Run of scheduled payment %s that was due at %s failed: %v", id, time.Unix(dueAt, 0).UTC(), apiErr)
+		run.Status = perun.ScheduledPaymentRunFailed
+		run.Error = apiErr.Message()
+	} else {
+		run.Version = ch.GetChInfo().Version
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	addScheduledPaymentRun(s.payments[id], run)
+	if err := s.persist(); err != nil {
+		s.Errorf("Persisting scheduled payments: %v", err)
+	}
+}
+
+// persist writes all the scheduled payments to the file. It should be called with the mutex locked.
+func (s *scheduler) persist() error {
+	if s.filePath == "" {
+		return nil
+	}
+	payments := make([]perun.ScheduledPayment, len(s.ids))
+	for i, id := range s.ids {
+		payments[i] = s.payments[id].ScheduledPayment
+	}
+	data, err := json.MarshalIndent(payments, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "encoding scheduled payments")
+	}
+	return errors.WithMessage(writeFileAtomic(s.filePath, data), "writing scheduled payments file")
+}
+
+// advance sets the next run to the time after the current next run, as per the schedule. If there is no such
+// time before the end time, the scheduled payment is ended.
+func (p *scheduledPayment) advance() {
+	next := p.schedule.next(time.Unix(p.NextRun, 0))
+	if next.IsZero() || (p.Params.EndTime != 0 && next.Unix() > p.Params.EndTime) {
+		p.Status = perun.ScheduledPaymentEnded
+		p.NextRun = 0
+		return
+	}
+	p.NextRun = next.Unix()
+}
+
+func (p *scheduledPayment) copy() perun.ScheduledPayment {
+	c := p.ScheduledPayment
+	if p.Runs != nil {
+		c.Runs = make([]perun.ScheduledPaymentRun, len(p.Runs))
+		copy(c.Runs, p.Runs)
+	}
+	return c
+}
+
+// addScheduledPaymentRun records the run, dropping the oldest one, if there are more than the max runs.
+func addScheduledPaymentRun(p *scheduledPayment, run perun.ScheduledPaymentRun) {
+	p.Runs = append(p.Runs, run)
+	if len(p.Runs) > maxScheduledPaymentRuns {
+		p.Runs = p.Runs[len(p.Runs)-maxScheduledPaymentRuns:]
+	}
+}
+
+func newScheduledPaymentID() (string, error) {
+	id := make([]byte, scheduledPaymentIDLen)
+	if _, err := rand.Read(id); err != nil {
+		return "", errors.Wrap(err, "generating scheduled payment id")
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// CreateScheduledPayment creates a recurring payment to the peer, that will be made as per the schedule until the
+// end time (if non zero) or until it is cancelled. The payment is made either on the given channel or on any
+// open channel with the given peer; exactly one of them should be set. The schedule is a cron expression with
+// five fields (minute, hour, day of month, month, day of week) evaluated in UTC or one of the shorthands @yearly,
+// @monthly, @weekly, @daily and @hourly.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPreCondition when the session is closed.
+// - ErrInvalidArgument with Name:"chID", when both or none of channel ID and peer alias are set.
+// - ErrResourceNotFound with ResourceType:"channel" when the channel is not known.
+// - ErrResourceNotFound with ResourceType:"peerID" when the peer alias is not known.
+// - ErrResourceNotFound with ResourceType:"currency" when the currency is not registered.
+// - ErrInvalidArgument with Name:"amount", when the amount is invalid or not positive.
+// - ErrInvalidArgument with Name:"schedule", when the schedule is invalid or has no upcoming runs.
+// - ErrInvalidArgument with Name:"endTime", when there are no runs before the end time.
+// - ErrUnknownInternal.
+func (s *Session) CreateScheduledPayment(params perun.ScheduledPaymentParams) (perun.ScheduledPayment,
+	perun.APIError) {
+	s.WithField("method", "CreateScheduledPayment").Infof("\nReceived request with params %+v", params)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("CreateScheduledPayment", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return perun.ScheduledPayment{}, apiErr
+	}
+	if (params.ChID == "") == (params.PeerAlias == "") {
+		err := errors.New("exactly one of channel ID and peer alias should be set")
+		apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameChID, params.ChID)
+		return perun.ScheduledPayment{}, apiErr
+	}
+	if params.ChID != "" && s.chs.get(params.ChID) == nil {
+		apiErr = perun.NewAPIErrResourceNotFound(ResTypeChannel, params.ChID)
+		return perun.ScheduledPayment{}, apiErr
+	}
+	if params.PeerAlias != "" {
+		if _, isPresent := s.idProvider.ReadByAlias(params.PeerAlias); !isPresent {
+			apiErr = perun.NewAPIErrResourceNotFound(ResTypePeerID, params.PeerAlias)
+			return perun.ScheduledPayment{}, apiErr
+		}
+	}
+	if !s.currencyRegistry.IsRegistered(params.Currency) {
+		apiErr = perun.NewAPIErrResourceNotFound(ResTypeCurrency, params.Currency)
+		return perun.ScheduledPayment{}, apiErr
+	}
+	curr := s.currencyRegistry.Currency(params.Currency)
+	parsedAmount, err := curr.Parse(params.Amount)
+	if err == nil && parsedAmount.Sign() <= 0 {
+		err = errors.New("amount should be positive")
+	}
+	if err != nil {
+		apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameAmount, params.Amount)
+		return perun.ScheduledPayment{}, apiErr
+	}
+	params.Amount = curr.Print(parsedAmount)
+
+	schedule, err := parseCronSchedule(params.Schedule)
+	if err != nil {
+		apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameSchedule, params.Schedule)
+		return perun.ScheduledPayment{}, apiErr
+	}
+	now := time.Now().UTC()
+	nextRun := schedule.next(now)
+	if nextRun.IsZero() {
+		err = errors.New("schedule has no upcoming runs")
+		apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameSchedule, params.Schedule)
+		return perun.ScheduledPayment{}, apiErr
+	}
+	if params.EndTime != 0 && nextRun.Unix() > params.EndTime {
+		err = errors.Errorf("first run at %s is after the end time", nextRun)
+		apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameEndTime, fmt.Sprintf("%d", params.EndTime))
+		return perun.ScheduledPayment{}, apiErr
+	}
+
+	id, err := newScheduledPaymentID()
+	if err != nil {
+		apiErr = perun.NewAPIErrUnknownInternal(err)
+		return perun.ScheduledPayment{}, apiErr
+	}
+	payment := perun.ScheduledPayment{
+		ID:        id,
+		Params:    params,
+		Status:    perun.ScheduledPaymentActive,
+		CreatedAt: now.Unix(),
+		NextRun:   nextRun.Unix(),
+	}
+	if err = s.scheduler.add(payment, schedule); err != nil {
+		apiErr = perun.NewAPIErrUnknownInternal(err)
+		return perun.ScheduledPayment{}, apiErr
+	}
+	return payment, nil
+}
+
+// GetScheduledPayments returns all the scheduled payments in the session, in the order of creation.
+func (s *Session) GetScheduledPayments() []perun.ScheduledPayment {
+	s.WithField("method", "GetScheduledPayments").Info("Received request")
+	return s.scheduler.list()
+}
+
+// CancelScheduledPayment cancels the scheduled payment, so that no further payments are made. A payment that is
+// in progress is not aborted.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrResourceNotFound with ResourceType:"scheduledPayment" when the scheduled payment is not known.
+// - ErrFailedPreCondition when the scheduled payment has already ended or been cancelled.
+// - ErrUnknownInternal.
+func (s *Session) CancelScheduledPayment(scheduledPaymentID string) (perun.ScheduledPayment, perun.APIError) {
+	s.WithField("method", "CancelScheduledPayment").Infof("\nReceived request with params %+v", scheduledPaymentID)
+
+	payment, apiErr := s.scheduler.cancelPayment(scheduledPaymentID)
+	if apiErr != nil {
+		s.WithFields(perun.APIErrAsMap("CancelScheduledPayment", apiErr)).Error(apiErr.Message())
+	}
+	return payment, apiErr
+}
+
+// StartScheduler starts making the scheduled payments using the runner. Runs that were due before it is
+// started are recorded as missed. It does nothing, if the scheduler is already started.
+func (s *Session) StartScheduler(runner perun.ScheduledPaymentRunner) {
+	s.WithField("method", "StartScheduler").Info("Received request")
+	s.scheduler.start(runner, time.Now().UTC())
+}
+
+// findChForScheduledPayment returns the channel on which the scheduled payment is to be made. If a peer alias is
+// set, it is the first open channel with the peer, in which the currency of the payment is used.
+func (s *Session) findChForScheduledPayment(params perun.ScheduledPaymentParams) (perun.ChAPI, perun.APIError) {
+	if params.ChID != "" {
+		return s.GetCh(params.ChID)
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	for _, ch := range s.openChsWithPeer(params.PeerAlias) {
+		if _, _, found := ch.Currency(params.Currency); found {
+			return ch, nil
+		}
+	}
+	return nil, perun.NewAPIErrFailedPreCondition(ErrNoOpenChWithPeer)
+}
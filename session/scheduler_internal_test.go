@@ -0,0 +1,303 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+)
+
+// fakeChAPI implements only those methods of the channel used by the scheduler.
+type fakeChAPI struct {
+	perun.ChAPI
+	info perun.ChInfo
+}
+
+func (ch *fakeChAPI) ID() string              { return ch.info.ChID }
+func (ch *fakeChAPI) GetChInfo() perun.ChInfo { return ch.info }
+
+func Test_CronSchedule_Next(t *testing.T) {
+	after := time.Date(2021, time.March, 10, 10, 30, 0, 0, time.UTC) // Wednesday.
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2021, time.March, 10, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2021, time.March, 10, 10, 45, 0, 0, time.UTC)},
+		{"0 9-17 * * *", time.Date(2021, time.March, 10, 11, 0, 0, 0, time.UTC)},
+		{"0 0 * * 1,5", time.Date(2021, time.March, 12, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 15 * 1", time.Date(2021, time.March, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2021, time.March, 10, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2021, time.March, 11, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2021, time.March, 14, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 2 *", time.Time{}},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.spec, func(t *testing.T) {
+			schedule, err := parseCronSchedule(tc.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, schedule.next(after))
+		})
+	}
+}
+
+func Test_ParseCronSchedule_Invalid(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *",
+		"* * * 13 *", "* * * * 7", "a * * * *", "5-1 * * * *", "*/0 * * * *", "1,,2 * * * *", "@every"} {
+		spec := spec
+		t.Run(spec, func(t *testing.T) {
+			_, err := parseCronSchedule(spec)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_Scheduler(t *testing.T) {
+	// Start in the future, so that the payments are not run by the loop of the scheduler, once it is started.
+	start := time.Now().UTC().Truncate(time.Hour).AddDate(1, 0, 0).Add(30 * time.Minute)
+	hourly, err := parseCronSchedule("@hourly")
+	require.NoError(t, err)
+
+	chInfo := perun.ChInfo{ChID: "ch1", Version: "5"}
+	ch := &fakeChAPI{info: chInfo}
+
+	// newScheduler returns a scheduler persisted in the file, with a runner that returns the errors in order and
+	// records the amounts paid.
+	newScheduler := func(t *testing.T, filePath string, errs ...perun.APIError) (*scheduler, *[]string) {
+		s, err := loadScheduler(filePath)
+		require.NoError(t, err)
+		s.findCh = func(perun.ScheduledPaymentParams) (perun.ChAPI, perun.APIError) { return ch, nil }
+		s.retryDelay = time.Millisecond
+
+		var mutex sync.Mutex
+		paid := []string{}
+		s.runner = func(_ context.Context, _ perun.ChAPI, _, amount string) perun.APIError {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if len(errs) != 0 {
+				err := errs[0]
+				errs = errs[1:]
+				if err != nil {
+					return err
+				}
+			}
+			paid = append(paid, amount)
+			return nil
+		}
+		return s, &paid
+	}
+	newPayment := func(t *testing.T, s *scheduler, endTime int64) perun.ScheduledPayment {
+		payment := perun.ScheduledPayment{
+			ID: "payment1",
+			Params: perun.ScheduledPaymentParams{
+				ChID: "ch1", Currency: "ETH", Amount: "1", Schedule: "@hourly", EndTime: endTime,
+			},
+			Status:  perun.ScheduledPaymentActive,
+			NextRun: hourly.next(start).Unix(),
+		}
+		require.NoError(t, s.add(payment, hourly))
+		return payment
+	}
+	runDue := func(s *scheduler, now time.Time) {
+		s.runDue(now)
+		s.wg.Wait()
+	}
+	timedOut := perun.NewAPIErrPeerRequestTimedOut(errors.New("timed out"), "peer", "1s")
+
+	t.Run("happy_run", func(t *testing.T) {
+		s, paid := newScheduler(t, "")
+		payment := newPayment(t, s, 0)
+
+		runDue(s, start.Add(10*time.Minute)) // Not yet due.
+		assert.Empty(t, *paid)
+
+		runDue(s, start.Add(30*time.Minute))
+		assert.Equal(t, []string{"1"}, *paid)
+		payments := s.list()
+		require.Len(t, payments, 1)
+		assert.Equal(t, perun.ScheduledPaymentActive, payments[0].Status)
+		assert.Equal(t, payment.NextRun+3600, payments[0].NextRun)
+		require.Len(t, payments[0].Runs, 1)
+		assert.Equal(t, payment.NextRun, payments[0].Runs[0].DueAt)
+		assert.Equal(t, perun.ScheduledPaymentRunSucceeded, payments[0].Runs[0].Status)
+		assert.Equal(t, chInfo.ChID, payments[0].Runs[0].ChID)
+		assert.Equal(t, chInfo.Version, payments[0].Runs[0].Version)
+	})
+
+	t.Run("happy_overdue_runs_missed", func(t *testing.T) {
+		s, paid := newScheduler(t, "")
+		newPayment(t, s, 0)
+
+		runDue(s, start.Add(150*time.Minute)) // Runs at 11:00, 12:00 and 13:00 are due.
+		assert.Equal(t, []string{"1"}, *paid)
+		runs := s.list()[0].Runs
+		require.Len(t, runs, 3)
+		assert.Equal(t, perun.ScheduledPaymentRunMissed, runs[0].Status)
+		assert.Equal(t, perun.ScheduledPaymentRunMissed, runs[1].Status)
+		assert.Equal(t, perun.ScheduledPaymentRunSucceeded, runs[2].Status)
+		assert.Equal(t, runs[0].DueAt+2*3600, runs[2].DueAt)
+	})
+
+	t.Run("happy_retry_timed_out", func(t *testing.T) {
+		s, paid := newScheduler(t, "", timedOut, timedOut)
+		newPayment(t, s, 0)
+
+		runDue(s, start.Add(30*time.Minute))
+		assert.Equal(t, []string{"1"}, *paid)
+		assert.Equal(t, perun.ScheduledPaymentRunSucceeded, s.list()[0].Runs[0].Status)
+	})
+
+	t.Run("retries_exhausted", func(t *testing.T) {
+		s, paid := newScheduler(t, "", timedOut, timedOut, timedOut, timedOut)
+		newPayment(t, s, 0)
+
+		runDue(s, start.Add(30*time.Minute))
+		assert.Empty(t, *paid)
+		run := s.list()[0].Runs[0]
+		assert.Equal(t, perun.ScheduledPaymentRunFailed, run.Status)
+		assert.Equal(t, timedOut.Message(), run.Error)
+	})
+
+	t.Run("no_retry_other_errors", func(t *testing.T) {
+		rejected := perun.NewAPIErrPeerRejected(errors.New("rejected"), "peer", "not now")
+		s, paid := newScheduler(t, "", rejected)
+		newPayment(t, s, 0)
+
+		runDue(s, start.Add(30*time.Minute))
+		assert.Empty(t, *paid)
+		assert.Equal(t, perun.ScheduledPaymentRunFailed, s.list()[0].Runs[0].Status)
+	})
+
+	t.Run("happy_ended", func(t *testing.T) {
+		s, paid := newScheduler(t, "")
+		payment := newPayment(t, s, start.Add(time.Hour).Unix())
+
+		runDue(s, start.Add(30*time.Minute))
+		assert.Equal(t, []string{"1"}, *paid)
+		payments := s.list()
+		assert.Equal(t, perun.ScheduledPaymentEnded, payments[0].Status)
+		assert.Zero(t, payments[0].NextRun)
+		assert.Equal(t, payment.NextRun, payments[0].Runs[0].DueAt)
+	})
+
+	t.Run("happy_cancel", func(t *testing.T) {
+		s, paid := newScheduler(t, "")
+		newPayment(t, s, 0)
+
+		cancelled, apiErr := s.cancelPayment("payment1")
+		require.NoError(t, apiErr)
+		assert.Equal(t, perun.ScheduledPaymentCancelled, cancelled.Status)
+		assert.Zero(t, cancelled.NextRun)
+
+		runDue(s, start.Add(30*time.Minute))
+		assert.Empty(t, *paid)
+
+		_, apiErr = s.cancelPayment("payment1")
+		require.Error(t, apiErr)
+		assert.Equal(t, perun.ErrFailedPreCondition, apiErr.Code())
+		_, apiErr = s.cancelPayment("unknown")
+		require.Error(t, apiErr)
+		assert.Equal(t, perun.ErrResourceNotFound, apiErr.Code())
+	})
+
+	t.Run("happy_persisted_missed_on_start", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), scheduledPaymentsFile)
+		s, _ := newScheduler(t, filePath)
+		payment := newPayment(t, s, 0)
+		s.close()
+
+		restored, paid := newScheduler(t, filePath)
+		restored.runner = nil
+		runner := func(context.Context, perun.ChAPI, string, string) perun.APIError { return nil }
+		restored.start(runner, start.Add(150*time.Minute))
+		defer restored.close()
+
+		assert.Empty(t, *paid)
+		payments := restored.list()
+		require.Len(t, payments, 1)
+		assert.Equal(t, payment.NextRun+3*3600, payments[0].NextRun)
+		require.Len(t, payments[0].Runs, 3)
+		for _, run := range payments[0].Runs {
+			assert.Equal(t, perun.ScheduledPaymentRunMissed, run.Status)
+		}
+
+		reloaded, err := loadScheduler(filePath)
+		require.NoError(t, err)
+		assert.Equal(t, payments, reloaded.list())
+	})
+
+	t.Run("closed_during_run_missed_on_start", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), scheduledPaymentsFile)
+		s, _ := newScheduler(t, filePath)
+		payment := newPayment(t, s, 0)
+		running := make(chan struct{})
+		s.runner = func(ctx context.Context, _ perun.ChAPI, _, _ string) perun.APIError {
+			close(running)
+			<-ctx.Done() // Slow runner, that returns only when aborted.
+			return perun.NewAPIErrUnknownInternal(ctx.Err())
+		}
+
+		s.runDue(start.Add(30 * time.Minute))
+		<-running
+		s.close()
+
+		reloaded, err := loadScheduler(filePath)
+		require.NoError(t, err)
+		payments := reloaded.list()
+		require.Len(t, payments, 1)
+		assert.Empty(t, payments[0].Runs, "aborted run should not be recorded as failed")
+		assert.Equal(t, payment.NextRun, payments[0].NextRun)
+
+		runner := func(context.Context, perun.ChAPI, string, string) perun.APIError { return nil }
+		reloaded.start(runner, start.Add(45*time.Minute))
+		defer reloaded.close()
+		runs := reloaded.list()[0].Runs
+		require.Len(t, runs, 1)
+		assert.Equal(t, perun.ScheduledPaymentRunMissed, runs[0].Status)
+		assert.Equal(t, payment.NextRun, runs[0].DueAt)
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		s, _ := newScheduler(t, "")
+		newPayment(t, s, 0)
+		runner := s.runner
+		s.runner = nil
+		s.start(runner, start)
+		s.close()
+
+		s.reopen(start.Add(60 * time.Minute))
+		defer s.close()
+		runs := s.list()[0].Runs
+		require.Len(t, runs, 1)
+		assert.Equal(t, perun.ScheduledPaymentRunMissed, runs[0].Status)
+		assert.NoError(t, s.ctx.Err())
+	})
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/currency/currencytest"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+	"github.com/hyperledger-labs/perun-node/session"
+)
+
+func Test_Session_ScheduledPayments(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(2))
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	pch, _ := newMockPCh()
+	pch.On("State").Return(makeState(t, openingBalInfo, false))
+	sess := newSessionWCh(t, peerIDs, openingBalInfo, pch)
+	chID := sess.GetChsInfo()[0].ChID
+	closedSess, _, _ := newSessionWMockChClient(t, false)
+
+	validParams := func() perun.ScheduledPaymentParams {
+		return perun.ScheduledPaymentParams{
+			PeerAlias: peerIDs[0].Alias,
+			Currency:  currency.ETHSymbol,
+			Amount:    "0.5",
+			Schedule:  "0 0 1 1 *", // Yearly, so that it is not run by the scheduler during the test.
+		}
+	}
+	paidOn := make(chan string, 1)
+	runner := func(_ context.Context, ch perun.ChAPI, currency, amount string) perun.APIError {
+		paidOn <- ch.ID()
+		return nil
+	}
+
+	var created []perun.ScheduledPayment
+	t.Run("happy_peer", func(t *testing.T) {
+		payment, err := sess.CreateScheduledPayment(validParams())
+		require.NoError(t, err)
+		assert.NotEmpty(t, payment.ID)
+		assert.Equal(t, validParams(), payment.Params)
+		assert.Equal(t, perun.ScheduledPaymentActive, payment.Status)
+		nextRun := time.Unix(payment.NextRun, 0).UTC()
+		assert.Equal(t, time.Date(nextRun.Year(), time.January, 1, 0, 0, 0, 0, time.UTC), nextRun)
+
+		sess.RunDueScheduledPayments(runner, nextRun)
+		assert.Equal(t, chID, <-paidOn)
+		got := sess.GetScheduledPayments()
+		require.Len(t, got, 1)
+		require.Len(t, got[0].Runs, 1)
+		assert.Equal(t, perun.ScheduledPaymentRunSucceeded, got[0].Runs[0].Status)
+		assert.Equal(t, chID, got[0].Runs[0].ChID)
+		created = append(created, got[0])
+	})
+
+	t.Run("happy_channel", func(t *testing.T) {
+		params := validParams()
+		params.PeerAlias, params.ChID = "", chID
+		params.EndTime = time.Now().AddDate(2, 0, 0).Unix()
+		payment, err := sess.CreateScheduledPayment(params)
+		require.NoError(t, err)
+		created = append(created, payment)
+		assert.Equal(t, created, sess.GetScheduledPayments())
+	})
+
+	t.Run("happy_cancel", func(t *testing.T) {
+		payment, err := sess.CancelScheduledPayment(created[1].ID)
+		require.NoError(t, err)
+		assert.Equal(t, perun.ScheduledPaymentCancelled, payment.Status)
+		assert.Zero(t, payment.NextRun)
+
+		_, err = sess.CancelScheduledPayment(created[1].ID)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+	})
+
+	t.Run("unknown_scheduled_payment", func(t *testing.T) {
+		_, err := sess.CancelScheduledPayment("unknown")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), session.ResTypeScheduledPayment, "unknown")
+	})
+
+	t.Run("no_open_ch_with_peer", func(t *testing.T) {
+		params := validParams()
+		params.PeerAlias = peerIDs[1].Alias
+		payment, err := sess.CreateScheduledPayment(params)
+		require.NoError(t, err)
+
+		sess.RunDueScheduledPayments(runner, time.Unix(payment.NextRun, 0))
+		for _, got := range sess.GetScheduledPayments() {
+			if got.ID == payment.ID {
+				require.Len(t, got.Runs, 1)
+				assert.Equal(t, perun.ScheduledPaymentRunFailed, got.Runs[0].Status)
+				assert.Contains(t, got.Runs[0].Error, session.ErrNoOpenChWithPeer.Error())
+			}
+		}
+	})
+
+	t.Run("no_open_ch_with_peer_in_currency", func(t *testing.T) {
+		otherSymbol := "PRN"
+		//nolint: errcheck	// Error is returned, if it is already registered, which can be ignored.
+		currencytest.Registry().Register(otherSymbol, currency.ETHMaxDecimals)
+		params := validParams()
+		params.Currency = otherSymbol
+		payment, err := sess.CreateScheduledPayment(params)
+		require.NoError(t, err)
+
+		sess.RunDueScheduledPayments(runner, time.Unix(payment.NextRun, 0))
+		for _, got := range sess.GetScheduledPayments() {
+			if got.ID == payment.ID {
+				require.Len(t, got.Runs, 1)
+				assert.Equal(t, perun.ScheduledPaymentRunFailed, got.Runs[0].Status)
+				assert.Contains(t, got.Runs[0].Error, session.ErrNoOpenChWithPeer.Error())
+			}
+		}
+		assert.Empty(t, paidOn)
+	})
+
+	invalidArgTests := []struct {
+		name    string
+		modify  func(*perun.ScheduledPaymentParams)
+		argName perun.ArgumentName
+	}{
+		{"both_ch_and_peer", func(p *perun.ScheduledPaymentParams) { p.ChID = chID }, session.ArgNameChID},
+		{"no_ch_or_peer", func(p *perun.ScheduledPaymentParams) { p.PeerAlias = "" }, session.ArgNameChID},
+		{"invalid_amount", func(p *perun.ScheduledPaymentParams) { p.Amount = "abc" }, session.ArgNameAmount},
+		{"zero_amount", func(p *perun.ScheduledPaymentParams) { p.Amount = "0" }, session.ArgNameAmount},
+		{"invalid_schedule", func(p *perun.ScheduledPaymentParams) { p.Schedule = "* *" }, session.ArgNameSchedule},
+		{"never_schedule", func(p *perun.ScheduledPaymentParams) { p.Schedule = "0 0 31 2 *" }, session.ArgNameSchedule},
+		{"past_end_time", func(p *perun.ScheduledPaymentParams) { p.EndTime = 1 }, session.ArgNameEndTime},
+	}
+	for _, tc := range invalidArgTests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := validParams()
+			tc.modify(&params)
+			_, err := sess.CreateScheduledPayment(params)
+			peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+			assert.Equal(t, string(tc.argName), err.AddInfo().(perun.ErrInfoInvalidArgument).Name, fmt.Sprint(err))
+		})
+	}
+
+	notFoundTests := []struct {
+		name    string
+		modify  func(*perun.ScheduledPaymentParams)
+		resType perun.ResourceType
+	}{
+		{"unknown_ch", func(p *perun.ScheduledPaymentParams) { p.PeerAlias, p.ChID = "", "x" }, session.ResTypeChannel},
+		{"unknown_peer", func(p *perun.ScheduledPaymentParams) { p.PeerAlias = "x" }, session.ResTypePeerID},
+		{"unknown_currency", func(p *perun.ScheduledPaymentParams) { p.Currency = "x" }, session.ResTypeCurrency},
+	}
+	for _, tc := range notFoundTests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := validParams()
+			tc.modify(&params)
+			_, err := sess.CreateScheduledPayment(params)
+			peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+			peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), tc.resType, "x")
+		})
+	}
+
+	t.Run("session_closed", func(t *testing.T) {
+		_, err := closedSess.CreateScheduledPayment(validParams())
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+	})
+}
@@ -21,7 +21,10 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"math/big"
+	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -33,11 +36,20 @@ import (
 	pwire "perun.network/go-perun/wire"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain"
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum"
+	"github.com/hyperledger-labs/perun-node/comm/monitor"
+	"github.com/hyperledger-labs/perun-node/comm/nodemsg"
+	"github.com/hyperledger-labs/perun-node/comm/relay"
 	"github.com/hyperledger-labs/perun-node/comm/tcp"
 	"github.com/hyperledger-labs/perun-node/comm/tcp/tcptest"
+	"github.com/hyperledger-labs/perun-node/comm/tls"
+	"github.com/hyperledger-labs/perun-node/comm/websocket"
+	"github.com/hyperledger-labs/perun-node/currency"
 	"github.com/hyperledger-labs/perun-node/idprovider"
 	"github.com/hyperledger-labs/perun-node/idprovider/local"
+	"github.com/hyperledger-labs/perun-node/idprovider/remote"
+	pricelocal "github.com/hyperledger-labs/perun-node/price/local"
 	"github.com/hyperledger-labs/perun-node/log"
 )
 
@@ -67,38 +79,72 @@ func (e Error) Error() string {
 // Definition of error constants for this package.
 const (
 	// For failed pre-condition.
-	ErrChClosed      Error = "action not allowed on a closed channel"
-	ErrSessionClosed Error = "action not allowed on a closed session"
+	ErrChClosed                  Error = "action not allowed on a closed channel"
+	ErrSessionClosed             Error = "action not allowed on a closed session"
+	ErrScheduledPaymentNotActive Error = "scheduled payment is not active"
+	ErrNoOpenChWithPeer          Error = "no open channel with the peer in the currency"
 
 	// For invalid config.
-	ErrUnsupportedType      Error = "type not supported, see node config for supported types"
-	ErrRepeatedPeerAlias    Error = "found repeated entries but each value should be unique"
-	ErrEntryForSelfNotFound Error = "own peer alias (self) not found"
+	ErrUnsupportedType       Error = "type not supported, see node config for supported types"
+	ErrRepeatedPeerAlias     Error = "found repeated entries but each value should be unique"
+	ErrEntryForSelfNotFound  Error = "own peer alias (self) not found"
+	ErrIDProviderNotListable Error = "listing all peer ids is not supported by the ID provider"
+	ErrNotERC20Currency      Error = "currency is not an ERC20 token"
 )
 
 // Enumeration of valid resource types for used in ResourceNotFound and
 // ResourceExists errors.
 const (
-	ResTypeUpdate      perun.ResourceType = "update"
-	ResTypeUpdateSub   perun.ResourceType = "updatesSub"
-	ResTypeChannel     perun.ResourceType = "channel"
-	ResTypeProposal    perun.ResourceType = "proposal"
-	ResTypeProposalSub perun.ResourceType = "proposalsSub"
-	ResTypePeerID      perun.ResourceType = "peerID"
-	ResTypeSession     perun.ResourceType = "session"
-	ResTypeCurrency    perun.ResourceType = "currency"
+	ResTypeUpdate           perun.ResourceType = "update"
+	ResTypeUpdateSub        perun.ResourceType = "updatesSub"
+	ResTypeChannel          perun.ResourceType = "channel"
+	ResTypeProposal         perun.ResourceType = "proposal"
+	ResTypeProposalSub      perun.ResourceType = "proposalsSub"
+	ResTypePeerStatusSub    perun.ResourceType = "peerStatusSub"
+	ResTypePeerID           perun.ResourceType = "peerID"
+	ResTypeSession          perun.ResourceType = "session"
+	ResTypeCurrency         perun.ResourceType = "currency"
+	ResTypeInvoice          perun.ResourceType = "invoice"
+	ResTypeStream           perun.ResourceType = "stream"
+	ResTypeStreamsSub       perun.ResourceType = "streamsSub"
+	ResTypeScheduledPayment perun.ResourceType = "scheduledPayment"
+	ResTypeReceipt          perun.ResourceType = "receipt"
 )
 
 // Enumeration of valid argument names for using in InvalidArgument error.
 const (
-	ArgNameAmount       perun.ArgumentName = "amount"
-	ArgNameCurrency     perun.ArgumentName = "currency"
-	ArgNamePeerAlias    perun.ArgumentName = "peerAlias"
-	ArgNameOffChainAddr perun.ArgumentName = "offChainAddress"
-	ArgNameConfigFile   perun.ArgumentName = "configFile"
-	ArgNamePayee        perun.ArgumentName = "payee"
-	ArgNameToken        perun.ArgumentName = "token"
-	ArgNameAsset        perun.ArgumentName = "asset"
+	ArgNameAmount            perun.ArgumentName = "amount"
+	ArgNameCurrency          perun.ArgumentName = "currency"
+	ArgNamePeerAlias         perun.ArgumentName = "peerAlias"
+	ArgNameOffChainAddr      perun.ArgumentName = "offChainAddress"
+	ArgNameConfigFile        perun.ArgumentName = "configFile"
+	ArgNamePayee             perun.ArgumentName = "payee"
+	ArgNameToken             perun.ArgumentName = "token"
+	ArgNameAsset             perun.ArgumentName = "asset"
+	ArgNameAddress           perun.ArgumentName = "address"
+	ArgNameSignature         perun.ArgumentName = "signature"
+	ArgNameFormat            perun.ArgumentName = "format"
+	ArgNamePeerIDsData       perun.ArgumentName = "peerIDsData"
+	ArgNameMergeStrategy     perun.ArgumentName = "mergeStrategy"
+	ArgNameInvoiceID         perun.ArgumentName = "invoiceID"
+	ArgNameMetadata          perun.ArgumentName = "metadata"
+	ArgNameCap               perun.ArgumentName = "cap"
+	ArgNameInterval          perun.ArgumentName = "interval"
+	ArgNameEndTime           perun.ArgumentName = "endTime"
+	ArgNameSchedule          perun.ArgumentName = "schedule"
+	ArgNameChID              perun.ArgumentName = "chID"
+	ArgNameSpendingLimits    perun.ArgumentName = "spendingLimits"
+	ArgNameChSelectionPolicy perun.ArgumentName = "chSelectionPolicy"
+	ArgNameVersion           perun.ArgumentName = "version"
+	ArgNameExpiry            perun.ArgumentName = "expiry"
+)
+
+// Enumeration of valid limit types for use in SpendingLimitExceeded error.
+const (
+	LimitTypeMaxPayment   perun.SpendingLimitType = "maxPayment"
+	LimitTypeMaxPerHour   perun.SpendingLimitType = "maxPerHour"
+	LimitTypeMaxPerDay    perun.SpendingLimitType = "maxPerDay"
+	LimitTypeMaxChFunding perun.SpendingLimitType = "maxChFunding"
 )
 
 type (
@@ -116,6 +162,8 @@ type (
 		user       User
 		chClient   ChClient
 		idProvider perun.IDProvider
+		// Stops watching the ID provider for changes, nil if it is not being watched.
+		stopIDProviderWatch func()
 
 		timeoutCfg timeoutConfig
 		chainURL   string // used for annotating error messages.
@@ -123,6 +171,9 @@ type (
 		chain  perun.ChainBackend
 		funder perun.Funder
 
+		// Costs of on-chain txs sent for channels that could not be opened.
+		txCosts *blockchain.TxCostRecorder
+
 		chs              *chRegistry
 		contractRegistry perun.ContractRegistry
 		currencyRegistry perun.ROCurrencyRegistry
@@ -130,6 +181,29 @@ type (
 		chProposalNotifier    perun.ChProposalNotifier
 		chProposalNotifsCache []perun.ChProposalNotif
 		chProposalResponders  map[string]chProposalResponderEntry
+
+		connMonitor        *monitor.Monitor
+		peerStatusNotifier perun.PeerStatusNotifier
+
+		chUpdateMetas *chUpdateMetaExchanger
+		invoices      *invoiceStore
+		chHistory     *chHistoryStore
+		chStartBlocks *chStartBlockStore
+		chTxCosts     *txCostStore
+		receipts      *receiptStore
+		scheduler     *scheduler
+		spending      *spendingLimiter
+		prices        perun.PriceSource // Nil, if no price source is configured.
+
+		// Block from which adjudicator events are read for restored channels with unknown start blocks.
+		adjEventsStartBlock uint64
+
+		// Held throughout Close, so that the scheduler is not reopened by one call, while another closes it.
+		closeMutex sync.Mutex
+
+		// payStreams has its own mutex, as the streams of a channel are stopped with the channel locked.
+		payStreamsMutex sync.Mutex
+		payStreams      perun.PayStreams // Nil, until the payment streams are first used.
 	}
 
 	chProposalResponderEntry struct {
@@ -170,14 +244,47 @@ func New(cfg Config, currencyRegistry perun.ROCurrencyRegistry, contractRegistry
 		return nil, apiErr
 	}
 
-	if cfg.User.CommType != "tcp" {
-		return nil, perun.NewAPIErrInvalidConfig(ErrUnsupportedType, "commType", cfg.User.CommType)
+	commBackends, apiErr := initCommBackends(cfg.User, user.OffChain)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	idProvider, apiErr := initIDProvider(cfg, walletBackend, user.PeerID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	invoices, err := loadInvoiceStore(filepath.Join(cfg.DatabaseDir, invoicesFile))
+	if err != nil {
+		return nil, perun.NewAPIErrInvalidConfig(err, "databaseDir", cfg.DatabaseDir)
+	}
+	chHistory, err := loadChHistoryStore(filepath.Join(cfg.DatabaseDir, chHistoryFile))
+	if err != nil {
+		return nil, perun.NewAPIErrInvalidConfig(err, "databaseDir", cfg.DatabaseDir)
+	}
+	chStartBlocks, err := loadChStartBlockStore(filepath.Join(cfg.DatabaseDir, chStartBlocksFile))
+	if err != nil {
+		return nil, perun.NewAPIErrInvalidConfig(err, "databaseDir", cfg.DatabaseDir)
+	}
+	chTxCosts, err := loadTxCostStore(filepath.Join(cfg.DatabaseDir, txCostsFile))
+	if err != nil {
+		return nil, perun.NewAPIErrInvalidConfig(err, "databaseDir", cfg.DatabaseDir)
 	}
-	commBackend := tcp.NewTCPBackend(tcptest.DialerTimeout)
-	idProvider, apiErr := initIDProvider(cfg.IDProviderType, cfg.IDProviderURL, walletBackend, user.PeerID)
+	receipts, err := loadReceiptStore(filepath.Join(cfg.DatabaseDir, receiptsFile))
+	if err != nil {
+		return nil, perun.NewAPIErrInvalidConfig(err, "databaseDir", cfg.DatabaseDir)
+	}
+	scheduler, err := loadScheduler(filepath.Join(cfg.DatabaseDir, scheduledPaymentsFile))
+	if err != nil {
+		return nil, perun.NewAPIErrInvalidConfig(err, "databaseDir", cfg.DatabaseDir)
+	}
+	prices, apiErr := initPriceSource(cfg)
 	if apiErr != nil {
 		return nil, apiErr
 	}
+	spending, err := loadSpendingLimiter(filepath.Join(cfg.DatabaseDir, spendsFile), currencyRegistry, prices,
+		cfg.MaxPriceAge, cfg.SpendingLimits)
+	if err != nil {
+		return nil, perun.NewAPIErrInvalidConfig(err, "spendingLimits", fmt.Sprintf("%+v", cfg.SpendingLimits))
+	}
 
 	chain, err := ethereum.NewChainBackend(
 		cfg.ChainURL, cfg.ChainID, cfg.ChainConnTimeout, cfg.OnChainTxTimeout, user.OnChain)
@@ -189,8 +296,12 @@ func New(cfg Config, currencyRegistry perun.ROCurrencyRegistry, contractRegistry
 	funder := chain.NewFunder(contractRegistry.AssetETH(), user.OnChain.Addr)
 	adjudicator := chain.NewAdjudicator(cfg.Adjudicator, user.OnChain.Addr)
 
-	chClient, apiErr := newEthereumPaymentClient(funder, adjudicator, commBackend, cfg.User.CommAddr, user.OffChain)
+	connMonitor := monitor.NewMonitor(user.OffChainAddr, cfg.PeerPingInterval)
+	router := nodemsg.NewRouter()
+	chClient, apiErr := newEthereumPaymentClient(funder, adjudicator, commBackends, cfg.User.CommType,
+		cfg.User.CommAddr, user.OffChain, connMonitor, router)
 	if apiErr != nil {
+		connMonitor.Close() // nolint: errcheck, gosec  // Only stops the go-routines of the monitor.
 		return nil, apiErr
 	}
 
@@ -210,40 +321,177 @@ func New(cfg Config, currencyRegistry perun.ROCurrencyRegistry, contractRegistry
 		idProvider:           idProvider,
 		chain:                chain,
 		funder:               funder,
+		txCosts:              blockchain.NewTxCostRecorder(),
 		chs:                  newChRegistry(initialChRegistrySize),
 		contractRegistry:     contractRegistry,
 		currencyRegistry:     currencyRegistry,
 		chProposalResponders: make(map[string]chProposalResponderEntry),
-	}
-
+		connMonitor:          connMonitor,
+		chUpdateMetas:        newChUpdateMetaExchanger(user.OffChainAddr, chClient.Publish, router),
+		invoices:             invoices,
+		chHistory:            chHistory,
+		chStartBlocks:        chStartBlocks,
+		chTxCosts:            chTxCosts,
+		receipts:             receipts,
+		scheduler:            scheduler,
+		spending:             spending,
+		prices:               prices,
+		adjEventsStartBlock:  cfg.AdjEventsStartBlock,
+	}
+	scheduler.findCh = sess.findChForScheduledPayment
+
+	sess.chClient.OnSignedState(receipts.handleSignedState)
 	err = sess.chClient.RestoreChs(cfg.DatabaseDir, cfg.PeerReconnTimeout, sess.handleRestoredCh)
 	if err != nil {
 		err = errors.WithMessage(err, "restoring channels")
 		return nil, perun.NewAPIErrInvalidConfig(err, "databaseDir", cfg.DatabaseDir)
 	}
 	chClient.Handle(sess, sess) // Init handlers
+	sess.watchIDProvider(cfg.IDProviderWatchInterval)
 	return sess, nil
 }
 
-func initIDProvider(idProviderType, idProviderURL string, wb perun.WalletBackend, own perun.PeerID) (
-	perun.IDProvider, perun.APIError) {
-	if idProviderType != "local" {
-		return nil, perun.NewAPIErrInvalidConfig(ErrUnsupportedType, "idProviderType", idProviderType)
+// initCommBackends initializes the comm backends for each of the supported comm types. The comm type of the
+// user should be one of these.
+func initCommBackends(cfg UserConfig, offChain perun.Credential) (map[string]perun.CommBackend, perun.APIError) {
+	acc, err := offChain.Wallet.Unlock(offChain.Addr)
+	if err != nil {
+		return nil, perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "off-chain account"))
+	}
+	tlsCfg := tls.Config{
+		CertFile:      cfg.TLS.CertFile,
+		KeyFile:       cfg.TLS.KeyFile,
+		CACertFile:    cfg.TLS.CACertFile,
+		PinnedCerts:   cfg.TLS.PinnedCerts,
+		DialerTimeout: tcptest.DialerTimeout,
+	}
+	if cfg.TLS.OffChainIdentity {
+		tlsCfg.IdentityAcc = acc
+	}
+	tlsBackend, err := tls.NewTLSBackend(tlsCfg, walletBackend)
+	if err != nil {
+		return nil, perun.NewAPIErrInvalidConfig(err, "tls", fmt.Sprintf("%+v", cfg.TLS))
+	}
+
+	wsBackend, err := websocket.NewWebSocketBackend(websocket.Config{
+		CertFile:      cfg.TLS.CertFile,
+		KeyFile:       cfg.TLS.KeyFile,
+		CACertFile:    cfg.TLS.CACertFile,
+		DialerTimeout: tcptest.DialerTimeout,
+	})
+	if err != nil {
+		return nil, perun.NewAPIErrInvalidConfig(err, "tls", fmt.Sprintf("%+v", cfg.TLS))
+	}
+
+	relayCfg := relay.Config{
+		Acc:           acc,
+		DialerTimeout: tcptest.DialerTimeout,
+	}
+	if cfg.RelayEncryption {
+		relayCfg.E2E = tlsBackend
+	}
+	relayBackend, err := relay.NewRelayBackend(relayCfg)
+	if err != nil {
+		return nil, perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "initializing relay comm backend"))
+	}
+
+	commBackends := map[string]perun.CommBackend{
+		"tcp":       tcp.NewTCPBackend(tcptest.DialerTimeout),
+		"tls":       tlsBackend,
+		"websocket": wsBackend,
+		"relay":     relayBackend,
+	}
+	if _, ok := commBackends[cfg.CommType]; !ok {
+		return nil, perun.NewAPIErrInvalidConfig(ErrUnsupportedType, "commType", cfg.CommType)
+	}
+	if cfg.CommType == "tls" && !cfg.TLS.OffChainIdentity && cfg.TLS.CertFile == "" {
+		return nil, perun.NewAPIErrInvalidConfig(tls.ErrCertNotConfigured, "tls.certFile", cfg.TLS.CertFile)
+	}
+	return commBackends, nil
+}
+
+func initIDProvider(cfg Config, wb perun.WalletBackend, own perun.PeerID) (perun.IDProvider, perun.APIError) {
+	var idProvider perun.IDProvider
+	var err error
+	switch cfg.IDProviderType {
+	case "local":
+		idProvider, err = local.NewIDprovider(cfg.IDProviderURL, wb, cfg.RequireSignedPeerIDs)
+	case "http":
+		idProvider, err = remote.NewIDProvider(remote.Config{
+			URL:      cfg.IDProviderURL,
+			Headers:  cfg.IDProviderAuthHeaders,
+			CacheTTL: cfg.IDProviderCacheTTL,
+			Timeout:  cfg.ResponseTimeout,
+
+			RequireSigned: cfg.RequireSignedPeerIDs,
+		}, wb)
+	default:
+		return nil, perun.NewAPIErrInvalidConfig(ErrUnsupportedType, "idProviderType", cfg.IDProviderType)
 	}
-	idProvider, err := local.NewIDprovider(idProviderURL, wb)
 	if err != nil {
-		return nil, perun.NewAPIErrInvalidConfig(err, "idProviderURL", idProviderURL)
+		return nil, perun.NewAPIErrInvalidConfig(err, "idProviderURL", cfg.IDProviderURL)
 	}
 
 	own.Alias = perun.OwnAlias
 	err = idProvider.Write(perun.OwnAlias, own)
 	if err != nil {
 		err = errors.Wrap(err, "registering own user in ID Provider")
-		return nil, perun.NewAPIErrInvalidConfig(err, "idProviderURL", idProviderURL)
+		return nil, perun.NewAPIErrInvalidConfig(err, "idProviderURL", cfg.IDProviderURL)
 	}
 	return idProvider, nil
 }
 
+// initPriceSource initializes the price source of the configured type. It returns nil, if the type is empty.
+func initPriceSource(cfg Config) (perun.PriceSource, perun.APIError) {
+	switch cfg.PriceSourceType {
+	case "":
+		return nil, nil
+	case "local":
+		prices, err := pricelocal.NewPriceSource(cfg.PriceSourceURL)
+		if err != nil {
+			return nil, perun.NewAPIErrInvalidConfig(err, "priceSourceURL", cfg.PriceSourceURL)
+		}
+		return prices, nil
+	default:
+		return nil, perun.NewAPIErrInvalidConfig(ErrUnsupportedType, "priceSourceType", cfg.PriceSourceType)
+	}
+}
+
+// watchIDProvider starts watching the ID provider for changes, if the interval is not zero and if the
+// ID provider supports it.
+func (s *Session) watchIDProvider(interval time.Duration) {
+	localIDProvider, ok := s.idProvider.(*local.IDProvider)
+	if interval == 0 || !ok {
+		return
+	}
+	s.stopIDProviderWatch = localIDProvider.Watch(interval, s.handleIDProviderReload)
+}
+
+// handleIDProviderReload is called when the changes made to the ID provider file by other programs are
+// loaded into the session. It registers the comm endpoints of the added and updated peers, so that the
+// new comm endpoints are used for dialing them.
+//
+// It does not acquire the session lock, because the session lock is held when stopping the watcher.
+func (s *Session) handleIDProviderReload(report local.ReloadReport, err error) {
+	if err != nil {
+		s.WithError(err).Error("Reloading ID provider")
+		return
+	}
+	for _, change := range report.Changes {
+		s.WithField("alias", change.Alias).Infof("Peer ID %s in ID provider: %+v", change.Type, change.New)
+		if change.Type != local.PeerIDRemoved && change.New.Alias != perun.OwnAlias {
+			s.chClient.RegisterEndpoints(change.New.OffChainAddr, change.New.CommEndpoints())
+		}
+	}
+	for _, conflict := range report.Conflicts {
+		s.WithField("alias", conflict.Alias).Warnf(
+			"Retaining unsaved peer ID %+v, ignoring the one in ID provider file %+v", conflict.Cached, conflict.File)
+	}
+	for alias, entryErr := range report.InvalidEntries {
+		s.WithField("alias", alias).WithError(entryErr).Warn("Ignoring invalid peer ID in ID provider file")
+	}
+}
+
 // calcSessionID calculates the sessionID as sha256 hash over the off-chain address of the user and
 // the current UTC time.
 //
@@ -290,9 +538,32 @@ func (s *Session) handleRestoredCh(pch PChannel) {
 		return
 	}
 
-	ch := newCh(pch, s.chainURL, currencies, aliases, s.timeoutCfg, pch.Params().ChallengeDuration)
+	ch := newCh(pch, s.chainURL, currencies, aliases, s.timeoutCfg, pch.Params().ChallengeDuration,
+		s.txFeeCurrency(), s.chTxCosts.recorder(fmt.Sprintf("%x", pch.ID())))
 	s.addCh(ch)
 	s.Debugf("restored channel from persistence: %v", ch.getChInfo())
+
+	// The watcher started for the restored channel looks only for recent
+	// adjudicator events. So, explicitly check for any events that were
+	// emitted while the node was offline, since the channel was opened.
+	startBlock, ok := s.chStartBlocks.get(ch.ID())
+	if !ok {
+		startBlock = s.adjEventsStartBlock
+	}
+	events, err := s.chain.PastAdjEvents(s.contractRegistry.Adjudicator(), pch.ID(), startBlock)
+	if err != nil {
+		s.Errorf("Reading past adjudicator events for restored channel %x: %v", pch.ID(), err)
+		apiErr := handleChainError(s.chainURL, s.timeoutCfg.onChainTx.String(), err)
+		if apiErr == nil {
+			apiErr = perun.NewAPIErrUnknownInternal(err)
+		}
+		ch.notifyPastAdjEventsErr(apiErr)
+		return
+	}
+	if len(events) != 0 {
+		s.Infof("Found %d adjudicator event(s) for restored channel %x", len(events), pch.ID())
+		go ch.handleMissedAdjEvents(events)
+	}
 }
 
 // AddPeerID adds the peer ID to the ID provider instance of the session.
@@ -302,6 +573,7 @@ func (s *Session) handleRestoredCh(pch PChannel) {
 // - ErrResourceExists with ResourceType: "peerID" when peer ID is already registered
 // - ErrInvalidArgument with Name:"peerAlias" when peer alias is used for another peer,
 // - ErrInvalidArgument with Name:"offChainAddress" when off-chain address is invalid.
+// - ErrInvalidArgument with Name:"signature" when signature is invalid or missing (when required).
 // - ErrUnknownInternal.
 func (s *Session) AddPeerID(peerID perun.PeerID) perun.APIError {
 	s.WithField("method", "AddPeerID").Info("Received request with params:", peerID)
@@ -325,6 +597,9 @@ func (s *Session) AddPeerID(peerID perun.PeerID) perun.APIError {
 			apiErr = perun.NewAPIErrResourceExists(ResTypePeerID, peerID.Alias)
 		case errors.Is(err, idprovider.ErrParsingOffChainAddress):
 			apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameOffChainAddr, peerID.OffChainAddrString)
+		case errors.Is(err, idprovider.ErrPeerIDSignatureMissing),
+			errors.Is(err, idprovider.ErrPeerIDSignatureInvalid):
+			apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameSignature, peerID.Signature)
 		default:
 			apiErr = perun.NewAPIErrUnknownInternal(err)
 		}
@@ -362,6 +637,243 @@ func (s *Session) GetPeerID(alias string) (perun.PeerID, perun.APIError) {
 	return peerID, nil
 }
 
+// ExportPeerIDs exports all the peer IDs in the ID provider of the session, except the user's own peer ID,
+// encoded in the given format ("json" or "csv").
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed or when the ID provider cannot list all peer IDs.
+// - ErrInvalidArgument with Name:"format" when the format is not supported.
+// - ErrUnknownInternal.
+func (s *Session) ExportPeerIDs(format string) ([]byte, perun.APIError) {
+	s.WithField("method", "ExportPeerIDs").Info("Received request with params:", format)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("ExportPeerIDs", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return nil, apiErr
+	}
+	lister, ok := s.idProvider.(perun.IDLister)
+	if !ok {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrIDProviderNotListable)
+		return nil, apiErr
+	}
+
+	allPeerIDs := lister.ReadAll()
+	peerIDs := make([]perun.PeerID, 0, len(allPeerIDs))
+	for i := range allPeerIDs {
+		if allPeerIDs[i].Alias != perun.OwnAlias {
+			peerIDs = append(peerIDs, allPeerIDs[i])
+		}
+	}
+	data, err := idprovider.EncodePeerIDs(peerIDs, format)
+	if err != nil {
+		if errors.Is(err, idprovider.ErrUnsupportedFormat) {
+			apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameFormat, format)
+		} else {
+			apiErr = perun.NewAPIErrUnknownInternal(err)
+		}
+		return nil, apiErr
+	}
+	s.WithField("method", "ExportPeerIDs").Infof("Exported %d peer IDs", len(peerIDs))
+	return data, nil
+}
+
+// ImportPeerIDs imports the peer IDs encoded in the given format ("json" or "csv") into the ID provider of the
+// session. The conflicts with existing peer IDs are resolved using the merge strategy. See
+// idprovider.ImportPeerIDs for details.
+//
+// If dryRun is true, the ID provider is not updated, but the returned report describes what would have been
+// done. Invalid and conflicting records are listed in the report and do not cause an error.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrInvalidArgument with Name:"format" when the format is not supported.
+// - ErrInvalidArgument with Name:"peerIDsData" when the data cannot be decoded.
+// - ErrInvalidArgument with Name:"mergeStrategy" when the merge strategy is not supported.
+func (s *Session) ImportPeerIDs(data []byte, format string, strategy perun.PeerIDMergeStrategy, dryRun bool) (
+	perun.PeerIDImportReport, perun.APIError) {
+	s.WithField("method", "ImportPeerIDs").Info("Received request with params:", format, strategy, dryRun)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("ImportPeerIDs", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return perun.PeerIDImportReport{}, apiErr
+	}
+	records, err := idprovider.DecodePeerIDs(data, format)
+	if err != nil {
+		if errors.Is(err, idprovider.ErrUnsupportedFormat) {
+			apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameFormat, format)
+		} else {
+			apiErr = perun.NewAPIErrInvalidArgument(err, ArgNamePeerIDsData, format)
+		}
+		return perun.PeerIDImportReport{}, apiErr
+	}
+	report, err := idprovider.ImportPeerIDs(s.idProvider, walletBackend, records, strategy, dryRun)
+	if err != nil {
+		apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameMergeStrategy, string(strategy))
+		return perun.PeerIDImportReport{}, apiErr
+	}
+	s.WithField("method", "ImportPeerIDs").Infof(
+		"Imported peer IDs (applied: %t): added %d, overwritten %d, unchanged %d, conflicts %d, invalid %d",
+		report.Applied, len(report.Added), len(report.Overwritten), len(report.Unchanged),
+		len(report.Conflicts), len(report.Invalid))
+	return report, nil
+}
+
+// GetPeerStatus returns the connectivity status of the peers with the given aliases. If no aliases are given,
+// it returns the status of all the peers that were dialed or connected in this session, sorted by their
+// off-chain addresses.
+//
+// Peers are reported as connected, once a message is received from them over a connection. Round trip
+// times are measured only when the pings are enabled in the session config.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrResourceNotFound with ResourceType: "peerID" when any of the peer aliases are not known.
+func (s *Session) GetPeerStatus(aliases []string) ([]perun.PeerStatus, perun.APIError) {
+	s.WithField("method", "GetPeerStatus").Info("Received request with params:", aliases)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("GetPeerStatus", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return nil, apiErr
+	}
+
+	if len(aliases) == 0 {
+		statuses := s.connMonitor.Statuses()
+		peerStatuses := make([]perun.PeerStatus, len(statuses))
+		for i := range statuses {
+			peerStatuses[i] = s.toPeerStatus(statuses[i])
+		}
+		sort.Slice(peerStatuses, func(i, j int) bool {
+			return peerStatuses[i].OffChainAddr < peerStatuses[j].OffChainAddr
+		})
+		return peerStatuses, nil
+	}
+
+	peerStatuses := make([]perun.PeerStatus, len(aliases))
+	for i, alias := range aliases {
+		peerID, isPresent := s.idProvider.ReadByAlias(alias)
+		if !isPresent {
+			apiErr = perun.NewAPIErrResourceNotFound(ResTypePeerID, alias)
+			return nil, apiErr
+		}
+		peerStatuses[i] = s.toPeerStatus(s.connMonitor.Status(peerID.OffChainAddr))
+	}
+	return peerStatuses, nil
+}
+
+// SubPeerStatus subscribes to notifications when any peer is connected or disconnected, or when dialing
+// a peer fails. Only one subscription can be made at a time in a session.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrResourceExists with ResourceType: "peerStatusSub" when a subscription already exists.
+func (s *Session) SubPeerStatus(notifier perun.PeerStatusNotifier) perun.APIError {
+	s.WithField("method", "SubPeerStatus").Info("Received request with params:", notifier)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("SubPeerStatus", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return apiErr
+	}
+	if s.peerStatusNotifier != nil {
+		apiErr = perun.NewAPIErrResourceExists(ResTypePeerStatusSub, s.ID())
+		return apiErr
+	}
+
+	s.peerStatusNotifier = notifier
+	s.connMonitor.SetNotifier(func(status monitor.Status) {
+		notifier(s.toPeerStatus(status))
+	})
+	s.WithField("method", "SubPeerStatus").Info("Subscribed successfully")
+	return nil
+}
+
+// UnsubPeerStatus unsubscribes from peer connectivity notifications in the session.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPrecondition when the session is closed.
+// - ErrResourceNotFound with ResourceType: "peerStatusSub" when a subscription does not exist.
+func (s *Session) UnsubPeerStatus() perun.APIError {
+	s.WithField("method", "UnsubPeerStatus").Info("Received request")
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("UnsubPeerStatus", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return apiErr
+	}
+	if s.peerStatusNotifier == nil {
+		apiErr = perun.NewAPIErrResourceNotFound(ResTypePeerStatusSub, s.ID())
+		return apiErr
+	}
+
+	s.peerStatusNotifier = nil
+	s.connMonitor.SetNotifier(nil)
+	s.WithField("method", "UnsubPeerStatus").Info("Unsubscribed successfully")
+	return nil
+}
+
+// toPeerStatus converts the status reported by the connectivity monitor to the peer status. The alias
+// is looked up from the ID provider.
+func (s *Session) toPeerStatus(status monitor.Status) perun.PeerStatus {
+	peerStatus := perun.PeerStatus{
+		OffChainAddr: status.Peer.String(),
+		Connected:    status.Connected,
+		RTT:          status.RTT,
+	}
+	if peerID, isPresent := s.idProvider.ReadByOffChainAddr(status.Peer); isPresent {
+		peerStatus.Alias = peerID.Alias
+	}
+	if !status.LastSeen.IsZero() {
+		peerStatus.LastSeen = status.LastSeen.Unix()
+	}
+	if status.LastError != nil {
+		peerStatus.LastError = status.LastError.Error()
+	}
+	return peerStatus
+}
+
 // OpenCh proposes a channel to the participants with the specified opening
 // balance and app, funds it on the blockchain when the proposal is accepted
 // and sets it up for off-chain transactions when all the participants have
@@ -375,6 +887,7 @@ func (s *Session) GetPeerID(alias string) (perun.PeerID, perun.APIError) {
 // - ErrResourceNotFound with ResourceType: "peerID" when any of the peer aliases are not known.
 // - ErrResourceNotFound with ResourceType: "currency" when the currency is not known.
 // - ErrInvalidArgument with Name:"amount" when any of the amounts is invalid.
+// - ErrSpendingLimitExceeded when the amount funded by the user exceeds the spending limit.
 // - ErrPeerRequestTimedOut when peer request times out.
 // - ErrPeerRejected when peer rejects the request.
 // - ErrPeerNotFunded when peer did not fund the channel in time.
@@ -414,6 +927,10 @@ func (s *Session) OpenCh(pctx context.Context, openingBalInfo perun.BalInfo, app
 	if apiErr != nil {
 		return perun.ChInfo{}, apiErr
 	}
+	apiErr = s.spending.checkChFunding(openingBalInfo.Parts, currencies, allocation.Balances)
+	if apiErr != nil {
+		return perun.ChInfo{}, apiErr
+	}
 	updateAssetsInFunder(currencies, s.contractRegistry, s.funder, s.user.OnChain.Addr)
 
 	proposal, err := pclient.NewLedgerChannelProposal(challengeDurSecs, s.user.OffChainAddr, allocation,
@@ -422,15 +939,22 @@ func (s *Session) OpenCh(pctx context.Context, openingBalInfo perun.BalInfo, app
 		apiErr = perun.NewAPIErrUnknownInternal(errors.WithMessage(err, "constructing channel proposal"))
 		return perun.ChInfo{}, apiErr
 	}
-	ctx, cancel := context.WithTimeout(pctx, s.timeoutCfg.proposeCh(challengeDurSecs))
+	startBlock, startBlockErr := s.chain.BlockNumber()
+	txCosts := blockchain.NewTxCostRecorder()
+	ctx, cancel := context.WithTimeout(blockchain.WithTxCostRecorder(pctx, txCosts),
+		s.timeoutCfg.proposeCh(challengeDurSecs))
 	defer cancel()
 	pch, err := s.chClient.ProposeChannel(ctx, proposal)
 	if err != nil {
+		s.recordOrphanedTxCosts(txCosts)
 		apiErr = s.handleProposeChError(openingBalInfo.Parts, errors.WithMessage(err, "proposing channel"))
 		return perun.ChInfo{}, apiErr
 	}
+	s.recordChStartBlock(pch.ID(), startBlock, startBlockErr)
+	s.chTxCosts.track(fmt.Sprintf("%x", pch.ID()), txCosts)
 
-	ch := newCh(pch, s.chainURL, currencies, openingBalInfo.Parts, s.timeoutCfg, challengeDurSecs)
+	ch := newCh(pch, s.chainURL, currencies, openingBalInfo.Parts, s.timeoutCfg, challengeDurSecs,
+		s.txFeeCurrency(), txCosts)
 	s.addCh(ch)
 	s.WithFields(log.Fields{"method": "OpenCh", "channelID": ch.ID()}).Info("Channel opened successfully")
 	return ch.GetChInfo(), nil
@@ -537,11 +1061,11 @@ func retrievePartIDs(aliases []string, idProvider perun.IDReader) ([]perun.PeerI
 	return partIDs, nil
 }
 
-// registerParts will register the given parts to the passed registry.
-func registerParts(parts []perun.PeerID, r perun.Registerer) {
+// registerParts will register the comm endpoints of the given parts to the passed registry.
+func registerParts(parts []perun.PeerID, r perun.EndpointsRegisterer) {
 	for idx := range parts {
 		if parts[idx].Alias != perun.OwnAlias { // Skip own alias.
-			r.Register(parts[idx].OffChainAddr, parts[idx].CommAddr)
+			r.RegisterEndpoints(parts[idx].OffChainAddr, parts[idx].CommEndpoints())
 		}
 	}
 }
@@ -612,6 +1136,13 @@ func updateAssetsInFunder(currs []perun.Currency, contractRegistry perun.ROContr
 // addCh adds the channel to session. It locks the session mutex during the operation.
 func (s *Session) addCh(ch *Channel) {
 	ch.Logger = log.NewDerivedLoggerWithField(s.Logger, "channel-id", ch.id)
+	ch.chUpdateMetas = s.chUpdateMetas
+	ch.onChUpdateAccepted = s.handleAcceptedChUpdate
+	ch.history = s.chHistory
+	ch.receipts = s.receipts
+	ch.spending = s.spending
+	ch.prices = s.prices
+	ch.onClose = s.stopPayStreams
 	s.Lock()
 	s.chs.put(ch)
 	s.Unlock()
@@ -810,6 +1341,8 @@ func (s *Session) UnsubChProposals() perun.APIError {
 // - ErrResourceNotFound with ResourceType: "proposal" when proposal ID is not known.
 // - ErrPeerNotFunded when peer did not fund the channel in time.
 // - ErrUserResponseTimedOut when user responded after time out expired.
+// - ErrSpendingLimitExceeded when the amount to be funded by the user exceeds the spending limit. The proposal
+// is rejected in this case.
 // - ErrTxTimedOut with TxType: "Fund" when there is tx timed error while funding.
 // - ErrChainNotReachable when connection to blockchain drops while funding.
 // - ErrUnknownInternal.
@@ -865,17 +1398,29 @@ func (s *Session) acceptChProposal(pctx context.Context, entry chProposalRespond
 	defer cancel()
 
 	proposal := entry.proposal
+	apiErr := s.spending.checkChFunding(entry.notif.OpeningBalInfo.Parts, entry.currencies, proposal.InitBals.Balances)
+	if apiErr != nil {
+		// nolint: errcheck              // It is sufficient to return the spending limit error.
+		s.rejectChProposal(pctx, entry.responder, "spending limit exceeded")
+		return perun.ChInfo{}, apiErr
+	}
 	resp := proposal.Accept(s.user.OffChainAddr, pclient.WithRandomNonce())
 
 	updateAssetsInFunder(entry.currencies, s.contractRegistry, s.funder, s.user.OnChain.Addr)
-	pch, err := entry.responder.Accept(ctx, resp)
+	startBlock, startBlockErr := s.chain.BlockNumber()
+	txCosts := blockchain.NewTxCostRecorder()
+	pch, err := entry.responder.Accept(blockchain.WithTxCostRecorder(ctx, txCosts), resp)
 	if err != nil {
+		s.recordOrphanedTxCosts(txCosts)
 		err = errors.WithMessage(err, "accepting channel proposal")
 		return perun.ChInfo{}, s.handleChProposalAcceptError(entry.notif.OpeningBalInfo.Parts, err)
 	}
+	s.recordChStartBlock(pch.ID(), startBlock, startBlockErr)
+	s.chTxCosts.track(fmt.Sprintf("%x", pch.ID()), txCosts)
 
 	parts := entry.notif.OpeningBalInfo.Parts
-	ch := newCh(pch, s.chainURL, entry.currencies, parts, s.timeoutCfg, entry.notif.ChallengeDurSecs)
+	ch := newCh(pch, s.chainURL, entry.currencies, parts, s.timeoutCfg, entry.notif.ChallengeDurSecs,
+		s.txFeeCurrency(), txCosts)
 	s.addCh(ch)
 	s.WithFields(log.Fields{"method": "RespondChProposal", "channelID": ch.ID()}).Info("Channel opened successfully")
 	return ch.getChInfo(), nil
@@ -947,6 +1492,66 @@ func (s *Session) GetCh(chID string) (perun.ChAPI, perun.APIError) {
 	return ch, nil
 }
 
+// GetOpenChsWithPeer is an internal API that retrieves the channel API instances of all the open channels with
+// the peer, in the order in which they were added to the session.
+//
+// The channel instances are safe for concurrent use.
+func (s *Session) GetOpenChsWithPeer(peerAlias string) []perun.ChAPI {
+	s.WithField("method", "GetOpenChsWithPeer").Info("Received request with params:", peerAlias)
+
+	s.Lock()
+	defer s.Unlock()
+	return s.openChsWithPeer(peerAlias)
+}
+
+// openChsWithPeer returns all the open channels with the peer. It should be called with the session locked.
+func (s *Session) openChsWithPeer(peerAlias string) []perun.ChAPI {
+	chs := []perun.ChAPI{}
+	s.chs.forEach(func(_ int, ch *Channel) {
+		if !containsString(ch.parts, peerAlias) {
+			return
+		}
+		ch.Lock()
+		if ch.status == open {
+			chs = append(chs, ch)
+		}
+		ch.Unlock()
+	})
+	return chs
+}
+
+// containsString returns true, if the list contains the string.
+func containsString(list []string, s string) bool {
+	for i := range list {
+		if list[i] == s {
+			return true
+		}
+	}
+	return false
+}
+
+// PayStreams returns the payment streams of the session. These are created using the given function on first
+// use and are stopped when the session or their channel is closed.
+func (s *Session) PayStreams(newPayStreams func() perun.PayStreams) perun.PayStreams {
+	s.payStreamsMutex.Lock()
+	defer s.payStreamsMutex.Unlock()
+
+	if s.payStreams == nil {
+		s.payStreams = newPayStreams()
+	}
+	return s.payStreams
+}
+
+// stopPayStreams stops the payment streams on the channel, as it has been closed.
+func (s *Session) stopPayStreams(chID string) {
+	s.payStreamsMutex.Lock()
+	defer s.payStreamsMutex.Unlock()
+
+	if s.payStreams != nil {
+		s.payStreams.StopCh(chID)
+	}
+}
+
 // HandleUpdate is a handler to be registered on the channel client for
 // processing incoming channel updates.  This function just identifies the
 // channel to which update is received and invokes the handler for that
@@ -998,6 +1603,9 @@ func (s *Session) HandleUpdateWInterface(
 //     for channel participants in any of the those open open channels to register
 //     an older, invalid state on the blockchain and finalize it.
 //
+// Runs of the scheduled payments that are in progress are aborted, even if the session is not closed. These are
+// recorded as missed, when the scheduler is started again.
+//
 // If there is an error, it will be one of the following codes:
 // - ErrFailedPrecondition when the session is closed.
 // - ErrFailedPreCondition when force=false and unclosed channels exists.
@@ -1006,6 +1614,12 @@ func (s *Session) HandleUpdateWInterface(
 // - ErrUnknownInternal.
 func (s *Session) Close(force bool) ([]perun.ChInfo, perun.APIError) {
 	s.WithField("method", "Close").Infof("\nReceived request with params %+v", force)
+	s.closeMutex.Lock()
+	defer s.closeMutex.Unlock()
+
+	// Runs of the scheduled payments that are in progress need the session and channel locks to complete. So,
+	// the scheduler is closed before acquiring these and is reopened, if the session is not closed.
+	s.scheduler.close()
 	s.Lock()
 	defer s.Unlock()
 
@@ -1048,12 +1662,14 @@ func (s *Session) Close(force bool) ([]perun.ChInfo, perun.APIError) {
 
 	if len(unexpectedPhaseChIDs) != 0 {
 		s.unlockAllChs()
+		s.scheduler.reopen(time.Now().UTC())
 		err := errors.New("session cannot be closed with channels in unexpected phase")
 		apiErr = perun.NewAPIErrFailedPreConditionUnclosedChs(err, unexpectedPhaseChIDs)
 		return nil, apiErr
 	}
 	if !force && len(openChsInfo) != 0 {
 		s.unlockAllChs()
+		s.scheduler.reopen(time.Now().UTC())
 		err := errors.New("session cannot be closed with channels in open phase without force option")
 		apiErr = perun.NewAPIErrFailedPreConditionUnclosedChs(err, openChsInfo)
 		return nil, apiErr
@@ -1071,6 +1687,15 @@ func (s *Session) unlockAllChs() {
 }
 
 func (s *Session) close() perun.APIError {
+	if s.stopIDProviderWatch != nil {
+		s.stopIDProviderWatch()
+	}
+	s.payStreamsMutex.Lock()
+	if s.payStreams != nil {
+		s.payStreams.StopAll()
+	}
+	s.payStreamsMutex.Unlock()
+	s.connMonitor.Close() // nolint: errcheck, gosec  // Only stops the go-routines of the monitor.
 	s.user.OnChain.Wallet.LockAll()
 	s.user.OffChain.Wallet.LockAll()
 	err := errors.WithMessage(s.chClient.Close(), "closing session")
@@ -1120,6 +1745,303 @@ func (s *Session) DeployAssetERC20(tokenAddr string) (assetAddr string, _ perun.
 	return asset.String(), nil
 }
 
+// GetOnChainBal returns the balance of the on-chain account of the session
+// user for the given currency.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPreCondition when session is closed
+// - ErrResourceNotFound with Type:"currency", when currency is not registered.
+// - ErrChainNotReachable when connection to blockchain drops while reading balance.
+// - ErrUnknownInternal.
+func (s *Session) GetOnChainBal(currency string) (string, perun.APIError) {
+	s.WithField("method", "GetOnChainBal").Infof("\nReceived request with params %+v", currency)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("GetOnChainBal", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return "", apiErr
+	}
+	if !s.currencyRegistry.IsRegistered(currency) {
+		apiErr = perun.NewAPIErrResourceNotFound(ResTypeCurrency, currency)
+		return "", apiErr
+	}
+
+	var bal *big.Int
+	var err error
+	if token, isERC20 := s.contractRegistry.Token(currency); isERC20 {
+		bal, err = s.chain.BalanceAtERC20(token, s.user.OnChain.Addr)
+	} else {
+		bal, err = s.chain.BalanceAt(s.user.OnChain.Addr)
+	}
+	if err != nil {
+		if apiErr = handleChainError(s.chainURL, s.timeoutCfg.onChainTx.String(), err); apiErr != nil {
+			return "", apiErr
+		}
+		apiErr = perun.NewAPIErrUnknownInternal(err)
+		return "", apiErr
+	}
+	return s.currencyRegistry.Currency(currency).Print(bal), nil
+}
+
+// TransferOnChain transfers the given amount in the given currency from the
+// on-chain account of the session user to the given address. For ERC20
+// currencies, the tokens are transferred using the token contract.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPreCondition when session is closed
+// - ErrResourceNotFound with Type:"currency", when currency is not registered.
+// - ErrInvalidArgument with Name:"address", when the address cannot be parsed.
+// - ErrInvalidArgument with Name:"amount", when the amount cannot be parsed.
+// - ErrTxTimedOut when there is tx timed error while transferring.
+// - ErrChainNotReachable when connection to blockchain drops while transferring.
+// - ErrUnknownInternal.
+func (s *Session) TransferOnChain(currency, to, amount string) (string, perun.APIError) {
+	s.WithField("method", "TransferOnChain").Infof("\nReceived request with params %+v, %+v, %+v",
+		currency, to, amount)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("TransferOnChain", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return "", apiErr
+	}
+	if !s.currencyRegistry.IsRegistered(currency) {
+		apiErr = perun.NewAPIErrResourceNotFound(ResTypeCurrency, currency)
+		return "", apiErr
+	}
+	toAddr, err := walletBackend.ParseAddr(to)
+	if err != nil {
+		apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameAddress, to)
+		return "", apiErr
+	}
+	amountBaseUnit, err := s.currencyRegistry.Currency(currency).Parse(amount)
+	if err != nil {
+		apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameAmount, amount)
+		return "", apiErr
+	}
+
+	var txID string
+	if token, isERC20 := s.contractRegistry.Token(currency); isERC20 {
+		txID, err = s.chain.TransferERC20(token, toAddr, amountBaseUnit, s.user.OnChain.Addr)
+	} else {
+		txID, err = s.chain.TransferETH(toAddr, amountBaseUnit, s.user.OnChain.Addr)
+	}
+	if err != nil {
+		if apiErr = handleChainError(s.chainURL, s.timeoutCfg.onChainTx.String(), err); apiErr != nil {
+			return "", apiErr
+		}
+		apiErr = perun.NewAPIErrUnknownInternal(err)
+		return "", apiErr
+	}
+	return txID, nil
+}
+
+// GetAllowance returns the amount of tokens in the given ERC20 currency that
+// the asset holder contract for that currency is allowed to withdraw from the
+// on-chain account of the session user.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPreCondition when session is closed
+// - ErrResourceNotFound with Type:"currency", when currency is not registered.
+// - ErrInvalidArgument with Name:"currency", when currency is not an ERC20 token.
+// - ErrChainNotReachable when connection to blockchain drops while reading allowance.
+// - ErrUnknownInternal.
+func (s *Session) GetAllowance(currency string) (string, perun.APIError) {
+	s.WithField("method", "GetAllowance").Infof("\nReceived request with params %+v", currency)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("GetAllowance", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return "", apiErr
+	}
+	token, asset, apiErr := s.erc20Contracts(currency)
+	if apiErr != nil {
+		return "", apiErr
+	}
+
+	allowance, err := s.chain.AllowanceERC20(token, s.user.OnChain.Addr, asset)
+	if err != nil {
+		if apiErr = handleChainError(s.chainURL, s.timeoutCfg.onChainTx.String(), err); apiErr != nil {
+			return "", apiErr
+		}
+		apiErr = perun.NewAPIErrUnknownInternal(err)
+		return "", apiErr
+	}
+	return s.currencyRegistry.Currency(currency).Print(allowance), nil
+}
+
+// SetAllowance sets the amount of tokens in the given ERC20 currency that the
+// asset holder contract for that currency is allowed to withdraw from the
+// on-chain account of the session user. An amount of "0" revokes the
+// allowance.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPreCondition when session is closed
+// - ErrResourceNotFound with Type:"currency", when currency is not registered.
+// - ErrInvalidArgument with Name:"currency", when currency is not an ERC20 token.
+// - ErrInvalidArgument with Name:"amount", when the amount cannot be parsed.
+// - ErrTxTimedOut when there is tx timed error while approving.
+// - ErrChainNotReachable when connection to blockchain drops while approving.
+// - ErrUnknownInternal.
+func (s *Session) SetAllowance(currency, amount string) (string, perun.APIError) {
+	s.WithField("method", "SetAllowance").Infof("\nReceived request with params %+v, %+v", currency, amount)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("SetAllowance", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return "", apiErr
+	}
+	token, asset, apiErr := s.erc20Contracts(currency)
+	if apiErr != nil {
+		return "", apiErr
+	}
+	allowance := big.NewInt(0)
+	if amount != "0" {
+		var err error
+		if allowance, err = s.currencyRegistry.Currency(currency).Parse(amount); err != nil {
+			apiErr = perun.NewAPIErrInvalidArgument(err, ArgNameAmount, amount)
+			return "", apiErr
+		}
+	}
+
+	txID, err := s.chain.ApproveERC20(token, asset, allowance, s.user.OnChain.Addr)
+	if err != nil {
+		if apiErr = handleChainError(s.chainURL, s.timeoutCfg.onChainTx.String(), err); apiErr != nil {
+			return "", apiErr
+		}
+		apiErr = perun.NewAPIErrUnknownInternal(err)
+		return "", apiErr
+	}
+	return txID, nil
+}
+
+// GetTxCostReport returns the costs of all the on-chain transactions sent by
+// the node for this session: for each of the channels, for the channels that
+// could not be opened and for the other operations such as deploying contracts
+// or transferring funds on the blockchain.
+//
+// Costs of the transactions sent before a channel was restored from the
+// persistence are not included.
+func (s *Session) GetTxCostReport() (perun.TxCostReport, perun.APIError) {
+	s.WithField("method", "GetTxCostReport").Info("Received request")
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("GetTxCostReport", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return perun.TxCostReport{}, apiErr
+	}
+
+	feeCurrency := s.txFeeCurrency()
+	totalFee := big.NewInt(0)
+	report := perun.TxCostReport{}
+	addCosts := func(chID string, costs []perun.TxCost) {
+		for i := range costs {
+			report.TxCosts = append(report.TxCosts, perun.TxCostInfo{
+				ChID:    chID,
+				TxType:  costs[i].TxType,
+				TxID:    costs[i].TxID,
+				GasUsed: costs[i].GasUsed,
+				Fee:     feeCurrency.Print(costs[i].Fee),
+			})
+			totalFee.Add(totalFee, costs[i].Fee)
+		}
+	}
+
+	addCosts("", s.chain.TxCosts())
+	addCosts("", s.txCosts.Costs())
+	s.chs.forEach(func(_ int, ch *Channel) {
+		addCosts(ch.ID(), ch.txCosts.Costs())
+	})
+	report.TotalFee = feeCurrency.Print(totalFee)
+	return report, nil
+}
+
+// txFeeCurrency returns the currency in which the fees for on-chain
+// transactions are paid.
+func (s *Session) txFeeCurrency() perun.Currency {
+	return s.currencyRegistry.Currency(currency.ETHSymbol)
+}
+
+// recordChStartBlock persists the block read before opening the channel, so
+// that adjudicator events are read only from this block onwards when the
+// channel is restored. Errors are only logged, as the channel is already open
+// and the configured start block will be used instead.
+func (s *Session) recordChStartBlock(chID pchannel.ID, block uint64, readErr error) {
+	if readErr != nil {
+		s.Errorf("Reading start block for channel %x: %v", chID, readErr)
+		return
+	}
+	if err := s.chStartBlocks.add(fmt.Sprintf("%x", chID), block); err != nil {
+		s.Errorf("Persisting start block for channel %x: %v", chID, err)
+	}
+}
+
+// recordOrphanedTxCosts records the costs of the transactions sent for a
+// channel that could not be opened, so that they are included in the report.
+func (s *Session) recordOrphanedTxCosts(txCosts *blockchain.TxCostRecorder) {
+	for _, cost := range txCosts.Costs() {
+		s.txCosts.Record(cost)
+	}
+}
+
+// erc20Contracts returns the token and asset holder contracts for the given
+// currency. It returns an error if the currency is not registered or if it is
+// not an ERC20 token.
+func (s *Session) erc20Contracts(currency string) (token, asset pwallet.Address, _ perun.APIError) {
+	if !s.currencyRegistry.IsRegistered(currency) {
+		return nil, nil, perun.NewAPIErrResourceNotFound(ResTypeCurrency, currency)
+	}
+	token, isERC20 := s.contractRegistry.Token(currency)
+	if !isERC20 {
+		return nil, nil, perun.NewAPIErrInvalidArgument(ErrNotERC20Currency, ArgNameCurrency, currency)
+	}
+	asset, found := s.contractRegistry.Asset(currency)
+	if !found {
+		err := errors.New("asset contract not found for registered token " + currency)
+		return nil, nil, perun.NewAPIErrUnknownInternal(err)
+	}
+	return token, asset, nil
+}
+
 // handleFundingTimeoutError inspects if the passed error is an funding timeout error.
 // If yes, it constructs & returns an APIError. If not, returns nil
 //
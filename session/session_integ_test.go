@@ -158,6 +158,24 @@ func Test_Integ_New(t *testing.T) {
 		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidConfig, "")
 		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "commType", cfgCopy.User.CommType)
 	})
+	t.Run("invalidConfig_tls_certFile_missing", func(t *testing.T) {
+		cfgCopy := cfg
+		cfgCopy.DatabaseDir = newDatabaseDir(t)
+		cfgCopy.User.CommType = "tls"
+		_, err := session.New(cfgCopy, currencies, contracts)
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidConfig, "")
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "tls.certFile", "")
+	})
+	t.Run("invalidConfig_tls", func(t *testing.T) {
+		cfgCopy := cfg
+		cfgCopy.DatabaseDir = newDatabaseDir(t)
+		cfgCopy.User.TLS.CertFile = "invalid-cert-file"
+		_, err := session.New(cfgCopy, currencies, contracts)
+		require.Error(t, err)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidConfig, "")
+		peruntest.AssertErrInfoInvalidConfig(t, err.AddInfo(), "tls", fmt.Sprintf("%+v", cfgCopy.User.TLS))
+	})
 	t.Run("invalidConfig_commAddr", func(t *testing.T) {
 		cfgCopy := cfg
 		cfgCopy.DatabaseDir = newDatabaseDir(t)
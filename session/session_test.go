@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -39,7 +40,10 @@ import (
 	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
 	"github.com/hyperledger-labs/perun-node/currency"
 	"github.com/hyperledger-labs/perun-node/currency/currencytest"
+	"github.com/hyperledger-labs/perun-node/idprovider"
+	"github.com/hyperledger-labs/perun-node/idprovider/idprovidertest"
 	"github.com/hyperledger-labs/perun-node/idprovider/local"
+	"github.com/hyperledger-labs/perun-node/idprovider/remote"
 	"github.com/hyperledger-labs/perun-node/internal/mocks"
 	"github.com/hyperledger-labs/perun-node/peruntest"
 	"github.com/hyperledger-labs/perun-node/session"
@@ -109,6 +113,15 @@ func Test_Session_AddPeerID(t *testing.T) {
 		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameOffChainAddr, argumentValue)
 	})
 
+	t.Run("peerID_invalid_signature", func(t *testing.T) {
+		peer2WithInvalidSig := peerIDs[2]
+		peer2WithInvalidSig.Signature = "0x1234"
+		err := openSession.AddPeerID(peer2WithInvalidSig)
+
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameSignature, peer2WithInvalidSig.Signature)
+	})
+
 	t.Run("session_closed", func(t *testing.T) {
 		err := closedSession.AddPeerID(peerIDs[0])
 
@@ -146,6 +159,205 @@ func Test_Session_GetPeerID(t *testing.T) {
 	})
 }
 
+func Test_Session_ExportPeerIDs_ImportPeerIDs(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(2))
+	// In exportSession, peer0 and peer1 are present. In importSession, only peer0 is present.
+	exportSession, _, _ := newSessionWMockChClient(t, true, peerIDs...)
+	importSession, _, _ := newSessionWMockChClient(t, true, peerIDs[0])
+	closedSession, _, _ := newSessionWMockChClient(t, false, peerIDs[0])
+
+	var data []byte
+	t.Run("happy_export", func(t *testing.T) {
+		var err perun.APIError
+		data, err = exportSession.ExportPeerIDs(idprovider.FormatCSV)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), perun.OwnAlias+",")
+	})
+
+	t.Run("happy_import_dry_run", func(t *testing.T) {
+		report, err := importSession.ImportPeerIDs(data, idprovider.FormatCSV, perun.MergeAbort, true)
+		require.NoError(t, err)
+		assert.False(t, report.Applied)
+		assert.Equal(t, []string{peerIDs[1].Alias}, report.Added)
+		assert.Equal(t, []string{peerIDs[0].Alias}, report.Unchanged)
+
+		_, err = importSession.GetPeerID(peerIDs[1].Alias)
+		assert.Error(t, err)
+	})
+
+	t.Run("happy_import", func(t *testing.T) {
+		report, err := importSession.ImportPeerIDs(data, idprovider.FormatCSV, perun.MergeAbort, false)
+		require.NoError(t, err)
+		assert.True(t, report.Applied)
+
+		peerID, err := importSession.GetPeerID(peerIDs[1].Alias)
+		require.NoError(t, err)
+		assert.True(t, local.PeerIDEqual(peerID, peerIDs[1]))
+	})
+
+	t.Run("unsupported_format", func(t *testing.T) {
+		_, err := exportSession.ExportPeerIDs("xml")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameFormat, "xml")
+
+		_, err = importSession.ImportPeerIDs(data, "xml", perun.MergeAbort, false)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameFormat, "xml")
+	})
+
+	t.Run("invalid_data", func(t *testing.T) {
+		_, err := importSession.ImportPeerIDs([]byte("{invalid"), idprovider.FormatJSON, perun.MergeAbort, false)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNamePeerIDsData, idprovider.FormatJSON)
+	})
+
+	t.Run("unsupported_merge_strategy", func(t *testing.T) {
+		_, err := importSession.ImportPeerIDs(data, idprovider.FormatCSV, "invalid", false)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameMergeStrategy, "invalid")
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		wantMessage := session.ErrSessionClosed.Error()
+		_, err := closedSession.ExportPeerIDs(idprovider.FormatJSON)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, wantMessage)
+
+		_, err = closedSession.ImportPeerIDs(data, idprovider.FormatCSV, perun.MergeAbort, false)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, wantMessage)
+	})
+}
+
+func Test_Session_GetPeerStatus(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	openSession, _, _ := newSessionWMockChClient(t, true, peerIDs...)
+	closedSession, _, _ := newSessionWMockChClient(t, false, peerIDs...)
+
+	t.Run("happy_not_connected", func(t *testing.T) {
+		statuses, err := openSession.GetPeerStatus([]string{peerIDs[0].Alias})
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		assert.Equal(t, perun.PeerStatus{Alias: peerIDs[0].Alias, OffChainAddr: peerIDs[0].OffChainAddrString},
+			statuses[0])
+	})
+
+	t.Run("happy_all", func(t *testing.T) {
+		statuses, err := openSession.GetPeerStatus(nil)
+		require.NoError(t, err)
+		assert.Empty(t, statuses)
+	})
+
+	t.Run("unknown_alias", func(t *testing.T) {
+		_, err := openSession.GetPeerStatus([]string{"unknown-alias"})
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), session.ResTypePeerID, "unknown-alias")
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		_, err := closedSession.GetPeerStatus(nil)
+		wantMessage := session.ErrSessionClosed.Error()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, wantMessage)
+	})
+}
+
+func Test_SubUnsubPeerStatus(t *testing.T) {
+	dummyNotifier := func(notif perun.PeerStatus) {}
+	openSession, _, _ := newSessionWMockChClient(t, true)
+	closedSession, _, _ := newSessionWMockChClient(t, false)
+
+	// Note: All sub tests are written at the same level because each sub test modifies the state of session
+	// and the order of execution needs to be maintained.
+
+	// == SubTest 1: Sub successfully ==
+	err := openSession.SubPeerStatus(dummyNotifier)
+	require.NoError(t, err)
+
+	// == SubTest 2: Sub again, should error ==
+	err = openSession.SubPeerStatus(dummyNotifier)
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceExists)
+	peruntest.AssertErrInfoResourceExists(t, err.AddInfo(), session.ResTypePeerStatusSub, openSession.ID())
+
+	// == SubTest 3: Unsub successfully ==
+	err = openSession.UnsubPeerStatus()
+	require.NoError(t, err)
+
+	// == SubTest 4: Unsub again, should error ==
+	err = openSession.UnsubPeerStatus()
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+	peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), session.ResTypePeerStatusSub, openSession.ID())
+
+	t.Run("session_closed", func(t *testing.T) {
+		wantMessage := session.ErrSessionClosed.Error()
+		err = closedSession.SubPeerStatus(dummyNotifier)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, wantMessage)
+		err = closedSession.UnsubPeerStatus()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition, wantMessage)
+	})
+}
+
+func Test_Session_WatchIDProvider(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(2))
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	cfg := sessiontest.NewConfigT(t, rng, peerIDs[0])
+	cfg.IDProviderWatchInterval = 10 * time.Millisecond
+	chClient := &mocks.ChClient{}
+	rng = rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+	s, err := session.NewSessionForTest(cfg, true, chClient, chainSetup)
+	require.NoError(t, err)
+
+	registered := make(chan []perun.CommEndpoint, 1)
+	chClient.On("RegisterEndpoints", peerIDs[1].OffChainAddr, mock.Anything).Run(func(args mock.Arguments) {
+		registered <- args.Get(1).([]perun.CommEndpoint)
+	}).Return()
+
+	// Add a peer ID to the ID provider file, as if it were done by another program.
+	externalIDProvider, err := local.NewIDprovider(cfg.IDProviderURL, ethereumtest.NewTestWalletBackend(), false)
+	require.NoError(t, err)
+	require.NoError(t, externalIDProvider.Write(peerIDs[1].Alias, peerIDs[1]))
+	require.NoError(t, externalIDProvider.UpdateStorage())
+
+	select {
+	case endpoints := <-registered:
+		assert.Equal(t, peerIDs[1].CommEndpoints(), endpoints)
+	case <-time.After(time.Second):
+		t.Fatal("comm endpoints of the added peer were not registered")
+	}
+	gotPeerID, apiErr := s.GetPeerID(peerIDs[1].Alias)
+	require.NoError(t, apiErr)
+	assert.True(t, local.PeerIDEqual(peerIDs[1], gotPeerID))
+
+	chClient.On("Close").Return(nil)
+	_, apiErr = s.Close(false)
+	require.NoError(t, apiErr)
+}
+
+func Test_Session_HTTPIDProvider(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	store, err := local.NewIDprovider(idprovidertest.NewIDProviderT(t), ethereumtest.NewTestWalletBackend(), false)
+	require.NoError(t, err)
+	server := httptest.NewServer(remote.NewServer(store, ethereumtest.NewTestWalletBackend(), nil))
+	t.Cleanup(server.Close)
+
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	cfg := sessiontest.NewConfigT(t, rng)
+	cfg.IDProviderType = "http"
+	cfg.IDProviderURL = server.URL
+	rng = rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+	s, err := session.NewSessionForTest(cfg, true, &mocks.ChClient{}, chainSetup)
+	require.NoError(t, err)
+
+	require.NoError(t, s.AddPeerID(peerIDs[0]))
+	gotPeerID, apiErr := s.GetPeerID(peerIDs[0].Alias)
+	require.NoError(t, apiErr)
+	assert.True(t, local.PeerIDEqual(peerIDs[0], gotPeerID))
+
+	_, isPresent := store.ReadByAlias(peerIDs[0].Alias)
+	assert.True(t, isPresent, "peer ID should be added to the directory service")
+	_, isPresent = store.ReadByAlias(perun.OwnAlias)
+	assert.False(t, isPresent, "own peer ID should not be added to the directory service")
+}
+
 func Test_Session_OpenCh(t *testing.T) {
 	peerIDs := newPeerIDs(t, uint(2))
 	validOpeningBalInfo := perun.BalInfo{
@@ -163,7 +375,7 @@ func Test_Session_OpenCh(t *testing.T) {
 		pch.On("State").Return(makeState(t, validOpeningBalInfo, false))
 		session, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
 		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil)
-		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 
 		chInfo, err := session.OpenCh(context.Background(), validOpeningBalInfo, app, 10)
 		require.NoError(t, err)
@@ -178,7 +390,7 @@ func Test_Session_OpenCh(t *testing.T) {
 		pch.On("State").Return(makeState(t, validOpeningBalInfo, false))
 		session, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
 		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil)
-		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 
 		chInfo, err := session.OpenCh(context.Background(), validOpeningBalInfo2, app, 10)
 		require.NoError(t, err)
@@ -189,7 +401,7 @@ func Test_Session_OpenCh(t *testing.T) {
 		ch, _ := newMockPCh()
 		sess, chClient, _ := newSessionWMockChClient(t, false, peerIDs...)
 		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(ch, nil)
-		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 
 		_, err := sess.OpenCh(context.Background(), validOpeningBalInfo, app, 10)
 		require.Error(t, err)
@@ -258,7 +470,7 @@ func Test_Session_OpenCh(t *testing.T) {
 		invalidOpeningBalInfo := validOpeningBalInfo
 		invalidOpeningBalInfo.Currencies = []string{"unknown-currency"}
 		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
-		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 
 		_, err := sess.OpenCh(context.Background(), invalidOpeningBalInfo, app, 10)
 
@@ -271,7 +483,7 @@ func Test_Session_OpenCh(t *testing.T) {
 		invalidOpeningBalInfo := validOpeningBalInfo
 		invalidOpeningBalInfo.Bals = [][]string{{"abc", "gef"}}
 		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
-		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 
 		_, err := sess.OpenCh(context.Background(), invalidOpeningBalInfo, app, 10)
 
@@ -282,7 +494,7 @@ func Test_Session_OpenCh(t *testing.T) {
 	t.Run("chClient_proposeChannel_AnError", func(t *testing.T) {
 		ch, _ := newMockPCh()
 		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
-		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(ch, assert.AnError)
 
 		_, err := sess.OpenCh(context.Background(), validOpeningBalInfo, app, 10)
@@ -295,7 +507,7 @@ func Test_Session_OpenCh(t *testing.T) {
 		peerRequestTimedOutError := pclient.RequestTimedOutError("some-error")
 		ch, _ := newMockPCh()
 		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
-		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(ch, peerRequestTimedOutError)
 
 		_, err := sess.OpenCh(context.Background(), validOpeningBalInfo, app, 10)
@@ -313,7 +525,7 @@ func Test_Session_OpenCh(t *testing.T) {
 		}
 		ch, _ := newMockPCh()
 		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
-		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(ch, peerRejectedError)
 
 		_, err := sess.OpenCh(context.Background(), validOpeningBalInfo, app, 10)
@@ -333,7 +545,7 @@ func Test_Session_OpenCh(t *testing.T) {
 		}
 		ch, _ := newMockPCh()
 		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
-		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(ch, fundingTimeoutError)
 
 		_, err := sess.OpenCh(context.Background(), validOpeningBalInfo, app, 10)
@@ -350,7 +562,7 @@ func Test_Session_OpenCh(t *testing.T) {
 		}
 		ch, _ := newMockPCh()
 		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
-		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(ch, fundingTxTimedOutError)
 
 		_, err := sess.OpenCh(context.Background(), validOpeningBalInfo, app, 10)
@@ -367,7 +579,7 @@ func Test_Session_OpenCh(t *testing.T) {
 		chainNotReachableError := pclient.ChainNotReachableError{}
 		ch, _ := newMockPCh()
 		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
-		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(ch, chainNotReachableError)
 
 		_, err := sess.OpenCh(context.Background(), validOpeningBalInfo, app, 10)
@@ -674,7 +886,7 @@ func Test_ProposeCh_GetChsInfo(t *testing.T) {
 		pch, _ := newMockPCh()
 		pch.On("State").Return(makeState(t, validOpeningBalInfo, false))
 		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil).Once()
-		chClient.On("Register", mock.Anything, mock.Anything).Return().Once()
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return().Once()
 
 		chInfo, err := session.OpenCh(context.Background(), validOpeningBalInfo, app, 10)
 		require.NoError(t, err)
@@ -733,7 +945,7 @@ func Test_ProposeCh_GetCh(t *testing.T) {
 	pch, _ := newMockPCh()
 	pch.On("State").Return(makeState(t, validOpeningBalInfo, false))
 	chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil)
-	chClient.On("Register", mock.Anything, mock.Anything).Return()
+	chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 
 	chInfo, err := sess.OpenCh(context.Background(), validOpeningBalInfo, app, 10)
 	require.NoError(t, err)
@@ -754,6 +966,40 @@ func Test_ProposeCh_GetCh(t *testing.T) {
 	})
 }
 
+func Test_ProposeCh_GetOpenChsWithPeer(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(2))
+	validOpeningBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	app := perun.App{
+		Def:  pchannel.NoApp(),
+		Data: pchannel.NoData(),
+	}
+
+	sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
+	require.NotNil(t, sess)
+	pch, _ := newMockPCh()
+	pch.On("State").Return(makeState(t, validOpeningBalInfo, false))
+	chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil)
+	chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
+
+	chInfo, err := sess.OpenCh(context.Background(), validOpeningBalInfo, app, 10)
+	require.NoError(t, err)
+	require.NotZero(t, chInfo)
+
+	t.Run("happy", func(t *testing.T) {
+		gotChs := sess.GetOpenChsWithPeer(peerIDs[0].Alias)
+		require.Len(t, gotChs, 1)
+		assert.Equal(t, chInfo.ChID, gotChs[0].ID())
+	})
+
+	t.Run("no_open_ch_with_peer", func(t *testing.T) {
+		assert.Empty(t, sess.GetOpenChsWithPeer(peerIDs[1].Alias))
+	})
+}
+
 func Test_ProposeCh_CloseSession(t *testing.T) {
 	peerIDs := newPeerIDs(t, uint(2))
 	validOpeningBalInfo := perun.BalInfo{
@@ -766,7 +1012,7 @@ func Test_ProposeCh_CloseSession(t *testing.T) {
 		ch.On("Phase").Return(pchannel.Acting)
 		session, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
 		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(ch, nil)
-		chClient.On("Register", mock.Anything, mock.Anything).Return()
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 		chClient.On("Close", mock.Anything).Return(nil)
 
 		persistedChs, err := session.Close(false)
@@ -875,6 +1121,168 @@ func Test_Session_DeployAssetERC20(t *testing.T) {
 	})
 }
 
+func Test_Session_OnChainBal_Transfer(t *testing.T) {
+	openSession, _, _ := newSessionWMockChClient(t, true)
+	closedSession, _, _ := newSessionWMockChClient(t, false)
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	randomAddr1 := ethereumtest.NewRandomAddress(rng)
+
+	t.Run("happy", func(t *testing.T) {
+		bal, err := openSession.GetOnChainBal(currency.ETHSymbol)
+		require.NoError(t, err)
+		assert.NotZero(t, bal)
+	})
+
+	t.Run("unknown_currency", func(t *testing.T) {
+		_, err := openSession.GetOnChainBal("unknown-currency")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), session.ResTypeCurrency, "unknown-currency")
+
+		_, err = openSession.TransferOnChain("unknown-currency", randomAddr1.String(), "0.5")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), session.ResTypeCurrency, "unknown-currency")
+	})
+
+	t.Run("invalid_address", func(t *testing.T) {
+		_, err := openSession.TransferOnChain(currency.ETHSymbol, "invalid-addr", "0.5")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameAddress, "invalid-addr")
+	})
+
+	t.Run("invalid_amount", func(t *testing.T) {
+		_, err := openSession.TransferOnChain(currency.ETHSymbol, randomAddr1.String(), "abc")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameAmount, "abc")
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		_, err := closedSession.GetOnChainBal(currency.ETHSymbol)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+
+		_, err = closedSession.TransferOnChain(currency.ETHSymbol, randomAddr1.String(), "0.5")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+	})
+}
+
+func Test_Session_GetAllowance_SetAllowance(t *testing.T) {
+	openSession, _, _ := newSessionWMockChClient(t, true)
+	closedSession, _, _ := newSessionWMockChClient(t, false)
+
+	t.Run("not_erc20_currency", func(t *testing.T) {
+		_, err := openSession.GetAllowance(currency.ETHSymbol)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameCurrency, currency.ETHSymbol)
+
+		_, err = openSession.SetAllowance(currency.ETHSymbol, "1")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameCurrency, currency.ETHSymbol)
+	})
+
+	t.Run("unknown_currency", func(t *testing.T) {
+		_, err := openSession.GetAllowance("unknown-currency")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), session.ResTypeCurrency, "unknown-currency")
+
+		_, err = openSession.SetAllowance("unknown-currency", "1")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), session.ResTypeCurrency, "unknown-currency")
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		_, err := closedSession.GetAllowance(currency.ETHSymbol)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+
+		_, err = closedSession.SetAllowance(currency.ETHSymbol, "1")
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+	})
+}
+
+func Test_Session_GetTxCostReport(t *testing.T) {
+	// Use an account in the keystore of the chain setup as the on-chain account of the user,
+	// so that the session can sign and send transactions on the simulated chain.
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	cfg := sessiontest.NewConfigT(t, rng)
+	chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+	cfg.User.OnChainAddr = chainSetup.Accs[0].Address().String()
+	cfg.User.OnChainWallet.KeystorePath = chainSetup.KeystorePath
+	openSession, err := session.NewSessionForTest(cfg, true, &mocks.ChClient{}, chainSetup)
+	require.NoError(t, err)
+	closedSession, _, _ := newSessionWMockChClient(t, false)
+	randomAddr1 := ethereumtest.NewRandomAddress(rng)
+
+	t.Run("happy", func(t *testing.T) {
+		txID, err := openSession.TransferOnChain(currency.ETHSymbol, randomAddr1.String(), "0.5")
+		require.NoError(t, err)
+
+		report, err := openSession.GetTxCostReport()
+		require.NoError(t, err)
+		require.NotEmpty(t, report.TxCosts)
+		txCost := report.TxCosts[len(report.TxCosts)-1]
+		assert.Equal(t, "", txCost.ChID)
+		assert.Equal(t, "transferETH", txCost.TxType)
+		assert.Equal(t, txID, txCost.TxID)
+		assert.EqualValues(t, 21000, txCost.GasUsed)
+		assert.NotZero(t, report.TotalFee)
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		_, err := closedSession.GetTxCostReport()
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+	})
+}
+
+func Test_Session_PayStreams(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(2))
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	pch, watcherSignal := newMockPCh()
+	pch.On("State").Return(makeState(t, openingBalInfo, true))
+	pch.On("Settle", mock.Anything, mock.Anything).Return(nil)
+	pch.On("Phase").Return(pchannel.Withdrawn)
+	pch.On("Close").Return(nil).Run(func(args mock.Arguments) {
+		watcherSignal <- time.Now() // Signal the watcher to return when pch is closed.
+	})
+	sess := newSessionWCh(t, peerIDs, openingBalInfo, pch)
+	chID := sess.GetChsInfo()[0].ChID
+
+	streams := &fakePayStreams{}
+	newCalls := 0
+	newPayStreams := func() perun.PayStreams {
+		newCalls++
+		return streams
+	}
+	assert.Same(t, streams, sess.PayStreams(newPayStreams))
+	assert.Same(t, streams, sess.PayStreams(newPayStreams))
+	assert.Equal(t, 1, newCalls, "streams should be created only on first use")
+
+	// Streams on the channel should be stopped, when it is closed.
+	ch, err := sess.GetCh(chID)
+	require.NoError(t, err)
+	concludedEvent := &pchannel.ConcludedEvent{
+		AdjudicatorEventBase: *pchannel.NewAdjudicatorEventBase(pch.ID(), &pchannel.ElapsedTimeout{}, 1),
+	}
+	ch.(*session.Channel).HandleAdjudicatorEvent(concludedEvent)
+	assert.Equal(t, []string{chID}, streams.stoppedChs)
+
+	// All streams should be stopped, when the session is closed.
+	_, err = sess.Close(false)
+	require.NoError(t, err)
+	assert.True(t, streams.stoppedAll)
+}
+
+// fakePayStreams records the calls to stop the payment streams.
+type fakePayStreams struct {
+	stoppedChs []string
+	stoppedAll bool
+}
+
+func (f *fakePayStreams) StopCh(chID string) { f.stoppedChs = append(f.stoppedChs, chID) }
+
+func (f *fakePayStreams) StopAll() { f.stoppedAll = true }
+
 func newPeerIDs(t *testing.T, n uint) []perun.PeerID {
 	ethereumBackend := ethereumtest.NewTestWalletBackend()
 	// Use same prng for each call.
@@ -953,7 +1361,7 @@ func newSessionWCh(t *testing.T, peerIDs []perun.PeerID, openingBalInfo perun.Ba
 	}
 	session, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
 	chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(ch, nil)
-	chClient.On("Register", mock.Anything, mock.Anything).Return()
+	chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
 	chClient.On("Close", mock.Anything).Return(nil)
 
 	chInfo, err := session.OpenCh(context.Background(), openingBalInfo, app, 10)
@@ -0,0 +1,511 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	pchannel "perun.network/go-perun/channel"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+const (
+	// spendsFile is the name of the file in the database directory, in which the payments made in the last day
+	// are persisted, so that the usage in the time windows of the spending limits is retained across restarts.
+	// Each line in the file is a JSON encoded spendRecord.
+	spendsFile = "spends.jsonl"
+
+	// spendsCompactThreshold is the number of expired records in the spends file, after which it is rewritten
+	// with only the unexpired ones.
+	spendsCompactThreshold = 1000
+
+	// defaultMaxPriceAge is the maximum age of the prices used for converting the limits set in fiat, if it
+	// is not configured.
+	defaultMaxPriceAge = time.Hour
+)
+
+type (
+	// spendingLimiter enforces the spending limits on the payments and the funding of channels. Payments are
+	// recorded (and the usage is tracked) only while at least one limit is set.
+	//
+	// It has its own mutex, as payments are checked with the channel locked.
+	spendingLimiter struct {
+		log.Logger
+
+		filePath   string // Payments are not persisted, if empty.
+		currencies perun.ROCurrencyRegistry
+		prices     perun.PriceSource // For converting the limits set in fiat. These cannot be set, if nil.
+		// Prices older than this are not used and payments to which limits set in fiat apply are not allowed.
+		maxPriceAge time.Duration
+
+		mutex   sync.Mutex
+		limits  []perun.SpendingLimit
+		parsed  map[spendingLimitKey]spendingLimit
+		spends  []spendRecord // Payments made in the last day, in the order of time.
+		expired int           // Number of expired records in the spends file.
+	}
+
+	spendingLimitKey struct {
+		currency, peerAlias string
+	}
+
+	// spendingLimit is the parsed form of perun.SpendingLimit. Fields are nil, if there is no limit.
+	// For limits set in fiat, only the fiat field is set.
+	spendingLimit struct {
+		maxPayment, maxPerHour, maxPerDay, maxChFunding *big.Int
+
+		fiat *fiatSpendingLimit
+	}
+
+	// fiatSpendingLimit is the parsed form of the amounts in a perun.SpendingLimit set in fiat. Fields are nil,
+	// if there is no limit.
+	fiatSpendingLimit struct {
+		maxPayment, maxPerHour, maxPerDay, maxChFunding *decimal.Decimal
+	}
+
+	// spendRecord is the format in which a payment is recorded. Payments that were reserved but not made are
+	// reversed by adding a record with the negated amount.
+	spendRecord struct {
+		PeerAlias string   `json:"peerAlias"`
+		Currency  string   `json:"currency"`
+		Amount    *big.Int `json:"amount"`
+		Time      int64    `json:"time"` // Unix timestamp.
+	}
+)
+
+// loadSpendingLimiter loads the payments made in the last day that are persisted in the file and sets the
+// limits. If the file does not exist, there are no payments. If the file path is empty, the payments are not
+// persisted. Limits can be set in fiat, only if the price source is not nil. If max price age is zero, the
+// default is used.
+func loadSpendingLimiter(filePath string, currencies perun.ROCurrencyRegistry, prices perun.PriceSource,
+	maxPriceAge time.Duration, limits []perun.SpendingLimit) (*spendingLimiter, error) {
+	if maxPriceAge == 0 {
+		maxPriceAge = defaultMaxPriceAge
+	}
+	s := &spendingLimiter{
+		Logger:      log.NewLoggerWithField("component", "spending-limiter"),
+		filePath:    filePath,
+		currencies:  currencies,
+		prices:      prices,
+		maxPriceAge: maxPriceAge,
+	}
+	if apiErr := s.setLimits(limits); apiErr != nil {
+		return nil, apiErr
+	}
+	if filePath == "" {
+		return s, nil
+	}
+	err := loadJSONLines(filePath, s.Logger, func(line []byte) error {
+		var record spendRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return errors.Wrap(err, "decoding entry")
+		}
+		s.spends = append(s.spends, record)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "loading spends file")
+	}
+	s.prune(time.Now().UTC())
+	return s, errors.WithMessage(s.compact(), "compacting spends file")
+}
+
+// setLimits validates and sets the limits, replacing the existing ones.
+func (s *spendingLimiter) setLimits(limits []perun.SpendingLimit) perun.APIError {
+	parsed := make(map[spendingLimitKey]spendingLimit, len(limits))
+	for _, limit := range limits {
+		if !s.currencies.IsRegistered(limit.Currency) {
+			return perun.NewAPIErrResourceNotFound(ResTypeCurrency, limit.Currency)
+		}
+		key := spendingLimitKey{currency: limit.Currency, peerAlias: limit.PeerAlias}
+		if _, ok := parsed[key]; ok {
+			err := errors.New("only one limit can be set for each currency and peer alias")
+			return perun.NewAPIErrInvalidArgument(err, ArgNameSpendingLimits,
+				fmt.Sprintf("%s/%s", limit.Currency, limit.PeerAlias))
+		}
+		var p spendingLimit
+		var apiErr perun.APIError
+		if limit.Fiat == "" {
+			p, apiErr = parseSpendingLimit(s.currencies.Currency(limit.Currency), limit)
+		} else {
+			p.fiat, apiErr = s.parseFiatSpendingLimit(limit)
+		}
+		if apiErr != nil {
+			return apiErr
+		}
+		parsed[key] = p
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.limits = make([]perun.SpendingLimit, len(limits))
+	copy(s.limits, limits)
+	s.parsed = parsed
+	return nil
+}
+
+// parseSpendingLimit parses the amounts in the limit, that are in the currency.
+func parseSpendingLimit(curr perun.Currency, limit perun.SpendingLimit) (spendingLimit, perun.APIError) {
+	var p spendingLimit
+	for _, field := range []struct {
+		value  string
+		parsed **big.Int
+	}{
+		{limit.MaxPayment, &p.maxPayment},
+		{limit.MaxPerHour, &p.maxPerHour},
+		{limit.MaxPerDay, &p.maxPerDay},
+		{limit.MaxChFunding, &p.maxChFunding},
+	} {
+		if field.value == "" {
+			continue
+		}
+		amount, err := curr.Parse(field.value)
+		if err == nil && amount.Sign() <= 0 {
+			err = errors.New("limit should be positive")
+		}
+		if err != nil {
+			return spendingLimit{}, perun.NewAPIErrInvalidArgument(err, ArgNameSpendingLimits, field.value)
+		}
+		*field.parsed = amount
+	}
+	return p, nil
+}
+
+// parseFiatSpendingLimit parses the amounts in the limit, that are in fiat. The fiat should be the one in
+// which the prices are quoted by the price source.
+func (s *spendingLimiter) parseFiatSpendingLimit(limit perun.SpendingLimit) (
+	*fiatSpendingLimit, perun.APIError) {
+	if s.prices == nil {
+		err := errors.New("limits cannot be set in fiat, as no price source is configured")
+		return nil, perun.NewAPIErrInvalidArgument(err, ArgNameSpendingLimits, limit.Fiat)
+	}
+	if limit.Fiat != s.prices.Fiat() {
+		err := errors.Errorf("fiat should be %s, as prices are quoted in it", s.prices.Fiat())
+		return nil, perun.NewAPIErrInvalidArgument(err, ArgNameSpendingLimits, limit.Fiat)
+	}
+	p := &fiatSpendingLimit{}
+	for _, field := range []struct {
+		value  string
+		parsed **decimal.Decimal
+	}{
+		{limit.MaxPayment, &p.maxPayment},
+		{limit.MaxPerHour, &p.maxPerHour},
+		{limit.MaxPerDay, &p.maxPerDay},
+		{limit.MaxChFunding, &p.maxChFunding},
+	} {
+		if field.value == "" {
+			continue
+		}
+		amount, err := decimal.NewFromString(field.value)
+		if err == nil && !amount.IsPositive() {
+			err = errors.New("limit should be positive")
+		}
+		if err != nil {
+			return nil, perun.NewAPIErrInvalidArgument(err, ArgNameSpendingLimits, field.value)
+		}
+		*field.parsed = &amount
+	}
+	return p, nil
+}
+
+// getLimits returns a copy of the limits.
+func (s *spendingLimiter) getLimits() []perun.SpendingLimit {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	limits := make([]perun.SpendingLimit, len(s.limits))
+	copy(limits, s.limits)
+	return limits
+}
+
+// isEnabled returns true, if at least one limit is set.
+func (s *spendingLimiter) isEnabled() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return len(s.parsed) != 0
+}
+
+// limitFor returns the limit for payments to the peer in the currency. Limits set in fiat are converted to the
+// currency at its current price, if the price is not older than the max price age. It should be called with the
+// mutex locked.
+func (s *spendingLimiter) limitFor(curr perun.Currency, peerAlias string, now time.Time) (spendingLimit,
+	perun.APIError) {
+	limit, ok := s.parsed[spendingLimitKey{currency: curr.Symbol(), peerAlias: peerAlias}]
+	if !ok {
+		limit = s.parsed[spendingLimitKey{currency: curr.Symbol()}]
+	}
+	if limit.fiat == nil {
+		return limit, nil
+	}
+
+	var converted spendingLimit
+	for _, field := range []struct {
+		fiatValue *decimal.Decimal
+		converted **big.Int
+	}{
+		{limit.fiat.maxPayment, &converted.maxPayment},
+		{limit.fiat.maxPerHour, &converted.maxPerHour},
+		{limit.fiat.maxPerDay, &converted.maxPerDay},
+		{limit.fiat.maxChFunding, &converted.maxChFunding},
+	} {
+		if field.fiatValue == nil {
+			continue
+		}
+		amount, err := fiatToCurrency(s.prices, curr, *field.fiatValue, now.Add(-s.maxPriceAge))
+		if err != nil {
+			// Payments are not allowed, as the limit cannot be enforced.
+			err = errors.WithMessage(err, "converting spending limit set in fiat")
+			return spendingLimit{}, perun.NewAPIErrUnknownInternal(err)
+		}
+		*field.converted = amount
+	}
+	return converted, nil
+}
+
+// reservePayment checks if the payment of the amount to the peer is within the limits and if so, records it.
+// The returned function should be called to reverse the record, if the payment is not made.
+func (s *spendingLimiter) reservePayment(peerAlias string, curr perun.Currency, amount *big.Int) (
+	release func(), _ perun.APIError) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now().UTC()
+	s.prune(now)
+	limit, apiErr := s.limitFor(curr, peerAlias, now)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if limit.maxPayment != nil && amount.Cmp(limit.maxPayment) > 0 {
+		return nil, perun.NewAPIErrSpendingLimitExceeded(LimitTypeMaxPayment, peerAlias, curr.Symbol(),
+			curr.Print(limit.maxPayment), curr.Print(big.NewInt(0)), curr.Print(amount))
+	}
+	for _, window := range []struct {
+		limitType perun.SpendingLimitType
+		limit     *big.Int
+		duration  time.Duration
+	}{
+		{LimitTypeMaxPerHour, limit.maxPerHour, time.Hour},
+		{LimitTypeMaxPerDay, limit.maxPerDay, 24 * time.Hour},
+	} {
+		if window.limit == nil {
+			continue
+		}
+		usage := s.usage(peerAlias, curr.Symbol(), now.Add(-window.duration))
+		if new(big.Int).Add(usage, amount).Cmp(window.limit) > 0 {
+			return nil, perun.NewAPIErrSpendingLimitExceeded(window.limitType, peerAlias, curr.Symbol(),
+				curr.Print(window.limit), curr.Print(usage), curr.Print(amount))
+		}
+	}
+
+	s.add(spendRecord{PeerAlias: peerAlias, Currency: curr.Symbol(), Amount: amount, Time: now.Unix()})
+	return func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.add(spendRecord{PeerAlias: peerAlias, Currency: curr.Symbol(), Amount: new(big.Int).Neg(amount),
+			Time: now.Unix()})
+	}, nil
+}
+
+// checkChFunding checks if the amounts funded by the user, when opening a channel with the balances, are
+// within the limits. The user and the peer are identified by their positions in the parts.
+func (s *spendingLimiter) checkChFunding(parts []string, currencies []perun.Currency,
+	balances [][]*big.Int) perun.APIError {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ownIdx := 0
+	for i := range parts {
+		if parts[i] == perun.OwnAlias {
+			ownIdx = i
+		}
+	}
+	peerAlias := parts[ownIdx^1] // Logic works only for a two party channel.
+	now := time.Now().UTC()
+	for i, curr := range currencies {
+		limit, apiErr := s.limitFor(curr, peerAlias, now)
+		if apiErr != nil {
+			return apiErr
+		}
+		if limit.maxChFunding != nil && balances[i][ownIdx].Cmp(limit.maxChFunding) > 0 {
+			return perun.NewAPIErrSpendingLimitExceeded(LimitTypeMaxChFunding, peerAlias, curr.Symbol(),
+				curr.Print(limit.maxChFunding), curr.Print(big.NewInt(0)), curr.Print(balances[i][ownIdx]))
+		}
+	}
+	return nil
+}
+
+// usage returns the total amount paid to the peer in the currency since the given time. It should be called
+// with the mutex locked.
+func (s *spendingLimiter) usage(peerAlias, currency string, since time.Time) *big.Int {
+	usage := big.NewInt(0)
+	for _, record := range s.spends {
+		if record.PeerAlias == peerAlias && record.Currency == currency && record.Time > since.Unix() {
+			usage.Add(usage, record.Amount)
+		}
+	}
+	return usage
+}
+
+// add records the payment and persists it. Errors in persisting are only logged, so that a failure to write
+// the file does not block the payments. It should be called with the mutex locked.
+func (s *spendingLimiter) add(record spendRecord) {
+	s.spends = append(s.spends, record)
+	if s.filePath == "" {
+		return
+	}
+	if err := s.persist(record); err != nil {
+		s.Errorf("Persisting payment to %s: %v", record.PeerAlias, err)
+	}
+}
+
+// prune drops the records older than a day and compacts the file, if there are too many expired records in it.
+// It should be called with the mutex locked.
+func (s *spendingLimiter) prune(now time.Time) {
+	cutoff := now.Add(-24 * time.Hour).Unix()
+	i := 0
+	for i < len(s.spends) && s.spends[i].Time <= cutoff {
+		i++
+	}
+	s.spends = s.spends[i:]
+	s.expired += i
+	if s.expired < spendsCompactThreshold {
+		return
+	}
+	if err := s.compact(); err != nil {
+		s.Errorf("Compacting spends file: %v", err)
+	}
+}
+
+// persist appends the record to the file.
+func (s *spendingLimiter) persist(record spendRecord) error {
+	return errors.WithMessage(appendJSONLine(s.filePath, record), "appending to spends file")
+}
+
+// compact rewrites the file with only the records in memory. It should be called with the mutex locked.
+func (s *spendingLimiter) compact() error {
+	if s.filePath == "" || s.expired == 0 {
+		return nil
+	}
+	var data []byte
+	for i := range s.spends {
+		line, err := json.Marshal(s.spends[i])
+		if err != nil {
+			return errors.Wrap(err, "encoding payment")
+		}
+		data = append(append(data, line...), '\n')
+	}
+	if err := writeFileAtomic(s.filePath, data); err != nil {
+		return errors.WithMessage(err, "writing spends file")
+	}
+	s.expired = 0
+	return nil
+}
+
+// reserveSpending checks if the amounts paid to the peer in the update are within the spending limits and if so,
+// records them. The returned function should be called to reverse the records, if the update is not accepted.
+func (ch *Channel) reserveSpending(updater perun.StateUpdater) (release func(), _ perun.APIError) {
+	if ch.spending == nil || !ch.spending.isEnabled() {
+		return func() {}, nil
+	}
+
+	currState := ch.pch.State()
+	proposedState := currState.Clone()
+	if err := updater(proposedState); err != nil {
+		return func() {}, nil // Error will be returned when sending the update.
+	}
+	return ch.reserveSpendingForStates(currState, proposedState)
+}
+
+// reserveSpendingForStates checks if the amounts paid to the peer in the update from the current to the proposed
+// state are within the spending limits and if so, records them. It is used for the updates sent by the user and
+// for those proposed by the peer and accepted by the user. The returned function should be called to reverse
+// the records, if the update is not accepted.
+func (ch *Channel) reserveSpendingForStates(currState, proposedState *pchannel.State) (release func(),
+	_ perun.APIError) {
+	var releases []func()
+	release = func() {
+		for i := range releases {
+			releases[i]()
+		}
+	}
+	if ch.spending == nil || !ch.spending.isEnabled() {
+		return release, nil
+	}
+
+	ownIdx := ch.pch.Idx()
+	peerAlias := ch.parts[ownIdx^1] // Logic works only for a two party channel.
+	for i, curr := range ch.currencies {
+		amount := new(big.Int).Sub(currState.Balances[i][ownIdx], proposedState.Balances[i][ownIdx])
+		if amount.Sign() <= 0 {
+			continue
+		}
+		releaseCurr, apiErr := ch.spending.reservePayment(peerAlias, curr, amount)
+		if apiErr != nil {
+			release()
+			return nil, apiErr
+		}
+		releases = append(releases, releaseCurr)
+	}
+	return release, nil
+}
+
+// SetSpendingLimits replaces the spending limits of the session, that were initially set from the session
+// config, with the given ones. The changes are not persisted and the limits in the session config will be
+// used when the session is opened again.
+//
+// Limits are enforced on all the updates sent, updates accepted and channels opened by the user. Payments are
+// recorded for the rolling time windows only while at least one limit is set.
+//
+// If there is an error, it will be one of the following codes:
+// - ErrFailedPreCondition when the session is closed.
+// - ErrResourceNotFound with ResourceType:"currency" when any of the currencies is not registered.
+// - ErrInvalidArgument with Name:"spendingLimits" when any of the limits is invalid or not positive, or
+// when there are more than one limits for the same currency and peer alias.
+func (s *Session) SetSpendingLimits(limits []perun.SpendingLimit) perun.APIError {
+	s.WithField("method", "SetSpendingLimits").Infof("\nReceived request with params %+v", limits)
+	s.Lock()
+	defer s.Unlock()
+
+	var apiErr perun.APIError
+	defer func() {
+		if apiErr != nil {
+			s.WithFields(perun.APIErrAsMap("SetSpendingLimits", apiErr)).Error(apiErr.Message())
+		}
+	}()
+
+	if !s.isOpen {
+		apiErr = perun.NewAPIErrFailedPreCondition(ErrSessionClosed)
+		return apiErr
+	}
+	apiErr = s.spending.setLimits(limits)
+	return apiErr
+}
+
+// GetSpendingLimits returns the spending limits of the session.
+func (s *Session) GetSpendingLimits() []perun.SpendingLimit {
+	s.WithField("method", "GetSpendingLimits").Info("Received request")
+	return s.spending.getLimits()
+}
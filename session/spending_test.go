@@ -0,0 +1,292 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	pclient "perun.network/go-perun/client"
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/currency/currencytest"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/peruntest"
+	"github.com/hyperledger-labs/perun-node/session"
+	"github.com/hyperledger-labs/perun-node/session/sessiontest"
+)
+
+func Test_Session_SetSpendingLimits(t *testing.T) {
+	sess, _, _ := newSessionWMockChClient(t, true)
+	closedSess, _, _ := newSessionWMockChClient(t, false)
+
+	t.Run("happy", func(t *testing.T) {
+		limits := []perun.SpendingLimit{
+			{Currency: currency.ETHSymbol, MaxPayment: "1", MaxPerDay: "10"},
+			{Currency: currency.ETHSymbol, PeerAlias: "bob", MaxPerHour: "2", MaxChFunding: "5"},
+		}
+		require.NoError(t, sess.SetSpendingLimits(limits))
+		assert.Equal(t, limits, sess.GetSpendingLimits())
+
+		require.NoError(t, sess.SetSpendingLimits(nil))
+		assert.Empty(t, sess.GetSpendingLimits())
+	})
+
+	t.Run("unknown_currency", func(t *testing.T) {
+		err := sess.SetSpendingLimits([]perun.SpendingLimit{{Currency: "unknown", MaxPayment: "1"}})
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrResourceNotFound)
+		peruntest.AssertErrInfoResourceNotFound(t, err.AddInfo(), session.ResTypeCurrency, "unknown")
+	})
+
+	for _, amount := range []string{"abc", "0", "-1"} {
+		amount := amount
+		t.Run("invalid_limit_"+amount, func(t *testing.T) {
+			err := sess.SetSpendingLimits([]perun.SpendingLimit{{Currency: currency.ETHSymbol, MaxPerHour: amount}})
+			peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+			peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameSpendingLimits, amount)
+		})
+	}
+
+	t.Run("repeated_limit", func(t *testing.T) {
+		limit := perun.SpendingLimit{Currency: currency.ETHSymbol, PeerAlias: "bob", MaxPayment: "1"}
+		err := sess.SetSpendingLimits([]perun.SpendingLimit{limit, limit})
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrInvalidArgument)
+		peruntest.AssertErrInfoInvalidArgument(t, err.AddInfo(), session.ArgNameSpendingLimits,
+			currency.ETHSymbol+"/bob")
+	})
+
+	t.Run("session_closed", func(t *testing.T) {
+		err := closedSess.SetSpendingLimits(nil)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrFailedPreCondition)
+	})
+}
+
+func Test_Session_SpendingLimits_Payments(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	peerAlias := peerIDs[0].Alias
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerAlias},
+		Bals:       [][]string{{"10", "10"}},
+	}
+
+	// setup returns a session with a channel, in which the user is at index 0 and all updates are accepted.
+	setup := func(t *testing.T) (*session.Session, perun.ChAPI) {
+		pch, _ := newMockPCh()
+		pch.On("State").Return(makeState(t, openingBalInfo, false))
+		pch.On("Idx").Return(pchannel.Index(0))
+		pch.On("UpdateBy", mock.Anything, mock.Anything).Return(nil)
+		sess := newSessionWCh(t, peerIDs, openingBalInfo, pch)
+		ownPeerID, err := sess.GetPeerID(perun.OwnAlias)
+		require.NoError(t, err)
+		pch.On("Peers").Return([]pwire.Address{ownPeerID.OffChainAddr, peerIDs[0].OffChainAddr})
+		ch, err := sess.GetCh(sess.GetChsInfo()[0].ChID)
+		require.NoError(t, err)
+		return sess, ch
+	}
+	// pay returns an updater that pays the amount (in ETH) to the peer.
+	pay := func(amount string) perun.StateUpdater {
+		return func(state *pchannel.State) error {
+			parsed, err := currencytest.Registry().Currency(currency.ETHSymbol).Parse(amount)
+			if err != nil {
+				return err
+			}
+			state.Balances[0][0] = new(big.Int).Sub(state.Balances[0][0], parsed)
+			state.Balances[0][1] = new(big.Int).Add(state.Balances[0][1], parsed)
+			return nil
+		}
+	}
+
+	t.Run("happy_no_limits", func(t *testing.T) {
+		_, ch := setup(t)
+		_, err := ch.SendChUpdate(context.Background(), pay("5"))
+		require.NoError(t, err)
+	})
+
+	t.Run("max_payment", func(t *testing.T) {
+		sess, ch := setup(t)
+		require.NoError(t, sess.SetSpendingLimits([]perun.SpendingLimit{{Currency: currency.ETHSymbol, MaxPayment: "1"}}))
+
+		_, err := ch.SendChUpdate(context.Background(), pay("1"))
+		require.NoError(t, err)
+		_, err = ch.SendChUpdate(context.Background(), pay("1.5"))
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrSpendingLimitExceeded)
+		peruntest.AssertErrInfoSpendingLimitExceeded(t, err.AddInfo(), session.LimitTypeMaxPayment, peerAlias,
+			currency.ETHSymbol, "1", "0", "1.5")
+	})
+
+	t.Run("max_per_hour_and_day", func(t *testing.T) {
+		sess, ch := setup(t)
+		require.NoError(t, sess.SetSpendingLimits([]perun.SpendingLimit{
+			{Currency: currency.ETHSymbol, MaxPerHour: "5"},
+			{Currency: currency.ETHSymbol, PeerAlias: peerAlias, MaxPerHour: "2", MaxPerDay: "3"},
+		}))
+
+		_, err := ch.SendChUpdate(context.Background(), pay("1.5"))
+		require.NoError(t, err)
+		_, err = ch.SendChUpdate(context.Background(), pay("1"))
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrSpendingLimitExceeded)
+		peruntest.AssertErrInfoSpendingLimitExceeded(t, err.AddInfo(), session.LimitTypeMaxPerHour, peerAlias,
+			currency.ETHSymbol, "2", "1.5", "1")
+
+		// Raising the hourly limit at runtime, allows more payments until the daily limit is reached.
+		require.NoError(t, sess.SetSpendingLimits([]perun.SpendingLimit{
+			{Currency: currency.ETHSymbol, PeerAlias: peerAlias, MaxPerHour: "5", MaxPerDay: "3"},
+		}))
+		_, err = ch.SendChUpdate(context.Background(), pay("1"))
+		require.NoError(t, err)
+		_, err = ch.SendChUpdate(context.Background(), pay("1"))
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrSpendingLimitExceeded)
+		peruntest.AssertErrInfoSpendingLimitExceeded(t, err.AddInfo(), session.LimitTypeMaxPerDay, peerAlias,
+			currency.ETHSymbol, "3", "2.5", "1")
+	})
+
+	t.Run("rejected_update_not_counted", func(t *testing.T) {
+		pch, _ := newMockPCh()
+		pch.On("State").Return(makeState(t, openingBalInfo, false))
+		pch.On("Idx").Return(pchannel.Index(0))
+		pch.On("UpdateBy", mock.Anything, mock.Anything).Return(nil).Once()
+		pch.On("UpdateBy", mock.Anything, mock.Anything).Return(assert.AnError).Once()
+		pch.On("UpdateBy", mock.Anything, mock.Anything).Return(nil)
+		sess := newSessionWCh(t, peerIDs, openingBalInfo, pch)
+		ch, err := sess.GetCh(sess.GetChsInfo()[0].ChID)
+		require.NoError(t, err)
+		require.NoError(t, sess.SetSpendingLimits([]perun.SpendingLimit{{Currency: currency.ETHSymbol, MaxPerHour: "2"}}))
+
+		_, err = ch.SendChUpdate(context.Background(), pay("1"))
+		require.NoError(t, err)
+		_, err = ch.SendChUpdate(context.Background(), pay("1"))
+		require.Error(t, err)
+		_, err = ch.SendChUpdate(context.Background(), pay("1"))
+		require.NoError(t, err)
+	})
+
+	t.Run("accepted_update", func(t *testing.T) {
+		sess, ch := setup(t)
+		require.NoError(t, sess.SetSpendingLimits([]perun.SpendingLimit{
+			{Currency: currency.ETHSymbol, MaxPayment: "1", MaxPerHour: "1.5"},
+		}))
+		// respond handles an update proposed by the peer, in which the user pays the amount, and accepts it.
+		respond := func(t *testing.T, amount string, version uint64) (*mocks.ChUpdateResponder, perun.APIError) {
+			currState := makeState(t, openingBalInfo, false)
+			proposedState := makeState(t, openingBalInfo, false)
+			require.NoError(t, pay(amount)(proposedState))
+			proposedState.Version = version
+			responder := &mocks.ChUpdateResponder{}
+			responder.On("Accept", mock.Anything).Return(nil)
+			responder.On("Reject", mock.Anything, mock.Anything).Return(nil)
+			ch.(*session.Channel).HandleUpdate(currState, pclient.ChannelUpdate{State: proposedState}, responder)
+
+			_, err := ch.RespondChUpdate(context.Background(), fmt.Sprintf("%s_%d", ch.ID(), version), true)
+			return responder, err
+		}
+
+		responder, err := respond(t, "1.5", 1)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrSpendingLimitExceeded)
+		peruntest.AssertErrInfoSpendingLimitExceeded(t, err.AddInfo(), session.LimitTypeMaxPayment, peerAlias,
+			currency.ETHSymbol, "1", "0", "1.5")
+		responder.AssertCalled(t, "Reject", mock.Anything, mock.Anything)
+		responder.AssertNotCalled(t, "Accept", mock.Anything)
+
+		responder, err = respond(t, "1", 2)
+		require.NoError(t, err)
+		responder.AssertCalled(t, "Accept", mock.Anything)
+
+		// Payments in the accepted updates count towards the limits on the updates sent by the user.
+		_, err = ch.SendChUpdate(context.Background(), pay("1"))
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrSpendingLimitExceeded)
+		peruntest.AssertErrInfoSpendingLimitExceeded(t, err.AddInfo(), session.LimitTypeMaxPerHour, peerAlias,
+			currency.ETHSymbol, "1.5", "1", "1")
+	})
+}
+
+func Test_Session_SpendingLimits_Persisted(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"10", "10"}},
+	}
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	cfg := sessiontest.NewConfigT(t, rng, peerIDs...)
+	cfg.SpendingLimits = []perun.SpendingLimit{{Currency: currency.ETHSymbol, MaxPerDay: "1"}}
+	chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+
+	// newSession returns a session, restored from the same database, with a channel that accepts all updates.
+	newSession := func(t *testing.T) perun.ChAPI {
+		chClient := &mocks.ChClient{}
+		pch, _ := newMockPCh()
+		pch.On("State").Return(makeState(t, openingBalInfo, false))
+		pch.On("Idx").Return(pchannel.Index(0))
+		pch.On("UpdateBy", mock.Anything, mock.Anything).Return(nil)
+		chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil)
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
+		sess, err := session.NewSessionForTest(cfg, true, chClient, chainSetup)
+		require.NoError(t, err)
+		app := perun.App{Def: pchannel.NoApp(), Data: pchannel.NoData()}
+		chInfo, err := sess.OpenCh(context.Background(), openingBalInfo, app, 10)
+		require.NoError(t, err)
+		ch, err := sess.GetCh(chInfo.ChID)
+		require.NoError(t, err)
+		return ch
+	}
+	updater := func(state *pchannel.State) error {
+		state.Balances[0][0] = new(big.Int).Sub(state.Balances[0][0], big.NewInt(1e18))
+		state.Balances[0][1] = new(big.Int).Add(state.Balances[0][1], big.NewInt(1e18))
+		return nil
+	}
+
+	_, err := newSession(t).SendChUpdate(context.Background(), updater)
+	require.NoError(t, err)
+
+	_, err = newSession(t).SendChUpdate(context.Background(), updater)
+	peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrSpendingLimitExceeded)
+}
+
+func Test_Session_SpendingLimits_ChFunding(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"2", "10"}},
+	}
+	app := perun.App{Def: pchannel.NoApp(), Data: pchannel.NoData()}
+
+	t.Run("open_ch", func(t *testing.T) {
+		sess, chClient, _ := newSessionWMockChClient(t, true, peerIDs...)
+		chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
+		require.NoError(t, sess.SetSpendingLimits([]perun.SpendingLimit{
+			{Currency: currency.ETHSymbol, PeerAlias: peerIDs[0].Alias, MaxChFunding: "1"},
+		}))
+
+		_, err := sess.OpenCh(context.Background(), openingBalInfo, app, 10)
+		peruntest.AssertAPIError(t, err, perun.ClientError, perun.ErrSpendingLimitExceeded)
+		peruntest.AssertErrInfoSpendingLimitExceeded(t, err.AddInfo(), session.LimitTypeMaxChFunding,
+			peerIDs[0].Alias, currency.ETHSymbol, "1", "0", "2")
+		chClient.AssertNotCalled(t, "ProposeChannel", mock.Anything, mock.Anything)
+	})
+}
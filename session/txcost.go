@@ -0,0 +1,140 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain"
+	"github.com/hyperledger-labs/perun-node/log"
+)
+
+// txCostsFile is the name of the file in the database directory, in which the costs of the on-chain txs sent for
+// the channels are persisted. Each line in the file is a JSON encoded txCostEntry.
+const txCostsFile = "txcosts.jsonl"
+
+type (
+	// txCostStore holds the costs of the on-chain txs sent for the channels in the session and persists them by
+	// appending to a file, so that these are available when the channels are restored.
+	txCostStore struct {
+		log.Logger
+
+		filePath string // Costs are not persisted, if empty.
+
+		mutex sync.Mutex
+		costs map[string][]perun.TxCost // Indexed by channel ID.
+	}
+
+	// txCostEntry is the format in which the cost of a tx is persisted.
+	txCostEntry struct {
+		ChID    string   `json:"chID"`
+		TxType  string   `json:"txType"`
+		TxID    string   `json:"txID"`
+		GasUsed uint64   `json:"gasUsed"`
+		Fee     *big.Int `json:"fee"`
+	}
+)
+
+// loadTxCostStore loads the tx costs persisted in the file. If the file does not exist, the store is empty.
+// If the file path is empty, the costs are not persisted.
+func loadTxCostStore(filePath string) (*txCostStore, error) {
+	s := &txCostStore{
+		Logger:   log.NewLoggerWithField("component", "tx-costs"),
+		filePath: filePath,
+		costs:    make(map[string][]perun.TxCost),
+	}
+	if filePath == "" {
+		return s, nil
+	}
+	err := loadJSONLines(filePath, s.Logger, func(line []byte) error {
+		var entry txCostEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return errors.Wrap(err, "decoding entry")
+		}
+		s.costs[entry.ChID] = append(s.costs[entry.ChID], perun.TxCost{
+			TxType:  entry.TxType,
+			TxID:    entry.TxID,
+			GasUsed: entry.GasUsed,
+			Fee:     entry.Fee,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "loading tx costs file")
+	}
+	return s, nil
+}
+
+// recorder returns a tx cost recorder for the channel, that holds the costs persisted for it and persists the
+// costs recorded into it.
+func (s *txCostStore) recorder(chID string) *blockchain.TxCostRecorder {
+	s.mutex.Lock()
+	costs := make([]perun.TxCost, len(s.costs[chID]))
+	copy(costs, s.costs[chID])
+	s.mutex.Unlock()
+
+	r := blockchain.NewTxCostRecorder(costs...)
+	s.track(chID, r)
+	return r
+}
+
+// track persists the costs recorded into the recorder so far and those that will be recorded into it, as the
+// costs of the channel. It should be called once for each channel.
+func (s *txCostStore) track(chID string, r *blockchain.TxCostRecorder) {
+	persist := func(cost perun.TxCost) {
+		if err := s.add(chID, cost); err != nil {
+			s.Errorf("Persisting cost of tx %s for channel %s: %v", cost.TxID, chID, err)
+		}
+	}
+	s.mutex.Lock()
+	persisted := len(s.costs[chID])
+	s.mutex.Unlock()
+	costs := r.OnRecord(persist)
+	for i := persisted; i < len(costs); i++ {
+		persist(costs[i])
+	}
+}
+
+// add adds the cost to those of the channel and persists it.
+func (s *txCostStore) add(chID string, cost perun.TxCost) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.filePath != "" {
+		if err := s.persist(txCostEntry{
+			ChID:    chID,
+			TxType:  cost.TxType,
+			TxID:    cost.TxID,
+			GasUsed: cost.GasUsed,
+			Fee:     cost.Fee,
+		}); err != nil {
+			return err
+		}
+	}
+	s.costs[chID] = append(s.costs[chID], cost)
+	return nil
+}
+
+// persist appends the entry to the file.
+func (s *txCostStore) persist(entry txCostEntry) error {
+	return errors.WithMessage(appendJSONLine(s.filePath, entry), "appending to tx costs file")
+}
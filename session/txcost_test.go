@@ -0,0 +1,81 @@
+// Copyright (c) 2021 - for information on the respective copyright owner
+// see the NOTICE file and/or the repository at
+// https://github.com/hyperledger-labs/perun-node
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session_test
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pchannel "perun.network/go-perun/channel"
+	pwire "perun.network/go-perun/wire"
+
+	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/blockchain"
+	"github.com/hyperledger-labs/perun-node/blockchain/ethereum/ethereumtest"
+	"github.com/hyperledger-labs/perun-node/currency"
+	"github.com/hyperledger-labs/perun-node/internal/mocks"
+	"github.com/hyperledger-labs/perun-node/session"
+	"github.com/hyperledger-labs/perun-node/session/sessiontest"
+)
+
+func Test_Session_OpenCh_TxCostsPersisted(t *testing.T) {
+	peerIDs := newPeerIDs(t, uint(1))
+	openingBalInfo := perun.BalInfo{
+		Currencies: []string{currency.ETHSymbol},
+		Parts:      []string{perun.OwnAlias, peerIDs[0].Alias},
+		Bals:       [][]string{{"1", "2"}},
+	}
+	app := perun.App{Def: pchannel.NoApp(), Data: pchannel.NoData()}
+	fundingCost := perun.TxCost{TxType: "fund", TxID: "0x1", GasUsed: 21000, Fee: big.NewInt(21000)}
+
+	rng := rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	cfg := sessiontest.NewConfigT(t, rng, peerIDs...)
+	rng = rand.New(rand.NewSource(ethereumtest.RandSeedForTestAccs))
+	chainSetup := ethereumtest.NewSimChainBackendSetup(t, rng, 2)
+
+	pch, _ := newMockPCh()
+	pch.On("State").Return(makeState(t, openingBalInfo, false))
+	chClient := &mocks.ChClient{}
+	chClient.On("ProposeChannel", mock.Anything, mock.Anything).Return(pch, nil).Run(func(args mock.Arguments) {
+		txCosts, ok := blockchain.TxCostRecorderFromContext(args.Get(0).(context.Context))
+		require.True(t, ok)
+		txCosts.Record(fundingCost)
+	})
+	chClient.On("RegisterEndpoints", mock.Anything, mock.Anything).Return()
+	sess, err := session.NewSessionForTest(cfg, true, chClient, chainSetup)
+	require.NoError(t, err)
+	ownPeerID, err := sess.GetPeerID(perun.OwnAlias)
+	require.NoError(t, err)
+	pch.On("Peers").Return([]pwire.Address{ownPeerID.OffChainAddr, peerIDs[0].OffChainAddr})
+
+	chInfo, err := sess.OpenCh(context.Background(), openingBalInfo, app, 10)
+	require.NoError(t, err)
+
+	// Costs of txs sent while opening the channel should be persisted, so that they are available after a restart.
+	gotCosts, err := session.ChTxCosts(cfg.DatabaseDir, chInfo.ChID)
+	require.NoError(t, err)
+	assert.Equal(t, []perun.TxCost{fundingCost}, gotCosts)
+
+	gotCosts, err = session.ChTxCosts(cfg.DatabaseDir, "unknown-channel")
+	require.NoError(t, err)
+	assert.Empty(t, gotCosts)
+}
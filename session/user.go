@@ -19,9 +19,11 @@ package session
 import (
 	"fmt"
 
+	"github.com/pkg/errors"
 	pwallet "perun.network/go-perun/wallet"
 
 	"github.com/hyperledger-labs/perun-node"
+	"github.com/hyperledger-labs/perun-node/idprovider"
 )
 
 // User represents a participant in the off-chain network that uses a session on this node for sending transactions.
@@ -37,6 +39,7 @@ type User struct {
 }
 
 // NewUnlockedUser initializes a user and unlocks onChaina and offChain accounts.
+// The peer ID of the user is signed using the off-chain account.
 func NewUnlockedUser(wb perun.WalletBackend, cfg UserConfig) (User, perun.APIError) {
 	var err error
 	u := User{}
@@ -61,9 +64,21 @@ func NewUnlockedUser(wb perun.WalletBackend, cfg UserConfig) (User, perun.APIErr
 	u.PeerID.Alias = perun.OwnAlias
 	u.PeerID.CommAddr = cfg.CommAddr
 	u.PeerID.CommType = cfg.CommType
+	u.PeerID.AltCommEndpoints = cfg.AltCommEndpoints
 	u.PeerID.OffChainAddr = u.OffChain.Addr
 	u.PeerID.OffChainAddrString = u.OffChain.Addr.String()
 
+	// Sign own peer ID, so that it can be shared with the peers that require signed peer IDs.
+	offChainAcc, err := wb.UnlockAccount(u.OffChain.Wallet, offChainAddr)
+	if err == nil {
+		u.PeerID.Signature, err = idprovider.SignPeerID(u.PeerID, offChainAcc)
+	}
+	if err != nil {
+		err = errors.WithMessage(err, "signing own peer ID")
+		value := fmt.Sprintf("%s, %s", cfg.OffChainWallet.KeystorePath, cfg.OffChainWallet.Password)
+		return User{}, perun.NewAPIErrInvalidConfig(err, "offChainWallet", value)
+	}
+
 	return u, nil
 }
 